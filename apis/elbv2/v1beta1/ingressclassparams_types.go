@@ -42,6 +42,32 @@ const (
 	LoadBalancerSchemeInternetFacing LoadBalancerScheme = "internet-facing"
 )
 
+// +kubebuilder:validation:Enum=None;Auto
+// DriftRemediation is the remediation of drift of load balancer resources from the desired state.
+//
+// * with None, drift is reported only.
+// * with Auto, drift is reported and load balancer resources are reconciled back to the desired state.
+type DriftRemediation string
+
+const (
+	DriftRemediationNone DriftRemediation = "None"
+	DriftRemediationAuto DriftRemediation = "Auto"
+)
+
+// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+// DeletionPolicy is the policy for load balancer resources once they're no longer desired.
+//
+// * with Delete, load balancer resources are deleted.
+// * with Retain, load balancer resources are left in place, and the controller's tracking tags are removed from them.
+// * with Orphan, load balancer resources are left in place as they are.
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
 // SubnetID specifies a subnet ID.
 // +kubebuilder:validation:Pattern=subnet-[0-9a-f]+
 type SubnetID string
@@ -76,6 +102,21 @@ type Tag struct {
 	Value string `json:"value"`
 }
 
+// AssumeRole defines the IAM role to assume to provision resources in another AWS account.
+type AssumeRole struct {
+	// RoleARN is the ARN of the IAM role to assume.
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is the external ID to specify when assuming the IAM role.
+	// +optional
+	ExternalID string `json:"externalID,omitempty"`
+
+	// VpcID is the VPC for the resources in the other AWS account. If unspecified, the VPC of the cluster is used, e.g. when it's shared with the other AWS account.
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+}
+
 // Attributes defines custom attributes on resources.
 type Attribute struct {
 	// The key of the attribute.
@@ -126,6 +167,42 @@ type IngressClassParamsSpec struct {
 	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this IngressClassParams.
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// DriftRemediation specifies the remediation of drift detected for load balancer resources of Ingresses that belong to IngressClass with this IngressClassParams.
+	// drift is only detected when the controller runs with a drift detection interval.
+	// +optional
+	DriftRemediation *DriftRemediation `json:"driftRemediation,omitempty"`
+
+	// DeletionPolicy specifies the policy for load balancer resources of Ingresses that belong to IngressClass with this IngressClassParams, once their IngressGroup is deleted.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AssumeRole specifies the IAM role to assume to provision load balancer resources of Ingresses that belong to IngressClass with this IngressClassParams in another AWS account.
+	// +optional
+	AssumeRole *AssumeRole `json:"assumeRole,omitempty"`
+
+	// WebACL specifies the name of WebACL to provision and associate with the load balancers of Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	WebACL string `json:"webACL,omitempty"`
+
+	// WAFv2ACLArn specifies the ARN of the WAFv2 web ACL for the load balancers of Ingresses that belong to IngressClass with this IngressClassParams.
+	// set it to "none" to disable WAFv2 on these load balancers.
+	// +optional
+	WAFv2ACLArn string `json:"wafv2AclArn,omitempty"`
+
+	// WAFACLID specifies the ID of the WAF Classic web ACL for the load balancers of Ingresses that belong to IngressClass with this IngressClassParams.
+	// set it to "none" to disable WAF Classic on these load balancers.
+	// +optional
+	WAFACLID string `json:"wafAclId,omitempty"`
+
+	// ShieldAdvancedProtection specifies whether to enable AWS Shield Advanced protection for the load balancers of Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	ShieldAdvancedProtection *bool `json:"shieldAdvancedProtection,omitempty"`
+
+	// LoadBalancerConfiguration specifies the LoadBalancerConfiguration for the load balancers of Ingresses that belong to IngressClass with this IngressClassParams.
+	// the settings in IngressClassParams take precedence over the ones in LoadBalancerConfiguration.
+	// +optional
+	LoadBalancerConfiguration *LoadBalancerConfigurationReference `json:"loadBalancerConfiguration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
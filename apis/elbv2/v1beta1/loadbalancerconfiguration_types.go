@@ -0,0 +1,178 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;UDP;TLS;TCP_UDP
+// ListenerProtocol is the protocol of listeners.
+// HTTP and HTTPS are only supported for ALBs, TCP, UDP, TLS and TCP_UDP are only supported for NLBs.
+type ListenerProtocol string
+
+const (
+	ListenerProtocolHTTP   ListenerProtocol = "HTTP"
+	ListenerProtocolHTTPS  ListenerProtocol = "HTTPS"
+	ListenerProtocolTCP    ListenerProtocol = "TCP"
+	ListenerProtocolUDP    ListenerProtocol = "UDP"
+	ListenerProtocolTLS    ListenerProtocol = "TLS"
+	ListenerProtocolTCPUDP ListenerProtocol = "TCP_UDP"
+)
+
+// ListenerConfiguration defines the settings of a listener.
+type ListenerConfiguration struct {
+	// Port is the port of listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Protocol is the protocol of listener.
+	// For ALBs, it's required and defines the listener ports along with Port.
+	// For NLBs, the listeners are defined by Service ports, and it's only needed to use TLS for a Service port.
+	// +optional
+	Protocol *ListenerProtocol `json:"protocol,omitempty"`
+
+	// CertificateARNs is the ARNs of certificates for HTTPS and TLS listeners, the first one is the default certificate.
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// SSLPolicy is the SSL policy for HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+}
+
+// AccessLogsConfiguration defines the access logs of load balancer.
+type AccessLogsConfiguration struct {
+	// Enabled specifies whether access logs are enabled, it defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// S3Bucket is the name of S3 bucket for access logs, it's required if access logs are enabled.
+	// +optional
+	S3Bucket string `json:"s3Bucket,omitempty"`
+
+	// S3Prefix is the prefix of access logs in S3 bucket.
+	// +optional
+	S3Prefix string `json:"s3Prefix,omitempty"`
+}
+
+// LoadBalancerConfigurationSpec defines the desired state of LoadBalancerConfiguration
+type LoadBalancerConfigurationSpec struct {
+	// Scheme defines the scheme of load balancer.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// IPAddressType defines the ip address type of load balancer.
+	// +optional
+	IPAddressType *IPAddressType `json:"ipAddressType,omitempty"`
+
+	// Subnets defines the subnets of load balancer.
+	// +optional
+	Subnets *SubnetSelector `json:"subnets,omitempty"`
+
+	// SecurityGroups defines the IDs or names of frontend security groups of load balancer.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// Listeners defines the settings of listeners.
+	// +optional
+	Listeners []ListenerConfiguration `json:"listeners,omitempty"`
+
+	// LoadBalancerAttributes defines the attributes of load balancer.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// AccessLogs defines the access logs of load balancer, it takes precedence over the access_logs.s3 attributes.
+	// +optional
+	AccessLogs *AccessLogsConfiguration `json:"accessLogs,omitempty"`
+
+	// Tags defines the AWS Tags on resources provisioned for load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// WAFv2ACLArn specifies the ARN of WAFv2 web ACL to associate with ALBs, or none to disassociate.
+	// +optional
+	WAFv2ACLArn string `json:"wafv2AclArn,omitempty"`
+
+	// WAFACLID specifies the ID of WAF Classic web ACL to associate with ALBs, or none to disassociate.
+	// +optional
+	WAFACLID string `json:"wafAclId,omitempty"`
+
+	// ShieldAdvancedProtection specifies whether to enable AWS Shield Advanced protection on ALBs.
+	// +optional
+	ShieldAdvancedProtection *bool `json:"shieldAdvancedProtection,omitempty"`
+}
+
+// LoadBalancerConfigurationReference defines the reference to a LoadBalancerConfiguration.
+type LoadBalancerConfigurationReference struct {
+	// Namespace is the namespace of LoadBalancerConfiguration.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name is the name of LoadBalancerConfiguration.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// LoadBalancerConfigurationUser defines an object that uses the LoadBalancerConfiguration.
+type LoadBalancerConfigurationUser struct {
+	// Kind is the kind of object, one of Ingress, IngressClassParams or Service.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of object, it's empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of object.
+	Name string `json:"name"`
+}
+
+// LoadBalancerConfigurationStatus defines the observed state of LoadBalancerConfiguration
+type LoadBalancerConfigurationStatus struct {
+	// UsedBy is the objects that reference the LoadBalancerConfiguration.
+	// +optional
+	UsedBy []LoadBalancerConfigurationUser `json:"usedBy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.scheme",description="The scheme of load balancer"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerConfiguration is the Schema for the LoadBalancerConfiguration API, it defines the settings of load balancers
+// for Ingresses, IngressGroups and Services that reference it.
+type LoadBalancerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerConfigurationSpec   `json:"spec,omitempty"`
+	Status LoadBalancerConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerConfigurationList contains a list of LoadBalancerConfiguration
+type LoadBalancerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerConfiguration{}, &LoadBalancerConfigurationList{})
+}
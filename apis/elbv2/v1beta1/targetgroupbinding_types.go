@@ -149,6 +149,69 @@ type TargetGroupBindingSpec struct {
 	// VpcID is the VPC of the TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
 	VpcID string `json:"vpcID,omitempty"`
+
+	// IAMRoleARNToAssume is the ARN of the IAM role to assume to access the TargetGroup in another AWS account.
+	// +optional
+	IAMRoleARNToAssume string `json:"iamRoleArnToAssume,omitempty"`
+
+	// AssumeRoleExternalID is the external ID to specify when assuming the IAM role.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
+
+	// MultiClusterTargetGroup denotes that the TargetGroup is shared among multiple clusters, so that only the targets registered by this cluster are managed.
+	// +optional
+	MultiClusterTargetGroup bool `json:"multiClusterTargetGroup,omitempty"`
+}
+
+const (
+	// TargetGroupBindingConditionReady denotes that targets are registered, networking is reconciled and all targets are healthy.
+	TargetGroupBindingConditionReady = "Ready"
+	// TargetGroupBindingConditionTargetsRegistered denotes that targets are registered into the TargetGroup.
+	TargetGroupBindingConditionTargetsRegistered = "TargetsRegistered"
+	// TargetGroupBindingConditionNetworkingReconciled denotes that networking rules to access targets are reconciled.
+	TargetGroupBindingConditionNetworkingReconciled = "NetworkingReconciled"
+)
+
+// TargetHealthSummary is the aggregated health of targets.
+type TargetHealthSummary struct {
+	// Total is the number of targets.
+	Total int32 `json:"total"`
+
+	// Healthy is the number of healthy targets.
+	Healthy int32 `json:"healthy"`
+
+	// Unhealthy is the number of unhealthy targets, including unused and unavailable targets.
+	Unhealthy int32 `json:"unhealthy"`
+
+	// Draining is the number of targets that are being deregistered.
+	Draining int32 `json:"draining"`
+
+	// Initial is the number of targets that are being registered.
+	Initial int32 `json:"initial"`
+
+	// UnhealthyReasons are the distinct reasons of unhealthy targets.
+	// +optional
+	UnhealthyReasons []string `json:"unhealthyReasons,omitempty"`
+}
+
+// TargetStatus is the health of a target.
+type TargetStatus struct {
+	// ID of the target, i.e. the IP address or the instance ID.
+	ID string `json:"id"`
+
+	// Port of the target.
+	Port int64 `json:"port"`
+
+	// State is the health state of the target, e.g. healthy, unhealthy, initial or draining.
+	State string `json:"state"`
+
+	// Reason of the health state.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Description of the health state.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
@@ -156,6 +219,26 @@ type TargetGroupBindingStatus struct {
 	// The generation observed by the TargetGroupBinding controller.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// TargetHealth is the aggregated health of targets of the TargetGroupBinding.
+	// +optional
+	TargetHealth *TargetHealthSummary `json:"targetHealth,omitempty"`
+
+	// Targets is the health of each target of the TargetGroupBinding, up to 100 targets.
+	// targets that aren't healthy are reported first, TargetHealth always counts all targets.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	Targets []TargetStatus `json:"targets,omitempty"`
+
+	// LastRegistrationTime is the last time targets were successfully registered into the TargetGroup.
+	// +optional
+	LastRegistrationTime *metav1.Time `json:"lastRegistrationTime,omitempty"`
+
+	// Conditions of the TargetGroupBinding, i.e. Ready, TargetsRegistered and NetworkingReconciled.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -164,6 +247,9 @@ type TargetGroupBindingStatus struct {
 // +kubebuilder:printcolumn:name="SERVICE-NAME",type="string",JSONPath=".spec.serviceRef.name",description="The Kubernetes Service's name"
 // +kubebuilder:printcolumn:name="SERVICE-PORT",type="string",JSONPath=".spec.serviceRef.port",description="The Kubernetes Service's port"
 // +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The AWS TargetGroup's TargetType"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether targets are registered and healthy"
+// +kubebuilder:printcolumn:name="HEALTHY",type="integer",JSONPath=".status.targetHealth.healthy",description="The number of healthy targets"
+// +kubebuilder:printcolumn:name="UNHEALTHY",type="integer",JSONPath=".status.targetHealth.unhealthy",description="The number of unhealthy targets"
 // +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".spec.targetGroupARN",description="The AWS TargetGroup's Amazon Resource Name",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // TargetGroupBinding is the Schema for the TargetGroupBinding API
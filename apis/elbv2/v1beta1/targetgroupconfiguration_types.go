@@ -0,0 +1,174 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:validation:Enum=HTTP1;HTTP2;GRPC
+// TargetGroupProtocolVersion is the protocol version of ALB TargetGroups.
+type TargetGroupProtocolVersion string
+
+const (
+	TargetGroupProtocolVersionHTTP1 TargetGroupProtocolVersion = "HTTP1"
+	TargetGroupProtocolVersionHTTP2 TargetGroupProtocolVersion = "HTTP2"
+	TargetGroupProtocolVersionGRPC  TargetGroupProtocolVersion = "GRPC"
+)
+
+// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+// TargetGroupHealthCheckProtocol is the protocol of TargetGroup health checks.
+// TCP is only supported for NLB TargetGroups.
+type TargetGroupHealthCheckProtocol string
+
+const (
+	TargetGroupHealthCheckProtocolTCP   TargetGroupHealthCheckProtocol = "TCP"
+	TargetGroupHealthCheckProtocolHTTP  TargetGroupHealthCheckProtocol = "HTTP"
+	TargetGroupHealthCheckProtocolHTTPS TargetGroupHealthCheckProtocol = "HTTPS"
+)
+
+// HealthCheckMatcher defines the codes to use when checking for a successful health check response.
+type HealthCheckMatcher struct {
+	// HTTPCode is the HTTP codes, e.g. 200 or 200-399. It's used unless the protocol version is GRPC.
+	// +optional
+	HTTPCode *string `json:"httpCode,omitempty"`
+
+	// GRPCCode is the gRPC codes, e.g. 0 or 0-99. It's only used if the protocol version is GRPC.
+	// +optional
+	GRPCCode *string `json:"grpcCode,omitempty"`
+}
+
+// TargetGroupHealthCheckConfig defines the health check settings of TargetGroups.
+type TargetGroupHealthCheckConfig struct {
+	// Port is the port for health checks, either traffic-port, a port number or the name of a Service port.
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// Protocol is the protocol for health checks.
+	// +optional
+	Protocol *TargetGroupHealthCheckProtocol `json:"protocol,omitempty"`
+
+	// Path is the destination for HTTP and HTTPS health checks.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Matcher is the codes to use when checking for a successful response from targets.
+	// +optional
+	Matcher *HealthCheckMatcher `json:"matcher,omitempty"`
+
+	// IntervalSeconds is the approximate amount of time, in seconds, between health checks of an individual target.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// TimeoutSeconds is the amount of time, in seconds, during which no response from a target means a failed health check.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of consecutive successful health checks required before considering an unhealthy target healthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of consecutive failed health checks required before considering a target unhealthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+}
+
+// TargetGroupProps defines the settings of TargetGroups for a Service.
+type TargetGroupProps struct {
+	// TargetType is the TargetType of TargetGroups.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// ProtocolVersion is the protocol version of TargetGroups. It's only supported for ALB TargetGroups.
+	// +optional
+	ProtocolVersion *TargetGroupProtocolVersion `json:"protocolVersion,omitempty"`
+
+	// HealthCheckConfig is the health check settings of TargetGroups.
+	// +optional
+	HealthCheckConfig *TargetGroupHealthCheckConfig `json:"healthCheckConfig,omitempty"`
+
+	// TargetGroupAttributes defines the attributes of TargetGroups.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// Tags defines the Tags on TargetGroups.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// TargetGroupPortConfiguration defines the settings of TargetGroups for a port of Service.
+type TargetGroupPortConfiguration struct {
+	// Port is the port of Service, either the port number or name.
+	Port intstr.IntOrString `json:"port"`
+
+	TargetGroupProps `json:",inline"`
+}
+
+// TargetGroupConfigurationServiceReference defines the Service that TargetGroupConfiguration applies to.
+type TargetGroupConfigurationServiceReference struct {
+	// Name is the name of Service in the same namespace as TargetGroupConfiguration.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// TargetGroupConfigurationSpec defines the desired state of TargetGroupConfiguration
+type TargetGroupConfigurationSpec struct {
+	// ServiceRef is the reference to the Service that TargetGroupConfiguration applies to.
+	ServiceRef TargetGroupConfigurationServiceReference `json:"serviceRef"`
+
+	// DefaultConfiguration is the settings of TargetGroups for all ports of Service.
+	// +optional
+	DefaultConfiguration TargetGroupProps `json:"defaultConfiguration,omitempty"`
+
+	// PortConfigurations is the settings of TargetGroups for specific ports of Service, which take precedence over DefaultConfiguration.
+	// +optional
+	PortConfigurations []TargetGroupPortConfiguration `json:"portConfigurations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SERVICE-NAME",type="string",JSONPath=".spec.serviceRef.name",description="The Kubernetes Service's name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TargetGroupConfiguration is the Schema for the TargetGroupConfiguration API, it defines the settings of TargetGroups provisioned for a Service.
+type TargetGroupConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TargetGroupConfigurationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupConfigurationList contains a list of TargetGroupConfiguration
+type TargetGroupConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroupConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetGroupConfiguration{}, &TargetGroupConfigurationList{})
+}
@@ -0,0 +1,233 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=Allow;Block;Count
+// WebACLActionType is the type of action for requests matching a rule of WebACL.
+//
+// * with Allow, requests are forwarded to the load balancer.
+// * with Block, requests are blocked, optionally with a custom response.
+// * with Count, requests are counted, and evaluated against the remaining rules.
+type WebACLActionType string
+
+const (
+	WebACLActionTypeAllow WebACLActionType = "Allow"
+	WebACLActionTypeBlock WebACLActionType = "Block"
+	WebACLActionTypeCount WebACLActionType = "Count"
+)
+
+// +kubebuilder:validation:Enum=None;Count
+// WebACLOverrideActionType is the action that overrides the actions of rules in a rule group.
+//
+// * with None, the actions of rules in the rule group apply.
+// * with Count, requests matching rules in the rule group are counted only.
+type WebACLOverrideActionType string
+
+const (
+	WebACLOverrideActionTypeNone  WebACLOverrideActionType = "None"
+	WebACLOverrideActionTypeCount WebACLOverrideActionType = "Count"
+)
+
+// +kubebuilder:validation:Enum=TEXT_PLAIN;TEXT_HTML;APPLICATION_JSON
+// WebACLResponseContentType is the content type of custom response body.
+type WebACLResponseContentType string
+
+const (
+	WebACLResponseContentTypeTextPlain       WebACLResponseContentType = "TEXT_PLAIN"
+	WebACLResponseContentTypeTextHTML        WebACLResponseContentType = "TEXT_HTML"
+	WebACLResponseContentTypeApplicationJSON WebACLResponseContentType = "APPLICATION_JSON"
+)
+
+// +kubebuilder:validation:Enum=IPV4;IPV6
+// WebACLIPAddressVersion is the IP address version of IP set.
+type WebACLIPAddressVersion string
+
+const (
+	WebACLIPAddressVersionIPV4 WebACLIPAddressVersion = "IPV4"
+	WebACLIPAddressVersionIPV6 WebACLIPAddressVersion = "IPV6"
+)
+
+// WebACLCustomResponse defines the custom response to blocked requests.
+type WebACLCustomResponse struct {
+	// ResponseCode is the HTTP status code to return.
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	ResponseCode int64 `json:"responseCode"`
+
+	// CustomResponseBodyKey references the response body in customResponseBodies of the WebACL.
+	// +optional
+	CustomResponseBodyKey string `json:"customResponseBodyKey,omitempty"`
+}
+
+// WebACLCustomResponseBody defines a body of custom responses.
+type WebACLCustomResponseBody struct {
+	// ContentType is the type of content in the body.
+	ContentType WebACLResponseContentType `json:"contentType"`
+
+	// Content is the body content.
+	// +kubebuilder:validation:MinLength=1
+	Content string `json:"content"`
+}
+
+// WebACLAction defines the action for requests matching a rule of WebACL.
+type WebACLAction struct {
+	// Type is the type of action.
+	Type WebACLActionType `json:"type"`
+
+	// CustomResponse specifies the response to blocked requests, it's only allowed for the Block action.
+	// +optional
+	CustomResponse *WebACLCustomResponse `json:"customResponse,omitempty"`
+}
+
+// WebACLManagedRuleGroup defines a rule that evaluates requests against a managed rule group.
+type WebACLManagedRuleGroup struct {
+	// VendorName is the vendor of rule group, e.g. AWS for AWS Managed Rules.
+	// +kubebuilder:validation:MinLength=1
+	VendorName string `json:"vendorName"`
+
+	// Name is the name of rule group, e.g. AWSManagedRulesCommonRuleSet.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Version is the version of rule group, the default version of vendor is used if unspecified.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ExcludedRules are the rules in the rule group whose matches are counted rather than acted on.
+	// +optional
+	ExcludedRules []string `json:"excludedRules,omitempty"`
+}
+
+// WebACLRateBasedRule defines a rule that matches requests from IP addresses exceeding a rate limit.
+type WebACLRateBasedRule struct {
+	// Limit is the maximum number of requests from an IP address in any 5 minute period.
+	// +kubebuilder:validation:Minimum=100
+	Limit int64 `json:"limit"`
+
+	// ForwardedIPHeader is the header with the IP address of requests, e.g. X-Forwarded-For.
+	// the origin IP address of requests is used if unspecified.
+	// +optional
+	ForwardedIPHeader string `json:"forwardedIPHeader,omitempty"`
+}
+
+// WebACLIPSetRule defines a rule that matches requests from IP addresses in an IP set.
+type WebACLIPSetRule struct {
+	// IPAddressVersion is the IP address version of addresses.
+	// +optional
+	IPAddressVersion WebACLIPAddressVersion `json:"ipAddressVersion,omitempty"`
+
+	// Addresses are the IP addresses in CIDR notation, an IP set is created for them.
+	// Exactly one of this or `arn` must be specified.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+
+	// ARN is the ARN of an existing IP set.
+	// Exactly one of this or `addresses` must be specified.
+	// +optional
+	ARN string `json:"arn,omitempty"`
+}
+
+// WebACLRule defines a rule of WebACL.
+type WebACLRule struct {
+	// Name is the name of rule, which is unique within the WebACL.
+	// +kubebuilder:validation:Pattern=^[\w-]{1,128}$
+	Name string `json:"name"`
+
+	// Priority is the order in which rules are evaluated, rules with lower priority are evaluated first.
+	// +kubebuilder:validation:Minimum=0
+	Priority int64 `json:"priority"`
+
+	// Action is the action for requests matching rateBased or ipSet rule, it defaults to Block.
+	// +optional
+	Action *WebACLAction `json:"action,omitempty"`
+
+	// OverrideAction overrides the actions of rules in managedRuleGroup, it defaults to None.
+	// +optional
+	OverrideAction *WebACLOverrideActionType `json:"overrideAction,omitempty"`
+
+	// ManagedRuleGroup specifies a managed rule group to evaluate requests against.
+	// Exactly one of managedRuleGroup, rateBased or ipSet must be specified.
+	// +optional
+	ManagedRuleGroup *WebACLManagedRuleGroup `json:"managedRuleGroup,omitempty"`
+
+	// RateBased specifies a rate limit of requests from IP addresses.
+	// Exactly one of managedRuleGroup, rateBased or ipSet must be specified.
+	// +optional
+	RateBased *WebACLRateBasedRule `json:"rateBased,omitempty"`
+
+	// IPSet specifies IP addresses to match requests from.
+	// Exactly one of managedRuleGroup, rateBased or ipSet must be specified.
+	// +optional
+	IPSet *WebACLIPSetRule `json:"ipSet,omitempty"`
+}
+
+// WebACLSpec defines the desired state of WebACL
+type WebACLSpec struct {
+	// Description is the description of WebACL.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DefaultAction is the action for requests matching none of the rules, either Allow or Block.
+	DefaultAction WebACLAction `json:"defaultAction"`
+
+	// Rules are the rules to evaluate requests against.
+	// +optional
+	Rules []WebACLRule `json:"rules,omitempty"`
+
+	// CustomResponseBodies are the bodies of custom responses, by the key they're referenced with.
+	// +optional
+	CustomResponseBodies map[string]WebACLCustomResponseBody `json:"customResponseBodies,omitempty"`
+
+	// MetricsEnabled specifies whether to send metrics of WebACL and its rules to CloudWatch, and to sample requests matching them.
+	// it defaults to true.
+	// +optional
+	MetricsEnabled *bool `json:"metricsEnabled,omitempty"`
+
+	// Tags defines list of Tags on the WAFv2 resources provisioned for this WebACL.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="DEFAULT-ACTION",type="string",JSONPath=".spec.defaultAction.type",description="The action for requests matching none of the rules"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// WebACL is the Schema for the WebACL API, it describes a WAFv2 Web ACL provisioned for the ALBs of IngressGroups that reference it.
+type WebACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WebACLSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLList contains a list of WebACL
+type WebACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACL `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WebACL{}, &WebACLList{})
+}
@@ -26,6 +26,41 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogsConfiguration) DeepCopyInto(out *AccessLogsConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogsConfiguration.
+func (in *AccessLogsConfiguration) DeepCopy() *AccessLogsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRole) DeepCopyInto(out *AssumeRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRole.
+func (in *AssumeRole) DeepCopy() *AssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Attribute) DeepCopyInto(out *Attribute) {
 	*out = *in
@@ -41,6 +76,31 @@ func (in *Attribute) DeepCopy() *Attribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckMatcher) DeepCopyInto(out *HealthCheckMatcher) {
+	*out = *in
+	if in.HTTPCode != nil {
+		in, out := &in.HTTPCode, &out.HTTPCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.GRPCCode != nil {
+		in, out := &in.GRPCCode, &out.GRPCCode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckMatcher.
+func (in *HealthCheckMatcher) DeepCopy() *HealthCheckMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
@@ -162,6 +222,31 @@ func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
 		*out = make([]Attribute, len(*in))
 		copy(*out, *in)
 	}
+	if in.DriftRemediation != nil {
+		in, out := &in.DriftRemediation, &out.DriftRemediation
+		*out = new(DriftRemediation)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.AssumeRole != nil {
+		in, out := &in.AssumeRole, &out.AssumeRole
+		*out = new(AssumeRole)
+		**out = **in
+	}
+	if in.ShieldAdvancedProtection != nil {
+		in, out := &in.ShieldAdvancedProtection, &out.ShieldAdvancedProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancerConfiguration != nil {
+		in, out := &in.LoadBalancerConfiguration, &out.LoadBalancerConfiguration
+		*out = new(LoadBalancerConfigurationReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
@@ -189,6 +274,207 @@ func (in *IngressGroup) DeepCopy() *IngressGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerConfiguration) DeepCopyInto(out *ListenerConfiguration) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(ListenerProtocol)
+		**out = **in
+	}
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSLPolicy != nil {
+		in, out := &in.SSLPolicy, &out.SSLPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfiguration.
+func (in *ListenerConfiguration) DeepCopy() *ListenerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfiguration) DeepCopyInto(out *LoadBalancerConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfiguration.
+func (in *LoadBalancerConfiguration) DeepCopy() *LoadBalancerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationList) DeepCopyInto(out *LoadBalancerConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationList.
+func (in *LoadBalancerConfigurationList) DeepCopy() *LoadBalancerConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationReference) DeepCopyInto(out *LoadBalancerConfigurationReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationReference.
+func (in *LoadBalancerConfigurationReference) DeepCopy() *LoadBalancerConfigurationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationSpec) DeepCopyInto(out *LoadBalancerConfigurationSpec) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(IPAddressType)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = new(SubnetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(AccessLogsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShieldAdvancedProtection != nil {
+		in, out := &in.ShieldAdvancedProtection, &out.ShieldAdvancedProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationSpec.
+func (in *LoadBalancerConfigurationSpec) DeepCopy() *LoadBalancerConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationStatus) DeepCopyInto(out *LoadBalancerConfigurationStatus) {
+	*out = *in
+	if in.UsedBy != nil {
+		in, out := &in.UsedBy, &out.UsedBy
+		*out = make([]LoadBalancerConfigurationUser, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationStatus.
+func (in *LoadBalancerConfigurationStatus) DeepCopy() *LoadBalancerConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationUser) DeepCopyInto(out *LoadBalancerConfigurationUser) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationUser.
+func (in *LoadBalancerConfigurationUser) DeepCopy() *LoadBalancerConfigurationUser {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkingIngressRule) DeepCopyInto(out *NetworkingIngressRule) {
 	*out = *in
@@ -475,6 +761,27 @@ func (in *TargetGroupBindingStatus) DeepCopyInto(out *TargetGroupBindingStatus)
 		*out = new(int64)
 		**out = **in
 	}
+	if in.TargetHealth != nil {
+		in, out := &in.TargetHealth, &out.TargetHealth
+		*out = new(TargetHealthSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRegistrationTime != nil {
+		in, out := &in.LastRegistrationTime, &out.LastRegistrationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingStatus.
@@ -486,3 +793,490 @@ func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupConfiguration) DeepCopyInto(out *TargetGroupConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupConfiguration.
+func (in *TargetGroupConfiguration) DeepCopy() *TargetGroupConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupConfigurationList) DeepCopyInto(out *TargetGroupConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetGroupConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupConfigurationList.
+func (in *TargetGroupConfigurationList) DeepCopy() *TargetGroupConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupConfigurationServiceReference) DeepCopyInto(out *TargetGroupConfigurationServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupConfigurationServiceReference.
+func (in *TargetGroupConfigurationServiceReference) DeepCopy() *TargetGroupConfigurationServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupConfigurationServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupConfigurationSpec) DeepCopyInto(out *TargetGroupConfigurationSpec) {
+	*out = *in
+	out.ServiceRef = in.ServiceRef
+	in.DefaultConfiguration.DeepCopyInto(&out.DefaultConfiguration)
+	if in.PortConfigurations != nil {
+		in, out := &in.PortConfigurations, &out.PortConfigurations
+		*out = make([]TargetGroupPortConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupConfigurationSpec.
+func (in *TargetGroupConfigurationSpec) DeepCopy() *TargetGroupConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupHealthCheckConfig) DeepCopyInto(out *TargetGroupHealthCheckConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(TargetGroupHealthCheckProtocol)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Matcher != nil {
+		in, out := &in.Matcher, &out.Matcher
+		*out = new(HealthCheckMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupHealthCheckConfig.
+func (in *TargetGroupHealthCheckConfig) DeepCopy() *TargetGroupHealthCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupHealthCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupPortConfiguration) DeepCopyInto(out *TargetGroupPortConfiguration) {
+	*out = *in
+	out.Port = in.Port
+	in.TargetGroupProps.DeepCopyInto(&out.TargetGroupProps)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupPortConfiguration.
+func (in *TargetGroupPortConfiguration) DeepCopy() *TargetGroupPortConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupPortConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupProps) DeepCopyInto(out *TargetGroupProps) {
+	*out = *in
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetType)
+		**out = **in
+	}
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(TargetGroupProtocolVersion)
+		**out = **in
+	}
+	if in.HealthCheckConfig != nil {
+		in, out := &in.HealthCheckConfig, &out.HealthCheckConfig
+		*out = new(TargetGroupHealthCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupProps.
+func (in *TargetGroupProps) DeepCopy() *TargetGroupProps {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupProps)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetHealthSummary) DeepCopyInto(out *TargetHealthSummary) {
+	*out = *in
+	if in.UnhealthyReasons != nil {
+		in, out := &in.UnhealthyReasons, &out.UnhealthyReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealthSummary.
+func (in *TargetHealthSummary) DeepCopy() *TargetHealthSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetHealthSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACL) DeepCopyInto(out *WebACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACL.
+func (in *WebACL) DeepCopy() *WebACL {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLAction) DeepCopyInto(out *WebACLAction) {
+	*out = *in
+	if in.CustomResponse != nil {
+		in, out := &in.CustomResponse, &out.CustomResponse
+		*out = new(WebACLCustomResponse)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLAction.
+func (in *WebACLAction) DeepCopy() *WebACLAction {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLCustomResponse) DeepCopyInto(out *WebACLCustomResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLCustomResponse.
+func (in *WebACLCustomResponse) DeepCopy() *WebACLCustomResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLCustomResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLCustomResponseBody) DeepCopyInto(out *WebACLCustomResponseBody) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLCustomResponseBody.
+func (in *WebACLCustomResponseBody) DeepCopy() *WebACLCustomResponseBody {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLCustomResponseBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLIPSetRule) DeepCopyInto(out *WebACLIPSetRule) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLIPSetRule.
+func (in *WebACLIPSetRule) DeepCopy() *WebACLIPSetRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLIPSetRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLList) DeepCopyInto(out *WebACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLList.
+func (in *WebACLList) DeepCopy() *WebACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLManagedRuleGroup) DeepCopyInto(out *WebACLManagedRuleGroup) {
+	*out = *in
+	if in.ExcludedRules != nil {
+		in, out := &in.ExcludedRules, &out.ExcludedRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLManagedRuleGroup.
+func (in *WebACLManagedRuleGroup) DeepCopy() *WebACLManagedRuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLManagedRuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLRateBasedRule) DeepCopyInto(out *WebACLRateBasedRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLRateBasedRule.
+func (in *WebACLRateBasedRule) DeepCopy() *WebACLRateBasedRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLRateBasedRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLRule) DeepCopyInto(out *WebACLRule) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(WebACLAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OverrideAction != nil {
+		in, out := &in.OverrideAction, &out.OverrideAction
+		*out = new(WebACLOverrideActionType)
+		**out = **in
+	}
+	if in.ManagedRuleGroup != nil {
+		in, out := &in.ManagedRuleGroup, &out.ManagedRuleGroup
+		*out = new(WebACLManagedRuleGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateBased != nil {
+		in, out := &in.RateBased, &out.RateBased
+		*out = new(WebACLRateBasedRule)
+		**out = **in
+	}
+	if in.IPSet != nil {
+		in, out := &in.IPSet, &out.IPSet
+		*out = new(WebACLIPSetRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLRule.
+func (in *WebACLRule) DeepCopy() *WebACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebACLSpec) DeepCopyInto(out *WebACLSpec) {
+	*out = *in
+	in.DefaultAction.DeepCopyInto(&out.DefaultAction)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]WebACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomResponseBodies != nil {
+		in, out := &in.CustomResponseBodies, &out.CustomResponseBodies
+		*out = make(map[string]WebACLCustomResponseBody, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MetricsEnabled != nil {
+		in, out := &in.MetricsEnabled, &out.MetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebACLSpec.
+func (in *WebACLSpec) DeepCopy() *WebACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
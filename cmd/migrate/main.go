@@ -0,0 +1,174 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// migrate migrates the annotations of Ingresses and Services into LoadBalancerConfiguration and TargetGroupConfiguration objects,
+// and prints the configurations along with the migrated Ingresses and Services as manifests.
+// the model stacks built for Ingresses and Services are verified to be identical before and after migration.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	zapraw "go.uber.org/zap"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/migrate"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	flagFilename         = "filename"
+	flagFromCluster      = "from-cluster"
+	flagFixture          = "fixture"
+	flagNamespace        = "namespace"
+	flagSkipVerification = "skip-verification"
+
+	defaultNamespace = "default"
+
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+	serviceFinalizer        = "service.k8s.aws/resources"
+)
+
+var scheme = k8sruntime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+}
+
+type migrateOptions struct {
+	filenames        []string
+	fromCluster      bool
+	fixturePath      string
+	namespace        string
+	skipVerification bool
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to migrate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	controllerCFG := config.ControllerConfig{
+		AWSConfig: aws.CloudConfig{
+			ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+		},
+		FeatureGates: config.NewFeatureGates(),
+	}
+	var opts migrateOptions
+	fs := pflag.NewFlagSet("migrate", pflag.ExitOnError)
+	controllerCFG.BindFlags(fs)
+	fs.StringSliceVarP(&opts.filenames, flagFilename, "f", nil, "Manifests that contain the Ingresses, Services, IngressClasses and IngressClassParams to migrate")
+	fs.BoolVar(&opts.fromCluster, flagFromCluster, false, "Migrate the Ingresses and Services in cluster instead of manifests")
+	fs.StringVar(&opts.fixturePath, flagFixture, "", "Fixture that contains the AWS resources to satisfy AWS lookups when verifying migration")
+	fs.StringVar(&opts.namespace, flagNamespace, defaultNamespace, "Namespace for namespaced objects that don't specify one in manifests, or the namespace to migrate in cluster")
+	fs.BoolVar(&opts.skipVerification, flagSkipVerification, false, "Skip verifying that model stacks are identical before and after migration")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(opts.filenames) == 0 && !opts.fromCluster {
+		return errors.Errorf("either %v or %v must be specified", flagFilename, flagFromCluster)
+	}
+	if len(opts.filenames) != 0 && opts.fromCluster {
+		return errors.Errorf("%v and %v cannot be specified together", flagFilename, flagFromCluster)
+	}
+	if opts.fixturePath == "" && !opts.skipVerification {
+		return errors.Errorf("%v must be specified unless %v", flagFixture, flagSkipVerification)
+	}
+	if err := controllerCFG.Validate(); err != nil {
+		return err
+	}
+
+	logLevel := zapraw.NewAtomicLevelAt(zapraw.InfoLevel)
+	if controllerCFG.LogLevel == "debug" {
+		logLevel = zapraw.NewAtomicLevelAt(zapraw.DebugLevel)
+	}
+	logger := zap.New(zap.UseDevMode(false), zap.Level(logLevel), zap.WriteTo(os.Stderr))
+
+	ctx := context.Background()
+	var objs []client.Object
+	if opts.fromCluster {
+		// all namespaces are migrated unless namespace is specified explicitly.
+		namespace := ""
+		if fs.Changed(flagNamespace) {
+			namespace = opts.namespace
+		}
+		k8sClient, err := buildClient(controllerCFG.RuntimeConfig)
+		if err != nil {
+			return err
+		}
+		if objs, err = migrate.LoadFromCluster(ctx, k8sClient, namespace); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if objs, err = render.LoadManifests(scheme, opts.filenames); err != nil {
+			return err
+		}
+		render.DefaultNamespace(objs, opts.namespace)
+	}
+
+	serviceUtils := service.NewServiceUtils(annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix), serviceFinalizer,
+		controllerCFG.ServiceConfig.LoadBalancerClass, controllerCFG.FeatureGates)
+	migrator := migrate.NewDefaultMigrator(serviceUtils, logger)
+	result, err := migrator.Migrate(ctx, objs)
+	if err != nil {
+		return err
+	}
+	if !opts.skipVerification {
+		fixture, err := render.LoadFixture(opts.fixturePath)
+		if err != nil {
+			return err
+		}
+		verifier := migrate.NewDefaultVerifier(render.NewDefaultRenderer(scheme, controllerCFG, fixture, logger))
+		if err := verifier.Verify(ctx, objs, result); err != nil {
+			return err
+		}
+	}
+	return migrate.WriteManifests(os.Stdout, scheme, append(append([]client.Object{}, result.Configurations...), result.Objects...))
+}
+
+// buildClient builds the client for cluster per the kubeconfig flag, or the default kubeconfig resolution if it's not specified.
+func buildClient(rtCfg config.RuntimeConfig) (client.Client, error) {
+	var restCFG *rest.Config
+	var err error
+	if rtCfg.KubeConfig == "" {
+		restCFG, err = ctrlconfig.GetConfig()
+	} else {
+		restCFG, err = config.BuildRestConfig(rtCfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.New(restCFG, client.Options{Scheme: scheme})
+}
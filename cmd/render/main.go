@@ -0,0 +1,118 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// render prints the model stacks that the controller would build for Ingresses and Services in manifests,
+// without contacting a cluster or AWS. AWS lookups are satisfied from a fixture file.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	zapraw "go.uber.org/zap"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	flagFilename  = "filename"
+	flagFixture   = "fixture"
+	flagNamespace = "namespace"
+
+	defaultNamespace = "default"
+)
+
+var scheme = k8sruntime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+}
+
+type renderOptions struct {
+	filenames   []string
+	fixturePath string
+	namespace   string
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to render: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	controllerCFG := config.ControllerConfig{
+		AWSConfig: aws.CloudConfig{
+			ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+		},
+		FeatureGates: config.NewFeatureGates(),
+	}
+	var opts renderOptions
+	fs := pflag.NewFlagSet("render", pflag.ExitOnError)
+	controllerCFG.BindFlags(fs)
+	fs.StringSliceVarP(&opts.filenames, flagFilename, "f", nil, "Manifests that contain the Ingresses, Services, IngressClasses and IngressClassParams to render")
+	fs.StringVar(&opts.fixturePath, flagFixture, "", "Fixture that contains the AWS resources to satisfy AWS lookups")
+	fs.StringVar(&opts.namespace, flagNamespace, defaultNamespace, "Namespace for namespaced objects that don't specify one")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(opts.filenames) == 0 {
+		return errors.Errorf("%v must be specified", flagFilename)
+	}
+	if opts.fixturePath == "" {
+		return errors.Errorf("%v must be specified", flagFixture)
+	}
+	if err := controllerCFG.Validate(); err != nil {
+		return err
+	}
+
+	logLevel := zapraw.NewAtomicLevelAt(zapraw.InfoLevel)
+	if controllerCFG.LogLevel == "debug" {
+		logLevel = zapraw.NewAtomicLevelAt(zapraw.DebugLevel)
+	}
+	logger := zap.New(zap.UseDevMode(false), zap.Level(logLevel), zap.WriteTo(os.Stderr))
+
+	fixture, err := render.LoadFixture(opts.fixturePath)
+	if err != nil {
+		return err
+	}
+	objs, err := render.LoadManifests(scheme, opts.filenames)
+	if err != nil {
+		return err
+	}
+	render.DefaultNamespace(objs, opts.namespace)
+
+	renderer := render.NewDefaultRenderer(scheme, controllerCFG, fixture, logger)
+	stacks, err := renderer.Render(context.Background(), objs)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(stacks)
+}
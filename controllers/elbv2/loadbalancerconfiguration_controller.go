@@ -0,0 +1,191 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	lbConfigControllerName  = "loadBalancerConfiguration"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+
+	lbConfigUserKindIngress            = "Ingress"
+	lbConfigUserKindIngressClassParams = "IngressClassParams"
+	lbConfigUserKindService            = "Service"
+)
+
+// NewLoadBalancerConfigurationReconciler constructs new loadBalancerConfigurationReconciler
+func NewLoadBalancerConfigurationReconciler(k8sClient client.Client, logger logr.Logger) *loadBalancerConfigurationReconciler {
+	return &loadBalancerConfigurationReconciler{
+		k8sClient:           k8sClient,
+		ingAnnotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+		svcAnnotationParser: annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+		logger:              logger,
+	}
+}
+
+// loadBalancerConfigurationReconciler reconciles the status of LoadBalancerConfiguration objects,
+// which reports the Ingresses, IngressClassParams and Services that reference it.
+type loadBalancerConfigurationReconciler struct {
+	k8sClient           client.Client
+	ingAnnotationParser annotations.Parser
+	svcAnnotationParser annotations.Parser
+	logger              logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations/status,verbs=update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+func (r *loadBalancerConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.logger.V(1).Info("Reconcile request", "name", req.Name)
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *loadBalancerConfigurationReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	lbConfig := &elbv2api.LoadBalancerConfiguration{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, lbConfig); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !lbConfig.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	usedBy, err := r.buildLoadBalancerConfigurationUsers(ctx, lbConfig)
+	if err != nil {
+		return err
+	}
+	return r.updateLoadBalancerConfigurationStatus(ctx, lbConfig, usedBy)
+}
+
+// buildLoadBalancerConfigurationUsers lists the objects that reference lbConfig, sorted by kind, namespace and name.
+func (r *loadBalancerConfigurationReconciler) buildLoadBalancerConfigurationUsers(ctx context.Context, lbConfig *elbv2api.LoadBalancerConfiguration) ([]elbv2api.LoadBalancerConfigurationUser, error) {
+	var usedBy []elbv2api.LoadBalancerConfigurationUser
+
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList, client.InNamespace(lbConfig.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingresses")
+	}
+	for _, ing := range ingList.Items {
+		if r.lookupAnnotationReference(r.ingAnnotationParser, annotations.IngressSuffixLoadBalancerConfiguration, &ing) == k8s.NamespacedName(lbConfig) {
+			usedBy = append(usedBy, elbv2api.LoadBalancerConfigurationUser{Kind: lbConfigUserKindIngress, Namespace: ing.Namespace, Name: ing.Name})
+		}
+	}
+
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList, client.InNamespace(lbConfig.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list services")
+	}
+	for _, svc := range svcList.Items {
+		if r.lookupAnnotationReference(r.svcAnnotationParser, annotations.SvcLBSuffixLoadBalancerConfiguration, &svc) == k8s.NamespacedName(lbConfig) {
+			usedBy = append(usedBy, elbv2api.LoadBalancerConfigurationUser{Kind: lbConfigUserKindService, Namespace: svc.Namespace, Name: svc.Name})
+		}
+	}
+
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := r.k8sClient.List(ctx, ingClassParamsList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingressClassParams")
+	}
+	for _, ingClassParams := range ingClassParamsList.Items {
+		if lookupIngressClassParamsReference(&ingClassParams) == k8s.NamespacedName(lbConfig) {
+			usedBy = append(usedBy, elbv2api.LoadBalancerConfigurationUser{Kind: lbConfigUserKindIngressClassParams, Name: ingClassParams.Name})
+		}
+	}
+
+	sort.Slice(usedBy, func(i, j int) bool {
+		if usedBy[i].Kind != usedBy[j].Kind {
+			return usedBy[i].Kind < usedBy[j].Kind
+		}
+		if usedBy[i].Namespace != usedBy[j].Namespace {
+			return usedBy[i].Namespace < usedBy[j].Namespace
+		}
+		return usedBy[i].Name < usedBy[j].Name
+	})
+	return usedBy, nil
+}
+
+func (r *loadBalancerConfigurationReconciler) updateLoadBalancerConfigurationStatus(ctx context.Context, lbConfig *elbv2api.LoadBalancerConfiguration,
+	usedBy []elbv2api.LoadBalancerConfigurationUser) error {
+	if equality.Semantic.DeepEqual(lbConfig.Status.UsedBy, usedBy) {
+		return nil
+	}
+	lbConfigOld := lbConfig.DeepCopy()
+	lbConfig.Status.UsedBy = usedBy
+	if err := r.k8sClient.Status().Patch(ctx, lbConfig, client.MergeFrom(lbConfigOld)); err != nil {
+		return errors.Wrapf(err, "failed to update loadBalancerConfiguration status: %v", k8s.NamespacedName(lbConfig))
+	}
+	return nil
+}
+
+// lookupAnnotationReference returns the LoadBalancerConfiguration referenced by annotation on obj, or empty if there is none.
+func (r *loadBalancerConfigurationReconciler) lookupAnnotationReference(annotationParser annotations.Parser, suffix string, obj client.Object) types.NamespacedName {
+	lbConfigName := ""
+	if exists := annotationParser.ParseStringAnnotation(suffix, &lbConfigName, obj.GetAnnotations()); !exists || lbConfigName == "" {
+		return types.NamespacedName{}
+	}
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: lbConfigName}
+}
+
+// lookupIngressClassParamsReference returns the LoadBalancerConfiguration referenced by ingClassParams, or empty if there is none.
+func lookupIngressClassParamsReference(ingClassParams *elbv2api.IngressClassParams) types.NamespacedName {
+	lbConfigRef := ingClassParams.Spec.LoadBalancerConfiguration
+	if lbConfigRef == nil {
+		return types.NamespacedName{}
+	}
+	return types.NamespacedName{Namespace: lbConfigRef.Namespace, Name: lbConfigRef.Name}
+}
+
+func (r *loadBalancerConfigurationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.LoadBalancerConfiguration{}).
+		Named(lbConfigControllerName).
+		Watches(&networking.Ingress{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+			return r.buildReconcileRequests(r.lookupAnnotationReference(r.ingAnnotationParser, annotations.IngressSuffixLoadBalancerConfiguration, obj))
+		})).
+		Watches(&corev1.Service{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+			return r.buildReconcileRequests(r.lookupAnnotationReference(r.svcAnnotationParser, annotations.SvcLBSuffixLoadBalancerConfiguration, obj))
+		})).
+		Watches(&elbv2api.IngressClassParams{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+			return r.buildReconcileRequests(lookupIngressClassParamsReference(obj.(*elbv2api.IngressClassParams)))
+		})).
+		Complete(r)
+}
+
+func (r *loadBalancerConfigurationReconciler) buildReconcileRequests(lbConfigKey types.NamespacedName) []reconcile.Request {
+	if lbConfigKey.Name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: lbConfigKey}}
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_loadBalancerConfigurationReconciler_reconcile(t *testing.T) {
+	lbConfigKey := types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-config"}
+	tests := []struct {
+		name       string
+		lbConfig   *elbv2api.LoadBalancerConfiguration
+		objects    []client.Object
+		wantUsedBy []elbv2api.LoadBalancerConfigurationUser
+	}{
+		{
+			name: "not used by any object",
+			lbConfig: &elbv2api.LoadBalancerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+			},
+			wantUsedBy: nil,
+		},
+		{
+			name: "used by Ingresses, IngressClassParams and Services",
+			lbConfig: &elbv2api.LoadBalancerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+			},
+			objects: []client.Object{
+				&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-b",
+					Annotations: map[string]string{"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config"},
+				}},
+				&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-a",
+					Annotations: map[string]string{"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config"},
+				}},
+				&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-other-config",
+					Annotations: map[string]string{"alb.ingress.kubernetes.io/load-balancer-configuration": "other-config"},
+				}},
+				&networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "other-ns",
+					Name:        "ing-other-ns",
+					Annotations: map[string]string{"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config"},
+				}},
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "svc",
+					Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config"},
+				}},
+				&elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						LoadBalancerConfiguration: &elbv2api.LoadBalancerConfigurationReference{Namespace: "awesome-ns", Name: "awesome-config"},
+					},
+				},
+				&elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-class"},
+				},
+			},
+			wantUsedBy: []elbv2api.LoadBalancerConfigurationUser{
+				{Kind: "Ingress", Namespace: "awesome-ns", Name: "ing-a"},
+				{Kind: "Ingress", Namespace: "awesome-ns", Name: "ing-b"},
+				{Kind: "IngressClassParams", Name: "awesome-class"},
+				{Kind: "Service", Namespace: "awesome-ns", Name: "svc"},
+			},
+		},
+		{
+			name: "stale users are removed",
+			lbConfig: &elbv2api.LoadBalancerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+				Status: elbv2api.LoadBalancerConfigurationStatus{
+					UsedBy: []elbv2api.LoadBalancerConfigurationUser{
+						{Kind: "Service", Namespace: "awesome-ns", Name: "deleted-svc"},
+					},
+				},
+			},
+			wantUsedBy: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				WithStatusSubresource(&elbv2api.LoadBalancerConfiguration{}).
+				WithObjects(append(tt.objects, tt.lbConfig)...).
+				Build()
+			r := NewLoadBalancerConfigurationReconciler(k8sClient, logr.Discard())
+
+			err := r.reconcile(context.Background(), ctrl.Request{NamespacedName: lbConfigKey})
+			assert.NoError(t, err)
+
+			gotLBConfig := &elbv2api.LoadBalancerConfiguration{}
+			assert.NoError(t, k8sClient.Get(context.Background(), lbConfigKey, gotLBConfig))
+			assert.Equal(t, tt.wantUsedBy, gotLBConfig.Status.UsedBy)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	discv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
@@ -86,6 +87,7 @@ type targetGroupBindingReconciler struct {
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch;delete
 
 func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	r.logger.V(1).Info("Reconcile request", "name", req.Name)
@@ -110,14 +112,16 @@ func (r *targetGroupBindingReconciler) reconcileTargetGroupBinding(ctx context.C
 		return err
 	}
 
-	if err := r.tgbResourceManager.Reconcile(ctx, tgb); err != nil {
-		return err
-	}
-
-	if err := r.updateTargetGroupBindingStatus(ctx, tgb); err != nil {
+	tgbOld := tgb.DeepCopy()
+	reconcileErr := r.tgbResourceManager.Reconcile(ctx, tgb)
+	// the status is updated even if reconciliation fails, so that the conditions surface the failure.
+	if err := r.updateTargetGroupBindingStatus(ctx, tgb, tgbOld, reconcileErr == nil); err != nil {
 		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
+	if reconcileErr != nil {
+		return reconcileErr
+	}
 
 	r.eventRecorder.Event(tgb, corev1.EventTypeNormal, k8s.TargetGroupBindingEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
@@ -137,12 +141,16 @@ func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Con
 	return nil
 }
 
-func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if aws.Int64Value(tgb.Status.ObservedGeneration) == tgb.Generation {
+// updateTargetGroupBindingStatus updates the status of tgb with the target health and conditions recorded by the resource manager.
+// the observed generation is only updated once tgb is reconciled successfully.
+func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	tgbOld *elbv2api.TargetGroupBinding, reconciled bool) error {
+	if reconciled {
+		tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	}
+	if equality.Semantic.DeepEqual(tgbOld.Status, tgb.Status) {
 		return nil
 	}
-	tgbOld := tgb.DeepCopy()
-	tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
 	if err := r.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
 		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
 	}
@@ -0,0 +1,78 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NewEnqueueRequestsForGatewayClassEvent constructs new enqueueRequestsForGatewayClassEvent.
+func NewEnqueueRequestsForGatewayClassEvent(gwEventChan chan<- event.TypedGenericEvent[*gwv1.Gateway],
+	k8sClient client.Client, logger logr.Logger) handler.TypedEventHandler[*gwv1.GatewayClass] {
+	return &enqueueRequestsForGatewayClassEvent{
+		gwEventChan: gwEventChan,
+		k8sClient:   k8sClient,
+		logger:      logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*gwv1.GatewayClass] = (*enqueueRequestsForGatewayClassEvent)(nil)
+
+type enqueueRequestsForGatewayClassEvent struct {
+	gwEventChan chan<- event.TypedGenericEvent[*gwv1.Gateway]
+	k8sClient   client.Client
+	logger      logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Create(ctx context.Context, e event.TypedCreateEvent[*gwv1.GatewayClass], _ workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, e.Object)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*gwv1.GatewayClass], _ workqueue.RateLimitingInterface) {
+	gwClassOld := e.ObjectOld
+	gwClassNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. GatewayClass spec updates
+	//	2. GatewayClass deletions
+	if equality.Semantic.DeepEqual(gwClassOld.Spec, gwClassNew.Spec) &&
+		equality.Semantic.DeepEqual(gwClassOld.DeletionTimestamp.IsZero(), gwClassNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedGateways(ctx, gwClassNew)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*gwv1.GatewayClass], _ workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, e.Object)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*gwv1.GatewayClass], _ workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, e.Object)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) enqueueImpactedGateways(ctx context.Context, gwClass *gwv1.GatewayClass) {
+	gwList := &gwv1.GatewayList{}
+	if err := h.k8sClient.List(ctx, gwList); err != nil {
+		h.logger.Error(err, "failed to fetch gateways")
+		return
+	}
+	for index := range gwList.Items {
+		gw := &gwList.Items[index]
+		if string(gw.Spec.GatewayClassName) != gwClass.Name {
+			continue
+		}
+		h.logger.V(1).Info("enqueue gateway for gatewayClass event",
+			"gatewayClass", gwClass.Name,
+			"gateway", k8s.NamespacedName(gw))
+		h.gwEventChan <- event.TypedGenericEvent[*gwv1.Gateway]{
+			Object: gw,
+		}
+	}
+}
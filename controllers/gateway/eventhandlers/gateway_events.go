@@ -0,0 +1,62 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NewEnqueueRequestsForGatewayEvent constructs new enqueueRequestsForGatewayEvent.
+func NewEnqueueRequestsForGatewayEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1.Gateway] {
+	return &enqueueRequestsForGatewayEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*gwv1.Gateway] = (*enqueueRequestsForGatewayEvent)(nil)
+
+type enqueueRequestsForGatewayEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayEvent) Create(ctx context.Context, e event.TypedCreateEvent[*gwv1.Gateway], queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForGatewayEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*gwv1.Gateway], queue workqueue.RateLimitingInterface) {
+	gwOld := e.ObjectOld
+	gwNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. Gateway annotation updates
+	//	2. Gateway spec updates
+	//	3. Gateway deletions
+	if equality.Semantic.DeepEqual(gwOld.Annotations, gwNew.Annotations) &&
+		equality.Semantic.DeepEqual(gwOld.Spec, gwNew.Spec) &&
+		equality.Semantic.DeepEqual(gwOld.DeletionTimestamp.IsZero(), gwNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueGateway(ctx, queue, gwNew)
+}
+
+func (h *enqueueRequestsForGatewayEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*gwv1.Gateway], queue workqueue.RateLimitingInterface) {
+	// we attach a finalizer during reconcile, and handle the user triggered delete action during the update event.
+	// In case of delete, there will first be an update event with nonzero deletionTimestamp set on the object. Since
+	// deletion is already taken care of during update event, we will ignore this event.
+}
+
+func (h *enqueueRequestsForGatewayEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*gwv1.Gateway], queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForGatewayEvent) enqueueGateway(_ context.Context, queue workqueue.RateLimitingInterface, gw *gwv1.Gateway) {
+	h.logger.V(1).Info("enqueue gateway", "gateway", k8s.NamespacedName(gw))
+	queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+}
@@ -0,0 +1,135 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// NewEnqueueRequestsForHTTPRouteEvent constructs new enqueueRequestsForRouteEvent for HTTPRoutes.
+func NewEnqueueRequestsForHTTPRouteEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1.HTTPRoute] {
+	return &enqueueRequestsForRouteEvent[*gwv1.HTTPRoute]{
+		parentRefsFunc: func(route *gwv1.HTTPRoute) []gwv1.ParentReference {
+			return route.Spec.ParentRefs
+		},
+		logger: logger,
+	}
+}
+
+// NewEnqueueRequestsForGRPCRouteEvent constructs new enqueueRequestsForRouteEvent for GRPCRoutes.
+func NewEnqueueRequestsForGRPCRouteEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1.GRPCRoute] {
+	return &enqueueRequestsForRouteEvent[*gwv1.GRPCRoute]{
+		parentRefsFunc: func(route *gwv1.GRPCRoute) []gwv1.ParentReference {
+			return route.Spec.ParentRefs
+		},
+		logger: logger,
+	}
+}
+
+// NewEnqueueRequestsForTCPRouteEvent constructs new enqueueRequestsForRouteEvent for TCPRoutes.
+func NewEnqueueRequestsForTCPRouteEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1alpha2.TCPRoute] {
+	return &enqueueRequestsForRouteEvent[*gwv1alpha2.TCPRoute]{
+		parentRefsFunc: func(route *gwv1alpha2.TCPRoute) []gwv1.ParentReference {
+			return route.Spec.ParentRefs
+		},
+		logger: logger,
+	}
+}
+
+// NewEnqueueRequestsForUDPRouteEvent constructs new enqueueRequestsForRouteEvent for UDPRoutes.
+func NewEnqueueRequestsForUDPRouteEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1alpha2.UDPRoute] {
+	return &enqueueRequestsForRouteEvent[*gwv1alpha2.UDPRoute]{
+		parentRefsFunc: func(route *gwv1alpha2.UDPRoute) []gwv1.ParentReference {
+			return route.Spec.ParentRefs
+		},
+		logger: logger,
+	}
+}
+
+// NewEnqueueRequestsForTLSRouteEvent constructs new enqueueRequestsForRouteEvent for TLSRoutes.
+func NewEnqueueRequestsForTLSRouteEvent(logger logr.Logger) handler.TypedEventHandler[*gwv1alpha2.TLSRoute] {
+	return &enqueueRequestsForRouteEvent[*gwv1alpha2.TLSRoute]{
+		parentRefsFunc: func(route *gwv1alpha2.TLSRoute) []gwv1.ParentReference {
+			return route.Spec.ParentRefs
+		},
+		logger: logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*gwv1.HTTPRoute] = (*enqueueRequestsForRouteEvent[*gwv1.HTTPRoute])(nil)
+
+// enqueueRequestsForRouteEvent enqueues the parent Gateways of routes.
+type enqueueRequestsForRouteEvent[T client.Object] struct {
+	parentRefsFunc func(route T) []gwv1.ParentReference
+	logger         logr.Logger
+}
+
+func (h *enqueueRequestsForRouteEvent[T]) Create(ctx context.Context, e event.TypedCreateEvent[T], queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForRouteEvent[T]) Update(ctx context.Context, e event.TypedUpdateEvent[T], queue workqueue.RateLimitingInterface) {
+	routeOld := e.ObjectOld
+	routeNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. route spec updates
+	//	2. route deletions
+	// route status updates are made by ourselves thus ignored.
+	if routeOld.GetGeneration() == routeNew.GetGeneration() &&
+		equality.Semantic.DeepEqual(routeOld.GetDeletionTimestamp().IsZero(), routeNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+	// parentRefs may change, so Gateways of both old and new route are impacted.
+	h.enqueueParentGateways(ctx, queue, routeOld)
+	h.enqueueParentGateways(ctx, queue, routeNew)
+}
+
+func (h *enqueueRequestsForRouteEvent[T]) Delete(ctx context.Context, e event.TypedDeleteEvent[T], queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForRouteEvent[T]) Generic(ctx context.Context, e event.TypedGenericEvent[T], queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForRouteEvent[T]) enqueueParentGateways(_ context.Context, queue workqueue.RateLimitingInterface, route T) {
+	for _, gwKey := range parentGatewayKeys(route.GetNamespace(), h.parentRefsFunc(route)) {
+		h.logger.V(1).Info("enqueue gateway for route event",
+			"route", k8s.NamespacedName(route),
+			"gateway", gwKey)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
+
+// parentGatewayKeys returns the keys of Gateways referenced by route parentRefs.
+func parentGatewayKeys(routeNamespace string, parentRefs []gwv1.ParentReference) []types.NamespacedName {
+	var gwKeys []types.NamespacedName
+	for _, parentRef := range parentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gwv1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+			continue
+		}
+		gwKey := types.NamespacedName{
+			Namespace: routeNamespace,
+			Name:      string(parentRef.Name),
+		}
+		if parentRef.Namespace != nil {
+			gwKey.Namespace = string(*parentRef.Namespace)
+		}
+		gwKeys = append(gwKeys, gwKey)
+	}
+	return gwKeys
+}
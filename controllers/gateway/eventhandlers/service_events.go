@@ -0,0 +1,114 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
+// Gateways are enqueued when their routes of routeKinds reference the Service.
+func NewEnqueueRequestsForServiceEvent(k8sClient client.Client, routeKinds []gateway.RouteKind, logger logr.Logger) handler.TypedEventHandler[*corev1.Service] {
+	return &enqueueRequestsForServiceEvent{
+		k8sClient:  k8sClient,
+		routeKinds: routeKinds,
+		logger:     logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*corev1.Service] = (*enqueueRequestsForServiceEvent)(nil)
+
+type enqueueRequestsForServiceEvent struct {
+	k8sClient  client.Client
+	routeKinds []gateway.RouteKind
+	logger     logr.Logger
+}
+
+func (h *enqueueRequestsForServiceEvent) Create(ctx context.Context, e event.TypedCreateEvent[*corev1.Service], queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForServiceEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*corev1.Service], queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld
+	svcNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. Service annotation updates
+	//	2. Service spec updates
+	//	3. Service deletions
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) &&
+		equality.Semantic.DeepEqual(svcOld.DeletionTimestamp.IsZero(), svcNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedGateways(ctx, queue, svcNew)
+}
+
+func (h *enqueueRequestsForServiceEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*corev1.Service], queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, queue, e.Object)
+}
+
+func (h *enqueueRequestsForServiceEvent) Generic(ctx context.Context, e event.TypedGenericEvent[*corev1.Service], queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(ctx, queue, e.Object)
+}
+
+// enqueueImpactedGateways enqueues the parent Gateways of routes that reference the Service.
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedGateways(ctx context.Context, queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	for _, kind := range h.routeKinds {
+		routes, err := gateway.ListRoutes(ctx, h.k8sClient, kind)
+		if err != nil {
+			h.logger.Error(err, "failed to fetch routes", "kind", kind)
+			return
+		}
+		for _, route := range routes {
+			var backendRefs []gwv1.BackendRef
+			for _, rule := range route.Rules {
+				backendRefs = append(backendRefs, rule.BackendRefs...)
+			}
+			if isServiceReferenced(svc, route.Object.GetNamespace(), backendRefs) {
+				h.enqueueParentGateways(queue, svc, route.Object, route.ParentRefs)
+			}
+		}
+	}
+}
+
+func (h *enqueueRequestsForServiceEvent) enqueueParentGateways(queue workqueue.RateLimitingInterface, svc *corev1.Service,
+	route client.Object, parentRefs []gwv1.ParentReference) {
+	for _, gwKey := range parentGatewayKeys(route.GetNamespace(), parentRefs) {
+		h.logger.V(1).Info("enqueue gateway for service event",
+			"service", k8s.NamespacedName(svc),
+			"route", k8s.NamespacedName(route),
+			"gateway", gwKey)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
+
+// isServiceReferenced checks whether any of the route backendRefs references the Service.
+func isServiceReferenced(svc *corev1.Service, routeNamespace string, backendRefs []gwv1.BackendRef) bool {
+	for _, backendRef := range backendRefs {
+		if backendRef.Group != nil && *backendRef.Group != "" {
+			continue
+		}
+		if backendRef.Kind != nil && *backendRef.Kind != "Service" {
+			continue
+		}
+		namespace := routeNamespace
+		if backendRef.Namespace != nil {
+			namespace = string(*backendRef.Namespace)
+		}
+		if namespace == svc.Namespace && string(backendRef.Name) == svc.Name {
+			return true
+		}
+	}
+	return false
+}
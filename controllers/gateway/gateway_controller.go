@@ -0,0 +1,373 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// loadBalancerConfig is the configuration of Gateways implemented by a specific type of LoadBalancer.
+type loadBalancerConfig struct {
+	// controllerName of GatewayClasses whose Gateways are implemented by this type of LoadBalancer.
+	gatewayClassControllerName gwv1.GatewayController
+	// route kinds can be attached to listeners of each protocol.
+	supportedKindsByProtocol map[gwv1.ProtocolType][]gateway.RouteKind
+	// finalizer added to Gateways, it must be distinct per LoadBalancer type as a Gateway can move between GatewayClasses.
+	finalizer string
+	// tagPrefix used for tracking resources, it must be distinct per LoadBalancer type so that stacks of the same Gateway don't overlap.
+	tagPrefix string
+	// name of the reconciler.
+	reconcilerName string
+}
+
+var loadBalancerConfigByType = map[elbv2model.LoadBalancerType]loadBalancerConfig{
+	elbv2model.LoadBalancerTypeApplication: {
+		gatewayClassControllerName: gateway.ALBGatewayClassControllerName,
+		supportedKindsByProtocol:   gateway.ALBSupportedKindsByProtocol,
+		finalizer:                  "gateway.k8s.aws/alb",
+		tagPrefix:                  "gateway.k8s.aws.alb",
+		reconcilerName:             "albGateway",
+	},
+	elbv2model.LoadBalancerTypeNetwork: {
+		gatewayClassControllerName: gateway.NLBGatewayClassControllerName,
+		supportedKindsByProtocol:   gateway.NLBSupportedKindsByProtocol,
+		finalizer:                  "gateway.k8s.aws/nlb",
+		tagPrefix:                  "gateway.k8s.aws.nlb",
+		reconcilerName:             "nlbGateway",
+	},
+}
+
+// NewGatewayReconciler constructs new gatewayReconciler for Gateways whose GatewayClass is implemented by LoadBalancers of loadBalancerType.
+func NewGatewayReconciler(loadBalancerType elbv2model.LoadBalancerType, cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
+	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
+	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
+	sgResolver networking.SecurityGroupResolver, logger logr.Logger) *gatewayReconciler {
+
+	lbConfig := loadBalancerConfigByType[loadBalancerType]
+	gatewayLoader := gateway.NewDefaultGatewayLoader(k8sClient, lbConfig.supportedKindsByProtocol, logger)
+	buildComponents := func(controllerConfig config.ControllerConfig) *gatewayComponents {
+		annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixGateway)
+		trackingProvider := tracking.NewDefaultProvider(lbConfig.tagPrefix, controllerConfig.ClusterName)
+		var nlbTargetGroupBuilder service.TargetGroupBuilder
+		if loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
+			// TargetGroups of NLB Gateways are configured the same way as for Services of type LoadBalancer, via the annotations of backend Services.
+			svcAnnotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+			nlbTargetGroupBuilder = service.NewDefaultModelBuilder(k8sClient, svcAnnotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
+				elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+				controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), nil,
+				config.NewDefaultTargetGroupConfigurationLoader(k8sClient), nil, sgResolver, false, controllerConfig.DisableRestrictedSGRules, nil, logger)
+		}
+		return &gatewayComponents{
+			modelBuilder: gateway.NewDefaultModelBuilder(loadBalancerType, nlbTargetGroupBuilder, cloud.ACM(), annotationParser, subnetsResolver, sgResolver,
+				trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+				cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+				controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.DisableRestrictedSGRules,
+				ingress.BuildCertDiscoveryFilters(controllerConfig.IngressConfig), controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger),
+			stackDeployer: deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager,
+				controllerConfig, lbConfig.tagPrefix, logger),
+		}
+	}
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	return &gatewayReconciler{
+		k8sClient:        k8sClient,
+		eventRecorder:    eventRecorder,
+		finalizerManager: finalizerManager,
+		lbConfig:         lbConfig,
+		controllerName:   lbConfig.gatewayClassControllerName,
+
+		gatewayLoader:   gatewayLoader,
+		stackMarshaller: stackMarshaller,
+		buildComponents: buildComponents,
+		components:      buildComponents(controllerConfig),
+		gwEventChan:     make(chan event.TypedGenericEvent[*gwv1.Gateway]),
+		logger:          logger,
+
+		maxConcurrentReconciles: controllerConfig.GatewayMaxConcurrentReconciles,
+	}
+}
+
+// gatewayComponents are the components to build and deploy the model of Gateways, which are rebuilt once the controller configuration is reloaded.
+type gatewayComponents struct {
+	modelBuilder  gateway.ModelBuilder
+	stackDeployer deploy.StackDeployer
+}
+
+type gatewayReconciler struct {
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	finalizerManager k8s.FinalizerManager
+	lbConfig         loadBalancerConfig
+	controllerName   gwv1.GatewayController
+
+	gatewayLoader   gateway.GatewayLoader
+	stackMarshaller deploy.StackMarshaller
+	buildComponents func(controllerConfig config.ControllerConfig) *gatewayComponents
+	gwEventChan     chan event.TypedGenericEvent[*gwv1.Gateway]
+	logger          logr.Logger
+
+	componentsMutex sync.Mutex
+	components      *gatewayComponents
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes;tcproutes;udproutes;tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status;grpcroutes/status;tcproutes/status;udproutes/status;tlsroutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *gatewayReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	gw := &gwv1.Gateway{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, gw); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	gwClass, err := r.loadManagedGatewayClass(ctx, gw)
+	if err != nil {
+		return err
+	}
+	components := r.loadComponents()
+	if gwClass == nil {
+		// the Gateway might have been moved to a GatewayClass of another controller, resources provisioned by us need to be released.
+		stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+		return r.cleanupGatewayResources(ctx, components, gw, stack)
+	}
+
+	loadedGW, err := r.gatewayLoader.Load(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedLoadRoutes, fmt.Sprintf("Failed load routes due to %v", err))
+		return err
+	}
+	stack, lb, err := r.buildModel(ctx, components, loadedGW)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		return r.cleanupGatewayResources(ctx, components, gw, stack)
+	}
+	return r.reconcileGatewayResources(ctx, components, gwClass, loadedGW, stack, lb)
+}
+
+// loadManagedGatewayClass loads the GatewayClass of Gateway, it returns nil if the GatewayClass isn't managed by us.
+func (r *gatewayReconciler) loadManagedGatewayClass(ctx context.Context, gw *gwv1.Gateway) (*gwv1.GatewayClass, error) {
+	gwClass := &gwv1.GatewayClass{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !gateway.IsGatewayClassManaged(gwClass, r.controllerName) {
+		return nil, nil
+	}
+	return gwClass, nil
+}
+
+func (r *gatewayReconciler) buildModel(ctx context.Context, components *gatewayComponents, gw gateway.Gateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack, lb, err := components.modelBuilder.Build(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw.Gateway, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(gw.Gateway, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+	return stack, lb, nil
+}
+
+func (r *gatewayReconciler) deployModel(ctx context.Context, components *gatewayComponents, gw *gwv1.Gateway, stack core.Stack) error {
+	if err := components.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "gateway", k8s.NamespacedName(gw))
+	return nil
+}
+
+func (r *gatewayReconciler) reconcileGatewayResources(ctx context.Context, components *gatewayComponents, gwClass *gwv1.GatewayClass, gw gateway.Gateway,
+	stack core.Stack, lb *elbv2model.LoadBalancer) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, gw.Gateway, r.lbConfig.finalizer); err != nil {
+		r.eventRecorder.Event(gw.Gateway, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, components, gw.Gateway, stack); err != nil {
+		return err
+	}
+	lbDNS, err := lb.DNSName().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.updateGatewayClassStatus(ctx, gwClass); err != nil {
+		return err
+	}
+	if err := r.updateGatewayStatus(ctx, gw, lbDNS); err != nil {
+		r.eventRecorder.Event(gw.Gateway, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if err := r.updateRouteStatuses(ctx, gw); err != nil {
+		r.eventRecorder.Event(gw.Gateway, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update route status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(gw.Gateway, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+func (r *gatewayReconciler) cleanupGatewayResources(ctx context.Context, components *gatewayComponents, gw *gwv1.Gateway, stack core.Stack) error {
+	if k8s.HasFinalizer(gw, r.lbConfig.finalizer) {
+		if err := r.deployModel(ctx, components, gw, stack); err != nil {
+			return err
+		}
+		if err := r.finalizerManager.RemoveFinalizers(ctx, gw, r.lbConfig.finalizer); err != nil {
+			r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(r.lbConfig.reconcilerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	return r.setupWatches(ctx, c, mgr)
+}
+
+func (r *gatewayReconciler) setupWatches(_ context.Context, c controller.Controller, mgr ctrl.Manager) error {
+	routeKinds := r.supportedRouteKinds()
+	gwEventHandler := eventhandlers.NewEnqueueRequestsForGatewayEvent(r.logger.WithName("eventHandlers").WithName("gateway"))
+	gwClassEventHandler := eventhandlers.NewEnqueueRequestsForGatewayClassEvent(r.gwEventChan, r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("gatewayClass"))
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient, routeKinds, r.logger.WithName("eventHandlers").WithName("service"))
+	if err := c.Watch(source.Channel(r.gwEventChan, gwEventHandler)); err != nil {
+		return err
+	}
+	if err := c.Watch(source.Kind(mgr.GetCache(), &gwv1.Gateway{}, gwEventHandler)); err != nil {
+		return err
+	}
+	if err := c.Watch(source.Kind(mgr.GetCache(), &gwv1.GatewayClass{}, gwClassEventHandler)); err != nil {
+		return err
+	}
+	for _, kind := range routeKinds {
+		if err := r.setupRouteWatch(c, mgr, kind); err != nil {
+			return err
+		}
+	}
+	if err := c.Watch(source.Kind(mgr.GetCache(), &corev1.Service{}, svcEventHandler)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) setupRouteWatch(c controller.Controller, mgr ctrl.Manager, kind gateway.RouteKind) error {
+	routeLogger := r.logger.WithName("eventHandlers").WithName(string(kind))
+	switch kind {
+	case gateway.RouteKindHTTPRoute:
+		return c.Watch(source.Kind(mgr.GetCache(), &gwv1.HTTPRoute{}, eventhandlers.NewEnqueueRequestsForHTTPRouteEvent(routeLogger)))
+	case gateway.RouteKindGRPCRoute:
+		return c.Watch(source.Kind(mgr.GetCache(), &gwv1.GRPCRoute{}, eventhandlers.NewEnqueueRequestsForGRPCRouteEvent(routeLogger)))
+	case gateway.RouteKindTCPRoute:
+		return c.Watch(source.Kind(mgr.GetCache(), &gwv1alpha2.TCPRoute{}, eventhandlers.NewEnqueueRequestsForTCPRouteEvent(routeLogger)))
+	case gateway.RouteKindUDPRoute:
+		return c.Watch(source.Kind(mgr.GetCache(), &gwv1alpha2.UDPRoute{}, eventhandlers.NewEnqueueRequestsForUDPRouteEvent(routeLogger)))
+	case gateway.RouteKindTLSRoute:
+		return c.Watch(source.Kind(mgr.GetCache(), &gwv1alpha2.TLSRoute{}, eventhandlers.NewEnqueueRequestsForTLSRouteEvent(routeLogger)))
+	default:
+		return errors.Errorf("unsupported route kind: %v", kind)
+	}
+}
+
+// supportedRouteKinds returns the route kinds supported by any listener protocol, in a stable order.
+func (r *gatewayReconciler) supportedRouteKinds() []gateway.RouteKind {
+	kindSet := sets.New[gateway.RouteKind]()
+	for _, kinds := range r.lbConfig.supportedKindsByProtocol {
+		kindSet.Insert(kinds...)
+	}
+	return sets.List(kindSet)
+}
+
+// loadComponents returns the components to build and deploy the model of Gateways with the current controller configuration.
+func (r *gatewayReconciler) loadComponents() *gatewayComponents {
+	r.componentsMutex.Lock()
+	defer r.componentsMutex.Unlock()
+	return r.components
+}
+
+// ReloadControllerConfig applies the reloaded controller configuration, by rebuilding the gatewayComponents with it
+// and enqueueing the Gateways of managed GatewayClasses for reconcile.
+func (r *gatewayReconciler) ReloadControllerConfig(ctx context.Context, controllerConfig config.ControllerConfig) error {
+	r.componentsMutex.Lock()
+	r.components = r.buildComponents(controllerConfig)
+	r.componentsMutex.Unlock()
+
+	gwList := &gwv1.GatewayList{}
+	if err := r.k8sClient.List(ctx, gwList); err != nil {
+		return errors.Wrap(err, "failed to list Gateways")
+	}
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		gwClass, err := r.loadManagedGatewayClass(ctx, gw)
+		if err != nil {
+			return err
+		}
+		if gwClass == nil {
+			continue
+		}
+		select {
+		case r.gwEventChan <- event.TypedGenericEvent[*gwv1.Gateway]{Object: gw}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
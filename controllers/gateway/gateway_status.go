@@ -0,0 +1,248 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func (r *gatewayReconciler) updateGatewayClassStatus(ctx context.Context, gwClass *gwv1.GatewayClass) error {
+	gwClassOld := gwClass.DeepCopy()
+	meta.SetStatusCondition(&gwClass.Status.Conditions, metav1.Condition{
+		Type:               string(gwv1.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayClassReasonAccepted),
+		Message:            "GatewayClass is accepted",
+		ObservedGeneration: gwClass.Generation,
+	})
+	if equality.Semantic.DeepEqual(gwClassOld.Status, gwClass.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gwClass, client.MergeFrom(gwClassOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gatewayClass status: %v", gwClass.Name)
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, gw gateway.Gateway, lbDNS string) error {
+	gwOld := gw.Gateway.DeepCopy()
+	status := &gw.Gateway.Status
+	hostnameAddressType := gwv1.HostnameAddressType
+	status.Addresses = []gwv1.GatewayStatusAddress{
+		{
+			Type:  &hostnameAddressType,
+			Value: lbDNS,
+		},
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayReasonAccepted),
+		Message:            "Gateway is accepted",
+		ObservedGeneration: gw.Gateway.Generation,
+	})
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1.GatewayReasonProgrammed),
+		Message:            "Gateway is programmed",
+		ObservedGeneration: gw.Gateway.Generation,
+	})
+	status.Listeners = buildListenerStatuses(gw, status.Listeners)
+	if equality.Semantic.DeepEqual(gwOld.Status, gw.Gateway.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw.Gateway, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gateway status: %v", k8s.NamespacedName(gw.Gateway))
+	}
+	return nil
+}
+
+// buildListenerStatuses builds the status of each Gateway listener, conditions are carried over from existing listener statuses.
+func buildListenerStatuses(gw gateway.Gateway, existingStatuses []gwv1.ListenerStatus) []gwv1.ListenerStatus {
+	listenerStatuses := make([]gwv1.ListenerStatus, 0, len(gw.Listeners))
+	for _, ls := range gw.Listeners {
+		var conditions []metav1.Condition
+		for _, existingStatus := range existingStatuses {
+			if existingStatus.Name == ls.Name {
+				conditions = existingStatus.Conditions
+				break
+			}
+		}
+		supportedKinds := make([]gwv1.RouteGroupKind, 0, len(ls.SupportedKinds))
+		for _, kind := range ls.SupportedKinds {
+			group := gwv1.Group(gwv1.GroupName)
+			supportedKinds = append(supportedKinds, gwv1.RouteGroupKind{
+				Group: &group,
+				Kind:  gwv1.Kind(kind),
+			})
+		}
+
+		if len(ls.SupportedKinds) == 0 {
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionAccepted),
+				Status:             metav1.ConditionFalse,
+				Reason:             string(gwv1.ListenerReasonUnsupportedProtocol),
+				Message:            "Listener protocol or allowed route kinds are not supported",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionResolvedRefs),
+				Status:             metav1.ConditionFalse,
+				Reason:             string(gwv1.ListenerReasonInvalidRouteKinds),
+				Message:            "Listener doesn't support any of the allowed route kinds",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionProgrammed),
+				Status:             metav1.ConditionFalse,
+				Reason:             string(gwv1.ListenerReasonInvalid),
+				Message:            "Listener is not programmed",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+		} else {
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionAccepted),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(gwv1.ListenerReasonAccepted),
+				Message:            "Listener is accepted",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionResolvedRefs),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(gwv1.ListenerReasonResolvedRefs),
+				Message:            "Listener references are resolved",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+			meta.SetStatusCondition(&conditions, metav1.Condition{
+				Type:               string(gwv1.ListenerConditionProgrammed),
+				Status:             metav1.ConditionTrue,
+				Reason:             string(gwv1.ListenerReasonProgrammed),
+				Message:            "Listener is programmed",
+				ObservedGeneration: gw.Gateway.Generation,
+			})
+		}
+		listenerStatuses = append(listenerStatuses, gwv1.ListenerStatus{
+			Name:           ls.Name,
+			SupportedKinds: supportedKinds,
+			AttachedRoutes: int32(len(ls.Routes)),
+			Conditions:     conditions,
+		})
+	}
+	return listenerStatuses
+}
+
+// updateRouteStatuses updates the parent statuses of routes that refer to the Gateway.
+func (r *gatewayReconciler) updateRouteStatuses(ctx context.Context, gw gateway.Gateway) error {
+	var routes []*gateway.Route
+	routeParentsByRoute := make(map[*gateway.Route][]gateway.RouteParent)
+	for _, routeParent := range gw.RouteParents {
+		if _, exists := routeParentsByRoute[routeParent.Route]; !exists {
+			routes = append(routes, routeParent.Route)
+		}
+		routeParentsByRoute[routeParent.Route] = append(routeParentsByRoute[routeParent.Route], routeParent)
+	}
+	for _, route := range routes {
+		if err := r.updateRouteStatus(ctx, gw, route, routeParentsByRoute[route]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateRouteStatus(ctx context.Context, gw gateway.Gateway, route *gateway.Route, routeParents []gateway.RouteParent) error {
+	routeOld := route.Object.DeepCopyObject().(client.Object)
+	routeStatus, err := gateway.RouteStatus(route.Object)
+	if err != nil {
+		return err
+	}
+	routeStatusOld := routeStatus.DeepCopy()
+
+	// parent statuses of other controllers or other Gateways are kept untouched.
+	var parentStatuses []gwv1.RouteParentStatus
+	existingParentStatusByKey := make(map[string]gwv1.RouteParentStatus)
+	for _, parentStatus := range routeStatus.Parents {
+		if parentStatus.ControllerName != r.controllerName || !isParentRefToGateway(route.Object.GetNamespace(), parentStatus.ParentRef, gw.Gateway) {
+			parentStatuses = append(parentStatuses, parentStatus)
+			continue
+		}
+		existingParentStatusByKey[parentRefKey(parentStatus.ParentRef)] = parentStatus
+	}
+	for _, routeParent := range routeParents {
+		conditions := existingParentStatusByKey[parentRefKey(routeParent.ParentRef)].Conditions
+		acceptedStatus := metav1.ConditionFalse
+		if routeParent.Accepted {
+			acceptedStatus = metav1.ConditionTrue
+		}
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               string(gwv1.RouteConditionAccepted),
+			Status:             acceptedStatus,
+			Reason:             string(routeParent.Reason),
+			Message:            routeParent.Message,
+			ObservedGeneration: route.Object.GetGeneration(),
+		})
+		resolvedRefsCondition := metav1.Condition{
+			Type:               string(gwv1.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1.RouteReasonResolvedRefs),
+			Message:            "All references are resolved",
+			ObservedGeneration: route.Object.GetGeneration(),
+		}
+		if route.UnresolvedRefsReason != "" {
+			resolvedRefsCondition.Status = metav1.ConditionFalse
+			resolvedRefsCondition.Reason = string(route.UnresolvedRefsReason)
+			resolvedRefsCondition.Message = route.UnresolvedRefsMessage
+		}
+		meta.SetStatusCondition(&conditions, resolvedRefsCondition)
+		parentStatuses = append(parentStatuses, gwv1.RouteParentStatus{
+			ParentRef:      routeParent.ParentRef,
+			ControllerName: r.controllerName,
+			Conditions:     conditions,
+		})
+	}
+	routeStatus.Parents = parentStatuses
+	if equality.Semantic.DeepEqual(routeStatusOld, routeStatus) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, route.Object, client.MergeFrom(routeOld)); err != nil {
+		return errors.Wrapf(err, "failed to update %v status: %v", route.Kind, k8s.NamespacedName(route.Object))
+	}
+	return nil
+}
+
+// isParentRefToGateway checks whether the parentRef of route in routeNamespace refers to the Gateway.
+func isParentRefToGateway(routeNamespace string, parentRef gwv1.ParentReference, gw *gwv1.Gateway) bool {
+	if parentRef.Group != nil && *parentRef.Group != gwv1.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+		return false
+	}
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	return namespace == gw.Namespace && string(parentRef.Name) == gw.Name
+}
+
+// parentRefKey computes an unique key for parentRef of the same Gateway.
+func parentRefKey(parentRef gwv1.ParentReference) string {
+	sectionName := ""
+	if parentRef.SectionName != nil {
+		sectionName = string(*parentRef.SectionName)
+	}
+	port := ""
+	if parentRef.Port != nil {
+		port = fmt.Sprintf("%v", *parentRef.Port)
+	}
+	return fmt.Sprintf("%v/%v", sectionName, port)
+}
@@ -0,0 +1,78 @@
+package ingress
+
+import (
+	"context"
+
+	networking "k8s.io/api/networking/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certmonitor"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// accountComponents are the components to build and deploy the model of IngressGroups through the clients of an AWS account.
+type accountComponents struct {
+	// assumeRole is the IAM role assumed for the AWS account, nil for the controller's own AWS account.
+	assumeRole    *aws.AssumeRoleConfig
+	modelBuilder  ingress.ModelBuilder
+	stackDeployer deploy.StackDeployer
+	// driftStackPlanner plans stacks without the cache of AWS Describe calls, so that drift is detected from the current state of resources.
+	driftStackPlanner drift.StackPlanner
+	backendSGProvider networkingpkg.BackendSGProvider
+	// tlsSecretCertImporter imports the TLS secrets of Ingress TLS blocks into ACM, nil if TLS secrets import is disabled.
+	tlsSecretCertImporter ingress.TLSSecretCertImporter
+	certDescriber         certmonitor.CertificateDescriber
+}
+
+// accountComponentsForIngressGroup returns the accountComponents for the AWS account that LoadBalancer resources of ingGroup are provisioned in.
+// the components for an assumed IAM role are built on first use, with networking components of their own for the VPC in that AWS account.
+func (r *groupReconciler) accountComponentsForIngressGroup(ctx context.Context, ingGroup ingress.Group) (*accountComponents, error) {
+	assumeRole, err := ingress.BuildAssumeRoleConfig(ctx, r.classLoader, ingGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	r.accountComponentsMutex.Lock()
+	defer r.accountComponentsMutex.Unlock()
+	if assumeRole == nil {
+		return r.defaultAccountComponents, nil
+	}
+	if components, exists := r.assumedRoleAccountComponents[*assumeRole]; exists {
+		return components, nil
+	}
+	cloud, err := r.cloudProvider.CloudForRole(assumeRole)
+	if err != nil {
+		return nil, err
+	}
+	components := r.buildAssumedRoleAccountComponents(r.controllerConfig, cloud, assumeRole)
+	r.assumedRoleAccountComponents[*assumeRole] = components
+	return components, nil
+}
+
+// recordDeployedAssumeRole records the IAM role of components on the member Ingresses of ingGroup, before their LoadBalancer resources are deployed with it.
+func (r *groupReconciler) recordDeployedAssumeRole(ctx context.Context, components *accountComponents, ingGroup ingress.Group) error {
+	rawAssumeRole := aws.MarshalAssumeRoleConfig(components.assumeRole)
+	for _, member := range ingGroup.Members {
+		if err := k8s.PatchAnnotation(ctx, r.k8sClient, member.Ing, ingress.AnnotationDeployedIAMRole, &rawAssumeRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forgetDeployedAssumeRole removes the recorded IAM role from the inactive member Ingresses of ingGroup, once they've left ingGroup.
+// deleted Ingresses are left as is.
+func (r *groupReconciler) forgetDeployedAssumeRole(ctx context.Context, inactiveMembers []*networking.Ingress) error {
+	for _, ing := range inactiveMembers {
+		if !ing.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := k8s.PatchAnnotation(ctx, r.k8sClient, ing, ingress.AnnotationDeployedIAMRole, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForTargetGroupConfigurationEvent constructs new enqueueRequestsForTargetGroupConfigurationEvent.
+func NewEnqueueRequestsForTargetGroupConfigurationEvent(ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress],
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*elbv2api.TargetGroupConfiguration] {
+	return &enqueueRequestsForTargetGroupConfigurationEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*elbv2api.TargetGroupConfiguration] = (*enqueueRequestsForTargetGroupConfigurationEvent)(nil)
+
+type enqueueRequestsForTargetGroupConfigurationEvent struct {
+	ingEventChan  chan<- event.TypedGenericEvent[*networking.Ingress]
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Create(ctx context.Context, e event.TypedCreateEvent[*elbv2api.TargetGroupConfiguration], _ workqueue.RateLimitingInterface) {
+	tgConfigNew := e.Object
+	h.enqueueImpactedIngresses(ctx, tgConfigNew)
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2api.TargetGroupConfiguration], _ workqueue.RateLimitingInterface) {
+	tgConfigOld := e.ObjectOld
+	tgConfigNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. TargetGroupConfiguration spec updates
+	//	2. TargetGroupConfiguration deletions
+	if equality.Semantic.DeepEqual(tgConfigOld.Spec, tgConfigNew.Spec) &&
+		equality.Semantic.DeepEqual(tgConfigOld.DeletionTimestamp.IsZero(), tgConfigNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	// Ingresses of the previously referenced Service need to be reconciled as well if serviceRef is changed.
+	if tgConfigOld.Spec.ServiceRef.Name != tgConfigNew.Spec.ServiceRef.Name {
+		h.enqueueImpactedIngresses(ctx, tgConfigOld)
+	}
+	h.enqueueImpactedIngresses(ctx, tgConfigNew)
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*elbv2api.TargetGroupConfiguration], _ workqueue.RateLimitingInterface) {
+	tgConfigOld := e.Object
+	h.enqueueImpactedIngresses(ctx, tgConfigOld)
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Generic(context.Context, event.TypedGenericEvent[*elbv2api.TargetGroupConfiguration], workqueue.RateLimitingInterface) {
+	// we don't have any generic event for targetGroupConfigurations.
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) enqueueImpactedIngresses(ctx context.Context, tgConfig *elbv2api.TargetGroupConfiguration) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(ctx, ingList,
+		client.InNamespace(tgConfig.GetNamespace()),
+		client.MatchingFields{ingress.IndexKeyServiceRefName: tgConfig.Spec.ServiceRef.Name}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+
+	tgConfigKey := k8s.NamespacedName(tgConfig)
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for targetGroupConfiguration event",
+			"targetGroupConfiguration", tgConfigKey,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.TypedGenericEvent[*networking.Ingress]{
+			Object: ing,
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForWebACLEvent constructs new enqueueRequestsForWebACLEvent.
+// ingClassEventChan can be nil if IngressClass resource is not available.
+func NewEnqueueRequestsForWebACLEvent(ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress], ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass],
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) handler.TypedEventHandler[*elbv2api.WebACL] {
+	return &enqueueRequestsForWebACLEvent{
+		ingEventChan:      ingEventChan,
+		ingClassEventChan: ingClassEventChan,
+		k8sClient:         k8sClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+var _ handler.TypedEventHandler[*elbv2api.WebACL] = (*enqueueRequestsForWebACLEvent)(nil)
+
+type enqueueRequestsForWebACLEvent struct {
+	ingEventChan      chan<- event.TypedGenericEvent[*networking.Ingress]
+	ingClassEventChan chan<- event.TypedGenericEvent[*networking.IngressClass]
+	k8sClient         client.Client
+	eventRecorder     record.EventRecorder
+	logger            logr.Logger
+}
+
+func (h *enqueueRequestsForWebACLEvent) Create(ctx context.Context, e event.TypedCreateEvent[*elbv2api.WebACL], _ workqueue.RateLimitingInterface) {
+	webACLNew := e.Object
+	h.enqueueImpactedObjects(ctx, webACLNew)
+}
+
+func (h *enqueueRequestsForWebACLEvent) Update(ctx context.Context, e event.TypedUpdateEvent[*elbv2api.WebACL], _ workqueue.RateLimitingInterface) {
+	webACLOld := e.ObjectOld
+	webACLNew := e.ObjectNew
+
+	// we only care below update event:
+	//	1. WebACL spec updates
+	//	2. WebACL deletions
+	if equality.Semantic.DeepEqual(webACLOld.Spec, webACLNew.Spec) &&
+		equality.Semantic.DeepEqual(webACLOld.DeletionTimestamp.IsZero(), webACLNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedObjects(ctx, webACLNew)
+}
+
+func (h *enqueueRequestsForWebACLEvent) Delete(ctx context.Context, e event.TypedDeleteEvent[*elbv2api.WebACL], _ workqueue.RateLimitingInterface) {
+	webACLOld := e.Object
+	h.enqueueImpactedObjects(ctx, webACLOld)
+}
+
+func (h *enqueueRequestsForWebACLEvent) Generic(context.Context, event.TypedGenericEvent[*elbv2api.WebACL], workqueue.RateLimitingInterface) {
+	// we don't have any generic event for webACLs.
+}
+
+func (h *enqueueRequestsForWebACLEvent) enqueueImpactedObjects(ctx context.Context, webACL *elbv2api.WebACL) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(ctx, ingList,
+		client.MatchingFields{ingress.IndexKeyWebACLRefName: webACL.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for webACL event",
+			"webACL", webACL.GetName(),
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.TypedGenericEvent[*networking.Ingress]{
+			Object: ing,
+		}
+	}
+
+	if h.ingClassEventChan == nil {
+		return
+	}
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := h.k8sClient.List(ctx, ingClassParamsList,
+		client.MatchingFields{ingress.IndexKeyWebACLRefName: webACL.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingressClassParams")
+		return
+	}
+	for _, ingClassParams := range ingClassParamsList.Items {
+		ingClassList := &networking.IngressClassList{}
+		if err := h.k8sClient.List(ctx, ingClassList,
+			client.MatchingFields{ingress.IndexKeyIngressClassParamsRefName: ingClassParams.GetName()}); err != nil {
+			h.logger.Error(err, "failed to fetch ingressClasses")
+			return
+		}
+		for index := range ingClassList.Items {
+			ingClass := &ingClassList.Items[index]
+
+			h.logger.V(1).Info("enqueue ingressClass for webACL event",
+				"webACL", webACL.GetName(),
+				"ingressClassParams", ingClassParams.GetName(),
+				"ingressClass", ingClass.GetName())
+			h.ingClassEventChan <- event.TypedGenericEvent[*networking.IngressClass]{
+				Object: ingClass,
+			}
+		}
+	}
+}
@@ -3,6 +3,8 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -16,10 +18,13 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certmonitor"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -34,7 +39,8 @@ import (
 )
 
 const (
-	ingressTagPrefix = "ingress.k8s.aws"
+	// IngressTagPrefix is the prefix of tags that track AWS resources created for IngressGroups.
+	IngressTagPrefix = "ingress.k8s.aws"
 	controllerName   = "ingress"
 
 	// the groupVersion of used Ingress & IngressClass resource.
@@ -43,27 +49,62 @@ const (
 )
 
 // NewGroupReconciler constructs new GroupReconciler
-func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+func NewGroupReconciler(cloudProvider aws.CloudProvider, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
 	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
 	elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
-	sgResolver networkingpkg.SecurityGroupResolver, logger logr.Logger) *groupReconciler {
+	sgResolver networkingpkg.SecurityGroupResolver, driftMetricsCollector drift.MetricsCollector, certMetricsCollector certmonitor.MetricsCollector,
+	logger logr.Logger) *groupReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
 	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction)
-	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
-	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName)
-	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
-		cloud.EC2(), cloud.ELBV2(), cloud.ACM(),
-		annotationParser, subnetsResolver,
-		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
-		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider, sgResolver,
-		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.IngressConfig.AllowedCertificateAuthorityARNs, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, annotationParser, logger)
+	trackingProvider := tracking.NewDefaultProvider(IngressTagPrefix, controllerConfig.ClusterName)
+	newAccountComponents := func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig, networkingSGManager networkingpkg.SecurityGroupManager,
+		networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
+		elbv2TaggingManager elbv2deploy.TaggingManager, backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver) *accountComponents {
+		var tlsSecretCertImporter ingress.TLSSecretCertImporter
+		if controllerConfig.IngressConfig.EnableTLSSecretsImport {
+			tlsSecretCertImporter = ingress.NewDefaultTLSSecretCertImporter(k8sClient, cloud.ACM(), trackingProvider, controllerConfig.DefaultTags,
+				logger.WithName("tls-secret-cert-importer"))
+		}
+		modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+			cloud.EC2(), cloud.ELBV2(), cloud.ACM(),
+			annotationParser, subnetsResolver,
+			authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+			cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+			controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider, sgResolver,
+			controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, ingress.BuildCertDiscoveryFilters(controllerConfig.IngressConfig), controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType),
+			controllerConfig.IngressConfig.ListenerRulesLimit, tlsSecretCertImporter, assumeRole, logger)
+		stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager,
+			controllerConfig, IngressTagPrefix, logger)
+		return &accountComponents{
+			assumeRole:            assumeRole,
+			modelBuilder:          modelBuilder,
+			stackDeployer:         stackDeployer,
+			driftStackPlanner:     deploy.NewUncachedStackDeployer(cloud, k8sClient, controllerConfig, IngressTagPrefix, logger),
+			backendSGProvider:     backendSGProvider,
+			tlsSecretCertImporter: tlsSecretCertImporter,
+			certDescriber:         certmonitor.NewDefaultCertificateDescriber(cloud.ACM(), cloud.IAM()),
+		}
+	}
+	buildDefaultAccountComponents := func(controllerConfig config.ControllerConfig) *accountComponents {
+		return newAccountComponents(controllerConfig, cloudProvider.DefaultCloud(), nil, networkingSGManager, networkingSGReconciler,
+			subnetsResolver, elbv2TaggingManager, backendSGProvider, sgResolver)
+	}
+	buildAssumedRoleAccountComponents := func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig) *accountComponents {
+		networkingSGManager := networkingpkg.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+		networkingSGReconciler := networkingpkg.NewDefaultSecurityGroupReconciler(networkingSGManager, logger)
+		azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(cloud.EC2(), logger)
+		subnetsResolver := networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerConfig.ClusterName, logger)
+		elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), logger)
+		backendSGProvider := networkingpkg.NewBackendSGProvider(controllerConfig.ClusterName, "", cloud.VpcID(), cloud.EC2(), k8sClient, controllerConfig.DefaultTags, logger)
+		sgResolver := networkingpkg.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
+		return newAccountComponents(controllerConfig, cloud, assumeRole, networkingSGManager, networkingSGReconciler,
+			subnetsResolver, elbv2TaggingManager, backendSGProvider, sgResolver)
+	}
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager,
-		controllerConfig, ingressTagPrefix, logger)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
 	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
@@ -71,14 +112,28 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
 
 	return &groupReconciler{
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		referenceIndexer:  referenceIndexer,
-		modelBuilder:      modelBuilder,
-		stackMarshaller:   stackMarshaller,
-		stackDeployer:     stackDeployer,
-		backendSGProvider: backendSGProvider,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		annotationParser:    annotationParser,
+		referenceIndexer:    referenceIndexer,
+		stackMarshaller:     stackMarshaller,
+		planConfigMapWriter: plan.NewDefaultConfigMapWriter(k8sClient),
 
+		cloudProvider:                     cloudProvider,
+		controllerConfig:                  controllerConfig,
+		defaultAccountComponents:          buildDefaultAccountComponents(controllerConfig),
+		buildDefaultAccountComponents:     buildDefaultAccountComponents,
+		buildAssumedRoleAccountComponents: buildAssumedRoleAccountComponents,
+		assumedRoleAccountComponents:      make(map[aws.AssumeRoleConfig]*accountComponents),
+
+		driftMetricsCollector:  driftMetricsCollector,
+		driftDetectionInterval: controllerConfig.DriftDetectionInterval,
+
+		certMetricsCollector:        certMetricsCollector,
+		certExpiryCheckInterval:     controllerConfig.CertExpiryCheckInterval,
+		certExpiryWarningThresholds: controllerConfig.CertExpiryWarningThresholds,
+
+		classLoader:           classLoader,
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
 		logger:                logger,
@@ -89,15 +144,34 @@ func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder
 
 // GroupReconciler reconciles a IngressGroup
 type groupReconciler struct {
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	referenceIndexer  ingress.ReferenceIndexer
-	modelBuilder      ingress.ModelBuilder
-	stackMarshaller   deploy.StackMarshaller
-	stackDeployer     deploy.StackDeployer
-	backendSGProvider networkingpkg.BackendSGProvider
-	secretsManager    k8s.SecretsManager
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	annotationParser    annotations.Parser
+	referenceIndexer    ingress.ReferenceIndexer
+	stackMarshaller     deploy.StackMarshaller
+	planConfigMapWriter plan.ConfigMapWriter
+	secretsManager      k8s.SecretsManager
+
+	cloudProvider                     aws.CloudProvider
+	buildDefaultAccountComponents     func(controllerConfig config.ControllerConfig) *accountComponents
+	buildAssumedRoleAccountComponents func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig) *accountComponents
+	// accountComponentsMutex protects the controllerConfig and the accountComponents built with it.
+	accountComponentsMutex       sync.Mutex
+	controllerConfig             config.ControllerConfig
+	defaultAccountComponents     *accountComponents
+	assumedRoleAccountComponents map[aws.AssumeRoleConfig]*accountComponents
+	enqueueIngresses             drift.RemediateFunc
 
+	driftMetricsCollector  drift.MetricsCollector
+	driftDetectionInterval time.Duration
+	driftDetector          drift.Detector
+
+	certMetricsCollector        certmonitor.MetricsCollector
+	certExpiryCheckInterval     time.Duration
+	certExpiryWarningThresholds []int
+	certMonitor                 certmonitor.Monitor
+
+	classLoader           ingress.ClassLoader
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
 	logger                logr.Logger
@@ -106,6 +180,9 @@ type groupReconciler struct {
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=webacls,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -113,6 +190,7 @@ type groupReconciler struct {
 // +kubebuilder:rbac:groups=extensions,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch
 
 func (r *groupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
@@ -124,42 +202,128 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	if err != nil {
 		return err
 	}
-
-	if err := r.groupFinalizerManager.AddGroupFinalizer(ctx, ingGroupID, ingGroup.Members); err != nil {
-		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+	components, err := r.accountComponentsForIngressGroup(ctx, ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 		return err
 	}
-	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
+
+	dryRunCfg, err := ingress.BuildDryRunConfig(r.annotationParser, ingGroup)
 	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
 		return err
 	}
+	if dryRunCfg.Enabled {
+		// changes to the LoadBalancer resources are intended while in plan-only mode.
+		r.driftDetector.Unregister(core.StackID(ingGroupID))
+		if err := r.buildAndPlanModel(ctx, components, ingGroup, dryRunCfg); err != nil {
+			return err
+		}
+		// Ingresses that are deleted or left the IngressGroup shouldn't be blocked by plan-only mode,
+		// their resources are cleaned up once the IngressGroup is deployed again.
+		return r.removeInactiveMembersFinalizer(ctx, ingGroup)
+	}
 
-	if len(ingGroup.Members) > 0 && lb != nil {
-		lbDNS, err := lb.DNSName().Resolve(ctx)
+	if len(ingGroup.Members) == 0 && len(ingGroup.InactiveMembers) > 0 {
+		deletionPolicy, err := ingress.BuildDeletionPolicy(ctx, r.annotationParser, r.classLoader, ingGroup)
 		if err != nil {
+			r.recordInactiveMembersEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedReleaseModel, fmt.Sprintf("Failed release model due to %v", err))
 			return err
 		}
-		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNS); err != nil {
-			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
-			return err
+		if deletionPolicy != elbv2api.DeletionPolicyDelete {
+			return r.releaseModel(ctx, components, ingGroup, deletionPolicy)
 		}
 	}
 
-	if len(ingGroup.InactiveMembers) > 0 {
-		if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroupID, ingGroup.InactiveMembers); err != nil {
-			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+	if err := r.groupFinalizerManager.AddGroupFinalizer(ctx, ingGroupID, ingGroup.Members); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	if err := r.recordDeployedAssumeRole(ctx, components, ingGroup); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRecordIAMRole, fmt.Sprintf("Failed record IAM role due to %v", err))
+		return err
+	}
+	stack, lbByIngress, err := r.buildAndDeployModel(ctx, components, ingGroup)
+	if err != nil {
+		return err
+	}
+
+	if len(ingGroup.Members) > 0 && len(lbByIngress) != 0 {
+		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbByIngress); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
 	}
 
+	if err := r.removeInactiveMembersFinalizer(ctx, ingGroup); err != nil {
+		return err
+	}
+
+	r.registerDriftDetection(components, ingGroup, stack)
+	r.registerCertificateMonitoring(components, ingGroup, stack)
 	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
 }
 
-func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, secrets, backendSGRequired, err := r.modelBuilder.Build(ctx, ingGroup)
+// removeInactiveMembersFinalizer removes the group finalizer from inactive members of ingGroup.
+func (r *groupReconciler) removeInactiveMembersFinalizer(ctx context.Context, ingGroup ingress.Group) error {
+	if len(ingGroup.InactiveMembers) == 0 {
+		return nil
+	}
+	if err := r.forgetDeployedAssumeRole(ctx, ingGroup.InactiveMembers); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRecordIAMRole, fmt.Sprintf("Failed record IAM role due to %v", err))
+		return err
+	}
+	if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroup.ID, ingGroup.InactiveMembers); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// registerDriftDetection registers the deployed stack of ingGroup for drift detection, or unregisters it once ingGroup has no members.
+func (r *groupReconciler) registerDriftDetection(components *accountComponents, ingGroup ingress.Group, stack core.Stack) {
+	if len(ingGroup.Members) == 0 {
+		r.driftDetector.Unregister(stack.StackID())
+		return
+	}
+	objs := make([]client.Object, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		objs = append(objs, member.Ing)
+	}
+	r.driftDetector.Register(drift.Target{
+		StackID: stack.StackID(),
+		BuildStack: func(ctx context.Context) (core.Stack, error) {
+			stack, _, _, _, err := components.modelBuilder.Build(ctx, ingGroup, ingress.WithPlanOnly(true))
+			return stack, err
+		},
+		Objects:      objs,
+		Remediate:    ingress.IsDriftRemediationEnabled(ingGroup),
+		StackPlanner: components.driftStackPlanner,
+	})
+}
+
+// registerCertificateMonitoring registers the deployed stack of ingGroup for certificate monitoring, or unregisters it once ingGroup has no members.
+func (r *groupReconciler) registerCertificateMonitoring(components *accountComponents, ingGroup ingress.Group, stack core.Stack) {
+	if len(ingGroup.Members) == 0 {
+		r.certMonitor.Unregister(stack.StackID())
+		return
+	}
+	objs := make([]client.Object, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		objs = append(objs, member.Ing)
+	}
+	r.certMonitor.Register(certmonitor.Target{
+		Stack:                stack,
+		Objects:              objs,
+		CertificateDescriber: components.certDescriber,
+	})
+}
+
+func (r *groupReconciler) buildAndDeployModel(ctx context.Context, components *accountComponents, ingGroup ingress.Group) (core.Stack, map[types.NamespacedName]*elbv2model.LoadBalancer, error) {
+	stack, lbByIngress, secrets, backendSGRequired, err := components.modelBuilder.Build(ctx, ingGroup)
 	if err != nil {
-		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		r.recordBuildModelFailureEvent(ctx, ingGroup, err)
 		return nil, nil, err
 	}
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
@@ -169,21 +333,85 @@ func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingr
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+	if err := components.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
 		return nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
 	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
+	if components.tlsSecretCertImporter != nil {
+		if err := components.tlsSecretCertImporter.Release(ctx, ingGroup.ID, secrets); err != nil {
+			return nil, nil, err
+		}
+	}
 	var inactiveResources []types.NamespacedName
 	inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.InactiveMembers)...)
 	if !backendSGRequired {
 		inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.Members)...)
 	}
-	if err := r.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
+	if err := components.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
 		return nil, nil, err
 	}
-	return stack, lb, nil
+	return stack, lbByIngress, nil
+}
+
+// buildAndPlanModel computes the changes to deploy the model of ingGroup without applying them.
+// finalizers and statuses are left untouched since nothing is deployed.
+func (r *groupReconciler) buildAndPlanModel(ctx context.Context, components *accountComponents, ingGroup ingress.Group, dryRunCfg ingress.DryRunConfig) error {
+	stack, _, _, _, err := components.modelBuilder.Build(ctx, ingGroup, ingress.WithPlanOnly(true))
+	if err != nil {
+		r.recordBuildModelFailureEvent(ctx, ingGroup, err)
+		return err
+	}
+	stackPlan, err := components.stackDeployer.Plan(ctx, stack)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	planJSON, err := plan.Marshal(stackPlan)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully planned model", "ingressGroup", ingGroup.ID, "plan", planJSON)
+	for _, cmKey := range dryRunCfg.PlanConfigMaps {
+		if err := r.planConfigMapWriter.Write(ctx, cmKey, stackPlan); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+			return err
+		}
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyPlanned, fmt.Sprintf("Successfully planned model: %v", stackPlan.Summary()))
+	return nil
+}
+
+// releaseModel releases the deployed resources of deleted ingGroup per deletionPolicy.
+// the backend security group is left in place as well since the retained LoadBalancer still references it.
+func (r *groupReconciler) releaseModel(ctx context.Context, components *accountComponents, ingGroup ingress.Group, deletionPolicy elbv2api.DeletionPolicy) error {
+	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	untrack := deletionPolicy == elbv2api.DeletionPolicyRetain
+	releasedResources, err := components.stackDeployer.Release(ctx, stack, untrack)
+	if err != nil {
+		r.recordInactiveMembersEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedReleaseModel, fmt.Sprintf("Failed release model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully released model", "ingressGroup", ingGroup.ID, "deletionPolicy", deletionPolicy, "resources", releasedResources)
+	r.recordInactiveMembersEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReleased,
+		fmt.Sprintf("Successfully released model with deletion policy %v, resources left in place: %v", deletionPolicy, releasedResources))
+	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), nil)
+	r.driftDetector.Unregister(stack.StackID())
+	r.certMonitor.Unregister(stack.StackID())
+	if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroup.ID, ingGroup.InactiveMembers); err != nil {
+		r.recordInactiveMembersEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// recordInactiveMembersEvent records event on inactive members of ingGroup, which are the only Ingresses left once an IngressGroup is deleted.
+func (r *groupReconciler) recordInactiveMembersEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
+	for _, ing := range ingGroup.InactiveMembers {
+		r.eventRecorder.Event(ing, eventType, reason, message)
+	}
 }
 
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
@@ -192,8 +420,27 @@ func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup in
 	}
 }
 
-func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbDNS string) error {
+// recordBuildModelFailureEvent records the failure to build model of ingGroup, quota overflows are reported with a dedicated reason.
+func (r *groupReconciler) recordBuildModelFailureEvent(ctx context.Context, ingGroup ingress.Group, err error) {
+	var limitExceededErr *ingress.ListenerRulesLimitExceededError
+	if errors.As(err, &limitExceededErr) {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonListenerRulesLimitExceeded, fmt.Sprintf("Failed build model due to %v", err))
+		return
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+}
+
+// updateIngressGroupStatus updates the status of members with the DNS name of LoadBalancer serving each of them.
+func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbByIngress map[types.NamespacedName]*elbv2model.LoadBalancer) error {
 	for _, member := range ingGroup.Members {
+		lb, ok := lbByIngress[k8s.NamespacedName(member.Ing)]
+		if !ok {
+			continue
+		}
+		lbDNS, err := lb.DNSName().Resolve(ctx)
+		if err != nil {
+			return err
+		}
 		if err := r.updateIngressStatus(ctx, lbDNS, member.Ing); err != nil {
 			return err
 		}
@@ -263,7 +510,35 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyWebACLRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildWebACLRefIndexes(context.Background(), obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyLoadBalancerConfigurationRefName,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildLoadBalancerConfigurationRefIndexes(context.Background(), obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
 	if ingressClassResourceAvailable {
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.IngressClassParams{}, ingress.IndexKeyWebACLRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildWebACLRefIndexes(ctx, obj.(*elbv2api.IngressClassParams))
+			},
+		); err != nil {
+			return err
+		}
+		if err := fieldIndexer.IndexField(ctx, &elbv2api.IngressClassParams{}, ingress.IndexKeyLoadBalancerConfigurationRefName,
+			func(obj client.Object) []string {
+				return r.referenceIndexer.BuildLoadBalancerConfigurationRefIndexes(ctx, obj.(*elbv2api.IngressClassParams))
+			},
+		); err != nil {
+			return err
+		}
 		if err := fieldIndexer.IndexField(ctx, &networking.IngressClass{}, ingress.IndexKeyIngressClassParamsRefName,
 			func(obj client.Object) []string {
 				return r.referenceIndexer.BuildIngressClassParamsRefIndexes(ctx, obj.(*networking.IngressClass))
@@ -307,8 +582,9 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(source.Channel(secretEventsChan, secretEventHandler)); err != nil {
 		return err
 	}
+	var ingClassEventChan chan event.TypedGenericEvent[*networking.IngressClass]
 	if ingressClassResourceAvailable {
-		ingClassEventChan := make(chan event.TypedGenericEvent[*networking.IngressClass])
+		ingClassEventChan = make(chan event.TypedGenericEvent[*networking.IngressClass])
 		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
 			r.logger.WithName("eventHandlers").WithName("ingressClassParams"))
 		ingClassEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassEvent(ingEventChan, r.k8sClient, r.eventRecorder,
@@ -323,10 +599,80 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 			return err
 		}
 	}
+	webACLEventHandler := eventhandlers.NewEnqueueRequestsForWebACLEvent(ingEventChan, ingClassEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("webACL"))
+	if err := c.Watch(source.Kind(mgr.GetCache(), &elbv2api.WebACL{}, webACLEventHandler)); err != nil {
+		return err
+	}
+	lbConfigEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerConfigurationEvent(ingEventChan, ingClassEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("loadBalancerConfiguration"))
+	if err := c.Watch(source.Kind(mgr.GetCache(), &elbv2api.LoadBalancerConfiguration{}, lbConfigEventHandler)); err != nil {
+		return err
+	}
+	tgConfigEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupConfigurationEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("targetGroupConfiguration"))
+	if err := c.Watch(source.Kind(mgr.GetCache(), &elbv2api.TargetGroupConfiguration{}, tgConfigEventHandler)); err != nil {
+		return err
+	}
 	r.secretsManager = k8s.NewSecretsManager(clientSet, secretEventsChan, ctrl.Log.WithName("secrets-manager"))
+	r.enqueueIngresses = buildEnqueueIngressesFunc(ingEventChan)
+	driftDetector := drift.NewDefaultDetector(core.StackKindIngressGroup, r.defaultAccountComponents.driftStackPlanner, r.eventRecorder, r.driftMetricsCollector,
+		r.driftDetectionInterval, r.enqueueIngresses, ctrl.Log.WithName("drift-detector").WithName("ingress"))
+	if driftDetector.Enabled() {
+		if err := mgr.Add(driftDetector); err != nil {
+			return err
+		}
+	}
+	r.driftDetector = driftDetector
+	certMonitor := certmonitor.NewDefaultMonitor(core.StackKindIngressGroup, r.defaultAccountComponents.certDescriber, r.eventRecorder,
+		r.certMetricsCollector, r.certExpiryCheckInterval, r.certExpiryWarningThresholds, ctrl.Log.WithName("cert-monitor").WithName("ingress"))
+	if certMonitor.Enabled() {
+		if err := mgr.Add(certMonitor); err != nil {
+			return err
+		}
+	}
+	r.certMonitor = certMonitor
 	return nil
 }
 
+// ReloadControllerConfig applies the reloaded controller configuration, by rebuilding the accountComponents with it
+// and enqueueing the IngressGroup of all Ingresses for reconcile.
+func (r *groupReconciler) ReloadControllerConfig(ctx context.Context, controllerConfig config.ControllerConfig) error {
+	r.accountComponentsMutex.Lock()
+	r.controllerConfig = controllerConfig
+	r.defaultAccountComponents = r.buildDefaultAccountComponents(controllerConfig)
+	r.assumedRoleAccountComponents = make(map[aws.AssumeRoleConfig]*accountComponents)
+	r.accountComponentsMutex.Unlock()
+
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList); err != nil {
+		return errors.Wrap(err, "failed to list Ingresses")
+	}
+	objs := make([]client.Object, 0, len(ingList.Items))
+	for i := range ingList.Items {
+		objs = append(objs, &ingList.Items[i])
+	}
+	return r.enqueueIngresses(ctx, objs)
+}
+
+// buildEnqueueIngressesFunc builds the func that enqueues the IngressGroup of Ingresses for reconcile, e.g. to remediate drift.
+func buildEnqueueIngressesFunc(ingEventChan chan<- event.TypedGenericEvent[*networking.Ingress]) drift.RemediateFunc {
+	return func(ctx context.Context, objs []client.Object) error {
+		for _, obj := range objs {
+			ing, ok := obj.(*networking.Ingress)
+			if !ok {
+				continue
+			}
+			select {
+			case ingEventChan <- event.TypedGenericEvent[*networking.Ingress]{Object: ing}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+}
+
 // isResourceKindAvailable checks whether specific kind is available.
 func isResourceKindAvailable(resList *metav1.APIResourceList, kind string) bool {
 	for _, res := range resList.APIResources {
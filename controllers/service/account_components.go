@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certmonitor"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+)
+
+// accountComponents are the components to build and deploy the model of Services through the clients of an AWS account.
+type accountComponents struct {
+	// assumeRole is the IAM role assumed for the AWS account, nil for the controller's own AWS account.
+	assumeRole    *aws.AssumeRoleConfig
+	modelBuilder  service.ModelBuilder
+	stackDeployer deploy.StackDeployer
+	// driftStackPlanner plans stacks without the cache of AWS Describe calls, so that drift is detected from the current state of resources.
+	driftStackPlanner drift.StackPlanner
+	backendSGProvider networking.BackendSGProvider
+	certDescriber     certmonitor.CertificateDescriber
+}
+
+// accountComponentsForService returns the accountComponents for the AWS account that LoadBalancer resources of svc are provisioned in.
+// the components for an assumed IAM role are built on first use, with networking components of their own for the VPC in that AWS account.
+func (r *serviceReconciler) accountComponentsForService(svc *corev1.Service) (*accountComponents, error) {
+	assumeRole, err := service.BuildAssumeRoleConfig(r.annotationParser, r.serviceUtils, svc)
+	if err != nil {
+		return nil, err
+	}
+
+	r.accountComponentsMutex.Lock()
+	defer r.accountComponentsMutex.Unlock()
+	if assumeRole == nil {
+		return r.defaultAccountComponents, nil
+	}
+	if components, exists := r.assumedRoleAccountComponents[*assumeRole]; exists {
+		return components, nil
+	}
+	cloud, err := r.cloudProvider.CloudForRole(assumeRole)
+	if err != nil {
+		return nil, err
+	}
+	components := r.buildAssumedRoleAccountComponents(r.controllerConfig, cloud, assumeRole)
+	r.assumedRoleAccountComponents[*assumeRole] = components
+	return components, nil
+}
+
+// recordDeployedAssumeRole records the IAM role of components on svc, before its LoadBalancer resources are deployed with it.
+func (r *serviceReconciler) recordDeployedAssumeRole(ctx context.Context, components *accountComponents, svc *corev1.Service) error {
+	rawAssumeRole := aws.MarshalAssumeRoleConfig(components.assumeRole)
+	return k8s.PatchAnnotation(ctx, r.k8sClient, svc, service.AnnotationDeployedIAMRole, &rawAssumeRole)
+}
+
+// forgetDeployedAssumeRole removes the recorded IAM role from svc, once its LoadBalancer resources are cleaned up.
+func (r *serviceReconciler) forgetDeployedAssumeRole(ctx context.Context, svc *corev1.Service) error {
+	return k8s.PatchAnnotation(ctx, r.k8sClient, svc, service.AnnotationDeployedIAMRole, nil)
+}
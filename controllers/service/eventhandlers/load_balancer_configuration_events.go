@@ -0,0 +1,84 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestForLoadBalancerConfigurationEvent constructs new enqueueRequestsForLoadBalancerConfigurationEvent.
+func NewEnqueueRequestForLoadBalancerConfigurationEvent(k8sClient client.Client, annotationParser annotations.Parser,
+	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForLoadBalancerConfigurationEvent {
+	return &enqueueRequestsForLoadBalancerConfigurationEvent{
+		k8sClient:        k8sClient,
+		annotationParser: annotationParser,
+		serviceUtils:     serviceUtils,
+		logger:           logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForLoadBalancerConfigurationEvent)(nil)
+
+type enqueueRequestsForLoadBalancerConfigurationEvent struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+	serviceUtils     svcpkg.ServiceUtils
+	logger           logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Create(ctx context.Context, e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencingServices(ctx, queue, e.Object.(*elbv2api.LoadBalancerConfiguration))
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Update(ctx context.Context, e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	lbConfigOld := e.ObjectOld.(*elbv2api.LoadBalancerConfiguration)
+	lbConfigNew := e.ObjectNew.(*elbv2api.LoadBalancerConfiguration)
+
+	if equality.Semantic.DeepEqual(lbConfigOld.Spec, lbConfigNew.Spec) &&
+		equality.Semantic.DeepEqual(lbConfigOld.DeletionTimestamp.IsZero(), lbConfigNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueReferencingServices(ctx, queue, lbConfigNew)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Delete(ctx context.Context, e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencingServices(ctx, queue, e.Object.(*elbv2api.LoadBalancerConfiguration))
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Generic(context.Context, event.GenericEvent, workqueue.RateLimitingInterface) {
+	// we don't have any generic event for loadBalancerConfigurations.
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) enqueueReferencingServices(ctx context.Context, queue workqueue.RateLimitingInterface, lbConfig *elbv2api.LoadBalancerConfiguration) {
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(ctx, svcList, client.InNamespace(lbConfig.Namespace)); err != nil {
+		h.logger.Error(err, "failed to fetch services", "loadBalancerConfiguration", k8s.NamespacedName(lbConfig))
+		return
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		lbConfigName := ""
+		if exists := h.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigName, svc.Annotations); !exists || lbConfigName != lbConfig.Name {
+			continue
+		}
+		// Check if the svc needs to be handled
+		if !h.serviceUtils.IsServicePendingFinalization(svc) && !h.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		h.logger.V(1).Info("enqueue service for loadBalancerConfiguration event",
+			"loadBalancerConfiguration", k8s.NamespacedName(lbConfig),
+			"service", k8s.NamespacedName(svc))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
+	}
+}
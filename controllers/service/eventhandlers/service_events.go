@@ -59,6 +59,7 @@ func (h *enqueueRequestsForServiceEvent) Delete(ctx context.Context, e event.Del
 }
 
 func (h *enqueueRequestsForServiceEvent) Generic(ctx context.Context, e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueManagedService(ctx, queue, e.Object.(*corev1.Service))
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(ctx context.Context, queue workqueue.RateLimitingInterface, service *corev1.Service) {
@@ -0,0 +1,86 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestForTargetGroupConfigurationEvent constructs new enqueueRequestsForTargetGroupConfigurationEvent.
+func NewEnqueueRequestForTargetGroupConfigurationEvent(k8sClient client.Client,
+	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForTargetGroupConfigurationEvent {
+	return &enqueueRequestsForTargetGroupConfigurationEvent{
+		k8sClient:    k8sClient,
+		serviceUtils: serviceUtils,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForTargetGroupConfigurationEvent)(nil)
+
+type enqueueRequestsForTargetGroupConfigurationEvent struct {
+	k8sClient    client.Client
+	serviceUtils svcpkg.ServiceUtils
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Create(ctx context.Context, e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedService(ctx, queue, e.Object.(*elbv2api.TargetGroupConfiguration))
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Update(ctx context.Context, e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	tgConfigOld := e.ObjectOld.(*elbv2api.TargetGroupConfiguration)
+	tgConfigNew := e.ObjectNew.(*elbv2api.TargetGroupConfiguration)
+
+	if equality.Semantic.DeepEqual(tgConfigOld.Spec, tgConfigNew.Spec) &&
+		equality.Semantic.DeepEqual(tgConfigOld.DeletionTimestamp.IsZero(), tgConfigNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	// the previously referenced Service needs to be reconciled as well if serviceRef is changed.
+	if tgConfigOld.Spec.ServiceRef.Name != tgConfigNew.Spec.ServiceRef.Name {
+		h.enqueueReferencedService(ctx, queue, tgConfigOld)
+	}
+	h.enqueueReferencedService(ctx, queue, tgConfigNew)
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Delete(ctx context.Context, e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedService(ctx, queue, e.Object.(*elbv2api.TargetGroupConfiguration))
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) Generic(context.Context, event.GenericEvent, workqueue.RateLimitingInterface) {
+	// we don't have any generic event for targetGroupConfigurations.
+}
+
+func (h *enqueueRequestsForTargetGroupConfigurationEvent) enqueueReferencedService(ctx context.Context, queue workqueue.RateLimitingInterface, tgConfig *elbv2api.TargetGroupConfiguration) {
+	svcKey := types.NamespacedName{
+		Namespace: tgConfig.Namespace,
+		Name:      tgConfig.Spec.ServiceRef.Name,
+	}
+	svc := &corev1.Service{}
+	if err := h.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			h.logger.Error(err, "failed to fetch service", "service", svcKey)
+		}
+		return
+	}
+	// Check if the svc needs to be handled
+	if !h.serviceUtils.IsServicePendingFinalization(svc) && !h.serviceUtils.IsServiceSupported(svc) {
+		return
+	}
+	h.logger.V(1).Info("enqueue service for targetGroupConfiguration event",
+		"targetGroupConfiguration", k8s.NamespacedName(tgConfig),
+		"service", svcKey)
+	queue.Add(reconcile.Request{NamespacedName: svcKey})
+}
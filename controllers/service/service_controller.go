@@ -3,19 +3,25 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certmonitor"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -25,30 +31,65 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
-	serviceFinalizer        = "service.k8s.aws/resources"
-	serviceTagPrefix        = "service.k8s.aws"
-	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+	// ServiceFinalizer is the finalizer added to Services managed by the controller.
+	ServiceFinalizer = "service.k8s.aws/resources"
+	// ServiceTagPrefix is the prefix of tags that track AWS resources created for Services.
+	ServiceTagPrefix = "service.k8s.aws"
+	// ServiceAnnotationPrefix is the prefix of Service annotations understood by the controller.
+	ServiceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
 )
 
-func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+func NewServiceReconciler(cloudProvider aws.CloudProvider, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
 	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
 	vpcInfoProvider networking.VPCInfoProvider, elbv2TaggingManager elbv2deploy.TaggingManager, controllerConfig config.ControllerConfig,
-	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger) *serviceReconciler {
-
-	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
-	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
-	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
-	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
-		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
-		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, logger)
+	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, driftMetricsCollector drift.MetricsCollector,
+	certMetricsCollector certmonitor.MetricsCollector, logger logr.Logger) *serviceReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(ServiceAnnotationPrefix)
+	trackingProvider := tracking.NewDefaultProvider(ServiceTagPrefix, controllerConfig.ClusterName)
+	serviceUtils := service.NewServiceUtils(annotationParser, ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
+	tgConfigLoader := config.NewDefaultTargetGroupConfigurationLoader(k8sClient)
+	newAccountComponents := func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig, networkingSGManager networking.SecurityGroupManager,
+		networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver, vpcInfoProvider networking.VPCInfoProvider,
+		elbv2TaggingManager elbv2deploy.TaggingManager, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver) *accountComponents {
+		modelBuilder := service.NewDefaultModelBuilder(k8sClient, annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
+			elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+			controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+			tgConfigLoader, backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, assumeRole, logger)
+		stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, ServiceTagPrefix, logger)
+		return &accountComponents{
+			assumeRole:        assumeRole,
+			modelBuilder:      modelBuilder,
+			stackDeployer:     stackDeployer,
+			driftStackPlanner: deploy.NewUncachedStackDeployer(cloud, k8sClient, controllerConfig, ServiceTagPrefix, logger),
+			backendSGProvider: backendSGProvider,
+			certDescriber:     certmonitor.NewDefaultCertificateDescriber(cloud.ACM(), cloud.IAM()),
+		}
+	}
+	buildDefaultAccountComponents := func(controllerConfig config.ControllerConfig) *accountComponents {
+		return newAccountComponents(controllerConfig, cloudProvider.DefaultCloud(), nil, networkingSGManager, networkingSGReconciler,
+			subnetsResolver, vpcInfoProvider, elbv2TaggingManager, backendSGProvider, sgResolver)
+	}
+	buildAssumedRoleAccountComponents := func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig) *accountComponents {
+		networkingSGManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+		networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, logger)
+		azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), logger)
+		vpcInfoProvider := networking.NewDefaultVPCInfoProvider(cloud.EC2(), logger)
+		subnetsResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerConfig.ClusterName, logger)
+		elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), logger)
+		backendSGProvider := networking.NewBackendSGProvider(controllerConfig.ClusterName, "", cloud.VpcID(), cloud.EC2(), k8sClient, controllerConfig.DefaultTags, logger)
+		sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
+		return newAccountComponents(controllerConfig, cloud, assumeRole, networkingSGManager, networkingSGReconciler,
+			subnetsResolver, vpcInfoProvider, elbv2TaggingManager, backendSGProvider, sgResolver)
+	}
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, controllerConfig, serviceTagPrefix, logger)
 	return &serviceReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
@@ -56,13 +97,26 @@ func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorde
 		annotationParser:  annotationParser,
 		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
 		serviceUtils:      serviceUtils,
-		backendSGProvider: backendSGProvider,
 
-		modelBuilder:    modelBuilder,
 		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
 		logger:          logger,
 
+		cloudProvider:                     cloudProvider,
+		controllerConfig:                  controllerConfig,
+		defaultAccountComponents:          buildDefaultAccountComponents(controllerConfig),
+		buildDefaultAccountComponents:     buildDefaultAccountComponents,
+		buildAssumedRoleAccountComponents: buildAssumedRoleAccountComponents,
+		assumedRoleAccountComponents:      make(map[aws.AssumeRoleConfig]*accountComponents),
+
+		svcEventChan:           make(chan event.GenericEvent),
+		planConfigMapWriter:    plan.NewDefaultConfigMapWriter(k8sClient),
+		driftMetricsCollector:  driftMetricsCollector,
+		driftDetectionInterval: controllerConfig.DriftDetectionInterval,
+
+		certMetricsCollector:        certMetricsCollector,
+		certExpiryCheckInterval:     controllerConfig.CertExpiryCheckInterval,
+		certExpiryWarningThresholds: controllerConfig.CertExpiryWarningThresholds,
+
 		maxConcurrentReconciles: controllerConfig.ServiceMaxConcurrentReconciles,
 	}
 }
@@ -74,19 +128,41 @@ type serviceReconciler struct {
 	annotationParser  annotations.Parser
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
-	backendSGProvider networking.BackendSGProvider
 
-	modelBuilder    service.ModelBuilder
 	stackMarshaller deploy.StackMarshaller
-	stackDeployer   deploy.StackDeployer
 	logger          logr.Logger
 
+	cloudProvider                     aws.CloudProvider
+	buildDefaultAccountComponents     func(controllerConfig config.ControllerConfig) *accountComponents
+	buildAssumedRoleAccountComponents func(controllerConfig config.ControllerConfig, cloud aws.Cloud, assumeRole *aws.AssumeRoleConfig) *accountComponents
+	// accountComponentsMutex protects the controllerConfig and the accountComponents built with it.
+	accountComponentsMutex       sync.Mutex
+	controllerConfig             config.ControllerConfig
+	defaultAccountComponents     *accountComponents
+	assumedRoleAccountComponents map[aws.AssumeRoleConfig]*accountComponents
+
+	svcEventChan        chan event.GenericEvent
+	enqueueServices     drift.RemediateFunc
+	planConfigMapWriter plan.ConfigMapWriter
+
+	driftMetricsCollector  drift.MetricsCollector
+	driftDetectionInterval time.Duration
+	driftDetector          drift.Detector
+
+	certMetricsCollector        certmonitor.MetricsCollector
+	certExpiryCheckInterval     time.Duration
+	certExpiryWarningThresholds []int
+	certMonitor                 certmonitor.Monitor
+
 	maxConcurrentReconciles int
 }
 
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
@@ -97,18 +173,35 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) err
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
 		return client.IgnoreNotFound(err)
 	}
-	stack, lb, backendSGRequired, err := r.buildModel(ctx, svc)
+	components, err := r.accountComponentsForService(svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	stack, lb, backendSGRequired, err := r.buildModel(ctx, components, svc)
 	if err != nil {
 		return err
 	}
 	if lb == nil {
-		return r.cleanupLoadBalancerResources(ctx, svc, stack)
+		r.driftDetector.Unregister(stack.StackID())
+		r.certMonitor.Unregister(stack.StackID())
+		return r.cleanupLoadBalancerResources(ctx, components, svc, stack)
+	}
+	dryRun := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixDryRun, &dryRun, svc.Annotations); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	if dryRun {
+		// changes to the LoadBalancer resources are intended while in plan-only mode.
+		r.driftDetector.Unregister(stack.StackID())
+		return r.planModel(ctx, components, svc, stack)
 	}
-	return r.reconcileLoadBalancerResources(ctx, svc, stack, lb, backendSGRequired)
+	return r.reconcileLoadBalancerResources(ctx, components, svc, stack, lb, backendSGRequired)
 }
 
-func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
-	stack, lb, backendSGRequired, err := r.modelBuilder.Build(ctx, svc)
+func (r *serviceReconciler) buildModel(ctx context.Context, components *accountComponents, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack, lb, backendSGRequired, err := components.modelBuilder.Build(ctx, svc)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 		return nil, nil, false, err
@@ -122,8 +215,8 @@ func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service)
 	return stack, lb, backendSGRequired, nil
 }
 
-func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+func (r *serviceReconciler) deployModel(ctx context.Context, components *accountComponents, svc *corev1.Service, stack core.Stack) error {
+	if err := components.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
 		return err
 	}
@@ -132,14 +225,49 @@ func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service
 	return nil
 }
 
-func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack,
+// planModel computes the changes to deploy the model of svc without applying them.
+// finalizers and statuses are left untouched since nothing is deployed.
+func (r *serviceReconciler) planModel(ctx context.Context, components *accountComponents, svc *corev1.Service, stack core.Stack) error {
+	stackPlan, err := components.stackDeployer.Plan(ctx, stack)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	planJSON, err := plan.Marshal(stackPlan)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully planned model", "service", k8s.NamespacedName(svc), "plan", planJSON)
+	var cmName string
+	r.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDryRunConfigMap, &cmName, svc.Annotations)
+	if cmName != "" {
+		cmKey := types.NamespacedName{Namespace: svc.Namespace, Name: cmName}
+		if err := r.planConfigMapWriter.Write(ctx, cmKey, stackPlan); err != nil {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedPlanModel, fmt.Sprintf("Failed plan model due to %v", err))
+			return err
+		}
+	}
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyPlanned, fmt.Sprintf("Successfully planned model: %v", stackPlan.Summary()))
+	return nil
+}
+
+func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, components *accountComponents, svc *corev1.Service, stack core.Stack,
 	lb *elbv2model.LoadBalancer, backendSGRequired bool) error {
-	if err := r.finalizerManager.AddFinalizers(ctx, svc, serviceFinalizer); err != nil {
+	driftRemediationEnabled, err := service.IsDriftRemediationEnabled(r.annotationParser, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := r.finalizerManager.AddFinalizers(ctx, svc, ServiceFinalizer); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	err := r.deployModel(ctx, svc, stack)
-	if err != nil {
+	if err := r.recordDeployedAssumeRole(ctx, components, svc); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRecordIAMRole, fmt.Sprintf("Failed record IAM role due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, components, svc, stack); err != nil {
 		return err
 	}
 	lbDNS, err := lb.DNSName().Resolve(ctx)
@@ -148,7 +276,7 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 	}
 
 	if !backendSGRequired {
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+		if err := components.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
 			return err
 		}
 	}
@@ -157,24 +285,53 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
+	r.driftDetector.Register(drift.Target{
+		StackID: stack.StackID(),
+		BuildStack: func(ctx context.Context) (core.Stack, error) {
+			stack, _, _, err := components.modelBuilder.Build(ctx, svc)
+			return stack, err
+		},
+		Objects:      []client.Object{svc},
+		Remediate:    driftRemediationEnabled,
+		StackPlanner: components.driftStackPlanner,
+	})
+	r.certMonitor.Register(certmonitor.Target{
+		Stack:                stack,
+		Objects:              []client.Object{svc},
+		CertificateDescriber: components.certDescriber,
+	})
 	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
 }
 
-func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
-	if k8s.HasFinalizer(svc, serviceFinalizer) {
-		err := r.deployModel(ctx, svc, stack)
+func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, components *accountComponents, svc *corev1.Service, stack core.Stack) error {
+	if k8s.HasFinalizer(svc, ServiceFinalizer) {
+		deletionPolicy, err := service.BuildDeletionPolicy(r.annotationParser, svc)
 		if err != nil {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedReleaseModel, fmt.Sprintf("Failed release model due to %v", err))
 			return err
 		}
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+		if deletionPolicy == elbv2api.DeletionPolicyDelete {
+			if err := r.deployModel(ctx, components, svc, stack); err != nil {
+				return err
+			}
+			if err := components.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+				return err
+			}
+		} else if err := r.releaseModel(ctx, components, svc, stack, deletionPolicy); err != nil {
 			return err
 		}
 		if err = r.cleanupServiceStatus(ctx, svc); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
-		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer); err != nil {
+		if svc.DeletionTimestamp.IsZero() {
+			if err := r.forgetDeployedAssumeRole(ctx, svc); err != nil {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRecordIAMRole, fmt.Sprintf("Failed record IAM role due to %v", err))
+				return err
+			}
+		}
+		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, ServiceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
 		}
@@ -182,6 +339,21 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 	return nil
 }
 
+// releaseModel releases the deployed resources of svc per deletionPolicy.
+// the backend security group is left in place as well since the retained LoadBalancer still references it.
+func (r *serviceReconciler) releaseModel(ctx context.Context, components *accountComponents, svc *corev1.Service, stack core.Stack, deletionPolicy elbv2api.DeletionPolicy) error {
+	untrack := deletionPolicy == elbv2api.DeletionPolicyRetain
+	releasedResources, err := components.stackDeployer.Release(ctx, stack, untrack)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedReleaseModel, fmt.Sprintf("Failed release model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully released model", "service", k8s.NamespacedName(svc), "deletionPolicy", deletionPolicy, "resources", releasedResources)
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReleased,
+		fmt.Sprintf("Successfully released model with deletion policy %v, resources left in place: %v", deletionPolicy, releasedResources))
+	return nil
+}
+
 func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
 	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
 		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
@@ -211,12 +383,70 @@ func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev
 func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder,
 		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("service"))
+	tgConfigEventHandler := eventhandlers.NewEnqueueRequestForTargetGroupConfigurationEvent(r.k8sClient,
+		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("targetGroupConfiguration"))
+	lbConfigEventHandler := eventhandlers.NewEnqueueRequestForLoadBalancerConfigurationEvent(r.k8sClient, r.annotationParser,
+		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("loadBalancerConfiguration"))
+	r.enqueueServices = buildEnqueueServicesFunc(r.svcEventChan)
+	driftDetector := drift.NewDefaultDetector(core.StackKindService, r.defaultAccountComponents.driftStackPlanner, r.eventRecorder, r.driftMetricsCollector,
+		r.driftDetectionInterval, r.enqueueServices, ctrl.Log.WithName("drift-detector").WithName("service"))
+	if driftDetector.Enabled() {
+		if err := mgr.Add(driftDetector); err != nil {
+			return err
+		}
+	}
+	r.driftDetector = driftDetector
+	certMonitor := certmonitor.NewDefaultMonitor(core.StackKindService, r.defaultAccountComponents.certDescriber, r.eventRecorder,
+		r.certMetricsCollector, r.certExpiryCheckInterval, r.certExpiryWarningThresholds, ctrl.Log.WithName("cert-monitor").WithName("service"))
+	if certMonitor.Enabled() {
+		if err := mgr.Add(certMonitor); err != nil {
+			return err
+		}
+	}
+	r.certMonitor = certMonitor
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(controllerName).
 		Watches(&corev1.Service{}, svcEventHandler).
+		WatchesRawSource(source.Channel(r.svcEventChan, svcEventHandler)).
+		Watches(&elbv2api.TargetGroupConfiguration{}, tgConfigEventHandler).
+		Watches(&elbv2api.LoadBalancerConfiguration{}, lbConfigEventHandler).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.maxConcurrentReconciles,
 		}).
 		Complete(r)
 }
+
+// ReloadControllerConfig applies the reloaded controller configuration, by rebuilding the accountComponents with it
+// and enqueueing all Services for reconcile.
+func (r *serviceReconciler) ReloadControllerConfig(ctx context.Context, controllerConfig config.ControllerConfig) error {
+	r.accountComponentsMutex.Lock()
+	r.controllerConfig = controllerConfig
+	r.defaultAccountComponents = r.buildDefaultAccountComponents(controllerConfig)
+	r.assumedRoleAccountComponents = make(map[aws.AssumeRoleConfig]*accountComponents)
+	r.accountComponentsMutex.Unlock()
+
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList); err != nil {
+		return errors.Wrap(err, "failed to list Services")
+	}
+	objs := make([]client.Object, 0, len(svcList.Items))
+	for i := range svcList.Items {
+		objs = append(objs, &svcList.Items[i])
+	}
+	return r.enqueueServices(ctx, objs)
+}
+
+// buildEnqueueServicesFunc builds the func that enqueues Services for reconcile, e.g. to remediate drift.
+func buildEnqueueServicesFunc(svcEventChan chan<- event.GenericEvent) drift.RemediateFunc {
+	return func(ctx context.Context, objs []client.Object) error {
+		for _, obj := range objs {
+			select {
+			case svcEventChan <- event.GenericEvent{Object: obj}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"os"
+
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 
 	"github.com/go-logr/logr"
@@ -29,13 +31,16 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2controller "sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
-	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certmonitor"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/drift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
@@ -47,6 +52,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -59,6 +67,9 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = elbv2api.AddToScheme(scheme)
+	_ = gwv1.AddToScheme(scheme)
+	_ = gwv1alpha2.AddToScheme(scheme)
+	_ = gwv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -69,18 +80,19 @@ func main() {
 		"GitCommit", version.GitCommit,
 		"BuildDate", version.BuildDate,
 	)
-	controllerCFG, err := loadControllerConfig()
+	controllerCFG, configMapData, err := loadControllerConfig()
 	if err != nil {
 		infoLogger.Error(err, "unable to load controller config")
 		os.Exit(1)
 	}
 	ctrl.SetLogger(getLoggerWithLogLevel(controllerCFG.LogLevel))
 
-	cloud, err := aws.NewCloud(controllerCFG.AWSConfig, metrics.Registry)
+	cloudProvider, err := aws.NewDefaultCloudProvider(controllerCFG.AWSConfig, metrics.Registry)
 	if err != nil {
 		setupLog.Error(err, "unable to initialize AWS cloud")
 		os.Exit(1)
 	}
+	cloud := cloudProvider.DefaultCloud()
 	restCFG, err := config.BuildRestConfig(controllerCFG.RuntimeConfig)
 	if err != nil {
 		setupLog.Error(err, "unable to build REST config")
@@ -106,7 +118,7 @@ func main() {
 	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
 	vpcInfoProvider := networking.NewDefaultVPCInfoProvider(cloud.EC2(), ctrl.Log.WithName("vpc-info-provider"))
 	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
-	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(),
+	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(), cloudProvider,
 		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider,
 		cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.FeatureGates.Enabled(config.EndpointsFailOpen), controllerCFG.EnableEndpointSlices, controllerCFG.DisableRestrictedSGRules,
 		controllerCFG.ServiceTargetENISGTags, mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
@@ -114,15 +126,46 @@ func main() {
 		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
 	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
 	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerCFG.FeatureGates, cloud.RGT(), ctrl.Log)
-	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+	driftMetricsCollector, err := drift.NewCollector(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize drift metrics collector")
+		os.Exit(1)
+	}
+	certMetricsCollector, err := certmonitor.NewCollector(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize certificate metrics collector")
+		os.Exit(1)
+	}
+	ingGroupReconciler := ingress.NewGroupReconciler(cloudProvider, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver, elbv2TaggingManager,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("ingress"))
-	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
+		controllerCFG, backendSGProvider, sgResolver, driftMetricsCollector, certMetricsCollector, ctrl.Log.WithName("controllers").WithName("ingress"))
+	svcReconciler := service.NewServiceReconciler(cloudProvider, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("service"))
+		controllerCFG, backendSGProvider, sgResolver, driftMetricsCollector, certMetricsCollector, ctrl.Log.WithName("controllers").WithName("service"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
 		finalizerManager, tgbResManager,
 		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+	lbConfigReconciler := elbv2controller.NewLoadBalancerConfigurationReconciler(mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName("loadBalancerConfiguration"))
+
+	var configReloader config.ControllerConfigReloader
+	if controllerCFG.ControllerConfigMap != "" {
+		cmKey, _ := controllerCFG.ControllerConfigMapKey()
+		defaultConfigReloader := config.NewDefaultControllerConfigReloader(clientSet, cmKey, os.Args, controllerCFG, configMapData,
+			mgr.GetEventRecorderFor("controller-config"), ctrl.Log.WithName("controller-config-reloader"))
+		configReloader = defaultConfigReloader
+		configReloader.Subscribe(func(ctx context.Context, cfg config.ControllerConfig) error {
+			return backendSGProvider.ReloadDefaultTags(ctx, cfg.DefaultTags)
+		})
+		configReloader.Subscribe(ingGroupReconciler.ReloadControllerConfig)
+		if controllerCFG.FeatureGates.Enabled(config.EnableServiceController) {
+			configReloader.Subscribe(svcReconciler.ReloadControllerConfig)
+		}
+		if err := mgr.Add(defaultConfigReloader); err != nil {
+			setupLog.Error(err, "unable to add controller config reloader")
+			os.Exit(1)
+		}
+	}
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -138,11 +181,44 @@ func main() {
 		}
 	}
 
+	// Setup ALB gateway reconciler only if ALBGatewayAPI is set to true.
+	if controllerCFG.FeatureGates.Enabled(config.ALBGatewayAPI) {
+		albGatewayReconciler := gateway.NewGatewayReconciler(elbv2model.LoadBalancerTypeApplication, cloud, mgr.GetClient(), mgr.GetEventRecorderFor("gateway"),
+			finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager,
+			controllerCFG, sgResolver, ctrl.Log.WithName("controllers").WithName("albGateway"))
+		if err = albGatewayReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ALBGateway")
+			os.Exit(1)
+		}
+		if configReloader != nil {
+			configReloader.Subscribe(albGatewayReconciler.ReloadControllerConfig)
+		}
+	}
+
+	// Setup NLB gateway reconciler only if NLBGatewayAPI is set to true.
+	if controllerCFG.FeatureGates.Enabled(config.NLBGatewayAPI) {
+		nlbGatewayReconciler := gateway.NewGatewayReconciler(elbv2model.LoadBalancerTypeNetwork, cloud, mgr.GetClient(), mgr.GetEventRecorderFor("gateway"),
+			finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, elbv2TaggingManager,
+			controllerCFG, sgResolver, ctrl.Log.WithName("controllers").WithName("nlbGateway"))
+		if err = nlbGatewayReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NLBGateway")
+			os.Exit(1)
+		}
+		if configReloader != nil {
+			configReloader.Subscribe(nlbGatewayReconciler.ReloadControllerConfig)
+		}
+	}
+
 	if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
 		os.Exit(1)
 	}
 
+	if err := lbConfigReconciler.SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LoadBalancerConfiguration")
+		os.Exit(1)
+	}
+
 	// Add liveness probe
 	err = mgr.AddHealthzCheck("health-ping", healthz.Ping)
 	setupLog.Info("adding health check for controller")
@@ -164,8 +240,8 @@ func main() {
 	corewebhook.NewPodMutator(podReadinessGateInjector).SetupWithManager(mgr)
 	corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass, ctrl.Log).SetupWithManager(mgr)
 	elbv2webhook.NewIngressClassParamsValidator().SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), cloud.VpcID(), ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), cloudProvider, ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), cloudProvider, cloud.VpcID(), ctrl.Log).SetupWithManager(mgr)
 	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log).SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
@@ -186,27 +262,37 @@ func main() {
 	}
 }
 
-// loadControllerConfig loads the controller configuration.
-func loadControllerConfig() (config.ControllerConfig, error) {
-	defaultAWSThrottleCFG := throttle.NewDefaultServiceOperationsThrottleConfig()
-	controllerCFG := config.ControllerConfig{
-		AWSConfig: aws.CloudConfig{
-			ThrottleConfig: defaultAWSThrottleCFG,
-		},
-		FeatureGates: config.NewFeatureGates(),
+// loadControllerConfig loads the controller configuration, and the data of the controller ConfigMap that overrides it if configured.
+func loadControllerConfig() (config.ControllerConfig, map[string]string, error) {
+	controllerCFG, err := config.LoadControllerConfig(os.Args, nil, pflag.ExitOnError)
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
 	}
-
-	fs := pflag.NewFlagSet("", pflag.ExitOnError)
-	controllerCFG.BindFlags(fs)
-
-	if err := fs.Parse(os.Args); err != nil {
-		return config.ControllerConfig{}, err
+	if controllerCFG.ControllerConfigMap == "" {
+		return controllerCFG, nil, nil
 	}
 
-	if err := controllerCFG.Validate(); err != nil {
-		return config.ControllerConfig{}, err
+	cmKey, err := controllerCFG.ControllerConfigMapKey()
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
+	}
+	restCFG, err := config.BuildRestConfig(controllerCFG.RuntimeConfig)
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
+	}
+	clientSet, err := kubernetes.NewForConfig(restCFG)
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
+	}
+	configMapData, err := config.LoadControllerConfigMapData(context.Background(), clientSet, cmKey)
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
+	}
+	controllerCFG, err = config.LoadControllerConfig(os.Args, configMapData, pflag.ExitOnError)
+	if err != nil {
+		return config.ControllerConfig{}, nil, err
 	}
-	return controllerCFG, nil
+	return controllerCFG, configMapData, nil
 }
 
 // getLoggerWithLogLevel returns logger with specific log level.
@@ -7,8 +7,11 @@ const (
 	AnnotationPrefixIngress = "alb.ingress.kubernetes.io"
 	// Ingress annotation suffixes
 	IngressSuffixLoadBalancerName             = "load-balancer-name"
+	IngressSuffixLoadBalancerARN              = "load-balancer-arn"
 	IngressSuffixGroupName                    = "group.name"
 	IngressSuffixGroupOrder                   = "group.order"
+	IngressSuffixRuleOrder                    = "rule-order"
+	IngressSuffixOverflowLoadBalancer         = "overflow-load-balancer"
 	IngressSuffixTags                         = "tags"
 	IngressSuffixIPAddressType                = "ip-address-type"
 	IngressSuffixScheme                       = "scheme"
@@ -16,6 +19,7 @@ const (
 	IngressSuffixCustomerOwnedIPv4Pool        = "customer-owned-ipv4-pool"
 	IngressSuffixLoadBalancerAttributes       = "load-balancer-attributes"
 	IngressSuffixWAFv2ACLARN                  = "wafv2-acl-arn"
+	IngressSuffixWAFv2WebACL                  = "wafv2-web-acl"
 	IngressSuffixWAFACLID                     = "waf-acl-id"
 	IngressSuffixWebACLID                     = "web-acl-id" // deprecated, use "waf-acl-id" instead.
 	IngressSuffixShieldAdvancedProtection     = "shield-advanced-protection"
@@ -48,6 +52,13 @@ const (
 	IngressSuffixManageSecurityGroupRules     = "manage-backend-security-group-rules"
 	IngressSuffixMutualAuthentication         = "mutual-authentication"
 	IngressSuffixSecurityGroupPrefixLists     = "security-group-prefix-lists"
+	IngressSuffixDryRun                       = "dry-run"
+	IngressSuffixDryRunConfigMap              = "dry-run-configmap"
+	IngressSuffixDeletionPolicy               = "deletion-policy"
+	IngressSuffixLoadBalancerConfiguration    = "load-balancer-configuration"
+
+	// Gateway annotations share the Ingress annotation suffixes, e.g. gateway.k8s.aws/scheme
+	AnnotationPrefixGateway = "gateway.k8s.aws"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -55,6 +66,7 @@ const (
 	SvcLBSuffixLoadBalancerType                          = "aws-load-balancer-type"
 	SvcLBSuffixTargetType                                = "aws-load-balancer-nlb-target-type"
 	SvcLBSuffixLoadBalancerName                          = "aws-load-balancer-name"
+	SvcLBSuffixLoadBalancerARN                           = "aws-load-balancer-arn"
 	SvcLBSuffixScheme                                    = "aws-load-balancer-scheme"
 	SvcLBSuffixInternal                                  = "aws-load-balancer-internal"
 	SvcLBSuffixProxyProtocol                             = "aws-load-balancer-proxy-protocol"
@@ -88,4 +100,12 @@ const (
 	SvcLBSuffixManageSGRules                             = "aws-load-balancer-manage-backend-security-group-rules"
 	SvcLBSuffixEnforceSGInboundRulesOnPrivateLinkTraffic = "aws-load-balancer-inbound-sg-rules-on-private-link-traffic"
 	SvcLBSuffixSecurityGroupPrefixLists                  = "aws-load-balancer-security-group-prefix-lists"
+	SvcLBSuffixDryRun                                    = "aws-load-balancer-dry-run"
+	SvcLBSuffixDryRunConfigMap                           = "aws-load-balancer-dry-run-configmap"
+	SvcLBSuffixDeletionPolicy                            = "aws-load-balancer-deletion-policy"
+	SvcLBSuffixDriftRemediation                          = "aws-load-balancer-drift-remediation"
+	SvcLBSuffixIAMRoleARN                                = "aws-load-balancer-iam-role-arn"
+	SvcLBSuffixIAMRoleExternalID                         = "aws-load-balancer-iam-role-external-id"
+	SvcLBSuffixIAMRoleVpcID                              = "aws-load-balancer-iam-role-vpc-id"
+	SvcLBSuffixLoadBalancerConfiguration                 = "aws-load-balancer-configuration"
 )
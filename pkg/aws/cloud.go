@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
@@ -41,6 +42,9 @@ type Cloud interface {
 	// RGT provides API to AWS RGT
 	RGT() services.RGT
 
+	// IAM provides API to AWS IAM
+	IAM() services.IAM
+
 	// Region for the kubernetes cluster
 	Region() string
 
@@ -50,6 +54,20 @@ type Cloud interface {
 
 // NewCloud constructs new Cloud implementation.
 func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud, error) {
+	return newDefaultCloud(cfg, metricsRegisterer)
+}
+
+// NewUncachedCloud wraps cloud so that its EC2 and ELBV2 services call AWS without the cache of Describe calls,
+// for callers that must observe the current state of AWS resources.
+func NewUncachedCloud(cloud Cloud) Cloud {
+	return &uncachedCloud{
+		Cloud: cloud,
+		ec2:   services.UncachedEC2(cloud.EC2()),
+		elbv2: services.UncachedELBV2(cloud.ELBV2()),
+	}
+}
+
+func newDefaultCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (*defaultCloud, error) {
 	hasIPv4 := true
 	addrs, err := net.InterfaceAddrs()
 	if err == nil {
@@ -95,22 +113,23 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	if !hasIPv4 {
 		opts.EC2IMDSEndpointMode = endpoints.EC2IMDSEndpointModeStateIPv6
 	}
-	sess := session.Must(session.NewSessionWithOptions(opts))
-	injectUserAgent(&sess.Handlers)
+	baseSess := session.Must(session.NewSessionWithOptions(opts))
+	injectUserAgent(&baseSess.Handlers)
 
-	if cfg.ThrottleConfig != nil {
-		throttler := throttle.NewThrottler(cfg.ThrottleConfig)
-		throttler.InjectHandlers(&sess.Handlers)
-	}
+	var metricsCollector metrics.Collector
 	if metricsRegisterer != nil {
-		metricsCollector, err := metrics.NewCollector(metricsRegisterer)
+		metricsCollector, err = metrics.NewCollector(metricsRegisterer)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to initialize sdk metrics collector")
 		}
+	}
+	sess := baseSess.Copy()
+	injectThrottler(cfg, &sess.Handlers, metricsCollector)
+	if metricsCollector != nil {
 		metricsCollector.InjectHandlers(&sess.Handlers)
 	}
 
-	ec2Service := services.NewEC2(sess)
+	ec2Service, elbv2Service := newEC2AndELBV2(cfg, sess, metricsCollector)
 
 	if len(cfg.VpcID) == 0 {
 		vpcID, err := inferVPCID(metadata, ec2Service)
@@ -121,17 +140,47 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	}
 
 	return &defaultCloud{
-		cfg:         cfg,
-		ec2:         ec2Service,
-		elbv2:       services.NewELBV2(sess),
-		acm:         services.NewACM(sess),
-		wafv2:       services.NewWAFv2(sess),
-		wafRegional: services.NewWAFRegional(sess, cfg.Region),
-		shield:      services.NewShield(sess),
-		rgt:         services.NewRGT(sess),
+		cfg:              cfg,
+		baseSess:         baseSess,
+		metricsCollector: metricsCollector,
+		ec2:              ec2Service,
+		elbv2:            elbv2Service,
+		acm:              services.NewACM(sess),
+		wafv2:            services.NewWAFv2(sess),
+		wafRegional:      services.NewWAFRegional(sess, cfg.Region),
+		shield:           services.NewShield(sess),
+		rgt:              services.NewRGT(sess),
+		iam:              services.NewIAM(sess),
 	}, nil
 }
 
+// injectThrottler injects the handlers of the throttler configured by cfg, which observes its effective rates with metricsCollector.
+// each AWS account has a throttler of its own, so that the rates of an account adapt to the throttling responses from that account.
+func injectThrottler(cfg CloudConfig, handlers *request.Handlers, metricsCollector metrics.Collector) {
+	if cfg.ThrottleConfig == nil {
+		return
+	}
+	throttler := throttle.NewThrottler(cfg.ThrottleConfig)
+	if cfg.ThrottleAdaptive {
+		throttler = throttler.WithAdaptiveRate()
+	}
+	if metricsCollector != nil {
+		throttler = throttler.WithRateMetricsCollector(metricsCollector)
+	}
+	throttler.InjectHandlers(handlers)
+}
+
+// newEC2AndELBV2 constructs the EC2 and ELBV2 services with sess, which cache the results of Describe calls if enabled by cfg.
+func newEC2AndELBV2(cfg CloudConfig, sess *session.Session, metricsCollector metrics.Collector) (services.EC2, services.ELBV2) {
+	ec2Service := services.NewEC2(sess)
+	elbv2Service := services.NewELBV2(sess)
+	if cfg.APICacheTTL <= 0 {
+		return ec2Service, elbv2Service
+	}
+	return services.NewCachedEC2(ec2Service, cfg.APICacheTTL, metricsCollector),
+		services.NewCachedELBV2(elbv2Service, cfg.APICacheTTL, metricsCollector)
+}
+
 func inferVPCID(metadata services.EC2Metadata, ec2Service services.EC2) (string, error) {
 	var errList []error
 	vpcId, err := metadata.VpcID()
@@ -173,6 +222,10 @@ var _ Cloud = &defaultCloud{}
 type defaultCloud struct {
 	cfg CloudConfig
 
+	// baseSess is the session without throttling and metrics handlers, sessions for assumed IAM roles are derived from it.
+	baseSess         *session.Session
+	metricsCollector metrics.Collector
+
 	ec2   services.EC2
 	elbv2 services.ELBV2
 
@@ -181,6 +234,7 @@ type defaultCloud struct {
 	wafRegional services.WAFRegional
 	shield      services.Shield
 	rgt         services.RGT
+	iam         services.IAM
 }
 
 func (c *defaultCloud) EC2() services.EC2 {
@@ -211,6 +265,10 @@ func (c *defaultCloud) RGT() services.RGT {
 	return c.rgt
 }
 
+func (c *defaultCloud) IAM() services.IAM {
+	return c.iam
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
@@ -218,3 +276,18 @@ func (c *defaultCloud) Region() string {
 func (c *defaultCloud) VpcID() string {
 	return c.cfg.VpcID
 }
+
+// uncachedCloud is Cloud implementation whose EC2 and ELBV2 services don't cache the results of Describe calls.
+type uncachedCloud struct {
+	Cloud
+	ec2   services.EC2
+	elbv2 services.ELBV2
+}
+
+func (c *uncachedCloud) EC2() services.EC2 {
+	return c.ec2
+}
+
+func (c *uncachedCloud) ELBV2() services.ELBV2 {
+	return c.elbv2
+}
@@ -8,15 +8,18 @@ import (
 )
 
 const (
-	flagAWSRegion        = "aws-region"
-	flagAWSAPIEndpoints  = "aws-api-endpoints"
-	flagAWSAPIThrottle   = "aws-api-throttle"
-	flagAWSVpcID         = "aws-vpc-id"
-	flagAWSVpcCacheTTL   = "aws-vpc-cache-ttl"
-	flagAWSMaxRetries    = "aws-max-retries"
-	defaultVpcID         = ""
-	defaultRegion        = ""
-	defaultAPIMaxRetries = 10
+	flagAWSRegion              = "aws-region"
+	flagAWSAPIEndpoints        = "aws-api-endpoints"
+	flagAWSAPIThrottle         = "aws-api-throttle"
+	flagAWSVpcID               = "aws-vpc-id"
+	flagAWSVpcCacheTTL         = "aws-vpc-cache-ttl"
+	flagAWSMaxRetries          = "aws-max-retries"
+	flagAWSAPICacheTTL         = "aws-api-cache-ttl"
+	flagAWSAPIThrottleAdaptive = "aws-api-throttle-adaptive"
+	defaultVpcID               = ""
+	defaultRegion              = ""
+	defaultAPIMaxRetries       = 10
+	defaultAPICacheTTL         = 0
 )
 
 type CloudConfig struct {
@@ -26,6 +29,9 @@ type CloudConfig struct {
 	// Throttle settings for AWS APIs
 	ThrottleConfig *throttle.ServiceOperationsThrottleConfig
 
+	// ThrottleAdaptive backs off the throttle rates on throttling responses from AWS APIs, and slowly recovers them.
+	ThrottleAdaptive bool
+
 	// VpcID for the LoadBalancer resources.
 	VpcID string
 
@@ -37,12 +43,17 @@ type CloudConfig struct {
 
 	// AWS endpoints configuration
 	AWSEndpoints map[string]string
+
+	// TTL of the cached results of ELBV2 and EC2 Describe calls, cache is disabled if not positive.
+	APICacheTTL time.Duration
 }
 
 func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&cfg.Region, flagAWSRegion, defaultRegion, "AWS Region for the kubernetes cluster")
 	fs.Var(cfg.ThrottleConfig, flagAWSAPIThrottle, "throttle settings for AWS APIs, format: serviceID1:operationRegex1=rate:burst,serviceID2:operationRegex2=rate:burst")
+	fs.BoolVar(&cfg.ThrottleAdaptive, flagAWSAPIThrottleAdaptive, false, "Back off the throttle rates for AWS APIs on throttling responses, and slowly recover them")
 	fs.StringVar(&cfg.VpcID, flagAWSVpcID, defaultVpcID, "AWS VpcID for the LoadBalancer resources")
 	fs.IntVar(&cfg.MaxRetries, flagAWSMaxRetries, defaultAPIMaxRetries, "Maximum retries for AWS APIs")
 	fs.StringToStringVar(&cfg.AWSEndpoints, flagAWSAPIEndpoints, nil, "Custom AWS endpoint configuration, format: serviceID1=URL1,serviceID2=URL2")
+	fs.DurationVar(&cfg.APICacheTTL, flagAWSAPICacheTTL, defaultAPICacheTTL, "TTL of the cached results of ELBV2 and EC2 Describe calls, the cache is disabled if 0")
 }
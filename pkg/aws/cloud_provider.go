@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"encoding/json"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// assumeRoleSessionName is the session name when assuming IAM roles.
+	assumeRoleSessionName = "aws-load-balancer-controller"
+)
+
+// AssumeRoleConfig specifies the IAM role to assume to access resources in another AWS account.
+type AssumeRoleConfig struct {
+	// RoleARN is the ARN of the IAM role to assume.
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// ExternalID is the external ID to specify when assuming the IAM role.
+	ExternalID string `json:"externalID,omitempty"`
+
+	// VpcID for the LoadBalancer resources in the other AWS account.
+	// defaults to the VpcID of the controller's own Cloud, e.g. when the VPC is shared with the other AWS account.
+	VpcID string `json:"vpcID,omitempty"`
+}
+
+// MarshalAssumeRoleConfig encodes assumeRole to be recorded on Kubernetes objects, nil assumeRole stands for the controller's own AWS account.
+func MarshalAssumeRoleConfig(assumeRole *AssumeRoleConfig) string {
+	if assumeRole == nil {
+		assumeRole = &AssumeRoleConfig{}
+	}
+	payload, _ := json.Marshal(assumeRole)
+	return string(payload)
+}
+
+// UnmarshalAssumeRoleConfig decodes the assumeRole encoded by MarshalAssumeRoleConfig.
+func UnmarshalAssumeRoleConfig(value string) (*AssumeRoleConfig, error) {
+	assumeRole := &AssumeRoleConfig{}
+	if err := json.Unmarshal([]byte(value), assumeRole); err != nil {
+		return nil, errors.Wrapf(err, "invalid IAM role: %v", value)
+	}
+	if assumeRole.RoleARN == "" {
+		return nil, nil
+	}
+	return assumeRole, nil
+}
+
+// CloudProvider provides the Cloud to access resources in AWS accounts.
+type CloudProvider interface {
+	// DefaultCloud returns the Cloud with the controller's own credentials.
+	DefaultCloud() Cloud
+
+	// CloudForRole returns the Cloud with the credentials of the assumed IAM role.
+	// returns the DefaultCloud if assumeRole is nil.
+	CloudForRole(assumeRole *AssumeRoleConfig) (Cloud, error)
+}
+
+// NewDefaultCloudProvider constructs new defaultCloudProvider.
+func NewDefaultCloudProvider(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (*defaultCloudProvider, error) {
+	cloud, err := newDefaultCloud(cfg, metricsRegisterer)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultCloudProvider{
+		defaultCloud:      cloud,
+		assumedRoleClouds: make(map[AssumeRoleConfig]Cloud),
+	}, nil
+}
+
+var _ CloudProvider = &defaultCloudProvider{}
+
+// default implementation for CloudProvider.
+// the Cloud for each IAM role is cached, so that API calls with it share the throttler and credentials.
+type defaultCloudProvider struct {
+	defaultCloud *defaultCloud
+
+	assumedRoleCloudsMutex sync.Mutex
+	assumedRoleClouds      map[AssumeRoleConfig]Cloud
+}
+
+func (p *defaultCloudProvider) DefaultCloud() Cloud {
+	return p.defaultCloud
+}
+
+func (p *defaultCloudProvider) CloudForRole(assumeRole *AssumeRoleConfig) (Cloud, error) {
+	if assumeRole == nil {
+		return p.defaultCloud, nil
+	}
+	if _, err := arn.Parse(assumeRole.RoleARN); err != nil {
+		return nil, errors.Wrapf(err, "invalid IAM role ARN: %v", assumeRole.RoleARN)
+	}
+	cacheKey := *assumeRole
+	if cacheKey.VpcID == "" {
+		cacheKey.VpcID = p.defaultCloud.VpcID()
+	}
+
+	p.assumedRoleCloudsMutex.Lock()
+	defer p.assumedRoleCloudsMutex.Unlock()
+	if cloud, exists := p.assumedRoleClouds[cacheKey]; exists {
+		return cloud, nil
+	}
+	cloud := p.defaultCloud.newAssumedRoleCloud(cacheKey)
+	p.assumedRoleClouds[cacheKey] = cloud
+	return cloud, nil
+}
+
+// newAssumedRoleCloud constructs the Cloud with the credentials of the assumed IAM role.
+// the Cloud has its own throttler, and the metrics of its API calls are labeled with the IAM role.
+func (c *defaultCloud) newAssumedRoleCloud(assumeRole AssumeRoleConfig) *defaultCloud {
+	creds := stscreds.NewCredentials(c.baseSess, assumeRole.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = assumeRoleSessionName
+		if assumeRole.ExternalID != "" {
+			p.ExternalID = awssdk.String(assumeRole.ExternalID)
+		}
+	})
+	baseSess := c.baseSess.Copy(awssdk.NewConfig().WithCredentials(creds))
+	sess := baseSess.Copy()
+	metricsCollector := c.metricsCollector
+	if metricsCollector != nil {
+		metricsCollector = metricsCollector.WithIAMRole(assumeRole.RoleARN)
+	}
+	injectThrottler(c.cfg, &sess.Handlers, metricsCollector)
+	if metricsCollector != nil {
+		metricsCollector.InjectHandlers(&sess.Handlers)
+	}
+
+	cfg := c.cfg
+	cfg.VpcID = assumeRole.VpcID
+	ec2Service, elbv2Service := newEC2AndELBV2(cfg, sess, metricsCollector)
+	return &defaultCloud{
+		cfg:              cfg,
+		baseSess:         baseSess,
+		metricsCollector: metricsCollector,
+		ec2:              ec2Service,
+		elbv2:            elbv2Service,
+		acm:              services.NewACM(sess),
+		wafv2:            services.NewWAFv2(sess),
+		wafRegional:      services.NewWAFRegional(sess, cfg.Region),
+		shield:           services.NewShield(sess),
+		rgt:              services.NewRGT(sess),
+		iam:              services.NewIAM(sess),
+	}
+}
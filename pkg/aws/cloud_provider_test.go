@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_defaultCloudProvider_CloudForRole(t *testing.T) {
+	defaultCloud := &defaultCloud{
+		cfg: CloudConfig{
+			Region: "us-west-2",
+			VpcID:  "vpc-cluster",
+		},
+		baseSess: session.Must(session.NewSession(awssdk.NewConfig().WithRegion("us-west-2"))),
+	}
+	tests := []struct {
+		name        string
+		assumeRoles []*AssumeRoleConfig
+		wantVpcIDs  []string
+		wantSame    bool
+		wantErr     string
+	}{
+		{
+			name:        "default cloud when no role to assume",
+			assumeRoles: []*AssumeRoleConfig{nil},
+			wantVpcIDs:  []string{"vpc-cluster"},
+		},
+		{
+			name: "cloud for role is cached",
+			assumeRoles: []*AssumeRoleConfig{
+				{RoleARN: "arn:aws:iam::123456789012:role/ingress", ExternalID: "external-id"},
+				{RoleARN: "arn:aws:iam::123456789012:role/ingress", ExternalID: "external-id", VpcID: "vpc-cluster"},
+			},
+			wantVpcIDs: []string{"vpc-cluster", "vpc-cluster"},
+			wantSame:   true,
+		},
+		{
+			name: "cloud for role with different external ID",
+			assumeRoles: []*AssumeRoleConfig{
+				{RoleARN: "arn:aws:iam::123456789012:role/ingress", ExternalID: "external-id"},
+				{RoleARN: "arn:aws:iam::123456789012:role/ingress", ExternalID: "other-external-id"},
+			},
+			wantVpcIDs: []string{"vpc-cluster", "vpc-cluster"},
+			wantSame:   false,
+		},
+		{
+			name: "cloud for role in VPC of other account",
+			assumeRoles: []*AssumeRoleConfig{
+				{RoleARN: "arn:aws:iam::123456789012:role/ingress", VpcID: "vpc-ingress"},
+			},
+			wantVpcIDs: []string{"vpc-ingress"},
+		},
+		{
+			name: "invalid role ARN",
+			assumeRoles: []*AssumeRoleConfig{
+				{RoleARN: "ingress"},
+			},
+			wantErr: "invalid IAM role ARN: ingress: arn: invalid prefix",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &defaultCloudProvider{
+				defaultCloud:      defaultCloud,
+				assumedRoleClouds: make(map[AssumeRoleConfig]Cloud),
+			}
+			var clouds []Cloud
+			for _, assumeRole := range tt.assumeRoles {
+				cloud, err := p.CloudForRole(assumeRole)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					return
+				}
+				assert.NoError(t, err)
+				clouds = append(clouds, cloud)
+			}
+			var gotVpcIDs []string
+			for _, cloud := range clouds {
+				gotVpcIDs = append(gotVpcIDs, cloud.VpcID())
+				assert.Equal(t, "us-west-2", cloud.Region())
+			}
+			assert.Equal(t, tt.wantVpcIDs, gotVpcIDs)
+			if len(clouds) == 2 {
+				assert.Equal(t, tt.wantSame, clouds[0] == clouds[1])
+			}
+		})
+	}
+}
+
+func Test_MarshalAssumeRoleConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		assumeRole *AssumeRoleConfig
+		want       string
+	}{
+		{
+			name:       "controller's own account",
+			assumeRole: nil,
+			want:       `{}`,
+		},
+		{
+			name:       "role with external ID and VPC",
+			assumeRole: &AssumeRoleConfig{RoleARN: "arn:aws:iam::123456789012:role/ingress", ExternalID: "external-id", VpcID: "vpc-ingress"},
+			want:       `{"roleARN":"arn:aws:iam::123456789012:role/ingress","externalID":"external-id","vpcID":"vpc-ingress"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MarshalAssumeRoleConfig(tt.assumeRole)
+			assert.Equal(t, tt.want, got)
+			assumeRole, err := UnmarshalAssumeRoleConfig(got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.assumeRole, assumeRole)
+		})
+	}
+}
+
+func Test_UnmarshalAssumeRoleConfig_Invalid(t *testing.T) {
+	_, err := UnmarshalAssumeRoleConfig("arn:aws:iam::123456789012:role/ingress")
+	assert.ErrorContains(t, err, "invalid IAM role: arn:aws:iam::123456789012:role/ingress")
+}
@@ -13,8 +13,30 @@ const (
 	sdkHandlerCollectAPIRequestMetric = "collectAPIRequestMetric"
 )
 
+// Collector collects metrics of AWS API calls.
+type Collector interface {
+	// InjectHandlers injects the handlers to collect metrics of API calls made with handlers.
+	InjectHandlers(handlers *request.Handlers)
+
+	// WithIAMRole returns a Collector that shares the metrics of this Collector, with API calls labeled as made with the assumed IAM role.
+	WithIAMRole(iamRole string) Collector
+
+	// ObserveAPICacheHit observes an API call to operation of service that is served from the API cache.
+	ObserveAPICacheHit(service string, operation string)
+
+	// ObserveAPICacheMiss observes a cacheable API call to operation of service that isn't served from the API cache.
+	ObserveAPICacheMiss(service string, operation string)
+
+	// ObserveThrottleRate observes the effective rate of the client side throttle for operations of service that match operationPattern.
+	ObserveThrottleRate(service string, operationPattern string, rate float64)
+}
+
+var _ Collector = &collector{}
+
 type collector struct {
 	instruments *instruments
+	// iamRole is the ARN of the assumed IAM role the collected API calls are made with, empty for the controller's own credentials.
+	iamRole string
 }
 
 func NewCollector(registerer prometheus.Registerer) (*collector, error) {
@@ -27,6 +49,13 @@ func NewCollector(registerer prometheus.Registerer) (*collector, error) {
 	}, nil
 }
 
+func (c *collector) WithIAMRole(iamRole string) Collector {
+	return &collector{
+		instruments: c.instruments,
+		iamRole:     iamRole,
+	}
+}
+
 func (c *collector) InjectHandlers(handlers *request.Handlers) {
 	handlers.CompleteAttempt.PushFrontNamed(request.NamedHandler{
 		Name: sdkHandlerCollectAPIRequestMetric,
@@ -38,6 +67,30 @@ func (c *collector) InjectHandlers(handlers *request.Handlers) {
 	})
 }
 
+func (c *collector) ObserveAPICacheHit(service string, operation string) {
+	c.instruments.apiCacheHitsTotal.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+		labelIAMRole:   c.iamRole,
+	}).Inc()
+}
+
+func (c *collector) ObserveAPICacheMiss(service string, operation string) {
+	c.instruments.apiCacheMissesTotal.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+		labelIAMRole:   c.iamRole,
+	}).Inc()
+}
+
+func (c *collector) ObserveThrottleRate(service string, operationPattern string, rate float64) {
+	c.instruments.apiThrottleRate.With(map[string]string{
+		labelService:          service,
+		labelOperationPattern: operationPattern,
+		labelIAMRole:          c.iamRole,
+	}).Set(rate)
+}
+
 func (c *collector) collectAPIRequestMetric(r *request.Request) {
 	service := r.ClientInfo.ServiceID
 	operation := r.Operation.Name
@@ -50,10 +103,12 @@ func (c *collector) collectAPIRequestMetric(r *request.Request) {
 		labelOperation:  operation,
 		labelStatusCode: statusCode,
 		labelErrorCode:  errorCode,
+		labelIAMRole:    c.iamRole,
 	}).Inc()
 	c.instruments.apiRequestDurationSecond.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelIAMRole:   c.iamRole,
 	}).Observe(duration.Seconds())
 }
 
@@ -69,14 +124,17 @@ func (c *collector) collectAPICallMetric(r *request.Request) {
 		labelOperation:  operation,
 		labelStatusCode: statusCode,
 		labelErrorCode:  errorCode,
+		labelIAMRole:    c.iamRole,
 	}).Inc()
 	c.instruments.apiCallDurationSeconds.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelIAMRole:   c.iamRole,
 	}).Observe(duration.Seconds())
 	c.instruments.apiCallRetries.With(map[string]string{
 		labelService:   service,
 		labelOperation: operation,
+		labelIAMRole:   c.iamRole,
 	}).Observe(float64(r.RetryCount))
 }
 
@@ -13,6 +13,11 @@ const (
 
 	metricAPIRequestsTotal          = "api_requests_total"
 	metricAPIRequestDurationSeconds = "api_request_duration_seconds"
+
+	metricAPICacheHitsTotal   = "api_cache_hits_total"
+	metricAPICacheMissesTotal = "api_cache_misses_total"
+
+	metricAPIThrottleRate = "api_throttle_rate"
 )
 
 const (
@@ -20,6 +25,9 @@ const (
 	labelOperation  = "operation"
 	labelStatusCode = "status_code"
 	labelErrorCode  = "error_code"
+	labelIAMRole    = "iam_role"
+
+	labelOperationPattern = "operation_pattern"
 )
 
 type instruments struct {
@@ -28,6 +36,9 @@ type instruments struct {
 	apiCallRetries           *prometheus.HistogramVec
 	apiRequestsTotal         *prometheus.CounterVec
 	apiRequestDurationSecond *prometheus.HistogramVec
+	apiCacheHitsTotal        *prometheus.CounterVec
+	apiCacheMissesTotal      *prometheus.CounterVec
+	apiThrottleRate          *prometheus.GaugeVec
 }
 
 // newInstruments allocates and register new metrics to registerer
@@ -36,29 +47,46 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallsTotal,
 		Help:      "Total number of SDK API calls from the customer's code to AWS services",
-	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode})
+	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode, labelIAMRole})
 	apiCallDurationSeconds := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallDurationSeconds,
 		Help:      "Perceived latency from when your code makes an SDK call, includes retries",
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelIAMRole})
 	apiCallRetries := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPICallRetries,
 		Help:      "Number of times the SDK retried requests to AWS services for SDK API calls",
 		Buckets:   []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelIAMRole})
 
 	apiRequestsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPIRequestsTotal,
 		Help:      "Total number of HTTP requests that the SDK made",
-	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode})
+	}, []string{labelService, labelOperation, labelStatusCode, labelErrorCode, labelIAMRole})
 	apiRequestDurationSecond := prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: metricSubsystemAWS,
 		Name:      metricAPIRequestDurationSeconds,
 		Help:      "Latency of an individual HTTP request to the service endpoint",
-	}, []string{labelService, labelOperation})
+	}, []string{labelService, labelOperation, labelIAMRole})
+
+	apiCacheHitsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPICacheHitsTotal,
+		Help:      "Total number of SDK API calls served from the API cache",
+	}, []string{labelService, labelOperation, labelIAMRole})
+	apiCacheMissesTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPICacheMissesTotal,
+		Help:      "Total number of cacheable SDK API calls not served from the API cache",
+	}, []string{labelService, labelOperation, labelIAMRole})
+
+	apiThrottleRate := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPIThrottleRate,
+		Help:      "Effective rate per second of SDK API calls allowed by the client side throttle",
+	}, []string{labelService, labelOperationPattern, labelIAMRole})
 
 	if err := registerer.Register(apiCallsTotal); err != nil {
 		return nil, err
@@ -75,11 +103,23 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 	if err := registerer.Register(apiRequestDurationSecond); err != nil {
 		return nil, err
 	}
+	if err := registerer.Register(apiCacheHitsTotal); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(apiCacheMissesTotal); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(apiThrottleRate); err != nil {
+		return nil, err
+	}
 	return &instruments{
 		apiCallsTotal:            apiCallsTotal,
 		apiCallDurationSeconds:   apiCallDurationSeconds,
 		apiCallRetries:           apiCallRetries,
 		apiRequestsTotal:         apiRequestsTotal,
 		apiRequestDurationSecond: apiRequestDurationSecond,
+		apiCacheHitsTotal:        apiCacheHitsTotal,
+		apiCacheMissesTotal:      apiCacheMissesTotal,
+		apiThrottleRate:          apiThrottleRate,
 	}, nil
 }
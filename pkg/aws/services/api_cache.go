@@ -0,0 +1,121 @@
+package services
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// APICacheMetricsCollector collects the hits and misses of API calls served from cache.
+type APICacheMetricsCollector interface {
+	// ObserveAPICacheHit observes an API call to operation of service that is served from cache.
+	ObserveAPICacheHit(service string, operation string)
+
+	// ObserveAPICacheMiss observes a cacheable API call to operation of service that isn't served from cache.
+	ObserveAPICacheMiss(service string, operation string)
+}
+
+// newAPICache constructs new apiCache.
+func newAPICache(service string, ttl time.Duration, metricsCollector APICacheMetricsCollector) *apiCache {
+	return &apiCache{
+		service:          service,
+		ttl:              ttl,
+		metricsCollector: metricsCollector,
+		entries:          cache.NewExpiring(),
+	}
+}
+
+// apiCache caches the results of API calls to a service, keyed by operation and the ARN or ID of resources.
+// results are deep copied when saved and returned, so that callers modifying them don't corrupt the cache.
+type apiCache struct {
+	service          string
+	ttl              time.Duration
+	metricsCollector APICacheMetricsCollector
+
+	mutex   sync.Mutex
+	entries *cache.Expiring
+	// generation is increased on every invalidation.
+	// results fetched before an invalidation might be stale, and aren't saved into cache.
+	generation uint64
+}
+
+// currentGeneration returns the current generation of cache, which should be acquired before fetching results to save.
+func (c *apiCache) currentGeneration() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.generation
+}
+
+// get returns the cached result of operation for key.
+func (c *apiCache) get(operation string, key string) (interface{}, bool) {
+	result, exists := c.entries.Get(apiCacheKey(operation, key))
+	c.observe(operation, exists)
+	if !exists {
+		return nil, false
+	}
+	return copyAPIResult(result), true
+}
+
+// getAll returns the cached results of operation for keys, along with the keys missing from cache.
+func (c *apiCache) getAll(operation string, keys []string) ([]interface{}, []string) {
+	var results []interface{}
+	var missingKeys []string
+	for _, key := range sets.NewString(keys...).List() {
+		if result, exists := c.entries.Get(apiCacheKey(operation, key)); exists {
+			results = append(results, copyAPIResult(result))
+		} else {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+	c.observe(operation, len(missingKeys) == 0)
+	return results, missingKeys
+}
+
+// set saves the result of operation for key, unless cache is invalidated since generation.
+func (c *apiCache) set(generation uint64, operation string, key string, result interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries.Set(apiCacheKey(operation, key), copyAPIResult(result), c.ttl)
+}
+
+// invalidate removes the cached result of operation for keys.
+func (c *apiCache) invalidate(operation string, keys ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	for _, key := range keys {
+		c.entries.Delete(apiCacheKey(operation, key))
+	}
+}
+
+func (c *apiCache) observe(operation string, hit bool) {
+	if c.metricsCollector == nil {
+		return
+	}
+	if hit {
+		c.metricsCollector.ObserveAPICacheHit(c.service, operation)
+	} else {
+		c.metricsCollector.ObserveAPICacheMiss(c.service, operation)
+	}
+}
+
+// copyAPIResult deep copies the result of an API call, e.g. a pointer to or a slice of AWS SDK shapes.
+func copyAPIResult(result interface{}) interface{} {
+	resultType := reflect.TypeOf(result)
+	src := reflect.New(resultType)
+	src.Elem().Set(reflect.ValueOf(result))
+	dst := reflect.New(resultType)
+	awsutil.Copy(dst.Interface(), src.Interface())
+	return dst.Elem().Interface()
+}
+
+func apiCacheKey(operation string, key string) string {
+	return operation + "/" + key
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	ec2OperationDescribeSecurityGroups = "DescribeSecurityGroups"
+)
+
+// NewCachedEC2 constructs new EC2 implementation that caches the results of Describe calls to ec2Client for ttl.
+// cached results are invalidated when resources are modified with it.
+func NewCachedEC2(ec2Client EC2, ttl time.Duration, metricsCollector APICacheMetricsCollector) EC2 {
+	return &cachedEC2{
+		EC2:   ec2Client,
+		cache: newAPICache(ec2.ServiceID, ttl, metricsCollector),
+	}
+}
+
+// UncachedEC2 returns the EC2 implementation that ec2Client caches the results of Describe calls to,
+// or ec2Client itself if it doesn't cache.
+func UncachedEC2(ec2Client EC2) EC2 {
+	if cachedClient, ok := ec2Client.(*cachedEC2); ok {
+		return cachedClient.EC2
+	}
+	return ec2Client
+}
+
+// cachedEC2 is EC2 implementation that serves Describe calls by the ID of security groups from cache.
+// other Describe calls of security groups are passed through, and their results are cached.
+// changes made outside the controller are observed once the cached results expire.
+type cachedEC2 struct {
+	EC2
+	cache *apiCache
+}
+
+func (c *cachedEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	generation := c.cache.currentGeneration()
+	if len(input.GroupIds) == 0 || len(input.GroupNames) != 0 || len(input.Filters) != 0 || input.NextToken != nil {
+		sgs, err := c.EC2.DescribeSecurityGroupsAsList(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		c.saveSecurityGroups(generation, sgs)
+		return sgs, nil
+	}
+
+	cachedSGs, missingSGIDs := c.cache.getAll(ec2OperationDescribeSecurityGroups, awssdk.StringValueSlice(input.GroupIds))
+	result := make([]*ec2.SecurityGroup, 0, len(input.GroupIds))
+	for _, sg := range cachedSGs {
+		result = append(result, sg.(*ec2.SecurityGroup))
+	}
+	if len(missingSGIDs) == 0 {
+		return result, nil
+	}
+	sgs, err := c.EC2.DescribeSecurityGroupsAsList(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: awssdk.StringSlice(missingSGIDs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.saveSecurityGroups(generation, sgs)
+	return append(result, sgs...), nil
+}
+
+func (c *cachedEC2) AuthorizeSecurityGroupIngressWithContext(ctx awssdk.Context, input *ec2.AuthorizeSecurityGroupIngressInput, opts ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValue(input.GroupId))
+	return c.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) RevokeSecurityGroupIngressWithContext(ctx awssdk.Context, input *ec2.RevokeSecurityGroupIngressInput, opts ...request.Option) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValue(input.GroupId))
+	return c.EC2.RevokeSecurityGroupIngressWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) AuthorizeSecurityGroupEgressWithContext(ctx awssdk.Context, input *ec2.AuthorizeSecurityGroupEgressInput, opts ...request.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValue(input.GroupId))
+	return c.EC2.AuthorizeSecurityGroupEgressWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) RevokeSecurityGroupEgressWithContext(ctx awssdk.Context, input *ec2.RevokeSecurityGroupEgressInput, opts ...request.Option) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValue(input.GroupId))
+	return c.EC2.RevokeSecurityGroupEgressWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) DeleteSecurityGroupWithContext(ctx awssdk.Context, input *ec2.DeleteSecurityGroupInput, opts ...request.Option) (*ec2.DeleteSecurityGroupOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValue(input.GroupId))
+	return c.EC2.DeleteSecurityGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) CreateTagsWithContext(ctx awssdk.Context, input *ec2.CreateTagsInput, opts ...request.Option) (*ec2.CreateTagsOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValueSlice(input.Resources)...)
+	return c.EC2.CreateTagsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) DeleteTagsWithContext(ctx awssdk.Context, input *ec2.DeleteTagsInput, opts ...request.Option) (*ec2.DeleteTagsOutput, error) {
+	defer c.cache.invalidate(ec2OperationDescribeSecurityGroups, awssdk.StringValueSlice(input.Resources)...)
+	return c.EC2.DeleteTagsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) saveSecurityGroups(generation uint64, sgs []*ec2.SecurityGroup) {
+	for _, sg := range sgs {
+		c.cache.set(generation, ec2OperationDescribeSecurityGroups, awssdk.StringValue(sg.GroupId), sg)
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cachedEC2_DescribeSecurityGroupsAsList(t *testing.T) {
+	sg1 := &ec2.SecurityGroup{GroupId: awssdk.String("sg-1")}
+	sg2 := &ec2.SecurityGroup{GroupId: awssdk.String("sg-2")}
+	filterInput := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   awssdk.String("tag:elbv2.k8s.aws/cluster"),
+				Values: awssdk.StringSlice([]string{"cluster"}),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ec2Client := NewMockEC2(ctrl)
+	gomock.InOrder(
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), filterInput).
+			Return([]*ec2.SecurityGroup{sg1}, nil),
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+			GroupIds: awssdk.StringSlice([]string{"sg-2"}),
+		}).Return([]*ec2.SecurityGroup{sg2}, nil),
+		ec2Client.EXPECT().CreateTagsWithContext(gomock.Any(), &ec2.CreateTagsInput{
+			Resources: awssdk.StringSlice([]string{"sg-1"}),
+		}).Return(&ec2.CreateTagsOutput{}, nil),
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+			GroupIds: awssdk.StringSlice([]string{"sg-1"}),
+		}).Return([]*ec2.SecurityGroup{sg1}, nil),
+	)
+	metricsCollector := newFakeAPICacheMetricsCollector()
+	cachedClient := NewCachedEC2(ec2Client, time.Minute, metricsCollector)
+	ctx := context.Background()
+
+	got, err := cachedClient.DescribeSecurityGroupsAsList(ctx, filterInput)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ec2.SecurityGroup{sg1}, got)
+
+	got, err = cachedClient.DescribeSecurityGroupsAsList(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: awssdk.StringSlice([]string{"sg-1", "sg-2"}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*ec2.SecurityGroup{sg1, sg2}, got)
+
+	_, err = cachedClient.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+		Resources: awssdk.StringSlice([]string{"sg-1"}),
+	})
+	assert.NoError(t, err)
+	got, err = cachedClient.DescribeSecurityGroupsAsList(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: awssdk.StringSlice([]string{"sg-1", "sg-2"}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*ec2.SecurityGroup{sg2, sg1}, got)
+
+	assert.Equal(t, 0, metricsCollector.hits[ec2OperationDescribeSecurityGroups])
+	assert.Equal(t, 2, metricsCollector.misses[ec2OperationDescribeSecurityGroups])
+}
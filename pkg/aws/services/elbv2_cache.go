@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+const (
+	elbv2OperationDescribeLoadBalancers          = "DescribeLoadBalancers"
+	elbv2OperationDescribeLoadBalancerAttributes = "DescribeLoadBalancerAttributes"
+	elbv2OperationDescribeListeners              = "DescribeListeners"
+	elbv2OperationDescribeRules                  = "DescribeRules"
+	elbv2OperationDescribeTargetGroups           = "DescribeTargetGroups"
+	elbv2OperationDescribeTargetGroupAttributes  = "DescribeTargetGroupAttributes"
+)
+
+// NewCachedELBV2 constructs new ELBV2 implementation that caches the results of Describe calls to elbv2Client for ttl.
+// cached results are invalidated when resources are modified with it.
+func NewCachedELBV2(elbv2Client ELBV2, ttl time.Duration, metricsCollector APICacheMetricsCollector) ELBV2 {
+	return &cachedELBV2{
+		ELBV2: elbv2Client,
+		cache: newAPICache(elbv2.ServiceID, ttl, metricsCollector),
+	}
+}
+
+// UncachedELBV2 returns the ELBV2 implementation that elbv2Client caches the results of Describe calls to,
+// or elbv2Client itself if it doesn't cache.
+func UncachedELBV2(elbv2Client ELBV2) ELBV2 {
+	if cachedClient, ok := elbv2Client.(*cachedELBV2); ok {
+		return cachedClient.ELBV2
+	}
+	return elbv2Client
+}
+
+// cachedELBV2 is ELBV2 implementation that serves Describe calls by the ARN of load balancers, listeners and
+// target groups from cache. other Describe calls are passed through, and their results are cached where possible.
+// changes made outside the controller are observed once the cached results expire.
+type cachedELBV2 struct {
+	ELBV2
+	cache *apiCache
+}
+
+func (c *cachedELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
+	generation := c.cache.currentGeneration()
+	if len(input.LoadBalancerArns) == 0 || len(input.Names) != 0 || input.Marker != nil {
+		lbs, err := c.ELBV2.DescribeLoadBalancersAsList(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		c.saveLoadBalancers(generation, lbs)
+		return lbs, nil
+	}
+
+	cachedLBs, missingLBARNs := c.cache.getAll(elbv2OperationDescribeLoadBalancers, awssdk.StringValueSlice(input.LoadBalancerArns))
+	result := make([]*elbv2.LoadBalancer, 0, len(input.LoadBalancerArns))
+	for _, lb := range cachedLBs {
+		result = append(result, lb.(*elbv2.LoadBalancer))
+	}
+	if len(missingLBARNs) == 0 {
+		return result, nil
+	}
+	lbs, err := c.ELBV2.DescribeLoadBalancersAsList(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice(missingLBARNs),
+		PageSize:         input.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.saveLoadBalancers(generation, lbs)
+	return append(result, lbs...), nil
+}
+
+func (c *cachedELBV2) DescribeLoadBalancerAttributesWithContext(ctx awssdk.Context, input *elbv2.DescribeLoadBalancerAttributesInput, opts ...request.Option) (*elbv2.DescribeLoadBalancerAttributesOutput, error) {
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	if cachedResp, exists := c.cache.get(elbv2OperationDescribeLoadBalancerAttributes, lbARN); exists {
+		return cachedResp.(*elbv2.DescribeLoadBalancerAttributesOutput), nil
+	}
+	generation := c.cache.currentGeneration()
+	resp, err := c.ELBV2.DescribeLoadBalancerAttributesWithContext(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(generation, elbv2OperationDescribeLoadBalancerAttributes, lbARN, resp)
+	return resp, nil
+}
+
+func (c *cachedELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error) {
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	if lbARN == "" || len(input.ListenerArns) != 0 || input.Marker != nil {
+		return c.ELBV2.DescribeListenersAsList(ctx, input)
+	}
+	if cachedListeners, exists := c.cache.get(elbv2OperationDescribeListeners, lbARN); exists {
+		return cachedListeners.([]*elbv2.Listener), nil
+	}
+	generation := c.cache.currentGeneration()
+	listeners, err := c.ELBV2.DescribeListenersAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(generation, elbv2OperationDescribeListeners, lbARN, listeners)
+	return listeners, nil
+}
+
+func (c *cachedELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	if lsARN == "" || len(input.RuleArns) != 0 || input.Marker != nil {
+		return c.ELBV2.DescribeRulesAsList(ctx, input)
+	}
+	if cachedRules, exists := c.cache.get(elbv2OperationDescribeRules, lsARN); exists {
+		return cachedRules.([]*elbv2.Rule), nil
+	}
+	generation := c.cache.currentGeneration()
+	rules, err := c.ELBV2.DescribeRulesAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(generation, elbv2OperationDescribeRules, lsARN, rules)
+	return rules, nil
+}
+
+func (c *cachedELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
+	generation := c.cache.currentGeneration()
+	if len(input.TargetGroupArns) == 0 || len(input.Names) != 0 || input.LoadBalancerArn != nil || input.Marker != nil {
+		tgs, err := c.ELBV2.DescribeTargetGroupsAsList(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		c.saveTargetGroups(generation, tgs)
+		return tgs, nil
+	}
+
+	cachedTGs, missingTGARNs := c.cache.getAll(elbv2OperationDescribeTargetGroups, awssdk.StringValueSlice(input.TargetGroupArns))
+	result := make([]*elbv2.TargetGroup, 0, len(input.TargetGroupArns))
+	for _, tg := range cachedTGs {
+		result = append(result, tg.(*elbv2.TargetGroup))
+	}
+	if len(missingTGARNs) == 0 {
+		return result, nil
+	}
+	tgs, err := c.ELBV2.DescribeTargetGroupsAsList(ctx, &elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice(missingTGARNs),
+		PageSize:        input.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.saveTargetGroups(generation, tgs)
+	return append(result, tgs...), nil
+}
+
+func (c *cachedELBV2) DescribeTargetGroupAttributesWithContext(ctx awssdk.Context, input *elbv2.DescribeTargetGroupAttributesInput, opts ...request.Option) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	if cachedResp, exists := c.cache.get(elbv2OperationDescribeTargetGroupAttributes, tgARN); exists {
+		return cachedResp.(*elbv2.DescribeTargetGroupAttributesOutput), nil
+	}
+	generation := c.cache.currentGeneration()
+	resp, err := c.ELBV2.DescribeTargetGroupAttributesWithContext(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(generation, elbv2OperationDescribeTargetGroupAttributes, tgARN, resp)
+	return resp, nil
+}
+
+func (c *cachedELBV2) DeleteLoadBalancerWithContext(ctx awssdk.Context, input *elbv2.DeleteLoadBalancerInput, opts ...request.Option) (*elbv2.DeleteLoadBalancerOutput, error) {
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	// listeners are deleted along with the load balancer, so are the rules of them.
+	// the listeners are described beforehand, so that cached rules of them are invalidated as well.
+	var lsARNs []string
+	if listeners, err := c.DescribeListenersAsList(ctx, &elbv2.DescribeListenersInput{LoadBalancerArn: input.LoadBalancerArn}); err == nil {
+		for _, listener := range listeners {
+			lsARNs = append(lsARNs, awssdk.StringValue(listener.ListenerArn))
+		}
+	}
+	defer func() {
+		c.invalidateLoadBalancer(lbARN)
+		c.cache.invalidate(elbv2OperationDescribeRules, lsARNs...)
+	}()
+	return c.ELBV2.DeleteLoadBalancerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetSecurityGroupsWithContext(ctx awssdk.Context, input *elbv2.SetSecurityGroupsInput, opts ...request.Option) (*elbv2.SetSecurityGroupsOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeLoadBalancers, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.SetSecurityGroupsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetSubnetsWithContext(ctx awssdk.Context, input *elbv2.SetSubnetsInput, opts ...request.Option) (*elbv2.SetSubnetsOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeLoadBalancers, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.SetSubnetsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetIpAddressTypeWithContext(ctx awssdk.Context, input *elbv2.SetIpAddressTypeInput, opts ...request.Option) (*elbv2.SetIpAddressTypeOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeLoadBalancers, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.SetIpAddressTypeWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyLoadBalancerAttributes(input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeLoadBalancerAttributes, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.ModifyLoadBalancerAttributes(input)
+}
+
+func (c *cachedELBV2) ModifyLoadBalancerAttributesWithContext(ctx awssdk.Context, input *elbv2.ModifyLoadBalancerAttributesInput, opts ...request.Option) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeLoadBalancerAttributes, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.ModifyLoadBalancerAttributesWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) CreateListenerWithContext(ctx awssdk.Context, input *elbv2.CreateListenerInput, opts ...request.Option) (*elbv2.CreateListenerOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeListeners, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.CreateListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyListenerWithContext(ctx awssdk.Context, input *elbv2.ModifyListenerInput, opts ...request.Option) (*elbv2.ModifyListenerOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeListeners, loadBalancerARNForListener(awssdk.StringValue(input.ListenerArn)))
+	return c.ELBV2.ModifyListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteListenerWithContext(ctx awssdk.Context, input *elbv2.DeleteListenerInput, opts ...request.Option) (*elbv2.DeleteListenerOutput, error) {
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	defer func() {
+		c.cache.invalidate(elbv2OperationDescribeListeners, loadBalancerARNForListener(lsARN))
+		c.cache.invalidate(elbv2OperationDescribeRules, lsARN)
+	}()
+	return c.ELBV2.DeleteListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) CreateRuleWithContext(ctx awssdk.Context, input *elbv2.CreateRuleInput, opts ...request.Option) (*elbv2.CreateRuleOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeRules, awssdk.StringValue(input.ListenerArn))
+	return c.ELBV2.CreateRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyRuleWithContext(ctx awssdk.Context, input *elbv2.ModifyRuleInput, opts ...request.Option) (*elbv2.ModifyRuleOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeRules, listenerARNForRule(awssdk.StringValue(input.RuleArn)))
+	return c.ELBV2.ModifyRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteRuleWithContext(ctx awssdk.Context, input *elbv2.DeleteRuleInput, opts ...request.Option) (*elbv2.DeleteRuleOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeRules, listenerARNForRule(awssdk.StringValue(input.RuleArn)))
+	return c.ELBV2.DeleteRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetRulePrioritiesWithContext(ctx awssdk.Context, input *elbv2.SetRulePrioritiesInput, opts ...request.Option) (*elbv2.SetRulePrioritiesOutput, error) {
+	lsARNs := make([]string, 0, len(input.RulePriorities))
+	for _, rulePriority := range input.RulePriorities {
+		lsARNs = append(lsARNs, listenerARNForRule(awssdk.StringValue(rulePriority.RuleArn)))
+	}
+	defer c.cache.invalidate(elbv2OperationDescribeRules, lsARNs...)
+	return c.ELBV2.SetRulePrioritiesWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyTargetGroupWithContext(ctx awssdk.Context, input *elbv2.ModifyTargetGroupInput, opts ...request.Option) (*elbv2.ModifyTargetGroupOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeTargetGroups, awssdk.StringValue(input.TargetGroupArn))
+	return c.ELBV2.ModifyTargetGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyTargetGroupAttributesWithContext(ctx awssdk.Context, input *elbv2.ModifyTargetGroupAttributesInput, opts ...request.Option) (*elbv2.ModifyTargetGroupAttributesOutput, error) {
+	defer c.cache.invalidate(elbv2OperationDescribeTargetGroupAttributes, awssdk.StringValue(input.TargetGroupArn))
+	return c.ELBV2.ModifyTargetGroupAttributesWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteTargetGroupWithContext(ctx awssdk.Context, input *elbv2.DeleteTargetGroupInput, opts ...request.Option) (*elbv2.DeleteTargetGroupOutput, error) {
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	defer func() {
+		c.cache.invalidate(elbv2OperationDescribeTargetGroups, tgARN)
+		c.cache.invalidate(elbv2OperationDescribeTargetGroupAttributes, tgARN)
+	}()
+	return c.ELBV2.DeleteTargetGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) invalidateLoadBalancer(lbARN string) {
+	c.cache.invalidate(elbv2OperationDescribeLoadBalancers, lbARN)
+	c.cache.invalidate(elbv2OperationDescribeLoadBalancerAttributes, lbARN)
+	c.cache.invalidate(elbv2OperationDescribeListeners, lbARN)
+}
+
+func (c *cachedELBV2) saveLoadBalancers(generation uint64, lbs []*elbv2.LoadBalancer) {
+	for _, lb := range lbs {
+		c.cache.set(generation, elbv2OperationDescribeLoadBalancers, awssdk.StringValue(lb.LoadBalancerArn), lb)
+	}
+}
+
+func (c *cachedELBV2) saveTargetGroups(generation uint64, tgs []*elbv2.TargetGroup) {
+	for _, tg := range tgs {
+		c.cache.set(generation, elbv2OperationDescribeTargetGroups, awssdk.StringValue(tg.TargetGroupArn), tg)
+	}
+}
+
+// loadBalancerARNForListener returns the ARN of the load balancer from the ARN of its listener, e.g.
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2 =>
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188
+func loadBalancerARNForListener(lsARN string) string {
+	return parentResourceARN(lsARN, "listener", "loadbalancer")
+}
+
+// listenerARNForRule returns the ARN of the listener from the ARN of its rule, e.g.
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee =>
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2
+func listenerARNForRule(ruleARN string) string {
+	return parentResourceARN(ruleARN, "listener-rule", "listener")
+}
+
+// parentResourceARN returns the ARN of the parent resource from the ARN of a child resource, whose resource path
+// is the resource path of the parent along with the child's own ID. returns empty if the ARN isn't of resourceType.
+func parentResourceARN(resARN string, resourceType string, parentResourceType string) string {
+	resourceTypeSegment := ":" + resourceType + "/"
+	idx := strings.Index(resARN, resourceTypeSegment)
+	lastSlashIdx := strings.LastIndex(resARN, "/")
+	if idx < 0 || lastSlashIdx < idx+len(resourceTypeSegment) {
+		return ""
+	}
+	return resARN[:idx] + ":" + parentResourceType + "/" + resARN[idx+len(resourceTypeSegment):lastSlashIdx]
+}
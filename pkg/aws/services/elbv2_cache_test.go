@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPICacheMetricsCollector counts the hits and misses of API cache by operation.
+type fakeAPICacheMetricsCollector struct {
+	mutex  sync.Mutex
+	hits   map[string]int
+	misses map[string]int
+}
+
+func newFakeAPICacheMetricsCollector() *fakeAPICacheMetricsCollector {
+	return &fakeAPICacheMetricsCollector{
+		hits:   make(map[string]int),
+		misses: make(map[string]int),
+	}
+}
+
+func (c *fakeAPICacheMetricsCollector) ObserveAPICacheHit(_ string, operation string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.hits[operation]++
+}
+
+func (c *fakeAPICacheMetricsCollector) ObserveAPICacheMiss(_ string, operation string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.misses[operation]++
+}
+
+func Test_cachedELBV2_DescribeLoadBalancersAsList(t *testing.T) {
+	lbARN1 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/50dc6c495c0c9188"
+	lbARN2 := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-2/60dc6c495c0c9188"
+	lb1 := &elbv2.LoadBalancer{LoadBalancerArn: awssdk.String(lbARN1)}
+	lb2 := &elbv2.LoadBalancer{LoadBalancerArn: awssdk.String(lbARN2)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: awssdk.StringSlice([]string{lbARN1}),
+		}).Return([]*elbv2.LoadBalancer{lb1}, nil),
+		elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: awssdk.StringSlice([]string{lbARN2}),
+		}).Return([]*elbv2.LoadBalancer{lb2}, nil),
+		elbv2Client.EXPECT().SetSecurityGroupsWithContext(gomock.Any(), &elbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: awssdk.String(lbARN1),
+		}).Return(&elbv2.SetSecurityGroupsOutput{}, nil),
+		elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: awssdk.StringSlice([]string{lbARN1}),
+		}).Return([]*elbv2.LoadBalancer{lb1}, nil),
+	)
+	metricsCollector := newFakeAPICacheMetricsCollector()
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, metricsCollector)
+	ctx := context.Background()
+
+	got, err := cachedClient.DescribeLoadBalancersAsList(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN1}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.LoadBalancer{lb1}, got)
+
+	got, err = cachedClient.DescribeLoadBalancersAsList(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN1, lbARN2}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.LoadBalancer{lb1, lb2}, got)
+
+	got, err = cachedClient.DescribeLoadBalancersAsList(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN2}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.LoadBalancer{lb2}, got)
+
+	_, err = cachedClient.SetSecurityGroupsWithContext(ctx, &elbv2.SetSecurityGroupsInput{
+		LoadBalancerArn: awssdk.String(lbARN1),
+	})
+	assert.NoError(t, err)
+	got, err = cachedClient.DescribeLoadBalancersAsList(ctx, &elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN1}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.LoadBalancer{lb1}, got)
+
+	assert.Equal(t, 1, metricsCollector.hits[elbv2OperationDescribeLoadBalancers])
+	assert.Equal(t, 3, metricsCollector.misses[elbv2OperationDescribeLoadBalancers])
+}
+
+func Test_cachedELBV2_DescribeListenersAsList(t *testing.T) {
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/50dc6c495c0c9188"
+	lsARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	listeners := []*elbv2.Listener{{ListenerArn: awssdk.String(lsARN), LoadBalancerArn: awssdk.String(lbARN)}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), &elbv2.DescribeListenersInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		}).Return(listeners, nil),
+		elbv2Client.EXPECT().ModifyListenerWithContext(gomock.Any(), &elbv2.ModifyListenerInput{
+			ListenerArn: awssdk.String(lsARN),
+		}).Return(&elbv2.ModifyListenerOutput{}, nil),
+		elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), &elbv2.DescribeListenersInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		}).Return(listeners, nil),
+	)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		got, err := cachedClient.DescribeListenersAsList(ctx, &elbv2.DescribeListenersInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, listeners, got)
+	}
+	_, err := cachedClient.ModifyListenerWithContext(ctx, &elbv2.ModifyListenerInput{
+		ListenerArn: awssdk.String(lsARN),
+	})
+	assert.NoError(t, err)
+	got, err := cachedClient.DescribeListenersAsList(ctx, &elbv2.DescribeListenersInput{
+		LoadBalancerArn: awssdk.String(lbARN),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, listeners, got)
+}
+
+func Test_cachedELBV2_DescribeRulesAsList(t *testing.T) {
+	lsARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	ruleARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	rules := []*elbv2.Rule{{RuleArn: awssdk.String(ruleARN)}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2.DescribeRulesInput{
+			ListenerArn: awssdk.String(lsARN),
+		}).Return(rules, nil),
+		elbv2Client.EXPECT().DeleteRuleWithContext(gomock.Any(), &elbv2.DeleteRuleInput{
+			RuleArn: awssdk.String(ruleARN),
+		}).Return(&elbv2.DeleteRuleOutput{}, nil),
+		elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2.DescribeRulesInput{
+			ListenerArn: awssdk.String(lsARN),
+		}).Return(nil, nil),
+	)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		got, err := cachedClient.DescribeRulesAsList(ctx, &elbv2.DescribeRulesInput{
+			ListenerArn: awssdk.String(lsARN),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, rules, got)
+	}
+	_, err := cachedClient.DeleteRuleWithContext(ctx, &elbv2.DeleteRuleInput{
+		RuleArn: awssdk.String(ruleARN),
+	})
+	assert.NoError(t, err)
+	got, err := cachedClient.DescribeRulesAsList(ctx, &elbv2.DescribeRulesInput{
+		ListenerArn: awssdk.String(lsARN),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func Test_cachedELBV2_DescribeTargetGroupsAsList(t *testing.T) {
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-1/73e2d6bc24d8a067"
+	tg := &elbv2.TargetGroup{TargetGroupArn: awssdk.String(tgARN)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), &elbv2.DescribeTargetGroupsInput{}).
+		Return([]*elbv2.TargetGroup{tg}, nil)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+
+	got, err := cachedClient.DescribeTargetGroupsAsList(ctx, &elbv2.DescribeTargetGroupsInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.TargetGroup{tg}, got)
+	got, err = cachedClient.DescribeTargetGroupsAsList(ctx, &elbv2.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.TargetGroup{tg}, got)
+}
+
+func Test_cachedELBV2_resultsFetchedBeforeInvalidationAreNotCached(t *testing.T) {
+	tgARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-1/73e2d6bc24d8a067"
+	input := &elbv2.DescribeTargetGroupAttributesInput{TargetGroupArn: awssdk.String(tgARN)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), input).
+			DoAndReturn(func(_ context.Context, _ *elbv2.DescribeTargetGroupAttributesInput, _ ...request.Option) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
+				_, err := cachedClient.ModifyTargetGroupAttributesWithContext(ctx, &elbv2.ModifyTargetGroupAttributesInput{
+					TargetGroupArn: awssdk.String(tgARN),
+				})
+				assert.NoError(t, err)
+				return &elbv2.DescribeTargetGroupAttributesOutput{}, nil
+			}),
+		elbv2Client.EXPECT().ModifyTargetGroupAttributesWithContext(gomock.Any(), gomock.Any()).
+			Return(&elbv2.ModifyTargetGroupAttributesOutput{}, nil),
+		elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), input).
+			Return(&elbv2.DescribeTargetGroupAttributesOutput{}, nil),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := cachedClient.DescribeTargetGroupAttributesWithContext(ctx, input)
+		assert.NoError(t, err)
+	}
+}
+
+func Test_cachedELBV2_DeleteLoadBalancerInvalidatesListenersAndRules(t *testing.T) {
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/50dc6c495c0c9188"
+	lsARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	ruleARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	listeners := []*elbv2.Listener{{ListenerArn: awssdk.String(lsARN), LoadBalancerArn: awssdk.String(lbARN)}}
+	rules := []*elbv2.Rule{{RuleArn: awssdk.String(ruleARN)}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	gomock.InOrder(
+		elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), &elbv2.DescribeListenersInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		}).Return(listeners, nil),
+		elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2.DescribeRulesInput{
+			ListenerArn: awssdk.String(lsARN),
+		}).Return(rules, nil),
+		elbv2Client.EXPECT().DeleteLoadBalancerWithContext(gomock.Any(), &elbv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		}).Return(&elbv2.DeleteLoadBalancerOutput{}, nil),
+		elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), &elbv2.DescribeListenersInput{
+			LoadBalancerArn: awssdk.String(lbARN),
+		}).Return(nil, nil),
+		elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2.DescribeRulesInput{
+			ListenerArn: awssdk.String(lsARN),
+		}).Return(nil, nil),
+	)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+
+	gotListeners, err := cachedClient.DescribeListenersAsList(ctx, &elbv2.DescribeListenersInput{LoadBalancerArn: awssdk.String(lbARN)})
+	assert.NoError(t, err)
+	assert.Equal(t, listeners, gotListeners)
+	gotRules, err := cachedClient.DescribeRulesAsList(ctx, &elbv2.DescribeRulesInput{ListenerArn: awssdk.String(lsARN)})
+	assert.NoError(t, err)
+	assert.Equal(t, rules, gotRules)
+
+	_, err = cachedClient.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String(lbARN)})
+	assert.NoError(t, err)
+	gotListeners, err = cachedClient.DescribeListenersAsList(ctx, &elbv2.DescribeListenersInput{LoadBalancerArn: awssdk.String(lbARN)})
+	assert.NoError(t, err)
+	assert.Empty(t, gotListeners)
+	gotRules, err = cachedClient.DescribeRulesAsList(ctx, &elbv2.DescribeRulesInput{ListenerArn: awssdk.String(lsARN)})
+	assert.NoError(t, err)
+	assert.Empty(t, gotRules)
+}
+
+func Test_cachedELBV2_cachedResultsAreCopied(t *testing.T) {
+	lbARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/50dc6c495c0c9188"
+	input := &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: awssdk.String(lbARN)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), input).
+		Return(&elbv2.DescribeLoadBalancerAttributesOutput{
+			Attributes: []*elbv2.LoadBalancerAttribute{{Key: awssdk.String("idle_timeout.timeout_seconds"), Value: awssdk.String("60")}},
+		}, nil)
+	cachedClient := NewCachedELBV2(elbv2Client, time.Minute, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		got, err := cachedClient.DescribeLoadBalancerAttributesWithContext(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "60", awssdk.StringValue(got.Attributes[0].Value))
+		got.Attributes[0].Value = awssdk.String("120")
+	}
+}
+
+func Test_parentResourceARN(t *testing.T) {
+	tests := []struct {
+		name               string
+		resARN             string
+		resourceType       string
+		parentResourceType string
+		want               string
+	}{
+		{
+			name:               "listener",
+			resARN:             "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2",
+			resourceType:       "listener",
+			parentResourceType: "loadbalancer",
+			want:               "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-1/50dc6c495c0c9188",
+		},
+		{
+			name:               "listener rule",
+			resARN:             "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/net/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee",
+			resourceType:       "listener-rule",
+			parentResourceType: "listener",
+			want:               "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/net/lb-1/50dc6c495c0c9188/f2f7dc8efc522ab2",
+		},
+		{
+			name:               "ARN of other resource type",
+			resARN:             "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-1/73e2d6bc24d8a067",
+			resourceType:       "listener",
+			parentResourceType: "loadbalancer",
+			want:               "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parentResourceARN(tt.resARN, tt.resourceType, tt.parentResourceType))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+)
+
+type IAM interface {
+	iamiface.IAMAPI
+}
+
+// NewIAM constructs new IAM implementation.
+func NewIAM(session *session.Session) IAM {
+	return &defaultIAM{
+		IAMAPI: iam.New(session),
+	}
+}
+
+// default implementation for IAM.
+type defaultIAM struct {
+	iamiface.IAMAPI
+}
@@ -0,0 +1,77 @@
+package throttle
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// the rate is halved on throttling responses, at most once per adaptiveRateDecreaseInterval,
+	// so that the concurrent requests throttled by AWS at once don't collapse the rate.
+	adaptiveRateDecreaseFactor   = 0.5
+	adaptiveRateDecreaseInterval = 1 * time.Second
+	// the rate recovers by adaptiveRateIncreaseFactor of the configured rate on successful responses,
+	// at most once per adaptiveRateIncreaseInterval since the rate was last adjusted.
+	adaptiveRateIncreaseFactor   = 0.1
+	adaptiveRateIncreaseInterval = 5 * time.Second
+	// the rate never drops below adaptiveRateMinFactor of the configured rate.
+	adaptiveRateMinFactor = 0.05
+)
+
+// adaptiveLimiter adjusts the rate of limiter between adaptiveRateMinFactor of its configured rate and the configured rate,
+// with additive increase on successful responses and multiplicative decrease on throttling responses (AIMD).
+type adaptiveLimiter struct {
+	limiter        *rate.Limiter
+	configuredRate rate.Limit
+
+	mutex            sync.Mutex
+	lastDecreaseTime time.Time
+	lastAdjustTime   time.Time
+}
+
+func newAdaptiveLimiter(limiter *rate.Limiter) *adaptiveLimiter {
+	return &adaptiveLimiter{
+		limiter:        limiter,
+		configuredRate: limiter.Limit(),
+	}
+}
+
+// onThrottled decreases the rate on throttling response at now, returns the rate and whether it's changed.
+func (l *adaptiveLimiter) onThrottled(now time.Time) (rate.Limit, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	currentRate := l.limiter.Limit()
+	if now.Sub(l.lastDecreaseTime) < adaptiveRateDecreaseInterval {
+		return currentRate, false
+	}
+	newRate := currentRate * adaptiveRateDecreaseFactor
+	if minRate := l.configuredRate * adaptiveRateMinFactor; newRate < minRate {
+		newRate = minRate
+	}
+	l.lastDecreaseTime = now
+	l.lastAdjustTime = now
+	if newRate == currentRate {
+		return currentRate, false
+	}
+	l.limiter.SetLimitAt(now, newRate)
+	return newRate, true
+}
+
+// onSucceeded increases the rate on successful response at now, returns the rate and whether it's changed.
+func (l *adaptiveLimiter) onSucceeded(now time.Time) (rate.Limit, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	currentRate := l.limiter.Limit()
+	if currentRate >= l.configuredRate || now.Sub(l.lastAdjustTime) < adaptiveRateIncreaseInterval {
+		return currentRate, false
+	}
+	newRate := currentRate + l.configuredRate*adaptiveRateIncreaseFactor
+	if newRate > l.configuredRate {
+		newRate = l.configuredRate
+	}
+	l.lastAdjustTime = now
+	l.limiter.SetLimitAt(now, newRate)
+	return newRate, true
+}
@@ -0,0 +1,81 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func Test_adaptiveLimiter(t *testing.T) {
+	type step struct {
+		elapsed     time.Duration
+		throttled   bool
+		wantRate    rate.Limit
+		wantChanged bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "successful responses at configured rate",
+			steps: []step{
+				{elapsed: 0, throttled: false, wantRate: 10, wantChanged: false},
+				{elapsed: time.Minute, throttled: false, wantRate: 10, wantChanged: false},
+			},
+		},
+		{
+			name: "throttling responses halve the rate at most once per decrease interval",
+			steps: []step{
+				{elapsed: 0, throttled: true, wantRate: 5, wantChanged: true},
+				{elapsed: 500 * time.Millisecond, throttled: true, wantRate: 5, wantChanged: false},
+				{elapsed: 1 * time.Second, throttled: true, wantRate: 2.5, wantChanged: true},
+			},
+		},
+		{
+			name: "rate never drops below the minimum rate",
+			steps: []step{
+				{elapsed: 0, throttled: true, wantRate: 5, wantChanged: true},
+				{elapsed: 1 * time.Second, throttled: true, wantRate: 2.5, wantChanged: true},
+				{elapsed: 2 * time.Second, throttled: true, wantRate: 1.25, wantChanged: true},
+				{elapsed: 3 * time.Second, throttled: true, wantRate: 0.625, wantChanged: true},
+				{elapsed: 4 * time.Second, throttled: true, wantRate: 0.5, wantChanged: true},
+				{elapsed: 5 * time.Second, throttled: true, wantRate: 0.5, wantChanged: false},
+			},
+		},
+		{
+			name: "successful responses recover the rate additively up to the configured rate",
+			steps: []step{
+				{elapsed: 0, throttled: true, wantRate: 5, wantChanged: true},
+				{elapsed: 1 * time.Second, throttled: false, wantRate: 5, wantChanged: false},
+				{elapsed: 5 * time.Second, throttled: false, wantRate: 6, wantChanged: true},
+				{elapsed: 6 * time.Second, throttled: false, wantRate: 6, wantChanged: false},
+				{elapsed: 10 * time.Second, throttled: false, wantRate: 7, wantChanged: true},
+				{elapsed: 15 * time.Second, throttled: false, wantRate: 8, wantChanged: true},
+				{elapsed: 20 * time.Second, throttled: false, wantRate: 9, wantChanged: true},
+				{elapsed: 25 * time.Second, throttled: false, wantRate: 10, wantChanged: true},
+				{elapsed: 30 * time.Second, throttled: false, wantRate: 10, wantChanged: false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newAdaptiveLimiter(rate.NewLimiter(10, 5))
+			startTime := time.Now()
+			for _, step := range tt.steps {
+				var gotRate rate.Limit
+				var gotChanged bool
+				if step.throttled {
+					gotRate, gotChanged = l.onThrottled(startTime.Add(step.elapsed))
+				} else {
+					gotRate, gotChanged = l.onSucceeded(startTime.Add(step.elapsed))
+				}
+				assert.InDelta(t, float64(step.wantRate), float64(gotRate), 1e-9)
+				assert.Equal(t, step.wantChanged, gotChanged)
+				assert.InDelta(t, float64(step.wantRate), float64(l.limiter.Limit()), 1e-9)
+			}
+		})
+	}
+}
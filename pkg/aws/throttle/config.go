@@ -100,3 +100,20 @@ func (c *ServiceOperationsThrottleConfig) Set(val string) error {
 func (c *ServiceOperationsThrottleConfig) Type() string {
 	return "serviceOperationsThrottleConfig"
 }
+
+// MaxConcurrency returns the maximum number of concurrent calls to operations of service, capped by limit.
+// concurrent calls beyond the smallest burst configured for operations of service would be throttled anyway.
+func (c *ServiceOperationsThrottleConfig) MaxConcurrency(serviceID string, limit int) int {
+	maxConcurrency := limit
+	if c != nil {
+		for _, operationsThrottleConfig := range c.value[serviceID] {
+			if operationsThrottleConfig.burst < maxConcurrency {
+				maxConcurrency = operationsThrottleConfig.burst
+			}
+		}
+	}
+	if maxConcurrency < 1 {
+		return 1
+	}
+	return maxConcurrency
+}
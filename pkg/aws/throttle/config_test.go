@@ -257,3 +257,55 @@ func TestServiceOperationsThrottleConfig_Type(t *testing.T) {
 	got := c.Type()
 	assert.Equal(t, "serviceOperationsThrottleConfig", got)
 }
+
+func TestServiceOperationsThrottleConfig_MaxConcurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *ServiceOperationsThrottleConfig
+		serviceID string
+		limit     int
+		want      int
+	}{
+		{
+			name:      "smallest burst of service",
+			config:    NewDefaultServiceOperationsThrottleConfig(),
+			serviceID: elbv2.ServiceID,
+			limit:     30,
+			want:      20,
+		},
+		{
+			name:      "capped by limit",
+			config:    NewDefaultServiceOperationsThrottleConfig(),
+			serviceID: elbv2.ServiceID,
+			limit:     10,
+			want:      10,
+		},
+		{
+			name:      "service without throttle",
+			config:    NewDefaultServiceOperationsThrottleConfig(),
+			serviceID: appmesh.ServiceID,
+			limit:     10,
+			want:      10,
+		},
+		{
+			name:      "nil config",
+			config:    nil,
+			serviceID: elbv2.ServiceID,
+			limit:     10,
+			want:      10,
+		},
+		{
+			name:      "non-positive limit",
+			config:    nil,
+			serviceID: elbv2.ServiceID,
+			limit:     0,
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.config.MaxConcurrency(tt.serviceID, tt.limit)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,17 +4,36 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"golang.org/x/time/rate"
 	"regexp"
+	"time"
 )
 
-const sdkHandlerRequestThrottle = "requestThrottle"
+const (
+	sdkHandlerRequestThrottle      = "requestThrottle"
+	sdkHandlerAdaptRequestThrottle = "adaptRequestThrottle"
+)
+
+// RateMetricsCollector collects the effective rates of throttled operations.
+type RateMetricsCollector interface {
+	// ObserveThrottleRate observes the effective rate of operations of service that match operationPattern.
+	ObserveThrottleRate(service string, operationPattern string, rate float64)
+}
 
 type conditionLimiter struct {
 	condition Condition
 	limiter   *rate.Limiter
+
+	// service and operationPattern describe the condition in metrics, the rate isn't observed if service is empty.
+	service          string
+	operationPattern string
+	adaptiveLimiter  *adaptiveLimiter
 }
 
 type throttler struct {
 	conditionLimiters []conditionLimiter
+
+	// adaptive throttler adjusts the rate of limiters based on throttling responses from AWS.
+	adaptive         bool
+	metricsCollector RateMetricsCollector
 }
 
 // NewThrottler constructs new request throttler instance.
@@ -33,24 +52,49 @@ func NewThrottler(config *ServiceOperationsThrottleConfig) *throttler {
 }
 
 func (t *throttler) WithConditionThrottle(condition Condition, r rate.Limit, burst int) *throttler {
-	limiter := rate.NewLimiter(r, burst)
-	t.conditionLimiters = append(t.conditionLimiters, conditionLimiter{
-		condition: condition,
-		limiter:   limiter,
-	})
-	return t
+	return t.withConditionThrottle(condition, "", "", r, burst)
 }
 
 func (t *throttler) WithServiceThrottle(serviceID string, r rate.Limit, burst int) *throttler {
-	return t.WithConditionThrottle(matchService(serviceID), r, burst)
+	return t.withConditionThrottle(matchService(serviceID), serviceID, ".*", r, burst)
 }
 
 func (t *throttler) WithOperationThrottle(serviceID string, operation string, r rate.Limit, burst int) *throttler {
-	return t.WithConditionThrottle(matchServiceOperation(serviceID, operation), r, burst)
+	return t.withConditionThrottle(matchServiceOperation(serviceID, operation), serviceID, regexp.QuoteMeta(operation), r, burst)
 }
 
 func (t *throttler) WithOperationPatternThrottle(serviceID string, operationPtn *regexp.Regexp, r rate.Limit, burst int) *throttler {
-	return t.WithConditionThrottle(matchServiceOperationPattern(serviceID, operationPtn), r, burst)
+	return t.withConditionThrottle(matchServiceOperationPattern(serviceID, operationPtn), serviceID, operationPtn.String(), r, burst)
+}
+
+// WithAdaptiveRate makes the throttler back off the rate of limiters on throttling responses from AWS and slowly recover it,
+// so that the controller doesn't starve other clients of the AWS account once the account level limits are reached.
+func (t *throttler) WithAdaptiveRate() *throttler {
+	t.adaptive = true
+	return t
+}
+
+// WithRateMetricsCollector makes the throttler observe the effective rate of limiters with metricsCollector.
+func (t *throttler) WithRateMetricsCollector(metricsCollector RateMetricsCollector) *throttler {
+	t.metricsCollector = metricsCollector
+	for _, conditionLimiter := range t.conditionLimiters {
+		t.observeRate(conditionLimiter, conditionLimiter.limiter.Limit())
+	}
+	return t
+}
+
+func (t *throttler) withConditionThrottle(condition Condition, service string, operationPattern string, r rate.Limit, burst int) *throttler {
+	limiter := rate.NewLimiter(r, burst)
+	conditionLimiter := conditionLimiter{
+		condition:        condition,
+		limiter:          limiter,
+		service:          service,
+		operationPattern: operationPattern,
+		adaptiveLimiter:  newAdaptiveLimiter(limiter),
+	}
+	t.conditionLimiters = append(t.conditionLimiters, conditionLimiter)
+	t.observeRate(conditionLimiter, r)
+	return t
 }
 
 func (t *throttler) InjectHandlers(handlers *request.Handlers) {
@@ -58,6 +102,12 @@ func (t *throttler) InjectHandlers(handlers *request.Handlers) {
 		Name: sdkHandlerRequestThrottle,
 		Fn:   t.beforeSign,
 	})
+	if t.adaptive {
+		handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: sdkHandlerAdaptRequestThrottle,
+			Fn:   t.afterAttempt,
+		})
+	}
 }
 
 // beforeSign is added to the Sign chain; called before each request
@@ -68,3 +118,34 @@ func (t *throttler) beforeSign(r *request.Request) {
 		}
 	}
 }
+
+// afterAttempt is added to the CompleteAttempt chain; called after each attempt of request
+func (t *throttler) afterAttempt(r *request.Request) {
+	throttled := r.Error != nil && request.IsErrorThrottle(r.Error)
+	if r.Error != nil && !throttled {
+		return
+	}
+	now := time.Now()
+	for _, conditionLimiter := range t.conditionLimiters {
+		if conditionLimiter.adaptiveLimiter == nil || !conditionLimiter.condition(r) {
+			continue
+		}
+		var newRate rate.Limit
+		var changed bool
+		if throttled {
+			newRate, changed = conditionLimiter.adaptiveLimiter.onThrottled(now)
+		} else {
+			newRate, changed = conditionLimiter.adaptiveLimiter.onSucceeded(now)
+		}
+		if changed {
+			t.observeRate(conditionLimiter, newRate)
+		}
+	}
+}
+
+func (t *throttler) observeRate(conditionLimiter conditionLimiter, r rate.Limit) {
+	if t.metricsCollector == nil || conditionLimiter.service == "" {
+		return
+	}
+	t.metricsCollector.ObserveThrottleRate(conditionLimiter.service, conditionLimiter.operationPattern, float64(r))
+}
@@ -2,6 +2,7 @@ package throttle
 
 import (
 	"context"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/appmesh"
@@ -100,6 +101,75 @@ func Test_throttler_InjectHandlers(t *testing.T) {
 	handlers := request.Handlers{}
 	throttler.InjectHandlers(&handlers)
 	assert.Equal(t, 1, handlers.Sign.Len())
+	assert.Equal(t, 0, handlers.CompleteAttempt.Len())
+}
+
+func Test_throttler_InjectHandlers_adaptiveRate(t *testing.T) {
+	throttler := (&throttler{}).WithAdaptiveRate()
+	handlers := request.Handlers{}
+	throttler.InjectHandlers(&handlers)
+	assert.Equal(t, 1, handlers.Sign.Len())
+	assert.Equal(t, 1, handlers.CompleteAttempt.Len())
+}
+
+type fakeRateMetricsCollector struct {
+	rates map[string]float64
+}
+
+func (c *fakeRateMetricsCollector) ObserveThrottleRate(service string, operationPattern string, rate float64) {
+	c.rates[service+":"+operationPattern] = rate
+}
+
+func Test_throttler_afterAttempt(t *testing.T) {
+	describeMeshRequest := func(err error) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceID: appmesh.ServiceID},
+			Operation:  &request.Operation{Name: "DescribeMesh"},
+			Error:      err,
+		}
+	}
+	tests := []struct {
+		name      string
+		r         *request.Request
+		wantRates map[string]float64
+	}{
+		{
+			name: "throttling response backs off the rate of matching limiters",
+			r:    describeMeshRequest(awserr.New("ThrottlingException", "Rate exceeded", nil)),
+			wantRates: map[string]float64{
+				"App Mesh:^Describe": 5,
+				"App Mesh:^Create":   2,
+			},
+		},
+		{
+			name: "other error keeps the rates",
+			r:    describeMeshRequest(awserr.New("NotFoundException", "mesh not found", nil)),
+			wantRates: map[string]float64{
+				"App Mesh:^Describe": 10,
+				"App Mesh:^Create":   2,
+			},
+		},
+		{
+			name: "successful response keeps the configured rates",
+			r:    describeMeshRequest(nil),
+			wantRates: map[string]float64{
+				"App Mesh:^Describe": 10,
+				"App Mesh:^Create":   2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsCollector := &fakeRateMetricsCollector{rates: make(map[string]float64)}
+			throttler := (&throttler{}).
+				WithOperationPatternThrottle(appmesh.ServiceID, regexp.MustCompile("^Describe"), 10, 5).
+				WithOperationPatternThrottle(appmesh.ServiceID, regexp.MustCompile("^Create"), 2, 1).
+				WithAdaptiveRate().
+				WithRateMetricsCollector(metricsCollector)
+			throttler.afterAttempt(tt.r)
+			assert.Equal(t, tt.wantRates, metricsCollector.rates)
+		})
+	}
 }
 
 // Test beforeSign to check whether throttle applies correctly.
@@ -0,0 +1,64 @@
+package certmonitor
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// MetricsCollector collects the metrics of certificate expiry monitoring.
+type MetricsCollector interface {
+	// ObserveCertificates records the certificates of stack as of now, replacing the certificates recorded previously.
+	ObserveCertificates(kind core.StackKind, stackID string, certs []Certificate, now time.Time)
+
+	// Reset removes the metrics recorded for stack.
+	Reset(kind core.StackKind, stackID string)
+}
+
+// NewCollector constructs new collector that registers metrics to registerer.
+func NewCollector(registerer prometheus.Registerer) (*collector, error) {
+	instruments, err := newInstruments(registerer)
+	if err != nil {
+		return nil, err
+	}
+	return &collector{
+		instruments: instruments,
+	}, nil
+}
+
+var _ MetricsCollector = &collector{}
+
+// default implementation for MetricsCollector.
+type collector struct {
+	instruments *instruments
+}
+
+func (c *collector) ObserveCertificates(kind core.StackKind, stackID string, certs []Certificate, now time.Time) {
+	c.Reset(kind, stackID)
+	for _, cert := range certs {
+		labels := prometheus.Labels{
+			labelKind:           string(kind),
+			labelStack:          stackID,
+			labelCertificateARN: cert.ARN,
+		}
+		c.instruments.daysToExpiry.With(labels).Set(daysToExpiry(cert, now))
+		invalid := 0.0
+		if cert.InvalidReason != "" {
+			invalid = 1
+		}
+		c.instruments.invalid.With(labels).Set(invalid)
+	}
+}
+
+func (c *collector) Reset(kind core.StackKind, stackID string) {
+	c.instruments.daysToExpiry.DeletePartialMatch(stackLabels(kind, stackID))
+	c.instruments.invalid.DeletePartialMatch(stackLabels(kind, stackID))
+}
+
+func stackLabels(kind core.StackKind, stackID string) prometheus.Labels {
+	return prometheus.Labels{
+		labelKind:  string(kind),
+		labelStack: stackID,
+	}
+}
@@ -0,0 +1,108 @@
+package certmonitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	iamServerCertificateResourcePrefix = "server-certificate/"
+)
+
+// Certificate is the expiry and validity of a listener certificate.
+type Certificate struct {
+	// ARN of the certificate.
+	ARN string
+
+	// NotAfter is the time after which the certificate is no longer valid.
+	NotAfter time.Time
+
+	// InvalidReason is the reason the certificate cannot be served, it's empty if the certificate is valid.
+	InvalidReason string
+}
+
+// CertificateDescriber describes listener certificates of ACM or IAM.
+type CertificateDescriber interface {
+	// Describe describes the certificate identified by certARN.
+	Describe(ctx context.Context, certARN string) (Certificate, error)
+}
+
+// NewDefaultCertificateDescriber constructs new defaultCertificateDescriber.
+func NewDefaultCertificateDescriber(acmClient services.ACM, iamClient services.IAM) *defaultCertificateDescriber {
+	return &defaultCertificateDescriber{
+		acmClient: acmClient,
+		iamClient: iamClient,
+	}
+}
+
+var _ CertificateDescriber = &defaultCertificateDescriber{}
+
+// default implementation for CertificateDescriber.
+type defaultCertificateDescriber struct {
+	acmClient services.ACM
+	iamClient services.IAM
+}
+
+func (d *defaultCertificateDescriber) Describe(ctx context.Context, certARN string) (Certificate, error) {
+	parsedARN, err := arn.Parse(certARN)
+	if err != nil {
+		return Certificate{}, errors.Wrapf(err, "invalid certificate ARN: %v", certARN)
+	}
+	switch parsedARN.Service {
+	case "acm":
+		return d.describeACMCertificate(ctx, certARN)
+	case "iam":
+		return d.describeIAMServerCertificate(ctx, certARN, parsedARN.Resource)
+	default:
+		return Certificate{}, errors.Errorf("unsupported certificate ARN: %v", certARN)
+	}
+}
+
+func (d *defaultCertificateDescriber) describeACMCertificate(ctx context.Context, certARN string) (Certificate, error) {
+	resp, err := d.acmClient.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: awssdk.String(certARN),
+	})
+	if err != nil {
+		return Certificate{}, err
+	}
+	certDetail := resp.Certificate
+	cert := Certificate{
+		ARN:      certARN,
+		NotAfter: awssdk.TimeValue(certDetail.NotAfter),
+	}
+	switch {
+	case certDetail.RevokedAt != nil:
+		cert.InvalidReason = fmt.Sprintf("revoked at %v", certDetail.RevokedAt.UTC().Format(time.RFC3339))
+	case awssdk.StringValue(certDetail.Status) != acm.CertificateStatusIssued:
+		cert.InvalidReason = fmt.Sprintf("status is %v", awssdk.StringValue(certDetail.Status))
+	}
+	return cert, nil
+}
+
+// describeIAMServerCertificate describes the IAM server certificate, whose name is the last segment of resource path
+// e.g. server-certificate/path/name.
+func (d *defaultCertificateDescriber) describeIAMServerCertificate(ctx context.Context, certARN string, resource string) (Certificate, error) {
+	if !strings.HasPrefix(resource, iamServerCertificateResourcePrefix) {
+		return Certificate{}, errors.Errorf("unsupported certificate ARN: %v", certARN)
+	}
+	certName := resource[strings.LastIndex(resource, "/")+1:]
+	resp, err := d.iamClient.GetServerCertificateWithContext(ctx, &iam.GetServerCertificateInput{
+		ServerCertificateName: awssdk.String(certName),
+	})
+	if err != nil {
+		return Certificate{}, err
+	}
+	return Certificate{
+		ARN:      certARN,
+		NotAfter: awssdk.TimeValue(resp.ServerCertificate.ServerCertificateMetadata.Expiration),
+	}, nil
+}
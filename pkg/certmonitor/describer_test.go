@@ -0,0 +1,136 @@
+package certmonitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+type fakeACM struct {
+	services.ACM
+	certs map[string]*acm.CertificateDetail
+}
+
+func (c *fakeACM) DescribeCertificateWithContext(_ context.Context, input *acm.DescribeCertificateInput, _ ...request.Option) (*acm.DescribeCertificateOutput, error) {
+	return &acm.DescribeCertificateOutput{Certificate: c.certs[awssdk.StringValue(input.CertificateArn)]}, nil
+}
+
+type fakeIAM struct {
+	services.IAM
+	certs map[string]*iam.ServerCertificateMetadata
+}
+
+func (c *fakeIAM) GetServerCertificateWithContext(_ context.Context, input *iam.GetServerCertificateInput, _ ...request.Option) (*iam.GetServerCertificateOutput, error) {
+	certMetadata, exists := c.certs[awssdk.StringValue(input.ServerCertificateName)]
+	if !exists {
+		return nil, errors.New("NoSuchEntity")
+	}
+	return &iam.GetServerCertificateOutput{
+		ServerCertificate: &iam.ServerCertificate{ServerCertificateMetadata: certMetadata},
+	}, nil
+}
+
+func Test_defaultCertificateDescriber_Describe(t *testing.T) {
+	notAfter := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	revokedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	acmClient := &fakeACM{
+		certs: map[string]*acm.CertificateDetail{
+			"arn:aws:acm:us-west-2:123456789012:certificate/issued": {
+				Status:   awssdk.String(acm.CertificateStatusIssued),
+				NotAfter: awssdk.Time(notAfter),
+			},
+			"arn:aws:acm:us-west-2:123456789012:certificate/revoked": {
+				Status:    awssdk.String(acm.CertificateStatusRevoked),
+				NotAfter:  awssdk.Time(notAfter),
+				RevokedAt: awssdk.Time(revokedAt),
+			},
+			"arn:aws:acm:us-west-2:123456789012:certificate/expired": {
+				Status:   awssdk.String(acm.CertificateStatusExpired),
+				NotAfter: awssdk.Time(notAfter),
+			},
+		},
+	}
+	iamClient := &fakeIAM{
+		certs: map[string]*iam.ServerCertificateMetadata{
+			"cert-1": {
+				Expiration: awssdk.Time(notAfter),
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		certARN string
+		want    Certificate
+		wantErr error
+	}{
+		{
+			name:    "issued ACM certificate",
+			certARN: "arn:aws:acm:us-west-2:123456789012:certificate/issued",
+			want: Certificate{
+				ARN:      "arn:aws:acm:us-west-2:123456789012:certificate/issued",
+				NotAfter: notAfter,
+			},
+		},
+		{
+			name:    "revoked ACM certificate",
+			certARN: "arn:aws:acm:us-west-2:123456789012:certificate/revoked",
+			want: Certificate{
+				ARN:           "arn:aws:acm:us-west-2:123456789012:certificate/revoked",
+				NotAfter:      notAfter,
+				InvalidReason: "revoked at 2024-01-01T00:00:00Z",
+			},
+		},
+		{
+			name:    "expired ACM certificate",
+			certARN: "arn:aws:acm:us-west-2:123456789012:certificate/expired",
+			want: Certificate{
+				ARN:           "arn:aws:acm:us-west-2:123456789012:certificate/expired",
+				NotAfter:      notAfter,
+				InvalidReason: "status is EXPIRED",
+			},
+		},
+		{
+			name:    "IAM server certificate with path",
+			certARN: "arn:aws:iam::123456789012:server-certificate/some/path/cert-1",
+			want: Certificate{
+				ARN:      "arn:aws:iam::123456789012:server-certificate/some/path/cert-1",
+				NotAfter: notAfter,
+			},
+		},
+		{
+			name:    "IAM server certificate not found",
+			certARN: "arn:aws:iam::123456789012:server-certificate/cert-2",
+			wantErr: errors.New("NoSuchEntity"),
+		},
+		{
+			name:    "unsupported IAM resource",
+			certARN: "arn:aws:iam::123456789012:role/some-role",
+			wantErr: errors.New("unsupported certificate ARN: arn:aws:iam::123456789012:role/some-role"),
+		},
+		{
+			name:    "invalid ARN",
+			certARN: "some-cert",
+			wantErr: errors.New("invalid certificate ARN: some-cert: arn: invalid prefix"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDefaultCertificateDescriber(acmClient, iamClient)
+			got, err := d.Describe(context.Background(), tt.certARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package certmonitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemCertificate = "certificate"
+
+	metricDaysToExpiry = "days_to_expiry"
+	metricInvalid      = "invalid"
+)
+
+const (
+	labelKind           = "kind"
+	labelStack          = "stack"
+	labelCertificateARN = "certificate_arn"
+)
+
+type instruments struct {
+	daysToExpiry *prometheus.GaugeVec
+	invalid      *prometheus.GaugeVec
+}
+
+// newInstruments allocates and register new metrics to registerer
+func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
+	daysToExpiry := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemCertificate,
+		Name:      metricDaysToExpiry,
+		Help:      "Days until the certificates of deployed listeners expire, negative once expired",
+	}, []string{labelKind, labelStack, labelCertificateARN})
+	invalid := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemCertificate,
+		Name:      metricInvalid,
+		Help:      "Whether the certificates of deployed listeners are invalid, e.g. revoked or failed",
+	}, []string{labelKind, labelStack, labelCertificateARN})
+
+	if err := registerer.Register(daysToExpiry); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(invalid); err != nil {
+		return nil, err
+	}
+	return &instruments{
+		daysToExpiry: daysToExpiry,
+		invalid:      invalid,
+	}, nil
+}
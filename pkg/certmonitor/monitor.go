@@ -0,0 +1,308 @@
+package certmonitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Target is a deployed model stack to monitor listener certificates for.
+type Target struct {
+	// Stack is the model stack that was last deployed.
+	Stack core.Stack
+
+	// Objects are the Kubernetes objects the stack is built for, expiring certificates are reported on these objects.
+	Objects []client.Object
+
+	// CertificateDescriber describes certificates, e.g. through the clients of the AWS account the stack is deployed to.
+	// the CertificateDescriber of the Monitor is used if nil.
+	CertificateDescriber CertificateDescriber
+}
+
+// Monitor periodically checks the expiry and validity of certificates on listeners of deployed model stacks.
+type Monitor interface {
+	// Register registers target for certificate monitoring, it replaces the target previously registered for the same stack.
+	Register(target Target)
+
+	// Unregister stops certificate monitoring for stack.
+	Unregister(stackID core.StackID)
+}
+
+// NewDefaultMonitor constructs new defaultMonitor.
+// certificate monitoring is disabled if interval isn't positive, thresholds are the days to expiry at which to warn about certificates.
+func NewDefaultMonitor(kind core.StackKind, certDescriber CertificateDescriber, eventRecorder record.EventRecorder,
+	metricsCollector MetricsCollector, interval time.Duration, thresholds []int, logger logr.Logger) *defaultMonitor {
+	// thresholds are sorted from the furthest to the closest to expiry, so that the number of thresholds crossed only grows towards expiry.
+	// expiry itself is always a threshold, so that expired certificates are warned about.
+	sortedThresholds := sets.NewInt(thresholds...).Insert(0).List()
+	sort.Sort(sort.Reverse(sort.IntSlice(sortedThresholds)))
+	return &defaultMonitor{
+		kind:             kind,
+		certDescriber:    certDescriber,
+		eventRecorder:    eventRecorder,
+		metricsCollector: metricsCollector,
+		interval:         interval,
+		thresholds:       sortedThresholds,
+		logger:           logger,
+		clock:            time.Now,
+		targets:          make(map[core.StackID]*Target),
+		alerts:           make(map[core.StackID]map[string]certAlert),
+	}
+}
+
+var _ Monitor = &defaultMonitor{}
+var _ manager.Runnable = &defaultMonitor{}
+
+// default implementation for Monitor.
+type defaultMonitor struct {
+	kind             core.StackKind
+	certDescriber    CertificateDescriber
+	eventRecorder    record.EventRecorder
+	metricsCollector MetricsCollector
+	interval         time.Duration
+	thresholds       []int
+	logger           logr.Logger
+	clock            func() time.Time
+
+	// targetsMutex protects the targets and the alerts raised for them.
+	targetsMutex sync.Mutex
+	targets      map[core.StackID]*Target
+	// alerts are the alerts last raised for certificates of each stack, they're kept across re-registrations of the stack
+	// so that each threshold is only warned about once.
+	alerts map[core.StackID]map[string]certAlert
+}
+
+// certAlert is the alert last raised for a certificate.
+type certAlert struct {
+	// crossedThresholds is the number of thresholds crossed.
+	crossedThresholds int
+	// invalidReason is the reason the certificate is invalid.
+	invalidReason string
+}
+
+// Enabled checks whether certificate monitoring is enabled.
+func (m *defaultMonitor) Enabled() bool {
+	return m.interval > 0
+}
+
+func (m *defaultMonitor) Register(target Target) {
+	if !m.Enabled() {
+		return
+	}
+	m.targetsMutex.Lock()
+	defer m.targetsMutex.Unlock()
+	m.targets[target.Stack.StackID()] = &target
+}
+
+func (m *defaultMonitor) Unregister(stackID core.StackID) {
+	if !m.Enabled() {
+		return
+	}
+	m.targetsMutex.Lock()
+	defer m.targetsMutex.Unlock()
+	if _, exists := m.targets[stackID]; !exists {
+		return
+	}
+	delete(m.targets, stackID)
+	delete(m.alerts, stackID)
+	m.metricsCollector.Reset(m.kind, stackID.String())
+}
+
+// Start checks the certificates of registered targets every interval until ctx is done.
+func (m *defaultMonitor) Start(ctx context.Context) error {
+	if !m.Enabled() {
+		return nil
+	}
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.checkAll(ctx)
+		}
+	}
+}
+
+func (m *defaultMonitor) checkAll(ctx context.Context) {
+	for _, stackID := range m.listStackIDs() {
+		if err := m.check(ctx, stackID); err != nil {
+			m.logger.Error(err, "failed to check certificates", "stackID", stackID.String())
+		}
+	}
+}
+
+// check checks the certificates on listeners of the target registered for stackID,
+// and warns about the certificates that crossed another threshold or became invalid since last check.
+func (m *defaultMonitor) check(ctx context.Context, stackID core.StackID) error {
+	target, exists := m.getTarget(stackID)
+	if !exists {
+		return nil
+	}
+	certDescriber := m.certDescriber
+	if target.CertificateDescriber != nil {
+		certDescriber = target.CertificateDescriber
+	}
+	certARNs, err := ListCertificateARNs(target.Stack)
+	if err != nil {
+		return err
+	}
+	certs := make([]Certificate, 0, len(certARNs))
+	for _, certARN := range certARNs {
+		cert, err := certDescriber.Describe(ctx, certARN)
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe certificate: %v", certARN)
+		}
+		certs = append(certs, cert)
+	}
+	now := m.clock()
+
+	// the target might be replaced or unregistered by reconcile while describing, in which case the result is stale.
+	m.targetsMutex.Lock()
+	if m.targets[stackID] != target {
+		m.targetsMutex.Unlock()
+		return nil
+	}
+	m.metricsCollector.ObserveCertificates(m.kind, stackID.String(), certs, now)
+	messages := m.computeAlertMessages(stackID, certs, now)
+	m.targetsMutex.Unlock()
+
+	for _, message := range messages {
+		m.logger.Info("certificate requires attention", "kind", m.kind, "stackID", stackID.String(), "message", message.message)
+		for _, obj := range target.Objects {
+			m.eventRecorder.Event(obj, corev1.EventTypeWarning, message.reason, message.message)
+		}
+	}
+	return nil
+}
+
+type alertMessage struct {
+	reason  string
+	message string
+}
+
+// computeAlertMessages computes the messages of alerts to raise for certs, and records the alerts raised for stackID.
+// certificates that are renewed beyond all thresholds and revalidated are alerted again once they require attention.
+func (m *defaultMonitor) computeAlertMessages(stackID core.StackID, certs []Certificate, now time.Time) []alertMessage {
+	lastAlerts := m.alerts[stackID]
+	alerts := make(map[string]certAlert, len(certs))
+	var messages []alertMessage
+	for _, cert := range certs {
+		lastAlert := lastAlerts[cert.ARN]
+		alert := certAlert{
+			crossedThresholds: m.countCrossedThresholds(cert, now),
+			invalidReason:     cert.InvalidReason,
+		}
+		alerts[cert.ARN] = alert
+		if alert.invalidReason != "" && alert.invalidReason != lastAlert.invalidReason {
+			messages = append(messages, alertMessage{
+				reason:  m.eventReasonInvalid(),
+				message: fmt.Sprintf("Certificate %v is invalid: %v", cert.ARN, cert.InvalidReason),
+			})
+		}
+		if alert.crossedThresholds > lastAlert.crossedThresholds {
+			messages = append(messages, alertMessage{
+				reason:  m.eventReasonExpiring(),
+				message: formatExpiryMessage(cert, now),
+			})
+		}
+	}
+	m.alerts[stackID] = alerts
+	return messages
+}
+
+// countCrossedThresholds counts the thresholds that days to expiry of cert is within.
+func (m *defaultMonitor) countCrossedThresholds(cert Certificate, now time.Time) int {
+	days := daysToExpiry(cert, now)
+	crossedThresholds := 0
+	for _, threshold := range m.thresholds {
+		if days > float64(threshold) {
+			break
+		}
+		crossedThresholds++
+	}
+	return crossedThresholds
+}
+
+// eventReason returns the reason of events that report expiring certificates on objects of kind.
+func (m *defaultMonitor) eventReasonExpiring() string {
+	if m.kind == core.StackKindService {
+		return k8s.ServiceEventReasonCertificateExpiring
+	}
+	return k8s.IngressEventReasonCertificateExpiring
+}
+
+// eventReasonInvalid returns the reason of events that report invalid certificates on objects of kind.
+func (m *defaultMonitor) eventReasonInvalid() string {
+	if m.kind == core.StackKindService {
+		return k8s.ServiceEventReasonCertificateInvalid
+	}
+	return k8s.IngressEventReasonCertificateInvalid
+}
+
+func (m *defaultMonitor) getTarget(stackID core.StackID) (*Target, bool) {
+	m.targetsMutex.Lock()
+	defer m.targetsMutex.Unlock()
+	target, exists := m.targets[stackID]
+	return target, exists
+}
+
+// listStackIDs returns the stackIDs of registered targets in a stable order.
+func (m *defaultMonitor) listStackIDs() []core.StackID {
+	m.targetsMutex.Lock()
+	defer m.targetsMutex.Unlock()
+	stackIDs := make([]core.StackID, 0, len(m.targets))
+	for stackID := range m.targets {
+		stackIDs = append(stackIDs, stackID)
+	}
+	sort.Slice(stackIDs, func(i, j int) bool {
+		return stackIDs[i].String() < stackIDs[j].String()
+	})
+	return stackIDs
+}
+
+// ListCertificateARNs lists the ARNs of certificates on listeners of stack, sorted and without duplicates.
+func ListCertificateARNs(stack core.Stack) ([]string, error) {
+	var listeners []*elbv2model.Listener
+	if err := stack.ListResources(&listeners); err != nil {
+		return nil, err
+	}
+	certARNs := sets.NewString()
+	for _, ls := range listeners {
+		for _, cert := range ls.Spec.Certificates {
+			if cert.CertificateARN != nil {
+				certARNs.Insert(*cert.CertificateARN)
+			}
+		}
+	}
+	return certARNs.List(), nil
+}
+
+// daysToExpiry computes the days until cert expires as of now, rounded down to 2 decimal places.
+func daysToExpiry(cert Certificate, now time.Time) float64 {
+	days := cert.NotAfter.Sub(now).Hours() / 24
+	return math.Floor(days*100) / 100
+}
+
+func formatExpiryMessage(cert Certificate, now time.Time) string {
+	notAfter := cert.NotAfter.UTC().Format(time.RFC3339)
+	if !now.Before(cert.NotAfter) {
+		return fmt.Sprintf("Certificate %v expired at %v", cert.ARN, notAfter)
+	}
+	return fmt.Sprintf("Certificate %v expires in %d days at %v", cert.ARN, int(daysToExpiry(cert, now)), notAfter)
+}
@@ -0,0 +1,286 @@
+package certmonitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeCertificateDescriber struct {
+	certs map[string]Certificate
+	err   error
+}
+
+func (d *fakeCertificateDescriber) Describe(_ context.Context, certARN string) (Certificate, error) {
+	if d.err != nil {
+		return Certificate{}, d.err
+	}
+	return d.certs[certARN], nil
+}
+
+type fakeMetricsCollector struct {
+	daysToExpiry map[string]map[string]float64
+}
+
+func (c *fakeMetricsCollector) ObserveCertificates(_ core.StackKind, stackID string, certs []Certificate, now time.Time) {
+	daysByCert := make(map[string]float64, len(certs))
+	for _, cert := range certs {
+		daysByCert[cert.ARN] = daysToExpiry(cert, now)
+	}
+	c.daysToExpiry[stackID] = daysByCert
+}
+
+func (c *fakeMetricsCollector) Reset(_ core.StackKind, stackID string) {
+	delete(c.daysToExpiry, stackID)
+}
+
+func newTestStack(stackID core.StackID, certARNs ...string) core.Stack {
+	stack := core.NewDefaultStack(stackID)
+	certs := make([]elbv2model.Certificate, 0, len(certARNs))
+	for _, certARN := range certARNs {
+		certs = append(certs, elbv2model.Certificate{CertificateARN: awssdk.String(certARN)})
+	}
+	elbv2model.NewListener(stack, "443", elbv2model.ListenerSpec{
+		LoadBalancerARN: core.LiteralStringToken("some-lb-arn"),
+		Port:            443,
+		Protocol:        elbv2model.ProtocolHTTPS,
+		Certificates:    certs,
+	})
+	elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+		LoadBalancerARN: core.LiteralStringToken("some-lb-arn"),
+		Port:            80,
+		Protocol:        elbv2model.ProtocolHTTP,
+	})
+	return stack
+}
+
+func Test_defaultMonitor_check(t *testing.T) {
+	stackID := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "ing-1"})
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ing-1",
+		},
+	}
+	certARN := "arn:aws:acm:us-west-2:123456789012:certificate/cert-1"
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type check struct {
+		cert       Certificate
+		wantDays   float64
+		wantEvents []string
+	}
+	tests := []struct {
+		name   string
+		checks []check
+	}{
+		{
+			name: "certificate far from expiry",
+			checks: []check{
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(90 * 24 * time.Hour)},
+					wantDays: 90,
+				},
+			},
+		},
+		{
+			name: "each threshold is warned about once",
+			checks: []check{
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(20 * 24 * time.Hour)},
+					wantDays: 20,
+					wantEvents: []string{
+						"Warning CertificateExpiring Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 expires in 20 days at 2024-01-21T00:00:00Z",
+					},
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(20 * 24 * time.Hour)},
+					wantDays: 20,
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(36 * time.Hour)},
+					wantDays: 1.5,
+					wantEvents: []string{
+						"Warning CertificateExpiring Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 expires in 1 days at 2024-01-02T12:00:00Z",
+					},
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(-time.Hour)},
+					wantDays: -0.05,
+					wantEvents: []string{
+						"Warning CertificateExpiring Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 expired at 2023-12-31T23:00:00Z",
+					},
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(-time.Hour)},
+					wantDays: -0.05,
+				},
+			},
+		},
+		{
+			name: "renewed certificate is warned about again",
+			checks: []check{
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(5 * 24 * time.Hour)},
+					wantDays: 5,
+					wantEvents: []string{
+						"Warning CertificateExpiring Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 expires in 5 days at 2024-01-06T00:00:00Z",
+					},
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(365 * 24 * time.Hour)},
+					wantDays: 365,
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(-24 * time.Hour)},
+					wantDays: -1,
+					wantEvents: []string{
+						"Warning CertificateExpiring Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 expired at 2023-12-31T00:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid certificate",
+			checks: []check{
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(90 * 24 * time.Hour), InvalidReason: "status is REVOKED"},
+					wantDays: 90,
+					wantEvents: []string{
+						"Warning CertificateInvalid Certificate arn:aws:acm:us-west-2:123456789012:certificate/cert-1 is invalid: status is REVOKED",
+					},
+				},
+				{
+					cert:     Certificate{ARN: certARN, NotAfter: now.Add(90 * 24 * time.Hour), InvalidReason: "status is REVOKED"},
+					wantDays: 90,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRecorder := record.NewFakeRecorder(10)
+			metricsCollector := &fakeMetricsCollector{daysToExpiry: map[string]map[string]float64{}}
+			certDescriber := &fakeCertificateDescriber{}
+			m := NewDefaultMonitor(core.StackKindIngressGroup, certDescriber, eventRecorder, metricsCollector,
+				time.Hour, []int{7, 30, 14, 7}, logr.New(&log.NullLogSink{}))
+			m.clock = func() time.Time { return now }
+			for _, check := range tt.checks {
+				// targets are re-registered on each reconcile, which retains the warnings raised.
+				m.Register(Target{
+					Stack:   newTestStack(stackID, certARN),
+					Objects: []client.Object{ing},
+				})
+				certDescriber.certs = map[string]Certificate{certARN: check.cert}
+				assert.NoError(t, m.check(context.Background(), stackID))
+				assert.Equal(t, map[string]map[string]float64{stackID.String(): {certARN: check.wantDays}}, metricsCollector.daysToExpiry)
+				var gotEvents []string
+				for len(eventRecorder.Events) > 0 {
+					gotEvents = append(gotEvents, <-eventRecorder.Events)
+				}
+				assert.Equal(t, check.wantEvents, gotEvents)
+			}
+		})
+	}
+}
+
+func Test_defaultMonitor_check_failures(t *testing.T) {
+	stackID := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "svc-1"})
+	certARN := "arn:aws:acm:us-west-2:123456789012:certificate/cert-1"
+	tests := []struct {
+		name             string
+		describerErr     error
+		targetDescriber  bool
+		unregisterFirst  bool
+		wantErr          error
+		wantDaysToExpiry map[string]map[string]float64
+	}{
+		{
+			name:             "failed to describe certificate",
+			describerErr:     errors.New("some error"),
+			wantErr:          errors.New("failed to describe certificate: arn:aws:acm:us-west-2:123456789012:certificate/cert-1: some error"),
+			wantDaysToExpiry: map[string]map[string]float64{},
+		},
+		{
+			name:             "certificate describer of target takes priority",
+			describerErr:     errors.New("some error"),
+			targetDescriber:  true,
+			wantDaysToExpiry: map[string]map[string]float64{stackID.String(): {certARN: 10}},
+		},
+		{
+			name:             "unregistered target",
+			unregisterFirst:  true,
+			wantDaysToExpiry: map[string]map[string]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			certs := map[string]Certificate{certARN: {ARN: certARN, NotAfter: now.Add(10 * 24 * time.Hour)}}
+			metricsCollector := &fakeMetricsCollector{daysToExpiry: map[string]map[string]float64{}}
+			m := NewDefaultMonitor(core.StackKindService, &fakeCertificateDescriber{certs: certs, err: tt.describerErr}, record.NewFakeRecorder(10),
+				metricsCollector, time.Hour, []int{30}, logr.New(&log.NullLogSink{}))
+			m.clock = func() time.Time { return now }
+			target := Target{Stack: newTestStack(stackID, certARN)}
+			if tt.targetDescriber {
+				target.CertificateDescriber = &fakeCertificateDescriber{certs: certs}
+			}
+			m.Register(target)
+			if tt.unregisterFirst {
+				m.Unregister(stackID)
+			}
+			err := m.check(context.Background(), stackID)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDaysToExpiry, metricsCollector.daysToExpiry)
+		})
+	}
+}
+
+func Test_ListCertificateARNs(t *testing.T) {
+	stackID := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "ing-1"})
+	tests := []struct {
+		name  string
+		stack core.Stack
+		want  []string
+	}{
+		{
+			name:  "listeners without certificates",
+			stack: newTestStack(stackID),
+			want:  []string{},
+		},
+		{
+			name: "certificates are sorted without duplicates",
+			stack: newTestStack(stackID,
+				"arn:aws:iam::123456789012:server-certificate/cert-2",
+				"arn:aws:acm:us-west-2:123456789012:certificate/cert-1",
+				"arn:aws:iam::123456789012:server-certificate/cert-2"),
+			want: []string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/cert-1",
+				"arn:aws:iam::123456789012:server-certificate/cert-2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListCertificateARNs(tt.stack)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
@@ -20,6 +21,7 @@ const (
 	flagExternalManagedTags                          = "external-managed-tags"
 	flagServiceTargetENISGTags                       = "service-target-eni-security-group-tags"
 	flagServiceMaxConcurrentReconciles               = "service-max-concurrent-reconciles"
+	flagGatewayMaxConcurrentReconciles               = "gateway-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxConcurrentReconciles    = "targetgroupbinding-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxExponentialBackoffDelay = "targetgroupbinding-max-exponential-backoff-delay"
 	flagDefaultSSLPolicy                             = "default-ssl-policy"
@@ -27,6 +29,11 @@ const (
 	flagBackendSecurityGroup                         = "backend-security-group"
 	flagEnableEndpointSlices                         = "enable-endpoint-slices"
 	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
+	flagDriftDetectionInterval                       = "drift-detection-interval"
+	flagCertExpiryCheckInterval                      = "cert-expiry-check-interval"
+	flagCertExpiryWarningThresholds                  = "cert-expiry-warning-thresholds"
+	flagDeployMaxConcurrency                         = "deploy-max-concurrency"
+	flagControllerConfigMap                          = "controller-config-map"
 	defaultLogLevel                                  = "info"
 	defaultMaxConcurrentReconciles                   = 3
 	defaultMaxExponentialBackoffDelay                = time.Second * 1000
@@ -34,16 +41,32 @@ const (
 	defaultEnableBackendSG                           = true
 	defaultEnableEndpointSlices                      = false
 	defaultDisableRestrictedSGRules                  = false
+	defaultDriftDetectionInterval                    = time.Duration(0)
+	defaultCertExpiryCheckInterval                   = time.Duration(0)
+	defaultDeployMaxConcurrency                      = 10
 )
 
 var (
 	trackingTagKeys = sets.NewString(
 		"elbv2.k8s.aws/cluster",
+		"elbv2.k8s.aws/adopted",
 		"elbv2.k8s.aws/resource",
 		"ingress.k8s.aws/stack",
 		"ingress.k8s.aws/resource",
 		"service.k8s.aws/stack",
 		"service.k8s.aws/resource",
+		"gateway.k8s.aws.alb/stack",
+		"gateway.k8s.aws.alb/resource",
+		"gateway.k8s.aws.nlb/stack",
+		"gateway.k8s.aws.nlb/resource",
+	)
+
+	// liveReloadableFlags are the flags whose changes in the controller ConfigMap are applied without restarting the controller.
+	liveReloadableFlags = sets.NewString(
+		flagDefaultTags,
+		flagExternalManagedTags,
+		flagDefaultSSLPolicy,
+		flagDefaultTargetType,
 	)
 )
 
@@ -87,6 +110,8 @@ type ControllerConfig struct {
 
 	// Max concurrent reconcile loops for Service objects
 	ServiceMaxConcurrentReconciles int
+	// Max concurrent reconcile loops for Gateway objects
+	GatewayMaxConcurrentReconciles int
 	// Max concurrent reconcile loops for TargetGroupBinding objects
 	TargetGroupBindingMaxConcurrentReconciles int
 	// Max exponential backoff delay for reconcile failures of TargetGroupBinding
@@ -102,6 +127,21 @@ type ControllerConfig struct {
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// DriftDetectionInterval specifies the interval to detect drift of deployed resources, drift detection is disabled if zero
+	DriftDetectionInterval time.Duration
+
+	// CertExpiryCheckInterval specifies the interval to check the certificates of deployed listeners, certificate monitoring is disabled if zero
+	CertExpiryCheckInterval time.Duration
+
+	// CertExpiryWarningThresholds specifies the days to expiry at which to warn about the certificates of deployed listeners
+	CertExpiryWarningThresholds []int
+
+	// DeployMaxConcurrency specifies the maximum number of resources created, updated or deleted concurrently when deploying a stack
+	DeployMaxConcurrency int
+
+	// ControllerConfigMap specifies the ConfigMap in namespace/name format, whose data overrides the command line flags and is watched for changes
+	ControllerConfigMap string
+
 	FeatureGates FeatureGates
 }
 
@@ -118,6 +158,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"List of Tag keys on AWS resources that will be managed externally")
 	fs.IntVar(&cfg.ServiceMaxConcurrentReconciles, flagServiceMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for service")
+	fs.IntVar(&cfg.GatewayMaxConcurrentReconciles, flagGatewayMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for gateway")
 	fs.IntVar(&cfg.TargetGroupBindingMaxConcurrentReconciles, flagTargetGroupBindingMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for targetGroupBinding")
 	fs.DurationVar(&cfg.TargetGroupBindingMaxExponentialBackoffDelay, flagTargetGroupBindingMaxExponentialBackoffDelay, defaultMaxExponentialBackoffDelay,
@@ -132,6 +174,16 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.DurationVar(&cfg.DriftDetectionInterval, flagDriftDetectionInterval, defaultDriftDetectionInterval,
+		"Interval to detect drift of deployed load balancer resources from the desired state, 0 disables drift detection")
+	fs.DurationVar(&cfg.CertExpiryCheckInterval, flagCertExpiryCheckInterval, defaultCertExpiryCheckInterval,
+		"Interval to check the expiry and validity of certificates on deployed listeners, 0 disables certificate monitoring")
+	fs.IntSliceVar(&cfg.CertExpiryWarningThresholds, flagCertExpiryWarningThresholds, []int{30, 14, 7, 1},
+		"Days to expiry at which to warn about the certificates on deployed listeners")
+	fs.IntVar(&cfg.DeployMaxConcurrency, flagDeployMaxConcurrency, defaultDeployMaxConcurrency,
+		"Maximum number of resources created, updated or deleted concurrently when deploying load balancers")
+	fs.StringVar(&cfg.ControllerConfigMap, flagControllerConfigMap, "",
+		"ConfigMap in namespace/name format, whose data of flag names to values overrides the command line flags and is watched for changes")
 	fs.StringToStringVar(&cfg.ServiceTargetENISGTags, flagServiceTargetENISGTags, nil,
 		"AWS Tags, in addition to cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs")
 	cfg.FeatureGates.BindFlags(fs)
@@ -165,6 +217,18 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateBackendSecurityGroupConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.validateDriftDetectionInterval(); err != nil {
+		return err
+	}
+	if err := cfg.validateCertExpiryMonitoring(); err != nil {
+		return err
+	}
+	if err := cfg.validateDeployMaxConcurrency(); err != nil {
+		return err
+	}
+	if err := cfg.validateControllerConfigMap(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -205,6 +269,59 @@ func (cfg *ControllerConfig) validateDefaultTargetType() error {
 	}
 }
 
+func (cfg *ControllerConfig) validateDriftDetectionInterval() error {
+	if cfg.DriftDetectionInterval < 0 {
+		return errors.Errorf("invalid value %v for %v flag, must not be negative", cfg.DriftDetectionInterval, flagDriftDetectionInterval)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateCertExpiryMonitoring() error {
+	if cfg.CertExpiryCheckInterval < 0 {
+		return errors.Errorf("invalid value %v for %v flag, must not be negative", cfg.CertExpiryCheckInterval, flagCertExpiryCheckInterval)
+	}
+	for _, threshold := range cfg.CertExpiryWarningThresholds {
+		if threshold < 0 {
+			return errors.Errorf("invalid value %v for %v flag, must not be negative", threshold, flagCertExpiryWarningThresholds)
+		}
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateDeployMaxConcurrency() error {
+	if cfg.DeployMaxConcurrency < 1 {
+		return errors.Errorf("invalid value %v for %v flag, must be positive", cfg.DeployMaxConcurrency, flagDeployMaxConcurrency)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateControllerConfigMap() error {
+	if len(cfg.ControllerConfigMap) == 0 {
+		return nil
+	}
+	if _, err := cfg.ControllerConfigMapKey(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ControllerConfigMapKey returns the key of ControllerConfigMap.
+func (cfg *ControllerConfig) ControllerConfigMapKey() (types.NamespacedName, error) {
+	parts := strings.Split(cfg.ControllerConfigMap, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return types.NamespacedName{}, errors.Errorf("invalid value %v for %v flag, must be in namespace/name format", cfg.ControllerConfigMap, flagControllerConfigMap)
+	}
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
+
+// applyLiveReloadableFields applies the fields of liveReloadableFlags from other.
+func (cfg *ControllerConfig) applyLiveReloadableFields(other ControllerConfig) {
+	cfg.DefaultTags = other.DefaultTags
+	cfg.ExternalManagedTags = other.ExternalManagedTags
+	cfg.DefaultSSLPolicy = other.DefaultSSLPolicy
+	cfg.DefaultTargetType = other.DefaultTargetType
+}
+
 func (cfg *ControllerConfig) validateBackendSecurityGroupConfiguration() error {
 	if len(cfg.BackendSecurityGroup) == 0 {
 		return nil
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+)
+
+// LoadControllerConfig loads the controller configuration from command line args, with the flag values in overrides
+// taking precedence over them, e.g. the data of the controller ConfigMap.
+func LoadControllerConfig(args []string, overrides map[string]string, errorHandling pflag.ErrorHandling) (ControllerConfig, error) {
+	controllerCFG := ControllerConfig{
+		AWSConfig: aws.CloudConfig{
+			ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+		},
+		FeatureGates: NewFeatureGates(),
+	}
+
+	// overrides are set through flags bound before args are parsed, so that values of slice and map flags in overrides
+	// replace rather than append to the values in args.
+	overridesFS := pflag.NewFlagSet("", errorHandling)
+	controllerCFG.BindFlags(overridesFS)
+	fs := pflag.NewFlagSet("", errorHandling)
+	controllerCFG.BindFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		return ControllerConfig{}, err
+	}
+	for _, name := range sets.StringKeySet(overrides).List() {
+		if name == flagControllerConfigMap || overridesFS.Lookup(name) == nil {
+			return ControllerConfig{}, errors.Errorf("unknown flag %v", name)
+		}
+		if err := overridesFS.Set(name, overrides[name]); err != nil {
+			return ControllerConfig{}, err
+		}
+	}
+
+	if err := controllerCFG.Validate(); err != nil {
+		return ControllerConfig{}, err
+	}
+	return controllerCFG, nil
+}
+
+// LoadControllerConfigMapData loads the data of the controller ConfigMap with cmKey, returns empty data if it doesn't exist.
+func LoadControllerConfigMapData(ctx context.Context, clientSet kubernetes.Interface, cmKey types.NamespacedName) (map[string]string, error) {
+	cm, err := clientSet.CoreV1().ConfigMaps(cmKey.Namespace).Get(ctx, cmKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to load controller ConfigMap %v", cmKey)
+	}
+	return cm.Data, nil
+}
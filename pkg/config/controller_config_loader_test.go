@@ -0,0 +1,95 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadControllerConfig(t *testing.T) {
+	args := []string{"controller", "--cluster-name=cluster", "--default-tags=team=a,env=dev", "--default-ssl-policy=ELBSecurityPolicy-2016-08"}
+	type want struct {
+		defaultTags         map[string]string
+		externalManagedTags []string
+		defaultSSLPolicy    string
+		clusterName         string
+		listenerRulesLimit  int
+	}
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      want
+		wantErr   error
+	}{
+		{
+			name:      "without overrides",
+			overrides: nil,
+			want: want{
+				defaultTags:      map[string]string{"team": "a", "env": "dev"},
+				defaultSSLPolicy: "ELBSecurityPolicy-2016-08",
+				clusterName:      "cluster",
+			},
+		},
+		{
+			name: "overrides replace the values of args",
+			overrides: map[string]string{
+				"default-tags":          "team=b",
+				"external-managed-tags": "owner,cost-center",
+				"default-ssl-policy":    "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				"listener-rules-limit":  "100",
+			},
+			want: want{
+				defaultTags:         map[string]string{"team": "b"},
+				externalManagedTags: []string{"owner", "cost-center"},
+				defaultSSLPolicy:    "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				clusterName:         "cluster",
+				listenerRulesLimit:  100,
+			},
+		},
+		{
+			name: "unknown flag in overrides",
+			overrides: map[string]string{
+				"unknown-flag": "value",
+			},
+			wantErr: errors.New("unknown flag unknown-flag"),
+		},
+		{
+			name: "controller ConfigMap flag in overrides",
+			overrides: map[string]string{
+				"controller-config-map": "kube-system/other-config",
+			},
+			wantErr: errors.New("unknown flag controller-config-map"),
+		},
+		{
+			name: "invalid value in overrides",
+			overrides: map[string]string{
+				"deploy-max-concurrency": "many",
+			},
+			wantErr: errors.New("invalid argument \"many\" for \"--deploy-max-concurrency\" flag: strconv.ParseInt: parsing \"many\": invalid syntax"),
+		},
+		{
+			name: "overrides fail validation",
+			overrides: map[string]string{
+				"deploy-max-concurrency": "0",
+			},
+			wantErr: errors.New("invalid value 0 for deploy-max-concurrency flag, must be positive"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadControllerConfig(args, tt.overrides, pflag.ContinueOnError)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.defaultTags, got.DefaultTags)
+				assert.Equal(t, tt.want.externalManagedTags, got.ExternalManagedTags)
+				assert.Equal(t, tt.want.defaultSSLPolicy, got.DefaultSSLPolicy)
+				assert.Equal(t, tt.want.clusterName, got.ClusterName)
+				assert.Equal(t, tt.want.listenerRulesLimit, got.IngressConfig.ListenerRulesLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ReloadFunc applies the reloaded controller configuration, e.g. by requeueing the objects affected by the changes.
+type ReloadFunc func(ctx context.Context, cfg ControllerConfig) error
+
+// ControllerConfigReloader watches the controller ConfigMap, and reloads the controller configuration once it changes.
+// changes to liveReloadableFlags are applied without restarting the controller, while changes to other flags are
+// reported via events on the ConfigMap, and only applied after the controller restarts.
+type ControllerConfigReloader interface {
+	// Subscribe registers reloadFunc to apply the controller configuration once changes to it are reloaded.
+	Subscribe(reloadFunc ReloadFunc)
+}
+
+// NewDefaultControllerConfigReloader constructs new defaultControllerConfigReloader.
+// controllerConfig is the controller configuration loaded from args with configMapData, the data of the ConfigMap with cmKey at startup.
+func NewDefaultControllerConfigReloader(clientSet kubernetes.Interface, cmKey types.NamespacedName, args []string,
+	controllerConfig ControllerConfig, configMapData map[string]string, eventRecorder record.EventRecorder, logger logr.Logger) *defaultControllerConfigReloader {
+	return &defaultControllerConfigReloader{
+		clientSet:        clientSet,
+		cmKey:            cmKey,
+		args:             args,
+		controllerConfig: controllerConfig,
+		configMapData:    configMapData,
+		eventRecorder:    eventRecorder,
+		logger:           logger,
+	}
+}
+
+var _ ControllerConfigReloader = &defaultControllerConfigReloader{}
+var _ manager.Runnable = &defaultControllerConfigReloader{}
+
+// default implementation for ControllerConfigReloader
+type defaultControllerConfigReloader struct {
+	clientSet     kubernetes.Interface
+	cmKey         types.NamespacedName
+	args          []string
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+
+	mutex            sync.Mutex
+	controllerConfig ControllerConfig
+	configMapData    map[string]string
+	reloadFuncs      []ReloadFunc
+}
+
+func (r *defaultControllerConfigReloader) Subscribe(reloadFunc ReloadFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.reloadFuncs = append(r.reloadFuncs, reloadFunc)
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// Start watches the ConfigMap until ctx is done.
+func (r *defaultControllerConfigReloader) Start(ctx context.Context) error {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(r.clientSet, 0,
+		informers.WithNamespace(r.cmKey.Namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", r.cmKey.Name).String()
+		}))
+	informer := informerFactory.Core().V1().ConfigMaps().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			cm := obj.(*corev1.ConfigMap)
+			r.reload(ctx, cm, cm.Data)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			cm := obj.(*corev1.ConfigMap)
+			r.reload(ctx, cm, cm.Data)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			cm, ok := obj.(*corev1.ConfigMap)
+			if !ok {
+				return
+			}
+			r.reload(ctx, cm, nil)
+		},
+	}); err != nil {
+		return err
+	}
+	informerFactory.Start(ctx.Done())
+	<-ctx.Done()
+	informerFactory.Shutdown()
+	return nil
+}
+
+// reload reloads the controller configuration with configMapData of cm, and applies the changes to liveReloadableFlags.
+func (r *defaultControllerConfigReloader) reload(ctx context.Context, cm *corev1.ConfigMap, configMapData map[string]string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	changedFlags := computeChangedFlags(r.configMapData, configMapData)
+	if changedFlags.Len() == 0 {
+		return
+	}
+	reloadedConfig, err := LoadControllerConfig(r.args, configMapData, pflag.ContinueOnError)
+	if err != nil {
+		r.logger.Error(err, "failed to reload controller config", "configMap", r.cmKey)
+		r.eventRecorder.Event(cm, corev1.EventTypeWarning, k8s.ControllerConfigEventReasonFailedReload, fmt.Sprintf("Failed reload controller config due to %v", err))
+		return
+	}
+	r.configMapData = configMapData
+
+	liveChangedFlags := changedFlags.Intersection(liveReloadableFlags)
+	restartChangedFlags := changedFlags.Difference(liveReloadableFlags)
+	if restartChangedFlags.Len() > 0 {
+		r.logger.Info("controller restart required to apply changes", "configMap", r.cmKey, "flags", restartChangedFlags.List())
+		r.eventRecorder.Event(cm, corev1.EventTypeWarning, k8s.ControllerConfigEventReasonRestartRequired,
+			fmt.Sprintf("Controller restart required to apply changes to flags: %v", strings.Join(restartChangedFlags.List(), ", ")))
+	}
+	if liveChangedFlags.Len() == 0 {
+		return
+	}
+	r.controllerConfig.applyLiveReloadableFields(reloadedConfig)
+	for _, reloadFunc := range r.reloadFuncs {
+		if err := reloadFunc(ctx, r.controllerConfig); err != nil {
+			r.logger.Error(err, "failed to apply reloaded controller config", "configMap", r.cmKey)
+		}
+	}
+	r.logger.Info("reloaded controller config", "configMap", r.cmKey, "flags", liveChangedFlags.List())
+	r.eventRecorder.Event(cm, corev1.EventTypeNormal, k8s.ControllerConfigEventReasonSuccessfullyReloaded,
+		fmt.Sprintf("Successfully reloaded changes to flags: %v", strings.Join(liveChangedFlags.List(), ", ")))
+}
+
+// computeChangedFlags computes the flags whose values differ between the ConfigMap data.
+func computeChangedFlags(configMapData map[string]string, otherConfigMapData map[string]string) sets.String {
+	changedFlags := sets.NewString()
+	for name, value := range configMapData {
+		if otherValue, exists := otherConfigMapData[name]; !exists || otherValue != value {
+			changedFlags.Insert(name)
+		}
+	}
+	for name := range otherConfigMapData {
+		if _, exists := configMapData[name]; !exists {
+			changedFlags.Insert(name)
+		}
+	}
+	return changedFlags
+}
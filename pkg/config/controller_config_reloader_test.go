@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func Test_computeChangedFlags(t *testing.T) {
+	tests := []struct {
+		name               string
+		configMapData      map[string]string
+		otherConfigMapData map[string]string
+		want               sets.String
+	}{
+		{
+			name:               "both empty",
+			configMapData:      nil,
+			otherConfigMapData: nil,
+			want:               sets.NewString(),
+		},
+		{
+			name:               "unchanged",
+			configMapData:      map[string]string{"default-ssl-policy": "ELBSecurityPolicy-2016-08"},
+			otherConfigMapData: map[string]string{"default-ssl-policy": "ELBSecurityPolicy-2016-08"},
+			want:               sets.NewString(),
+		},
+		{
+			name: "added, changed and removed flags",
+			configMapData: map[string]string{
+				"default-ssl-policy":  "ELBSecurityPolicy-2016-08",
+				"default-target-type": "instance",
+			},
+			otherConfigMapData: map[string]string{
+				"default-ssl-policy": "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				"log-level":          "debug",
+			},
+			want: sets.NewString("default-ssl-policy", "default-target-type", "log-level"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeChangedFlags(tt.configMapData, tt.otherConfigMapData))
+		})
+	}
+}
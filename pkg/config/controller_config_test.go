@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestControllerConfig_validateDefaultTagsCollisionWithTrackingTags(t *testing.T) {
@@ -164,3 +165,166 @@ func TestControllerConfig_validateExternalManagedTagsCollisionWithDefaultTags(t
 		})
 	}
 }
+
+func TestControllerConfig_validateDriftDetectionInterval(t *testing.T) {
+	tests := []struct {
+		name                   string
+		driftDetectionInterval time.Duration
+		wantErr                error
+	}{
+		{
+			name:                   "drift detection disabled",
+			driftDetectionInterval: 0,
+			wantErr:                nil,
+		},
+		{
+			name:                   "drift detection enabled",
+			driftDetectionInterval: 5 * time.Minute,
+			wantErr:                nil,
+		},
+		{
+			name:                   "negative drift detection interval",
+			driftDetectionInterval: -time.Minute,
+			wantErr:                errors.New("invalid value -1m0s for drift-detection-interval flag, must not be negative"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				DriftDetectionInterval: tt.driftDetectionInterval,
+			}
+			err := cfg.validateDriftDetectionInterval()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateCertExpiryMonitoring(t *testing.T) {
+	tests := []struct {
+		name                        string
+		certExpiryCheckInterval     time.Duration
+		certExpiryWarningThresholds []int
+		wantErr                     error
+	}{
+		{
+			name:                        "certificate monitoring disabled",
+			certExpiryCheckInterval:     0,
+			certExpiryWarningThresholds: []int{30, 14, 7, 1},
+			wantErr:                     nil,
+		},
+		{
+			name:                        "certificate monitoring enabled",
+			certExpiryCheckInterval:     time.Hour,
+			certExpiryWarningThresholds: []int{30, 0},
+			wantErr:                     nil,
+		},
+		{
+			name:                    "negative certificate expiry check interval",
+			certExpiryCheckInterval: -time.Hour,
+			wantErr:                 errors.New("invalid value -1h0m0s for cert-expiry-check-interval flag, must not be negative"),
+		},
+		{
+			name:                        "negative certificate expiry warning threshold",
+			certExpiryCheckInterval:     time.Hour,
+			certExpiryWarningThresholds: []int{30, -1},
+			wantErr:                     errors.New("invalid value -1 for cert-expiry-warning-thresholds flag, must not be negative"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				CertExpiryCheckInterval:     tt.certExpiryCheckInterval,
+				CertExpiryWarningThresholds: tt.certExpiryWarningThresholds,
+			}
+			err := cfg.validateCertExpiryMonitoring()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateDeployMaxConcurrency(t *testing.T) {
+	tests := []struct {
+		name                 string
+		deployMaxConcurrency int
+		wantErr              error
+	}{
+		{
+			name:                 "serial deployment",
+			deployMaxConcurrency: 1,
+			wantErr:              nil,
+		},
+		{
+			name:                 "concurrent deployment",
+			deployMaxConcurrency: 10,
+			wantErr:              nil,
+		},
+		{
+			name:                 "zero concurrency",
+			deployMaxConcurrency: 0,
+			wantErr:              errors.New("invalid value 0 for deploy-max-concurrency flag, must be positive"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				DeployMaxConcurrency: tt.deployMaxConcurrency,
+			}
+			err := cfg.validateDeployMaxConcurrency()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateControllerConfigMap(t *testing.T) {
+	tests := []struct {
+		name                string
+		controllerConfigMap string
+		wantErr             error
+	}{
+		{
+			name:                "controller ConfigMap not configured",
+			controllerConfigMap: "",
+			wantErr:             nil,
+		},
+		{
+			name:                "controller ConfigMap in namespace/name format",
+			controllerConfigMap: "kube-system/aws-load-balancer-controller-config",
+			wantErr:             nil,
+		},
+		{
+			name:                "controller ConfigMap without namespace",
+			controllerConfigMap: "aws-load-balancer-controller-config",
+			wantErr:             errors.New("invalid value aws-load-balancer-controller-config for controller-config-map flag, must be in namespace/name format"),
+		},
+		{
+			name:                "controller ConfigMap with empty name",
+			controllerConfigMap: "kube-system/",
+			wantErr:             errors.New("invalid value kube-system/ for controller-config-map flag, must be in namespace/name format"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				ControllerConfigMap: tt.controllerConfigMap,
+			}
+			err := cfg.validateControllerConfigMap()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
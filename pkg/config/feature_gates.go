@@ -22,6 +22,8 @@ const (
 	NLBHealthCheckAdvancedConfig Feature = "NLBHealthCheckAdvancedConfig"
 	NLBSecurityGroup             Feature = "NLBSecurityGroup"
 	ALBSingleSubnet              Feature = "ALBSingleSubnet"
+	ALBGatewayAPI                Feature = "ALBGatewayAPI"
+	NLBGatewayAPI                Feature = "NLBGatewayAPI"
 )
 
 type FeatureGates interface {
@@ -60,6 +62,8 @@ func NewFeatureGates() FeatureGates {
 			NLBHealthCheckAdvancedConfig: true,
 			NLBSecurityGroup:             true,
 			ALBSingleSubnet:              false,
+			ALBGatewayAPI:                false,
+			NLBGatewayAPI:                false,
 		},
 	}
 }
@@ -10,12 +10,18 @@ const (
 	flagTolerateNonExistentBackendService    = "tolerate-non-existent-backend-service"
 	flagTolerateNonExistentBackendAction     = "tolerate-non-existent-backend-action"
 	flagAllowedCAArns                        = "allowed-certificate-authority-arns"
+	flagCertDiscoveryKeyAlgorithms           = "cert-discovery-key-algorithms"
+	flagCertDiscoveryTags                    = "cert-discovery-tags"
+	flagListenerRulesLimit                   = "listener-rules-limit"
+	flagEnableTLSSecretsImport               = "enable-tls-secrets-import"
 	defaultIngressClass                      = "alb"
 	defaultDisableIngressClassAnnotation     = false
 	defaultDisableIngressGroupNameAnnotation = false
 	defaultMaxIngressConcurrentReconciles    = 3
 	defaultTolerateNonExistentBackendService = true
 	defaultTolerateNonExistentBackendAction  = true
+	defaultListenerRulesLimit                = 0
+	defaultEnableTLSSecretsImport            = false
 )
 
 // IngressConfig contains the configurations for the Ingress controller
@@ -46,6 +52,20 @@ type IngressConfig struct {
 
 	// AllowedCertificateAuthoritiyARNs contains a list of all CAs to consider when discovering certificates for ingress resources
 	AllowedCertificateAuthorityARNs []string
+
+	// CertDiscoveryKeyAlgorithms contains the key algorithms of certificates to consider when discovering certificates for ingress resources
+	CertDiscoveryKeyAlgorithms []string
+
+	// CertDiscoveryTags contains the tags that certificates must have to be considered when discovering certificates for ingress resources
+	CertDiscoveryTags map[string]string
+
+	// ListenerRulesLimit is the maximum number of listener rules per load balancer, excluding the default rules.
+	// Zero disables the limit check, which is the default.
+	ListenerRulesLimit int
+
+	// EnableTLSSecretsImport specifies whether to import the kubernetes.io/tls secrets referenced by Ingress TLS blocks into ACM,
+	// and attach them as listener certificates.
+	EnableTLSSecretsImport bool
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -63,4 +83,10 @@ func (cfg *IngressConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&cfg.TolerateNonExistentBackendAction, flagTolerateNonExistentBackendAction, defaultTolerateNonExistentBackendAction,
 		"Tolerate rules that specify a non-existent backend action")
 	fs.StringSliceVar(&cfg.AllowedCertificateAuthorityARNs, flagAllowedCAArns, []string{}, "Specify an optional list of CA ARNs to filter on in cert discovery")
+	fs.StringSliceVar(&cfg.CertDiscoveryKeyAlgorithms, flagCertDiscoveryKeyAlgorithms, []string{}, "Specify an optional list of key algorithms to filter on in cert discovery, e.g. RSA_2048,EC_prime256v1")
+	fs.StringToStringVar(&cfg.CertDiscoveryTags, flagCertDiscoveryTags, nil, "Specify an optional set of key=value tags to filter on in cert discovery")
+	fs.IntVar(&cfg.ListenerRulesLimit, flagListenerRulesLimit, defaultListenerRulesLimit,
+		"Maximum number of listener rules per load balancer excluding default rules, 0 disables the check")
+	fs.BoolVar(&cfg.EnableTLSSecretsImport, flagEnableTLSSecretsImport, defaultEnableTLSSecretsImport,
+		"Import TLS secrets referenced by Ingress TLS blocks into ACM as listener certificates")
 }
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TargetGroupConfigurationLoader loads the settings of TargetGroups for Service ports from TargetGroupConfigurations.
+type TargetGroupConfigurationLoader interface {
+	// Load returns the settings of TargetGroups for port of svc, or nil if no TargetGroupConfiguration references svc.
+	// the settings for port take precedence over the default settings of the TargetGroupConfiguration.
+	Load(ctx context.Context, svc *corev1.Service, port corev1.ServicePort) (*elbv2api.TargetGroupProps, error)
+}
+
+// NewDefaultTargetGroupConfigurationLoader constructs new defaultTargetGroupConfigurationLoader.
+func NewDefaultTargetGroupConfigurationLoader(k8sClient client.Client) *defaultTargetGroupConfigurationLoader {
+	return &defaultTargetGroupConfigurationLoader{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ TargetGroupConfigurationLoader = &defaultTargetGroupConfigurationLoader{}
+
+// default implementation for TargetGroupConfigurationLoader
+type defaultTargetGroupConfigurationLoader struct {
+	k8sClient client.Client
+}
+
+func (l *defaultTargetGroupConfigurationLoader) Load(ctx context.Context, svc *corev1.Service, port corev1.ServicePort) (*elbv2api.TargetGroupProps, error) {
+	tgConfigList := &elbv2api.TargetGroupConfigurationList{}
+	if err := l.k8sClient.List(ctx, tgConfigList, client.InNamespace(svc.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list TargetGroupConfigurations")
+	}
+	var tgConfig *elbv2api.TargetGroupConfiguration
+	for i := range tgConfigList.Items {
+		if tgConfigList.Items[i].Spec.ServiceRef.Name != svc.Name {
+			continue
+		}
+		if tgConfig != nil {
+			return nil, errors.Errorf("conflicting TargetGroupConfigurations for Service %v: %v, %v",
+				k8s.NamespacedName(svc), tgConfig.Name, tgConfigList.Items[i].Name)
+		}
+		tgConfig = &tgConfigList.Items[i]
+	}
+	if tgConfig == nil {
+		return nil, nil
+	}
+
+	var portProps *elbv2api.TargetGroupProps
+	for i := range tgConfig.Spec.PortConfigurations {
+		portConfig := &tgConfig.Spec.PortConfigurations[i]
+		if !isServicePortMatched(portConfig.Port, port) {
+			continue
+		}
+		if portProps != nil {
+			return nil, errors.Errorf("duplicate portConfigurations for port %v in TargetGroupConfiguration %v",
+				port.Port, k8s.NamespacedName(tgConfig))
+		}
+		portProps = &portConfig.TargetGroupProps
+	}
+	props := tgConfig.Spec.DefaultConfiguration.DeepCopy()
+	if portProps != nil {
+		mergeTargetGroupProps(props, portProps)
+	}
+	return props, nil
+}
+
+// isServicePortMatched checks whether the port of a portConfiguration matches the Service port, either by number or name.
+func isServicePortMatched(port intstr.IntOrString, svcPort corev1.ServicePort) bool {
+	if port.Type == intstr.Int {
+		return port.IntVal == svcPort.Port
+	}
+	return port.StrVal == svcPort.Name
+}
+
+// mergeTargetGroupProps merges the settings in override into props, with the ones in override taking precedence.
+func mergeTargetGroupProps(props *elbv2api.TargetGroupProps, override *elbv2api.TargetGroupProps) {
+	if override.TargetType != nil {
+		props.TargetType = override.TargetType
+	}
+	if override.ProtocolVersion != nil {
+		props.ProtocolVersion = override.ProtocolVersion
+	}
+	if override.HealthCheckConfig != nil {
+		if props.HealthCheckConfig == nil {
+			props.HealthCheckConfig = &elbv2api.TargetGroupHealthCheckConfig{}
+		}
+		mergeTargetGroupHealthCheckConfig(props.HealthCheckConfig, override.HealthCheckConfig)
+	}
+	for _, attr := range override.TargetGroupAttributes {
+		props.TargetGroupAttributes = mergeAttribute(props.TargetGroupAttributes, attr)
+	}
+	for _, tag := range override.Tags {
+		props.Tags = mergeTag(props.Tags, tag)
+	}
+}
+
+func mergeTargetGroupHealthCheckConfig(hc *elbv2api.TargetGroupHealthCheckConfig, override *elbv2api.TargetGroupHealthCheckConfig) {
+	if override.Port != nil {
+		hc.Port = override.Port
+	}
+	if override.Protocol != nil {
+		hc.Protocol = override.Protocol
+	}
+	if override.Path != nil {
+		hc.Path = override.Path
+	}
+	if override.Matcher != nil {
+		hc.Matcher = override.Matcher
+	}
+	if override.IntervalSeconds != nil {
+		hc.IntervalSeconds = override.IntervalSeconds
+	}
+	if override.TimeoutSeconds != nil {
+		hc.TimeoutSeconds = override.TimeoutSeconds
+	}
+	if override.HealthyThresholdCount != nil {
+		hc.HealthyThresholdCount = override.HealthyThresholdCount
+	}
+	if override.UnhealthyThresholdCount != nil {
+		hc.UnhealthyThresholdCount = override.UnhealthyThresholdCount
+	}
+}
+
+func mergeAttribute(attrs []elbv2api.Attribute, attr elbv2api.Attribute) []elbv2api.Attribute {
+	for i := range attrs {
+		if attrs[i].Key == attr.Key {
+			attrs[i].Value = attr.Value
+			return attrs
+		}
+	}
+	return append(attrs, attr)
+}
+
+func mergeTag(tags []elbv2api.Tag, tag elbv2api.Tag) []elbv2api.Tag {
+	for i := range tags {
+		if tags[i].Key == tag.Key {
+			tags[i].Value = tag.Value
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
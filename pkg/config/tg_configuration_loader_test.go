@@ -0,0 +1,221 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultTargetGroupConfigurationLoader_Load(t *testing.T) {
+	targetTypeIP := elbv2api.TargetTypeIP
+	targetTypeInstance := elbv2api.TargetTypeInstance
+	hcPort := intstr.FromString("health")
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+	}
+	httpPort := corev1.ServicePort{Name: "http", Port: 80}
+	httpsPort := corev1.ServicePort{Name: "https", Port: 443}
+	tests := []struct {
+		name      string
+		tgConfigs []*elbv2api.TargetGroupConfiguration
+		port      corev1.ServicePort
+		want      *elbv2api.TargetGroupProps
+		wantErr   error
+	}{
+		{
+			name: "no TargetGroupConfiguration for Service",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "other-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef:           elbv2api.TargetGroupConfigurationServiceReference{Name: "other-svc"},
+						DefaultConfiguration: elbv2api.TargetGroupProps{TargetType: &targetTypeIP},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "awesome-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef:           elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+						DefaultConfiguration: elbv2api.TargetGroupProps{TargetType: &targetTypeIP},
+					},
+				},
+			},
+			port: httpPort,
+			want: nil,
+		},
+		{
+			name: "default configuration only",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+						DefaultConfiguration: elbv2api.TargetGroupProps{
+							TargetType: &targetTypeIP,
+							Tags:       []elbv2api.Tag{{Key: "team", Value: "awesome"}},
+						},
+						PortConfigurations: []elbv2api.TargetGroupPortConfiguration{
+							{
+								Port:             intstr.FromInt(443),
+								TargetGroupProps: elbv2api.TargetGroupProps{TargetType: &targetTypeInstance},
+							},
+						},
+					},
+				},
+			},
+			port: httpPort,
+			want: &elbv2api.TargetGroupProps{
+				TargetType: &targetTypeIP,
+				Tags:       []elbv2api.Tag{{Key: "team", Value: "awesome"}},
+			},
+		},
+		{
+			name: "port configuration matched by number takes precedence",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+						DefaultConfiguration: elbv2api.TargetGroupProps{
+							TargetType: &targetTypeIP,
+							HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+								Path:            awssdk.String("/healthz"),
+								IntervalSeconds: awssdk.Int64(10),
+							},
+							TargetGroupAttributes: []elbv2api.Attribute{
+								{Key: "deregistration_delay.timeout_seconds", Value: "60"},
+								{Key: "stickiness.enabled", Value: "true"},
+							},
+							Tags: []elbv2api.Tag{{Key: "team", Value: "awesome"}},
+						},
+						PortConfigurations: []elbv2api.TargetGroupPortConfiguration{
+							{
+								Port: intstr.FromInt(443),
+								TargetGroupProps: elbv2api.TargetGroupProps{
+									TargetType: &targetTypeInstance,
+									HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+										Port: &hcPort,
+										Path: awssdk.String("/ping"),
+									},
+									TargetGroupAttributes: []elbv2api.Attribute{
+										{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+									},
+									Tags: []elbv2api.Tag{{Key: "port", Value: "https"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			port: httpsPort,
+			want: &elbv2api.TargetGroupProps{
+				TargetType: &targetTypeInstance,
+				HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+					Port:            &hcPort,
+					Path:            awssdk.String("/ping"),
+					IntervalSeconds: awssdk.Int64(10),
+				},
+				TargetGroupAttributes: []elbv2api.Attribute{
+					{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+					{Key: "stickiness.enabled", Value: "true"},
+				},
+				Tags: []elbv2api.Tag{{Key: "team", Value: "awesome"}, {Key: "port", Value: "https"}},
+			},
+		},
+		{
+			name: "port configuration matched by name takes precedence",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+						PortConfigurations: []elbv2api.TargetGroupPortConfiguration{
+							{
+								Port: intstr.FromString("http"),
+								TargetGroupProps: elbv2api.TargetGroupProps{
+									HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+										Path: awssdk.String("/ping"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			port: httpPort,
+			want: &elbv2api.TargetGroupProps{
+				HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+					Path: awssdk.String("/ping"),
+				},
+			},
+		},
+		{
+			name: "conflicting TargetGroupConfigurations",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "config-a"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "config-b"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+					},
+				},
+			},
+			port:    httpPort,
+			wantErr: errors.New("conflicting TargetGroupConfigurations for Service awesome-ns/awesome-svc: config-a, config-b"),
+		},
+		{
+			name: "duplicate port configurations",
+			tgConfigs: []*elbv2api.TargetGroupConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+					Spec: elbv2api.TargetGroupConfigurationSpec{
+						ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "awesome-svc"},
+						PortConfigurations: []elbv2api.TargetGroupPortConfiguration{
+							{Port: intstr.FromInt(80)},
+							{Port: intstr.FromString("http")},
+						},
+					},
+				},
+			},
+			port:    httpPort,
+			wantErr: errors.New("duplicate portConfigurations for port 80 in TargetGroupConfiguration awesome-ns/awesome-config"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, tgConfig := range tt.tgConfigs {
+				assert.NoError(t, k8sClient.Create(context.Background(), tgConfig.DeepCopy()))
+			}
+			loader := NewDefaultTargetGroupConfigurationLoader(k8sClient)
+			got, err := loader.Load(context.Background(), svc, tt.port)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
@@ -80,6 +82,109 @@ func (s *securityGroupSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+// Plan computes the changes to SecurityGroups of stack without applying them.
+// resources of stack are resolved with the live SecurityGroups or placeholders, so that planning of dependent resources can proceed.
+func (s *securityGroupSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resSGs []*ec2model.SecurityGroup
+	s.stack.ListResources(&resSGs)
+	sdkSGs, err := s.findSDKSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKSGs, unmatchedResSGs, unmatchedSDKSGs, err := matchResAndSDKSecurityGroups(resSGs, sdkSGs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, resSG := range unmatchedResSGs {
+		resSG.SetStatus(ec2model.SecurityGroupStatus{GroupID: plan.PlaceholderIdentifier(resSG)})
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resSG.Type(),
+			ResourceID:   resSG.ID(),
+		})
+	}
+	for _, resAndSDKSG := range matchedResAndSDKSGs {
+		resAndSDKSG.resSG.SetStatus(ec2model.SecurityGroupStatus{GroupID: resAndSDKSG.sdkSG.SecurityGroupID})
+		changes, err := s.computeSecurityGroupChanges(resAndSDKSG.resSG, resAndSDKSG.sdkSG)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndSDKSG.resSG.Type(),
+			ResourceID:   resAndSDKSG.resSG.ID(),
+			Identifier:   resAndSDKSG.sdkSG.SecurityGroupID,
+			Changes:      changes,
+		})
+	}
+	for _, sdkSG := range unmatchedSDKSGs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "AWS::EC2::SecurityGroup",
+			Identifier:   sdkSG.SecurityGroupID,
+		})
+	}
+	return actions, nil
+}
+
+// computeSecurityGroupChanges computes the settings of sdkSG that need to be updated to fulfill resSG.
+func (s *securityGroupSynthesizer) computeSecurityGroupChanges(resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) ([]string, error) {
+	var changes []string
+	desiredTags := s.trackingProvider.ResourceTags(resSG.Stack(), resSG, resSG.Spec.Tags)
+	if tagsToUpdate, _ := algorithm.DiffStringMap(desiredTags, sdkSG.Tags); len(tagsToUpdate) != 0 {
+		changes = append(changes, "tags")
+	}
+	desiredPermissions, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	if err != nil {
+		return nil, err
+	}
+	desiredPermissionHashCodes := sets.NewString()
+	for i := range desiredPermissions {
+		desiredPermissionHashCodes.Insert(desiredPermissions[i].HashCode())
+	}
+	currentPermissionHashCodes := sets.NewString()
+	for i := range sdkSG.Ingress {
+		currentPermissionHashCodes.Insert(sdkSG.Ingress[i].HashCode())
+	}
+	if !desiredPermissionHashCodes.Equal(currentPermissionHashCodes) {
+		changes = append(changes, "ingress")
+	}
+	return changes, nil
+}
+
+// Release releases SecurityGroups of stack.
+// with untrack, the tracking tags are removed from them so that they're no longer managed for stack.
+func (s *securityGroupSynthesizer) Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error) {
+	sdkSGs, err := s.findSDKSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var releasedResources []tracking.ReleasedResource
+	for _, sdkSG := range sdkSGs {
+		if untrack {
+			untrackedTags := tracking.UntrackedTags(s.trackingProvider, s.stack, sdkSG.Tags)
+			if len(untrackedTags) != len(sdkSG.Tags) {
+				if err := s.taggingManager.ReconcileTags(ctx, sdkSG.SecurityGroupID, untrackedTags, WithCurrentTags(sdkSG.Tags)); err != nil {
+					return nil, err
+				}
+			}
+			s.logger.Info("untracked securityGroup",
+				"stackID", s.stack.StackID().String(),
+				"securityGroupID", sdkSG.SecurityGroupID)
+		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: "AWS::EC2::SecurityGroup",
+			Identifier:   sdkSG.SecurityGroupID,
+		})
+	}
+	return releasedResources, nil
+}
+
 // findSDKSecurityGroups will find all AWS SecurityGroups created for stack.
 func (s *securityGroupSynthesizer) findSDKSecurityGroups(ctx context.Context) ([]networking.SecurityGroupInfo, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
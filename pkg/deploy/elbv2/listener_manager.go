@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -27,6 +28,15 @@ type ListenerManager interface {
 	Update(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) (elbv2model.ListenerStatus, error)
 
 	Delete(ctx context.Context, sdkLS ListenerWithTags) error
+
+	// PlanCreate computes the calls that Create would make without making them.
+	PlanCreate(ctx context.Context, resLS *elbv2model.Listener) ([]plan.Call, error)
+
+	// PlanUpdate computes the calls that Update would make without making them.
+	PlanUpdate(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) ([]plan.Call, error)
+
+	// PlanDelete computes the calls that Delete would make without making them.
+	PlanDelete(ctx context.Context, sdkLS ListenerWithTags) []plan.Call
 }
 
 func NewDefaultListenerManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
@@ -59,15 +69,10 @@ type defaultListenerManager struct {
 }
 
 func (m *defaultListenerManager) Create(ctx context.Context, resLS *elbv2model.Listener) (elbv2model.ListenerStatus, error) {
-	req, err := buildSDKCreateListenerInput(resLS.Spec, m.featureGates)
+	req, lsTags, err := m.buildSDKCreateListenerInputWithTags(resLS)
 	if err != nil {
 		return elbv2model.ListenerStatus{}, err
 	}
-	var lsTags map[string]string
-	if m.featureGates.Enabled(config.ListenerRulesTagging) {
-		lsTags = m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
-	}
-	req.Tags = convertTagsToSDKTags(lsTags)
 
 	m.logger.Info("creating listener",
 		"stackID", resLS.Stack().StackID(),
@@ -109,9 +114,7 @@ func (m *defaultListenerManager) Update(ctx context.Context, resLS *elbv2model.L
 }
 
 func (m *defaultListenerManager) Delete(ctx context.Context, sdkLS ListenerWithTags) error {
-	req := &elbv2sdk.DeleteListenerInput{
-		ListenerArn: sdkLS.Listener.ListenerArn,
-	}
+	req := buildSDKDeleteListenerInput(sdkLS)
 	m.logger.Info("deleting listener",
 		"arn", awssdk.StringValue(req.ListenerArn))
 	if _, err := m.elbv2Client.DeleteListenerWithContext(ctx, req); err != nil {
@@ -122,25 +125,70 @@ func (m *defaultListenerManager) Delete(ctx context.Context, sdkLS ListenerWithT
 	return nil
 }
 
+func (m *defaultListenerManager) PlanCreate(ctx context.Context, resLS *elbv2model.Listener) ([]plan.Call, error) {
+	req, _, err := m.buildSDKCreateListenerInputWithTags(resLS)
+	if err != nil {
+		return nil, err
+	}
+	calls := []plan.Call{{API: "CreateListener", Input: req}}
+	sdkLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String(plan.PlaceholderIdentifier(resLS)),
+		},
+	}
+	certCalls, err := m.planSDKListenerWithExtraCertificates(ctx, resLS, sdkLS, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(calls, certCalls...), nil
+}
+
+func (m *defaultListenerManager) PlanUpdate(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) ([]plan.Call, error) {
+	var calls []plan.Call
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredLSTags, reconcileTagsOpts := m.buildSDKListenerTagsReconciliation(resLS, sdkLS)
+		calls = append(calls, planReconcileTags(awssdk.StringValue(sdkLS.Listener.ListenerArn), desiredLSTags, reconcileTagsOpts...)...)
+	}
+	req, err := m.buildSDKModifyListenerInputForDriftedSettings(resLS, sdkLS)
+	if err != nil {
+		return nil, err
+	}
+	if req != nil {
+		calls = append(calls, plan.Call{API: "ModifyListener", Input: req})
+	}
+	certCalls, err := m.planSDKListenerWithExtraCertificates(ctx, resLS, sdkLS, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(calls, certCalls...), nil
+}
+
+func (m *defaultListenerManager) PlanDelete(_ context.Context, sdkLS ListenerWithTags) []plan.Call {
+	return []plan.Call{{API: "DeleteListener", Input: buildSDKDeleteListenerInput(sdkLS)}}
+}
+
 func (m *defaultListenerManager) updateSDKListenerWithTags(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error {
+	desiredLSTags, reconcileTagsOpts := m.buildSDKListenerTagsReconciliation(resLS, sdkLS)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLS.Listener.ListenerArn), desiredLSTags, reconcileTagsOpts...)
+}
+
+// buildSDKListenerTagsReconciliation builds the desired tags of sdkLS along with the options to reconcile them.
+func (m *defaultListenerManager) buildSDKListenerTagsReconciliation(resLS *elbv2model.Listener, sdkLS ListenerWithTags) (map[string]string, []ReconcileTagsOption) {
 	desiredLSTags := m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
-	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLS.Listener.ListenerArn), desiredLSTags,
+	return desiredLSTags, []ReconcileTagsOption{
 		WithCurrentTags(sdkLS.Tags),
-		WithIgnoredTagKeys(m.externalManagedTags))
+		WithIgnoredTagKeys(m.externalManagedTags),
+	}
 }
 
 func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error {
-	desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions, m.featureGates)
+	req, err := m.buildSDKModifyListenerInputForDriftedSettings(resLS, sdkLS)
 	if err != nil {
 		return err
 	}
-	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	desiredDefaultMutualAuthentication := buildSDKMutualAuthenticationConfig(resLS.Spec.MutualAuthentication)
-	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication) {
+	if req == nil {
 		return nil
 	}
-	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
-	req.ListenerArn = sdkLS.Listener.ListenerArn
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
 		"resourceID", resLS.ID(),
@@ -165,29 +213,13 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 		return nil
 	}
 
-	desiredExtraCertARNs := sets.NewString()
-	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
-	for _, cert := range desiredExtraCerts {
-		desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
-	}
-	currentExtraCertARNs := sets.NewString()
-	if !isNewSDKListener {
-		certARNs, err := m.fetchSDKListenerExtraCertificateARNs(ctx, sdkLS)
-		if err != nil {
-			return err
-		}
-		currentExtraCertARNs.Insert(certARNs...)
+	certARNsToRemove, certARNsToAdd, err := m.computeSDKListenerExtraCertificateChanges(ctx, resLS, sdkLS, isNewSDKListener)
+	if err != nil {
+		return err
 	}
 
-	for _, certARN := range currentExtraCertARNs.Difference(desiredExtraCertARNs).List() {
-		req := &elbv2sdk.RemoveListenerCertificatesInput{
-			ListenerArn: sdkLS.Listener.ListenerArn,
-			Certificates: []*elbv2sdk.Certificate{
-				{
-					CertificateArn: awssdk.String(certARN),
-				},
-			},
-		}
+	for _, certARN := range certARNsToRemove {
+		req := buildSDKRemoveListenerCertificatesInput(sdkLS, certARN)
 		m.logger.Info("removing certificate from listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
@@ -203,15 +235,8 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 			"certificateARN", certARN)
 	}
 
-	for _, certARN := range desiredExtraCertARNs.Difference(currentExtraCertARNs).List() {
-		req := &elbv2sdk.AddListenerCertificatesInput{
-			ListenerArn: sdkLS.Listener.ListenerArn,
-			Certificates: []*elbv2sdk.Certificate{
-				{
-					CertificateArn: awssdk.String(certARN),
-				},
-			},
-		}
+	for _, certARN := range certARNsToAdd {
+		req := buildSDKAddListenerCertificatesInput(sdkLS, certARN)
 		m.logger.Info("adding certificate to listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
@@ -230,6 +255,103 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 	return nil
 }
 
+// planSDKListenerWithExtraCertificates computes the calls that updateSDKListenerWithExtraCertificates would make without making them.
+func (m *defaultListenerManager) planSDKListenerWithExtraCertificates(ctx context.Context, resLS *elbv2model.Listener,
+	sdkLS ListenerWithTags, isNewSDKListener bool) ([]plan.Call, error) {
+	if resLS.Spec.SSLPolicy == nil && sdkLS.Listener.SslPolicy == nil {
+		return nil, nil
+	}
+	certARNsToRemove, certARNsToAdd, err := m.computeSDKListenerExtraCertificateChanges(ctx, resLS, sdkLS, isNewSDKListener)
+	if err != nil {
+		return nil, err
+	}
+	var calls []plan.Call
+	for _, certARN := range certARNsToRemove {
+		calls = append(calls, plan.Call{API: "RemoveListenerCertificates", Input: buildSDKRemoveListenerCertificatesInput(sdkLS, certARN)})
+	}
+	for _, certARN := range certARNsToAdd {
+		calls = append(calls, plan.Call{API: "AddListenerCertificates", Input: buildSDKAddListenerCertificatesInput(sdkLS, certARN)})
+	}
+	return calls, nil
+}
+
+// computeSDKListenerExtraCertificateChanges computes the extra certificates to remove from and to add to sdkLS to fulfill resLS.
+func (m *defaultListenerManager) computeSDKListenerExtraCertificateChanges(ctx context.Context, resLS *elbv2model.Listener,
+	sdkLS ListenerWithTags, isNewSDKListener bool) ([]string, []string, error) {
+	desiredExtraCertARNs := sets.NewString()
+	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
+	for _, cert := range desiredExtraCerts {
+		desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
+	}
+	currentExtraCertARNs := sets.NewString()
+	if !isNewSDKListener {
+		certARNs, err := m.fetchSDKListenerExtraCertificateARNs(ctx, sdkLS)
+		if err != nil {
+			return nil, nil, err
+		}
+		currentExtraCertARNs.Insert(certARNs...)
+	}
+	return currentExtraCertARNs.Difference(desiredExtraCertARNs).List(), desiredExtraCertARNs.Difference(currentExtraCertARNs).List(), nil
+}
+
+// buildSDKModifyListenerInputForDriftedSettings builds the request to update the settings of sdkLS to fulfill resLS.
+// nil request is returned if the settings aren't drifted.
+func (m *defaultListenerManager) buildSDKModifyListenerInputForDriftedSettings(resLS *elbv2model.Listener, sdkLS ListenerWithTags) (*elbv2sdk.ModifyListenerInput, error) {
+	desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions, m.featureGates)
+	if err != nil {
+		return nil, err
+	}
+	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
+	desiredDefaultMutualAuthentication := buildSDKMutualAuthenticationConfig(resLS.Spec.MutualAuthentication)
+	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredDefaultMutualAuthentication) {
+		return nil, nil
+	}
+	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
+	req.ListenerArn = sdkLS.Listener.ListenerArn
+	return req, nil
+}
+
+func (m *defaultListenerManager) buildSDKCreateListenerInputWithTags(resLS *elbv2model.Listener) (*elbv2sdk.CreateListenerInput, map[string]string, error) {
+	req, err := buildSDKCreateListenerInput(resLS.Spec, m.featureGates)
+	if err != nil {
+		return nil, nil, err
+	}
+	var lsTags map[string]string
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		lsTags = m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
+	}
+	req.Tags = convertTagsToSDKTags(lsTags)
+	return req, lsTags, nil
+}
+
+func buildSDKAddListenerCertificatesInput(sdkLS ListenerWithTags, certARN string) *elbv2sdk.AddListenerCertificatesInput {
+	return &elbv2sdk.AddListenerCertificatesInput{
+		ListenerArn: sdkLS.Listener.ListenerArn,
+		Certificates: []*elbv2sdk.Certificate{
+			{
+				CertificateArn: awssdk.String(certARN),
+			},
+		},
+	}
+}
+
+func buildSDKRemoveListenerCertificatesInput(sdkLS ListenerWithTags, certARN string) *elbv2sdk.RemoveListenerCertificatesInput {
+	return &elbv2sdk.RemoveListenerCertificatesInput{
+		ListenerArn: sdkLS.Listener.ListenerArn,
+		Certificates: []*elbv2sdk.Certificate{
+			{
+				CertificateArn: awssdk.String(certARN),
+			},
+		},
+	}
+}
+
+func buildSDKDeleteListenerInput(sdkLS ListenerWithTags) *elbv2sdk.DeleteListenerInput {
+	return &elbv2sdk.DeleteListenerInput{
+		ListenerArn: sdkLS.Listener.ListenerArn,
+	}
+}
+
 func (m *defaultListenerManager) fetchSDKListenerExtraCertificateARNs(ctx context.Context, sdkLS ListenerWithTags) ([]string, error) {
 	req := &elbv2sdk.DescribeListenerCertificatesInput{
 		ListenerArn: sdkLS.Listener.ListenerArn,
@@ -1,11 +1,20 @@
 package elbv2
 
 import (
+	"context"
+	"testing"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
-	"testing"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func Test_isSDKListenerSettingsDrifted(t *testing.T) {
@@ -271,3 +280,67 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultListenerManager_PlanUpdate(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resLS := elbv2model.NewListener(stack, "443", elbv2model.ListenerSpec{
+		LoadBalancerARN: coremodel.LiteralStringToken("lb-arn"),
+		Port:            443,
+		Protocol:        elbv2model.ProtocolHTTPS,
+		DefaultActions: []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeFixedResponse,
+				FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+					StatusCode: "404",
+				},
+			},
+		},
+		Certificates: []elbv2model.Certificate{
+			{CertificateARN: awssdk.String("cert-arn-1")},
+			{CertificateARN: awssdk.String("cert-arn-2")},
+		},
+		SSLPolicy: awssdk.String("ELBSecurityPolicy-2016-08"),
+	})
+	sdkLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String("ls-arn"),
+			Port:        awssdk.Int64(443),
+			Protocol:    awssdk.String("HTTPS"),
+			DefaultActions: []*elbv2sdk.Action{
+				{
+					Type: awssdk.String("fixed-response"),
+					FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+						StatusCode: awssdk.String("404"),
+					},
+				},
+			},
+			Certificates: []*elbv2sdk.Certificate{
+				{CertificateArn: awssdk.String("cert-arn-1")},
+			},
+			SslPolicy: awssdk.String("ELBSecurityPolicy-FS-1-2-Res-2019-08"),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeListenerCertificatesAsList(gomock.Any(), &elbv2sdk.DescribeListenerCertificatesInput{
+		ListenerArn: awssdk.String("ls-arn"),
+	}).Return([]*elbv2sdk.Certificate{
+		{CertificateArn: awssdk.String("cert-arn-1"), IsDefault: awssdk.Bool(true)},
+		{CertificateArn: awssdk.String("cert-arn-3"), IsDefault: awssdk.Bool(false)},
+	}, nil)
+	m := &defaultListenerManager{
+		elbv2Client:  elbv2Client,
+		featureGates: config.NewFeatureGates(),
+		logger:       logr.New(&log.NullLogSink{}),
+	}
+	m.featureGates.Disable(config.ListenerRulesTagging)
+
+	got, err := m.PlanUpdate(context.Background(), resLS, sdkLS)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ModifyListener", "RemoveListenerCertificates", "AddListenerCertificates"}, plan.CallAPIs(got))
+	assert.Equal(t, awssdk.String("ELBSecurityPolicy-2016-08"), got[0].Input.(*elbv2sdk.ModifyListenerInput).SslPolicy)
+	assert.Equal(t, buildSDKRemoveListenerCertificatesInput(sdkLS, "cert-arn-3"), got[1].Input)
+	assert.Equal(t, buildSDKAddListenerCertificatesInput(sdkLS, "cert-arn-2"), got[2].Input)
+}
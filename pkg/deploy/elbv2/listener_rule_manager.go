@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
@@ -18,11 +19,14 @@ import (
 
 // ListenerRuleManager is responsible for create/update/delete ListenerRule resources.
 type ListenerRuleManager interface {
-	Create(ctx context.Context, resLR *elbv2model.ListenerRule) (elbv2model.ListenerRuleStatus, error)
+	Create(ctx context.Context, resLR *elbv2model.ListenerRule, priority int64) (elbv2model.ListenerRuleStatus, error)
 
 	Update(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error)
 
 	Delete(ctx context.Context, sdkLR ListenerRuleWithTags) error
+
+	// SetPriorities changes the priorities of existing rules on a listener at once, so that rules can swap priorities.
+	SetPriorities(ctx context.Context, priorityByRuleARN map[string]int64) error
 }
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
@@ -53,11 +57,12 @@ type defaultListenerRuleManager struct {
 	waitLSExistenceTimeout      time.Duration
 }
 
-func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2model.ListenerRule) (elbv2model.ListenerRuleStatus, error) {
+func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2model.ListenerRule, priority int64) (elbv2model.ListenerRuleStatus, error) {
 	req, err := buildSDKCreateListenerRuleInput(resLR.Spec, m.featureGates)
 	if err != nil {
 		return elbv2model.ListenerRuleStatus{}, err
 	}
+	req.Priority = awssdk.Int64(priority)
 	var ruleTags map[string]string
 	if m.featureGates.Enabled(config.ListenerRulesTagging) {
 		ruleTags = m.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
@@ -66,7 +71,8 @@ func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2mod
 
 	m.logger.Info("creating listener rule",
 		"stackID", resLR.Stack().StackID(),
-		"resourceID", resLR.ID())
+		"resourceID", resLR.ID(),
+		"priority", priority)
 	var sdkLR ListenerRuleWithTags
 	if err := runtime.RetryImmediateOnError(m.waitLSExistencePollInterval, m.waitLSExistenceTimeout, isListenerNotFoundError, func() error {
 		resp, err := m.elbv2Client.CreateRuleWithContext(ctx, req)
@@ -115,6 +121,30 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 	return nil
 }
 
+func (m *defaultListenerRuleManager) SetPriorities(ctx context.Context, priorityByRuleARN map[string]int64) error {
+	if len(priorityByRuleARN) == 0 {
+		return nil
+	}
+	ruleARNs := sets.StringKeySet(priorityByRuleARN).List()
+	req := &elbv2sdk.SetRulePrioritiesInput{
+		RulePriorities: make([]*elbv2sdk.RulePriorityPair, 0, len(ruleARNs)),
+	}
+	for _, ruleARN := range ruleARNs {
+		req.RulePriorities = append(req.RulePriorities, &elbv2sdk.RulePriorityPair{
+			RuleArn:  awssdk.String(ruleARN),
+			Priority: awssdk.Int64(priorityByRuleARN[ruleARN]),
+		})
+	}
+	m.logger.Info("setting listener rule priorities",
+		"priorities", priorityByRuleARN)
+	if _, err := m.elbv2Client.SetRulePrioritiesWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set listener rule priorities")
+	}
+	m.logger.Info("set listener rule priorities",
+		"priorities", priorityByRuleARN)
+	return nil
+}
+
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithSettings(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredActions, err := buildSDKActions(resLR.Spec.Actions, m.featureGates)
 	if err != nil {
@@ -0,0 +1,131 @@
+package elbv2
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxListenerRulePriority is the maximum priority of a listener rule allowed by ELBV2.
+	maxListenerRulePriority int64 = 50000
+	// listenerRulePriorityStep is the gap between priorities of consecutive rules when allocating new priorities,
+	// so that rules can be inserted later without moving existing ones.
+	listenerRulePriorityStep int64 = 100
+)
+
+// allocateListenerRulePriorities allocates the priorities of rules ordered by their desired precedence.
+// currentPriorities contains the current priority of each rule on listener, or zero for rules that don't exist yet.
+// Rules keep their current priority as long as their relative order is unchanged, the others get priorities within
+// the gaps between kept rules. The allocated priorities are strictly increasing.
+func allocateListenerRulePriorities(currentPriorities []int64) ([]int64, error) {
+	if int64(len(currentPriorities)) > maxListenerRulePriority {
+		return nil, errors.Errorf("too many listener rules: %v", len(currentPriorities))
+	}
+	kept := computeKeptListenerRules(currentPriorities)
+	for {
+		conflicted := false
+		for _, gap := range buildListenerRuleGaps(kept) {
+			lowerPriority, upperPriority := listenerRuleGapBounds(currentPriorities, gap)
+			if upperPriority-lowerPriority-1 >= int64(gap.upper-gap.lower-1) {
+				continue
+			}
+			// there is no room for rules within this gap, so we move the kept rule bounding it and retry.
+			if gap.upper < len(currentPriorities) {
+				kept[gap.upper] = false
+			} else {
+				kept[gap.lower] = false
+			}
+			conflicted = true
+			break
+		}
+		if !conflicted {
+			break
+		}
+	}
+
+	priorities := make([]int64, len(currentPriorities))
+	for _, gap := range buildListenerRuleGaps(kept) {
+		if gap.lower >= 0 {
+			priorities[gap.lower] = currentPriorities[gap.lower]
+		}
+		count := int64(gap.upper - gap.lower - 1)
+		if count == 0 {
+			continue
+		}
+		lowerPriority, upperPriority := listenerRuleGapBounds(currentPriorities, gap)
+		var step int64
+		if gap.upper < len(currentPriorities) {
+			step = (upperPriority - lowerPriority) / (count + 1)
+		} else {
+			step = listenerRulePriorityStep
+			if lowerPriority+step*count > maxListenerRulePriority {
+				step = (maxListenerRulePriority - lowerPriority) / count
+			}
+		}
+		for i := int64(1); i <= count; i++ {
+			priorities[gap.lower+int(i)] = lowerPriority + step*i
+		}
+	}
+	return priorities, nil
+}
+
+// computeKeptListenerRules computes the rules that can keep their current priority,
+// which is the longest subsequence of existing rules with strictly increasing priorities.
+func computeKeptListenerRules(currentPriorities []int64) []bool {
+	lengths := make([]int, len(currentPriorities))
+	prevs := make([]int, len(currentPriorities))
+	last := -1
+	for i, priority := range currentPriorities {
+		prevs[i] = -1
+		if priority <= 0 || priority > maxListenerRulePriority {
+			continue
+		}
+		lengths[i] = 1
+		for j := 0; j < i; j++ {
+			if lengths[j] > 0 && currentPriorities[j] < priority && lengths[j]+1 > lengths[i] {
+				lengths[i] = lengths[j] + 1
+				prevs[i] = j
+			}
+		}
+		if last == -1 || lengths[i] > lengths[last] {
+			last = i
+		}
+	}
+	kept := make([]bool, len(currentPriorities))
+	for i := last; i >= 0; i = prevs[i] {
+		kept[i] = true
+	}
+	return kept
+}
+
+// listenerRuleGap is the gap between two kept rules, denoted by their indexes.
+// lower is -1 for the gap before first kept rule, and upper is the count of rules for the gap after last kept rule.
+type listenerRuleGap struct {
+	lower int
+	upper int
+}
+
+// buildListenerRuleGaps builds the gaps between kept rules in order.
+func buildListenerRuleGaps(kept []bool) []listenerRuleGap {
+	var gaps []listenerRuleGap
+	lower := -1
+	for i := range kept {
+		if kept[i] {
+			gaps = append(gaps, listenerRuleGap{lower: lower, upper: i})
+			lower = i
+		}
+	}
+	return append(gaps, listenerRuleGap{lower: lower, upper: len(kept)})
+}
+
+// listenerRuleGapBounds returns the priorities bounding gap exclusively.
+func listenerRuleGapBounds(currentPriorities []int64, gap listenerRuleGap) (int64, int64) {
+	lowerPriority := int64(0)
+	if gap.lower >= 0 {
+		lowerPriority = currentPriorities[gap.lower]
+	}
+	upperPriority := maxListenerRulePriority + 1
+	if gap.upper < len(currentPriorities) {
+		upperPriority = currentPriorities[gap.upper]
+	}
+	return lowerPriority, upperPriority
+}
@@ -0,0 +1,68 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_allocateListenerRulePriorities(t *testing.T) {
+	tests := []struct {
+		name              string
+		currentPriorities []int64
+		want              []int64
+	}{
+		{
+			name:              "new rules are allocated with gaps",
+			currentPriorities: []int64{0, 0, 0},
+			want:              []int64{100, 200, 300},
+		},
+		{
+			name:              "existing rules in order keep their priorities",
+			currentPriorities: []int64{1, 2, 3},
+			want:              []int64{1, 2, 3},
+		},
+		{
+			name:              "new rule inserted at top",
+			currentPriorities: []int64{0, 100, 200},
+			want:              []int64{50, 100, 200},
+		},
+		{
+			name:              "new rule inserted in between",
+			currentPriorities: []int64{100, 0, 0, 200},
+			want:              []int64{100, 133, 166, 200},
+		},
+		{
+			name:              "new rule appended",
+			currentPriorities: []int64{100, 200, 0},
+			want:              []int64{100, 200, 300},
+		},
+		{
+			name:              "new rule inserted without gap moves the following rules",
+			currentPriorities: []int64{1, 0, 2, 3},
+			want:              []int64{1, 101, 201, 301},
+		},
+		{
+			name:              "swapped rules only move one of them",
+			currentPriorities: []int64{200, 100},
+			want:              []int64{200, 300},
+		},
+		{
+			name:              "moved rule in the middle",
+			currentPriorities: []int64{100, 400, 200, 300},
+			want:              []int64{100, 150, 200, 300},
+		},
+		{
+			name:              "new rules near maximum priority are compressed",
+			currentPriorities: []int64{49990, 0, 0},
+			want:              []int64{49990, 49995, 50000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := allocateListenerRulePriorities(tt.currentPriorities)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,30 +4,45 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sort"
 	"strconv"
 )
 
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
-func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
-	lrManager ListenerRuleManager, logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
+// rules on up to maxConcurrency listeners are synthesized concurrently, while rules on the same listener are synthesized serially.
+func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	lrManager ListenerRuleManager, logger logr.Logger, featureGates config.FeatureGates, maxConcurrency int, stack core.Stack) *listenerRuleSynthesizer {
 	return &listenerRuleSynthesizer{
-		elbv2Client:    elbv2Client,
-		lrManager:      lrManager,
-		logger:         logger,
-		taggingManager: taggingManager,
-		stack:          stack,
+		elbv2Client:      elbv2Client,
+		trackingProvider: trackingProvider,
+		lrManager:        lrManager,
+		logger:           logger,
+		taggingManager:   taggingManager,
+		featureGates:     featureGates,
+		maxConcurrency:   maxConcurrency,
+		stack:            stack,
 	}
 }
 
 type listenerRuleSynthesizer struct {
-	elbv2Client    services.ELBV2
-	lrManager      ListenerRuleManager
-	logger         logr.Logger
-	taggingManager TaggingManager
+	elbv2Client      services.ELBV2
+	trackingProvider tracking.Provider
+	lrManager        ListenerRuleManager
+	logger           logr.Logger
+	taggingManager   TaggingManager
+	featureGates     config.FeatureGates
+	maxConcurrency   int
 
 	stack core.Stack
 }
@@ -42,17 +57,18 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 
 	var resLSs []*elbv2model.Listener
 	s.stack.ListResources(&resLSs)
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.maxConcurrency)
 	for _, resLS := range resLSs {
-		lsARN, err := resLS.ListenerARN().Resolve(ctx)
-		if err != nil {
-			return err
-		}
-		resLRs := resLRsByLSARN[lsARN]
-		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
-			return err
-		}
+		eg.Go(func() error {
+			lsARN, err := resLS.ListenerARN().Resolve(egCtx)
+			if err != nil {
+				return err
+			}
+			return s.synthesizeListenerRulesOnListener(egCtx, lsARN, resLRsByLSARN[lsARN])
+		})
 	}
-	return nil
+	return eg.Wait()
 }
 
 func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
@@ -66,29 +82,144 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		return err
 	}
 
-	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs, s.trackingProvider.ResourceIDTagKey())
+	priorityByResLR, err := allocatePrioritiesForResListenerRules(resLRs, matchedResAndSDKLRs)
+	if err != nil {
+		return err
+	}
 	for _, sdkLR := range unmatchedSDKLRs {
 		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
 			return err
 		}
 	}
-	for _, resLR := range unmatchedResLRs {
-		lrStatus, err := s.lrManager.Create(ctx, resLR)
+	// existing rules are updated before they're moved, so that they're tagged for stack as the IAM policy expects for SetRulePriorities.
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		lsStatus, err := s.lrManager.Update(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
 		if err != nil {
 			return err
 		}
-		resLR.SetStatus(lrStatus)
+		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
+	// existing rules are moved before creating new rules, so that new rules never conflict with priorities of moved ones.
+	priorityByRuleARN := make(map[string]int64)
 	for _, resAndSDKLR := range matchedResAndSDKLRs {
-		lsStatus, err := s.lrManager.Update(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
+		if priority := priorityByResLR[resAndSDKLR.resLR]; priority != sdkListenerRulePriority(resAndSDKLR.sdkLR) {
+			priorityByRuleARN[awssdk.StringValue(resAndSDKLR.sdkLR.ListenerRule.RuleArn)] = priority
+		}
+	}
+	if err := s.lrManager.SetPriorities(ctx, priorityByRuleARN); err != nil {
+		return err
+	}
+	for _, resLR := range unmatchedResLRs {
+		lrStatus, err := s.lrManager.Create(ctx, resLR, priorityByResLR[resLR])
 		if err != nil {
 			return err
 		}
-		resAndSDKLR.resLR.SetStatus(lsStatus)
+		resLR.SetStatus(lrStatus)
 	}
 	return nil
 }
 
+// Plan computes the changes to ListenerRules of stack without applying them.
+func (s *listenerRuleSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resLRs []*elbv2model.ListenerRule
+	s.stack.ListResources(&resLRs)
+	resLRsByLSARN, err := mapResListenerRuleByListenerARN(resLRs)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	var resLSs []*elbv2model.Listener
+	s.stack.ListResources(&resLSs)
+	for _, resLS := range resLSs {
+		lsARN, err := resLS.ListenerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lsActions, err := s.planListenerRulesOnListener(ctx, lsARN, resLRsByLSARN[lsARN])
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, lsActions...)
+	}
+	return actions, nil
+}
+
+func (s *listenerRuleSynthesizer) planListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) ([]plan.Action, error) {
+	var sdkLRs []ListenerRuleWithTags
+	if !plan.IsPlaceholderIdentifier(lsARN) {
+		var err error
+		sdkLRs, err = s.findSDKListenersRulesOnLS(ctx, lsARN)
+		if err != nil {
+			return nil, err
+		}
+	}
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs, s.trackingProvider.ResourceIDTagKey())
+	priorityByResLR, err := allocatePrioritiesForResListenerRules(resLRs, matchedResAndSDKLRs)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, sdkLR := range unmatchedSDKLRs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::ListenerRule",
+			Identifier:   awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+		})
+	}
+	for _, resLR := range unmatchedResLRs {
+		resLR.SetStatus(elbv2model.ListenerRuleStatus{RuleARN: plan.PlaceholderIdentifier(resLR)})
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resLR.Type(),
+			ResourceID:   resLR.ID(),
+		})
+	}
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		resAndSDKLR.resLR.SetStatus(buildResListenerRuleStatus(resAndSDKLR.sdkLR))
+		changes, err := s.computeListenerRuleChanges(resAndSDKLR.resLR, resAndSDKLR.sdkLR)
+		if err != nil {
+			return nil, err
+		}
+		if priorityByResLR[resAndSDKLR.resLR] != sdkListenerRulePriority(resAndSDKLR.sdkLR) {
+			changes = append(changes, "priority")
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndSDKLR.resLR.Type(),
+			ResourceID:   resAndSDKLR.resLR.ID(),
+			Identifier:   awssdk.StringValue(resAndSDKLR.sdkLR.ListenerRule.RuleArn),
+			Changes:      changes,
+		})
+	}
+	return actions, nil
+}
+
+// computeListenerRuleChanges computes the settings of sdkLR that need to be updated to fulfill resLR.
+func (s *listenerRuleSynthesizer) computeListenerRuleChanges(resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) ([]string, error) {
+	var changes []string
+	if s.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredTags := s.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
+		if tagsToUpdate, _ := algorithm.DiffStringMap(desiredTags, sdkLR.Tags); len(tagsToUpdate) != 0 {
+			changes = append(changes, "tags")
+		}
+	}
+	desiredActions, err := buildSDKActions(resLR.Spec.Actions, s.featureGates)
+	if err != nil {
+		return nil, err
+	}
+	desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+	if isSDKListenerRuleSettingsDrifted(resLR.Spec, sdkLR, desiredActions, desiredConditions) {
+		changes = append(changes, "settings")
+	}
+	return changes, nil
+}
+
 // findSDKListenersRulesOnLS returns the listenerRules configured on Listener.
 func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error) {
 	sdkLRs, err := s.taggingManager.ListListenerRules(ctx, lsARN)
@@ -110,48 +241,109 @@ type resAndSDKListenerRulePair struct {
 	sdkLR ListenerRuleWithTags
 }
 
-func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
+// matchResAndSDKListenerRules matches resLRs with sdkLRs by their resourceID tag,
+// the remaining ones are matched by identical conditions, e.g. rules created without tags.
+func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags, resourceIDTagKey string) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
 	var matchedResAndSDKLRs []resAndSDKListenerRulePair
 	var unmatchedResLRs []*elbv2model.ListenerRule
 	var unmatchedSDKLRs []ListenerRuleWithTags
 
-	resLRByPriority := mapResListenerRuleByPriority(resLRs)
-	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRs)
-	resLRPriorities := sets.Int64KeySet(resLRByPriority)
-	sdkLRPriorities := sets.Int64KeySet(sdkLRByPriority)
-	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
-		resLR := resLRByPriority[priority]
-		sdkLR := sdkLRByPriority[priority]
+	sortedSDKLRs := sortSDKListenerRulesByPriority(sdkLRs)
+	sdkLRsByResID := make(map[string][]ListenerRuleWithTags, len(sortedSDKLRs))
+	for _, sdkLR := range sortedSDKLRs {
+		if resID := sdkLR.Tags[resourceIDTagKey]; resID != "" {
+			sdkLRsByResID[resID] = append(sdkLRsByResID[resID], sdkLR)
+		}
+	}
+	matchedSDKLRARNs := sets.NewString()
+	var resLRsWithoutSDKLRByResID []*elbv2model.ListenerRule
+	for _, resLR := range sortResListenerRulesByPriority(resLRs) {
+		if candidates := sdkLRsByResID[resLR.ID()]; len(candidates) != 0 {
+			matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
+				resLR: resLR,
+				sdkLR: candidates[0],
+			})
+			sdkLRsByResID[resLR.ID()] = candidates[1:]
+			matchedSDKLRARNs.Insert(awssdk.StringValue(candidates[0].ListenerRule.RuleArn))
+			continue
+		}
+		resLRsWithoutSDKLRByResID = append(resLRsWithoutSDKLRByResID, resLR)
+	}
+
+	var remainingSDKLRs []ListenerRuleWithTags
+	for _, sdkLR := range sortedSDKLRs {
+		if !matchedSDKLRARNs.Has(awssdk.StringValue(sdkLR.ListenerRule.RuleArn)) {
+			remainingSDKLRs = append(remainingSDKLRs, sdkLR)
+		}
+	}
+	for _, resLR := range resLRsWithoutSDKLRByResID {
+		desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+		matchedIdx := -1
+		for idx, sdkLR := range remainingSDKLRs {
+			if cmp.Equal(desiredConditions, sdkLR.ListenerRule.Conditions, elbv2equality.CompareOptionForRuleConditions()) {
+				matchedIdx = idx
+				break
+			}
+		}
+		if matchedIdx == -1 {
+			unmatchedResLRs = append(unmatchedResLRs, resLR)
+			continue
+		}
 		matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
 			resLR: resLR,
-			sdkLR: sdkLR,
+			sdkLR: remainingSDKLRs[matchedIdx],
 		})
+		remainingSDKLRs = append(remainingSDKLRs[:matchedIdx], remainingSDKLRs[matchedIdx+1:]...)
 	}
-	for _, priority := range resLRPriorities.Difference(sdkLRPriorities).List() {
-		unmatchedResLRs = append(unmatchedResLRs, resLRByPriority[priority])
-	}
-	for _, priority := range sdkLRPriorities.Difference(resLRPriorities).List() {
-		unmatchedSDKLRs = append(unmatchedSDKLRs, sdkLRByPriority[priority])
-	}
+	unmatchedSDKLRs = remainingSDKLRs
 
 	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs
 }
 
-func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*elbv2model.ListenerRule {
-	resLRByPriority := make(map[int64]*elbv2model.ListenerRule, len(resLRs))
-	for _, resLR := range resLRs {
-		resLRByPriority[resLR.Spec.Priority] = resLR
+// allocatePrioritiesForResListenerRules allocates the priorities of resLRs on listener,
+// matched rules keep their current priority unless they need to move to fulfill the precedence of resLRs.
+func allocatePrioritiesForResListenerRules(resLRs []*elbv2model.ListenerRule, matchedResAndSDKLRs []resAndSDKListenerRulePair) (map[*elbv2model.ListenerRule]int64, error) {
+	currentPriorityByResLR := make(map[*elbv2model.ListenerRule]int64, len(matchedResAndSDKLRs))
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		currentPriorityByResLR[resAndSDKLR.resLR] = sdkListenerRulePriority(resAndSDKLR.sdkLR)
+	}
+	sortedResLRs := sortResListenerRulesByPriority(resLRs)
+	currentPriorities := make([]int64, 0, len(sortedResLRs))
+	for _, resLR := range sortedResLRs {
+		currentPriorities = append(currentPriorities, currentPriorityByResLR[resLR])
+	}
+	priorities, err := allocateListenerRulePriorities(currentPriorities)
+	if err != nil {
+		return nil, err
 	}
-	return resLRByPriority
+	priorityByResLR := make(map[*elbv2model.ListenerRule]int64, len(sortedResLRs))
+	for idx, resLR := range sortedResLRs {
+		priorityByResLR[resLR] = priorities[idx]
+	}
+	return priorityByResLR, nil
 }
 
-func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
-	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
-	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
-		sdkLRByPriority[priority] = sdkLR
-	}
-	return sdkLRByPriority
+// sortResListenerRulesByPriority returns resLRs sorted by their desired precedence.
+func sortResListenerRulesByPriority(resLRs []*elbv2model.ListenerRule) []*elbv2model.ListenerRule {
+	sortedResLRs := append([]*elbv2model.ListenerRule(nil), resLRs...)
+	sort.SliceStable(sortedResLRs, func(i, j int) bool {
+		return sortedResLRs[i].Spec.Priority < sortedResLRs[j].Spec.Priority
+	})
+	return sortedResLRs
+}
+
+// sortSDKListenerRulesByPriority returns sdkLRs sorted by their current priority.
+func sortSDKListenerRulesByPriority(sdkLRs []ListenerRuleWithTags) []ListenerRuleWithTags {
+	sortedSDKLRs := append([]ListenerRuleWithTags(nil), sdkLRs...)
+	sort.SliceStable(sortedSDKLRs, func(i, j int) bool {
+		return sdkListenerRulePriority(sortedSDKLRs[i]) < sdkListenerRulePriority(sortedSDKLRs[j])
+	})
+	return sortedSDKLRs
+}
+
+func sdkListenerRulePriority(sdkLR ListenerRuleWithTags) int64 {
+	priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+	return priority
 }
 
 func mapResListenerRuleByListenerARN(resLRs []*elbv2model.ListenerRule) (map[string][]*elbv2model.ListenerRule, error) {
@@ -0,0 +1,69 @@
+package elbv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_matchResAndSDKListenerRules(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	newResLR := func(id string, priority int64, path string) *elbv2model.ListenerRule {
+		return elbv2model.NewListenerRule(stack, id, elbv2model.ListenerRuleSpec{
+			ListenerARN: coremodel.LiteralStringToken("lsARN"),
+			Priority:    priority,
+			Conditions: []elbv2model.RuleCondition{
+				{
+					Field:             elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{path}},
+				},
+			},
+		})
+	}
+	newSDKLR := func(arn string, priority string, path string, tags map[string]string) ListenerRuleWithTags {
+		return ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				RuleArn:  awssdk.String(arn),
+				Priority: awssdk.String(priority),
+				Conditions: []*elbv2sdk.RuleCondition{
+					{
+						Field:             awssdk.String("path-pattern"),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{Values: awssdk.StringSlice([]string{path})},
+					},
+				},
+			},
+			Tags: tags,
+		}
+	}
+	resLR1 := newResLR("80:rule-1", 1, "/svc-1")
+	resLR2 := newResLR("80:rule-2", 2, "/svc-2")
+	resLR3 := newResLR("80:rule-3", 3, "/svc-3")
+	sdkLR1 := newSDKLR("arn-1", "200", "/svc-1", map[string]string{"ingress.k8s.aws/resource": "80:rule-1"})
+	sdkLR2 := newSDKLR("arn-2", "300", "/svc-2-old", map[string]string{"ingress.k8s.aws/resource": "80:rule-2"})
+	sdkLR3 := newSDKLR("arn-3", "1", "/svc-3", nil)
+	sdkLR4 := newSDKLR("arn-4", "400", "/svc-4", map[string]string{"ingress.k8s.aws/resource": "80:rule-4"})
+
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(
+		[]*elbv2model.ListenerRule{resLR3, resLR1, resLR2},
+		[]ListenerRuleWithTags{sdkLR4, sdkLR3, sdkLR2, sdkLR1},
+		"ingress.k8s.aws/resource")
+	assert.Equal(t, []resAndSDKListenerRulePair{
+		{resLR: resLR1, sdkLR: sdkLR1},
+		{resLR: resLR2, sdkLR: sdkLR2},
+		{resLR: resLR3, sdkLR: sdkLR3},
+	}, matchedResAndSDKLRs)
+	assert.Empty(t, unmatchedResLRs)
+	assert.Equal(t, []ListenerRuleWithTags{sdkLR4}, unmatchedSDKLRs)
+
+	priorityByResLR, err := allocatePrioritiesForResListenerRules([]*elbv2model.ListenerRule{resLR3, resLR1, resLR2}, matchedResAndSDKLRs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[*elbv2model.ListenerRule]int64{
+		resLR1: 200,
+		resLR2: 300,
+		resLR3: 400,
+	}, priorityByResLR)
+}
@@ -4,28 +4,36 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
-func NewListenerSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
-	lsManager ListenerManager, logger logr.Logger, stack core.Stack) *listenerSynthesizer {
+func NewListenerSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	lsManager ListenerManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *listenerSynthesizer {
 	return &listenerSynthesizer{
-		elbv2Client:    elbv2Client,
-		lsManager:      lsManager,
-		logger:         logger,
-		taggingManager: taggingManager,
-		stack:          stack,
+		elbv2Client:      elbv2Client,
+		trackingProvider: trackingProvider,
+		lsManager:        lsManager,
+		logger:           logger,
+		taggingManager:   taggingManager,
+		featureGates:     featureGates,
+		stack:            stack,
 	}
 }
 
 type listenerSynthesizer struct {
-	elbv2Client    services.ELBV2
-	lsManager      ListenerManager
-	logger         logr.Logger
-	taggingManager TaggingManager
+	elbv2Client      services.ELBV2
+	trackingProvider tracking.Provider
+	lsManager        ListenerManager
+	logger           logr.Logger
+	taggingManager   TaggingManager
+	featureGates     config.FeatureGates
 
 	stack core.Stack
 }
@@ -79,11 +87,104 @@ func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN
 	return nil
 }
 
+// Plan computes the changes to Listeners of stack without applying them.
+// resources of stack are resolved with the live Listeners or placeholders, so that planning of dependent resources can proceed.
+func (s *listenerSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resLSs []*elbv2model.Listener
+	s.stack.ListResources(&resLSs)
+	resLSsByLBARN, err := mapResListenerByLoadBalancerARN(resLSs)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, lbARN := range sets.StringKeySet(resLSsByLBARN).List() {
+		lbActions, err := s.planListenersOnLB(ctx, lbARN, resLSsByLBARN[lbARN])
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, lbActions...)
+	}
+	return actions, nil
+}
+
+func (s *listenerSynthesizer) planListenersOnLB(ctx context.Context, lbARN string, resLSs []*elbv2model.Listener) ([]plan.Action, error) {
+	var sdkLSs []ListenerWithTags
+	if !plan.IsPlaceholderIdentifier(lbARN) {
+		var err error
+		sdkLSs, err = s.findSDKListenersOnLB(ctx, lbARN)
+		if err != nil {
+			return nil, err
+		}
+	}
+	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
+
+	var actions []plan.Action
+	for _, sdkLS := range unmatchedSDKLSs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+			Identifier:   awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			Calls:        s.lsManager.PlanDelete(ctx, sdkLS),
+		})
+	}
+	for _, resLS := range unmatchedResLSs {
+		calls, err := s.lsManager.PlanCreate(ctx, resLS)
+		if err != nil {
+			return nil, err
+		}
+		resLS.SetStatus(elbv2model.ListenerStatus{ListenerARN: plan.PlaceholderIdentifier(resLS)})
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resLS.Type(),
+			ResourceID:   resLS.ID(),
+			Calls:        calls,
+		})
+	}
+	for _, resAndSDKLS := range matchedResAndSDKLSs {
+		resAndSDKLS.resLS.SetStatus(buildResListenerStatus(resAndSDKLS.sdkLS))
+		calls, err := s.lsManager.PlanUpdate(ctx, resAndSDKLS.resLS, resAndSDKLS.sdkLS)
+		if err != nil {
+			return nil, err
+		}
+		if len(calls) == 0 {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndSDKLS.resLS.Type(),
+			ResourceID:   resAndSDKLS.resLS.ID(),
+			Identifier:   awssdk.StringValue(resAndSDKLS.sdkLS.Listener.ListenerArn),
+			Changes:      plan.CallAPIs(calls),
+			Calls:        calls,
+		})
+	}
+	return actions, nil
+}
+
 // findSDKListenersOnLB returns the listeners configured on LoadBalancer.
+// on a LoadBalancer adopted by stack, the listeners that exist before adoption are taken over along with their rules.
+// taken over listeners are tagged for stack, so that they're released along with the LoadBalancer.
 func (s *listenerSynthesizer) findSDKListenersOnLB(ctx context.Context, lbARN string) ([]ListenerWithTags, error) {
+	if s.isAdoptedLoadBalancer(lbARN) && !s.featureGates.Enabled(config.ListenerRulesTagging) {
+		return nil, errors.Errorf("loadBalancer %v is adopted, which requires the %v feature gate to tag the listeners taken over for stack",
+			lbARN, config.ListenerRulesTagging)
+	}
 	return s.taggingManager.ListListeners(ctx, lbARN)
 }
 
+// isAdoptedLoadBalancer checks whether the LoadBalancer is adopted by stack rather than created for it.
+func (s *listenerSynthesizer) isAdoptedLoadBalancer(lbARN string) bool {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	for _, resLB := range resLBs {
+		if resLB.Spec.ExistingLoadBalancerARN == lbARN {
+			return true
+		}
+	}
+	return false
+}
+
 type resAndSDKListenerPair struct {
 	resLS *elbv2model.Listener
 	sdkLS ListenerWithTags
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -15,6 +16,9 @@ import (
 type LoadBalancerAttributeReconciler interface {
 	// Reconcile loadBalancer attributes
 	Reconcile(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error
+
+	// Plan computes the calls that Reconcile would make without making them.
+	Plan(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]plan.Call, error)
 }
 
 // NewDefaultLoadBalancerAttributeReconciler constructs new defaultLoadBalancerAttributeReconciler.
@@ -34,39 +38,65 @@ type defaultLoadBalancerAttributeReconciler struct {
 }
 
 func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
+	req, attributesToUpdate, err := r.buildSDKModifyLoadBalancerAttributesInput(ctx, resLB, sdkLB)
+	if err != nil {
+		return err
+	}
+	if req == nil {
+		return nil
+	}
+
+	r.logger.Info("modifying loadBalancer attributes",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+		"change", attributesToUpdate)
+	if _, err := r.elbv2Client.ModifyLoadBalancerAttributesWithContext(ctx, req); err != nil {
+		return err
+	}
+	r.logger.Info("modified loadBalancer attributes",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+	return nil
+}
+
+func (r *defaultLoadBalancerAttributeReconciler) Plan(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]plan.Call, error) {
+	req, _, err := r.buildSDKModifyLoadBalancerAttributesInput(ctx, resLB, sdkLB)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, nil
+	}
+	return []plan.Call{{API: "ModifyLoadBalancerAttributes", Input: req}}, nil
+}
+
+// buildSDKModifyLoadBalancerAttributesInput builds the request to update attributes of sdkLB that drifted from resLB, along with the drifted attributes.
+// nil request is returned if no attribute drifted.
+func (r *defaultLoadBalancerAttributeReconciler) buildSDKModifyLoadBalancerAttributesInput(ctx context.Context, resLB *elbv2model.LoadBalancer,
+	sdkLB LoadBalancerWithTags) (*elbv2sdk.ModifyLoadBalancerAttributesInput, map[string]string, error) {
 	desiredAttrs := r.getDesiredLoadBalancerAttributes(ctx, resLB)
 	currentAttrs, err := r.getCurrentLoadBalancerAttributes(ctx, sdkLB)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
-	if len(attributesToUpdate) > 0 {
-		req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
-			LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-			Attributes:      nil,
-		}
-		for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
-			req.Attributes = append(req.Attributes, &elbv2sdk.LoadBalancerAttribute{
-				Key:   awssdk.String(attrKey),
-				Value: awssdk.String(attributesToUpdate[attrKey]),
-			})
-		}
-
-		r.logger.Info("modifying loadBalancer attributes",
-			"stackID", resLB.Stack().StackID(),
-			"resourceID", resLB.ID(),
-			"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
-			"change", attributesToUpdate)
-		if _, err := r.elbv2Client.ModifyLoadBalancerAttributesWithContext(ctx, req); err != nil {
-			return err
-		}
-		r.logger.Info("modified loadBalancer attributes",
-			"stackID", resLB.Stack().StackID(),
-			"resourceID", resLB.ID(),
-			"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+	if len(attributesToUpdate) == 0 {
+		return nil, nil, nil
 	}
-	return nil
+	req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		Attributes:      nil,
+	}
+	for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
+		req.Attributes = append(req.Attributes, &elbv2sdk.LoadBalancerAttribute{
+			Key:   awssdk.String(attrKey),
+			Value: awssdk.String(attributesToUpdate[attrKey]),
+		})
+	}
+	return req, attributesToUpdate, nil
 }
 
 func (r *defaultLoadBalancerAttributeReconciler) getDesiredLoadBalancerAttributes(ctx context.Context, resLB *elbv2model.LoadBalancer) map[string]string {
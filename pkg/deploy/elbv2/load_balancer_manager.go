@@ -8,7 +8,9 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -21,6 +23,15 @@ type LoadBalancerManager interface {
 	Update(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (elbv2model.LoadBalancerStatus, error)
 
 	Delete(ctx context.Context, sdkLB LoadBalancerWithTags) error
+
+	// PlanCreate computes the calls that Create would make without making them.
+	PlanCreate(ctx context.Context, resLB *elbv2model.LoadBalancer) ([]plan.Call, error)
+
+	// PlanUpdate computes the calls that Update would make without making them.
+	PlanUpdate(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]plan.Call, error)
+
+	// PlanDelete computes the calls that Delete would make without making them.
+	PlanDelete(ctx context.Context, sdkLB LoadBalancerWithTags) []plan.Call
 }
 
 // NewDefaultLoadBalancerManager constructs new defaultLoadBalancerManager.
@@ -50,12 +61,10 @@ type defaultLoadBalancerManager struct {
 }
 
 func (m *defaultLoadBalancerManager) Create(ctx context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
-	req, err := buildSDKCreateLoadBalancerInput(resLB.Spec)
+	req, lbTags, err := m.buildSDKCreateLoadBalancerInputWithTags(resLB)
 	if err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
-	lbTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
-	req.Tags = convertTagsToSDKTags(lbTags)
 
 	m.logger.Info("creating loadBalancer",
 		"stackID", resLB.Stack().StackID(),
@@ -108,9 +117,7 @@ func (m *defaultLoadBalancerManager) Update(ctx context.Context, resLB *elbv2mod
 }
 
 func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalancerWithTags) error {
-	req := &elbv2sdk.DeleteLoadBalancerInput{
-		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-	}
+	req := buildSDKDeleteLoadBalancerInput(sdkLB)
 	m.logger.Info("deleting loadBalancer",
 		"arn", awssdk.StringValue(req.LoadBalancerArn))
 	if _, err := m.elbv2Client.DeleteLoadBalancerWithContext(ctx, req); err != nil {
@@ -121,21 +128,47 @@ func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalan
 	return nil
 }
 
-func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	if resLB.Spec.IPAddressType == nil {
-		return nil
+func (m *defaultLoadBalancerManager) PlanCreate(_ context.Context, resLB *elbv2model.LoadBalancer) ([]plan.Call, error) {
+	req, _, err := m.buildSDKCreateLoadBalancerInputWithTags(resLB)
+	if err != nil {
+		return nil, err
 	}
-	desiredIPAddressType := string(*resLB.Spec.IPAddressType)
-	currentIPAddressType := awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType)
-	if desiredIPAddressType == currentIPAddressType {
-		return nil
+	return []plan.Call{{API: "CreateLoadBalancer", Input: req}}, nil
+}
+
+func (m *defaultLoadBalancerManager) PlanUpdate(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]plan.Call, error) {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	desiredLBTags, reconcileTagsOpts := m.buildSDKLoadBalancerTagsReconciliation(resLB, sdkLB)
+	calls := planReconcileTags(lbARN, desiredLBTags, reconcileTagsOpts...)
+	sgReq, _, err := buildSDKSetSecurityGroupsInput(resLB, sdkLB)
+	if err != nil {
+		return nil, err
+	}
+	if sgReq != nil {
+		calls = append(calls, plan.Call{API: "SetSecurityGroups", Input: sgReq})
+	}
+	if subnetsReq, _ := buildSDKSetSubnetsInput(resLB, sdkLB); subnetsReq != nil {
+		calls = append(calls, plan.Call{API: "SetSubnets", Input: subnetsReq})
+	}
+	if ipAddressTypeReq, _ := buildSDKSetIpAddressTypeInput(resLB, sdkLB); ipAddressTypeReq != nil {
+		calls = append(calls, plan.Call{API: "SetIpAddressType", Input: ipAddressTypeReq})
 	}
+	attrsCalls, err := m.attributesReconciler.Plan(ctx, resLB, sdkLB)
+	if err != nil {
+		return nil, err
+	}
+	return append(calls, attrsCalls...), nil
+}
 
-	req := &elbv2sdk.SetIpAddressTypeInput{
-		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		IpAddressType:   awssdk.String(desiredIPAddressType),
+func (m *defaultLoadBalancerManager) PlanDelete(_ context.Context, sdkLB LoadBalancerWithTags) []plan.Call {
+	return []plan.Call{{API: "DeleteLoadBalancer", Input: buildSDKDeleteLoadBalancerInput(sdkLB)}}
+}
+
+func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
+	req, changeDesc := buildSDKSetIpAddressTypeInput(resLB, sdkLB)
+	if req == nil {
+		return nil
 	}
-	changeDesc := fmt.Sprintf("%v => %v", currentIPAddressType, desiredIPAddressType)
 	m.logger.Info("modifying loadBalancer ipAddressType",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
@@ -153,23 +186,10 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	desiredSubnets := sets.NewString()
-	for _, mapping := range resLB.Spec.SubnetMappings {
-		desiredSubnets.Insert(mapping.SubnetID)
-	}
-	currentSubnets := sets.NewString()
-	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
-		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
-	}
-	if desiredSubnets.Equal(currentSubnets) {
+	req, changeDesc := buildSDKSetSubnetsInput(resLB, sdkLB)
+	if req == nil {
 		return nil
 	}
-
-	req := &elbv2sdk.SetSubnetsInput{
-		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		SubnetMappings:  buildSDKSubnetMappings(resLB.Spec.SubnetMappings),
-	}
-	changeDesc := fmt.Sprintf("%v => %v", currentSubnets.List(), desiredSubnets.List())
 	m.logger.Info("modifying loadBalancer subnetMappings",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
@@ -187,36 +207,14 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSecurityGroups(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	req, changeDescriptions, err := buildSDKSetSecurityGroupsInput(resLB, sdkLB)
 	if err != nil {
 		return err
 	}
-	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
-	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
-
-	isEnforceSGInboundRulesOnPrivateLinkUpdated, currentEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic, desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic := isEnforceSGInboundRulesOnPrivateLinkUpdated(resLB, sdkLB)
-	if desiredSecurityGroups.Equal(currentSecurityGroups) && !isEnforceSGInboundRulesOnPrivateLinkUpdated {
+	if req == nil {
 		return nil
 	}
 
-	var changeDescriptions []string
-
-	if !desiredSecurityGroups.Equal(currentSecurityGroups) {
-		changeSecurityGroupsDesc := fmt.Sprintf("%v => %v", currentSecurityGroups.List(), desiredSecurityGroups.List())
-		changeDescriptions = append(changeDescriptions, "changeSecurityGroups", changeSecurityGroupsDesc)
-	}
-
-	req := &elbv2sdk.SetSecurityGroupsInput{
-		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		SecurityGroups:  securityGroups,
-	}
-
-	if isEnforceSGInboundRulesOnPrivateLinkUpdated {
-		changeEnforceSecurityGroupInboundRulesOnPrivateLinkTrafficDesc := fmt.Sprintf("%v => %v", currentEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic, desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic)
-		changeDescriptions = append(changeDescriptions, "changeEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic", changeEnforceSecurityGroupInboundRulesOnPrivateLinkTrafficDesc)
-		req.EnforceSecurityGroupInboundRulesOnPrivateLinkTraffic = &desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic
-	}
-
 	if _, err := m.elbv2Client.SetSecurityGroupsWithContext(ctx, req); err != nil {
 		return err
 	}
@@ -240,11 +238,126 @@ func (m *defaultLoadBalancerManager) checkSDKLoadBalancerWithCOIPv4Pool(_ contex
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
+	desiredLBTags, reconcileTagsOpts := m.buildSDKLoadBalancerTagsReconciliation(resLB, sdkLB)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), desiredLBTags, reconcileTagsOpts...)
+}
+
+// buildSDKLoadBalancerTagsReconciliation builds the desired tags of sdkLB along with the options to reconcile them.
+func (m *defaultLoadBalancerManager) buildSDKLoadBalancerTagsReconciliation(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (map[string]string, []ReconcileTagsOption) {
 	desiredLBTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
-	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), desiredLBTags,
+	var preexistingTagKeys []string
+	if _, adopted := sdkLB.Tags[tracking.AdoptedTagKey]; adopted {
+		desiredLBTags = algorithm.MergeStringMap(desiredLBTags, map[string]string{tracking.AdoptedTagKey: "true"})
+		preexistingTagKeys = buildPreexistingTagKeysOfAdoptedResource(desiredLBTags, sdkLB.Tags)
+	}
+	return desiredLBTags, []ReconcileTagsOption{
 		WithCurrentTags(sdkLB.Tags),
 		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
-		WithIgnoredTagKeys(m.externalManagedTags))
+		WithIgnoredTagKeys(m.externalManagedTags),
+		WithIgnoredTagKeys(preexistingTagKeys),
+	}
+}
+
+func (m *defaultLoadBalancerManager) buildSDKCreateLoadBalancerInputWithTags(resLB *elbv2model.LoadBalancer) (*elbv2sdk.CreateLoadBalancerInput, map[string]string, error) {
+	req, err := buildSDKCreateLoadBalancerInput(resLB.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	lbTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	req.Tags = convertTagsToSDKTags(lbTags)
+	return req, lbTags, nil
+}
+
+// buildSDKSetIpAddressTypeInput builds the request to update the ipAddressType of sdkLB to fulfill resLB, along with the change description.
+// nil request is returned if the ipAddressType isn't drifted.
+func buildSDKSetIpAddressTypeInput(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (*elbv2sdk.SetIpAddressTypeInput, string) {
+	if resLB.Spec.IPAddressType == nil {
+		return nil, ""
+	}
+	desiredIPAddressType := string(*resLB.Spec.IPAddressType)
+	currentIPAddressType := awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType)
+	if desiredIPAddressType == currentIPAddressType {
+		return nil, ""
+	}
+	req := &elbv2sdk.SetIpAddressTypeInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		IpAddressType:   awssdk.String(desiredIPAddressType),
+	}
+	return req, fmt.Sprintf("%v => %v", currentIPAddressType, desiredIPAddressType)
+}
+
+// buildSDKSetSubnetsInput builds the request to update the subnets of sdkLB to fulfill resLB, along with the change description.
+// nil request is returned if the subnets aren't drifted.
+func buildSDKSetSubnetsInput(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (*elbv2sdk.SetSubnetsInput, string) {
+	desiredSubnets := sets.NewString()
+	for _, mapping := range resLB.Spec.SubnetMappings {
+		desiredSubnets.Insert(mapping.SubnetID)
+	}
+	currentSubnets := sets.NewString()
+	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
+		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+	}
+	if desiredSubnets.Equal(currentSubnets) {
+		return nil, ""
+	}
+	req := &elbv2sdk.SetSubnetsInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		SubnetMappings:  buildSDKSubnetMappings(resLB.Spec.SubnetMappings),
+	}
+	return req, fmt.Sprintf("%v => %v", currentSubnets.List(), desiredSubnets.List())
+}
+
+// buildSDKSetSecurityGroupsInput builds the request to update the securityGroups of sdkLB to fulfill resLB, along with the change descriptions.
+// nil request is returned if the securityGroups aren't drifted.
+func buildSDKSetSecurityGroupsInput(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (*elbv2sdk.SetSecurityGroupsInput, []string, error) {
+	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	if err != nil {
+		return nil, nil, err
+	}
+	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
+	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
+
+	isEnforceSGInboundRulesOnPrivateLinkUpdated, currentEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic, desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic := isEnforceSGInboundRulesOnPrivateLinkUpdated(resLB, sdkLB)
+	if desiredSecurityGroups.Equal(currentSecurityGroups) && !isEnforceSGInboundRulesOnPrivateLinkUpdated {
+		return nil, nil, nil
+	}
+
+	var changeDescriptions []string
+
+	if !desiredSecurityGroups.Equal(currentSecurityGroups) {
+		changeSecurityGroupsDesc := fmt.Sprintf("%v => %v", currentSecurityGroups.List(), desiredSecurityGroups.List())
+		changeDescriptions = append(changeDescriptions, "changeSecurityGroups", changeSecurityGroupsDesc)
+	}
+
+	req := &elbv2sdk.SetSecurityGroupsInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+		SecurityGroups:  securityGroups,
+	}
+
+	if isEnforceSGInboundRulesOnPrivateLinkUpdated {
+		changeEnforceSecurityGroupInboundRulesOnPrivateLinkTrafficDesc := fmt.Sprintf("%v => %v", currentEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic, desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic)
+		changeDescriptions = append(changeDescriptions, "changeEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic", changeEnforceSecurityGroupInboundRulesOnPrivateLinkTrafficDesc)
+		req.EnforceSecurityGroupInboundRulesOnPrivateLinkTraffic = &desiredEnforceSecurityGroupInboundRulesOnPrivateLinkTraffic
+	}
+	return req, changeDescriptions, nil
+}
+
+func buildSDKDeleteLoadBalancerInput(sdkLB LoadBalancerWithTags) *elbv2sdk.DeleteLoadBalancerInput {
+	return &elbv2sdk.DeleteLoadBalancerInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+	}
+}
+
+// buildPreexistingTagKeysOfAdoptedResource returns the keys of tags on an adopted resource that aren't desired.
+// these tags were set before the resource is adopted, they're kept as is.
+func buildPreexistingTagKeysOfAdoptedResource(desiredTags map[string]string, currentTags map[string]string) []string {
+	var preexistingTagKeys []string
+	for tagKey := range currentTags {
+		if _, desired := desiredTags[tagKey]; !desired {
+			preexistingTagKeys = append(preexistingTagKeys, tagKey)
+		}
+	}
+	return preexistingTagKeys
 }
 
 func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
@@ -309,6 +309,51 @@ func Test_buildResLoadBalancerStatus(t *testing.T) {
 	}
 }
 
+func Test_buildPreexistingTagKeysOfAdoptedResource(t *testing.T) {
+	type args struct {
+		desiredTags map[string]string
+		currentTags map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "preexisting tags are kept",
+			args: args{
+				desiredTags: map[string]string{
+					"elbv2.k8s.aws/adopted": "true",
+					"owner":                 "my-team",
+				},
+				currentTags: map[string]string{
+					"elbv2.k8s.aws/adopted": "true",
+					"owner":                 "another-team",
+					"cost-center":           "1234",
+					"env":                   "prod",
+				},
+			},
+			want: []string{"cost-center", "env"},
+		},
+		{
+			name: "no preexisting tags",
+			args: args{
+				desiredTags: map[string]string{
+					"elbv2.k8s.aws/adopted": "true",
+				},
+				currentTags: map[string]string{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPreexistingTagKeysOfAdoptedResource(tt.args.desiredTags, tt.args.currentTags)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
 func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testing.T) {
 	type args struct {
 		resLB *elbv2model.LoadBalancer
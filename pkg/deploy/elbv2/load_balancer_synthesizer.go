@@ -9,7 +9,9 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -61,6 +63,12 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 	//  * we can avoid the operation to detach a targetGroup from unmatched LBs. (a targetGroup can only attach to one LB).
 	// I don't like this, but it's the easiest solution to meet our requirement :D.
 	for _, sdkLB := range unmatchedSDKLBs {
+		if isAdoptedSDKLoadBalancer(sdkLB) {
+			if err := s.releaseLoadBalancer(ctx, sdkLB); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := s.lbManager.Delete(ctx, sdkLB); err != nil {
 			errMessage := err.Error()
 			if strings.Contains(errMessage, "OperationNotPermitted") && strings.Contains(errMessage, "deletion protection") {
@@ -74,7 +82,13 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 		}
 	}
 	for _, resLB := range unmatchedResLBs {
-		lbStatus, err := s.lbManager.Create(ctx, resLB)
+		var lbStatus elbv2model.LoadBalancerStatus
+		var err error
+		if resLB.Spec.ExistingLoadBalancerARN != "" {
+			lbStatus, err = s.adoptLoadBalancer(ctx, resLB)
+		} else {
+			lbStatus, err = s.lbManager.Create(ctx, resLB)
+		}
 		if err != nil {
 			return err
 		}
@@ -90,6 +104,136 @@ func (s *loadBalancerSynthesizer) Synthesize(ctx context.Context) error {
 	return nil
 }
 
+// adoptLoadBalancer adopts the existing LoadBalancer referenced by resLB, it's tagged for stack and then updated to fulfill resLB.
+func (s *loadBalancerSynthesizer) adoptLoadBalancer(ctx context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
+	sdkLB, err := s.loadLoadBalancerToAdopt(ctx, resLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	adoptionTags, reconcileTagsOpts := s.buildAdoptionTagsReconciliation(resLB, sdkLB)
+	s.logger.Info("adopting loadBalancer",
+		"stackID", s.stack.StackID().String(),
+		"resourceID", resLB.ID(),
+		"arn", lbARN)
+	if err := s.taggingManager.ReconcileTags(ctx, lbARN, adoptionTags, reconcileTagsOpts...); err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	s.logger.Info("adopted loadBalancer",
+		"stackID", s.stack.StackID().String(),
+		"resourceID", resLB.ID(),
+		"arn", lbARN)
+	sdkLB.Tags = algorithm.MergeStringMap(adoptionTags, sdkLB.Tags)
+	return s.lbManager.Update(ctx, resLB, sdkLB)
+}
+
+// buildAdoptionTagsReconciliation builds the tags to adopt sdkLB for resLB along with the options to reconcile them.
+// tags that existed before adoption are kept as is.
+func (s *loadBalancerSynthesizer) buildAdoptionTagsReconciliation(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (map[string]string, []ReconcileTagsOption) {
+	adoptionTags := algorithm.MergeStringMap(s.trackingProvider.ResourceTags(s.stack, resLB, nil),
+		map[string]string{tracking.AdoptedTagKey: "true"})
+	return adoptionTags, []ReconcileTagsOption{
+		WithCurrentTags(sdkLB.Tags),
+		WithIgnoredTagKeys(buildPreexistingTagKeysOfAdoptedResource(adoptionTags, sdkLB.Tags)),
+	}
+}
+
+// loadLoadBalancerToAdopt loads the existing LoadBalancer referenced by resLB and validates that it can be adopted.
+func (s *loadBalancerSynthesizer) loadLoadBalancerToAdopt(ctx context.Context, resLB *elbv2model.LoadBalancer) (LoadBalancerWithTags, error) {
+	sdkLB, err := s.taggingManager.GetLoadBalancer(ctx, resLB.Spec.ExistingLoadBalancerARN)
+	if err != nil {
+		return LoadBalancerWithTags{}, errors.Wrapf(err, "failed to load loadBalancer to adopt: %v", resLB.Spec.ExistingLoadBalancerARN)
+	}
+	if err := validateLoadBalancerAdoption(resLB, sdkLB, s.trackingProvider.StackTags(s.stack)); err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	return sdkLB, nil
+}
+
+// releaseLoadBalancer releases an adopted LoadBalancer that's no longer desired, the LoadBalancer itself is left in place.
+// listeners managed for stack are deleted since they forward to target groups of stack, then the tracking tags are removed.
+func (s *loadBalancerSynthesizer) releaseLoadBalancer(ctx context.Context, sdkLB LoadBalancerWithTags) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	s.logger.Info("releasing loadBalancer",
+		"arn", lbARN)
+	sdkLSs, err := s.findSDKListenersOnLB(ctx, lbARN)
+	if err != nil {
+		return err
+	}
+	for _, sdkLS := range sdkLSs {
+		req := &elbv2sdk.DeleteListenerInput{
+			ListenerArn: sdkLS.Listener.ListenerArn,
+		}
+		if _, err := s.elbv2Client.DeleteListenerWithContext(ctx, req); err != nil {
+			return err
+		}
+	}
+	if err := untrackSDKResource(ctx, s.taggingManager, s.trackingProvider, s.stack, lbARN, sdkLB.Tags); err != nil {
+		return err
+	}
+	s.logger.Info("released loadBalancer",
+		"arn", lbARN)
+	return nil
+}
+
+// Release releases LoadBalancers of stack along with their listeners and listener rules.
+// with untrack, the tracking tags are removed from them so that they're no longer managed for stack.
+func (s *loadBalancerSynthesizer) Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error) {
+	sdkLBs, err := s.findSDKLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var releasedResources []tracking.ReleasedResource
+	for _, sdkLB := range sdkLBs {
+		lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+		sdkLSs, err := s.findSDKListenersOnLB(ctx, lbARN)
+		if err != nil {
+			return nil, err
+		}
+		for _, sdkLS := range sdkLSs {
+			lsARN := awssdk.StringValue(sdkLS.Listener.ListenerArn)
+			if untrack {
+				if err := s.untrackListener(ctx, sdkLS); err != nil {
+					return nil, err
+				}
+			}
+			releasedResources = append(releasedResources, tracking.ReleasedResource{
+				ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+				Identifier:   lsARN,
+			})
+		}
+		if untrack {
+			if err := untrackSDKResource(ctx, s.taggingManager, s.trackingProvider, s.stack, lbARN, sdkLB.Tags); err != nil {
+				return nil, err
+			}
+			s.logger.Info("untracked loadBalancer",
+				"stackID", s.stack.StackID().String(),
+				"arn", lbARN)
+		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			Identifier:   lbARN,
+		})
+	}
+	return releasedResources, nil
+}
+
+// untrackListener removes the tracking tags for stack from sdkLS and its listener rules.
+func (s *loadBalancerSynthesizer) untrackListener(ctx context.Context, sdkLS ListenerWithTags) error {
+	lsARN := awssdk.StringValue(sdkLS.Listener.ListenerArn)
+	sdkLRs, err := s.taggingManager.ListListenerRules(ctx, lsARN)
+	if err != nil {
+		return err
+	}
+	for _, sdkLR := range sdkLRs {
+		lrARN := awssdk.StringValue(sdkLR.ListenerRule.RuleArn)
+		if err := untrackSDKResource(ctx, s.taggingManager, s.trackingProvider, s.stack, lrARN, sdkLR.Tags); err != nil {
+			return err
+		}
+	}
+	return untrackSDKResource(ctx, s.taggingManager, s.trackingProvider, s.stack, lsARN, sdkLS.Tags)
+}
+
 func (s *loadBalancerSynthesizer) disableDeletionProtection(lb *elbv2sdk.LoadBalancer) error {
 	input := &elbv2sdk.ModifyLoadBalancerAttributesInput{
 		Attributes: []*elbv2sdk.LoadBalancerAttribute{
@@ -109,6 +253,106 @@ func (s *loadBalancerSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+// Plan computes the changes to LoadBalancers of stack without applying them.
+// resources of stack are resolved with the live LoadBalancers or placeholders, so that planning of dependent resources can proceed.
+func (s *loadBalancerSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resLBs []*elbv2model.LoadBalancer
+	s.stack.ListResources(&resLBs)
+	sdkLBs, err := s.findSDKLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKLBs, unmatchedResLBs, unmatchedSDKLBs, err := matchResAndSDKLoadBalancers(resLBs, sdkLBs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, sdkLB := range unmatchedSDKLBs {
+		if isAdoptedSDKLoadBalancer(sdkLB) {
+			actions = append(actions, plan.Action{
+				Type:         plan.ActionTypeUpdate,
+				ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+				Identifier:   awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+				Changes:      []string{"release"},
+			})
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			Identifier:   awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+			Calls:        s.lbManager.PlanDelete(ctx, sdkLB),
+		})
+	}
+	for _, resLB := range unmatchedResLBs {
+		if resLB.Spec.ExistingLoadBalancerARN != "" {
+			action, err := s.planLoadBalancerAdoption(ctx, resLB)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, action)
+			continue
+		}
+		calls, err := s.lbManager.PlanCreate(ctx, resLB)
+		if err != nil {
+			return nil, err
+		}
+		resLB.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: plan.PlaceholderIdentifier(resLB)})
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resLB.Type(),
+			ResourceID:   resLB.ID(),
+			Calls:        calls,
+		})
+	}
+	for _, resAndSDKLB := range matchedResAndSDKLBs {
+		resAndSDKLB.resLB.SetStatus(buildResLoadBalancerStatus(resAndSDKLB.sdkLB))
+		calls, err := s.lbManager.PlanUpdate(ctx, resAndSDKLB.resLB, resAndSDKLB.sdkLB)
+		if err != nil {
+			return nil, err
+		}
+		if len(calls) == 0 {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndSDKLB.resLB.Type(),
+			ResourceID:   resAndSDKLB.resLB.ID(),
+			Identifier:   awssdk.StringValue(resAndSDKLB.sdkLB.LoadBalancer.LoadBalancerArn),
+			Changes:      plan.CallAPIs(calls),
+			Calls:        calls,
+		})
+	}
+	return actions, nil
+}
+
+// planLoadBalancerAdoption computes the calls that adoptLoadBalancer would make for resLB without making them.
+func (s *loadBalancerSynthesizer) planLoadBalancerAdoption(ctx context.Context, resLB *elbv2model.LoadBalancer) (plan.Action, error) {
+	sdkLB, err := s.loadLoadBalancerToAdopt(ctx, resLB)
+	if err != nil {
+		return plan.Action{}, err
+	}
+	resLB.SetStatus(buildResLoadBalancerStatus(sdkLB))
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	adoptionTags, reconcileTagsOpts := s.buildAdoptionTagsReconciliation(resLB, sdkLB)
+	calls := planReconcileTags(lbARN, adoptionTags, reconcileTagsOpts...)
+	sdkLB.Tags = algorithm.MergeStringMap(adoptionTags, sdkLB.Tags)
+	updateCalls, err := s.lbManager.PlanUpdate(ctx, resLB, sdkLB)
+	if err != nil {
+		return plan.Action{}, err
+	}
+	calls = append(calls, updateCalls...)
+	return plan.Action{
+		Type:         plan.ActionTypeUpdate,
+		ResourceType: resLB.Type(),
+		ResourceID:   resLB.ID(),
+		Identifier:   lbARN,
+		Changes:      append([]string{"adopt"}, plan.CallAPIs(calls)...),
+		Calls:        calls,
+	}, nil
+}
+
 // findSDKLoadBalancers will find all AWS LoadBalancer created for stack.
 func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]LoadBalancerWithTags, error) {
 	stackTags := s.trackingProvider.StackTags(s.stack)
@@ -118,6 +362,27 @@ func (s *loadBalancerSynthesizer) findSDKLoadBalancers(ctx context.Context) ([]L
 		tracking.TagsAsTagFilter(stackTagsLegacy))
 }
 
+// findSDKListenersOnLB will find the listeners on LoadBalancer that are managed for stack.
+// listeners of other stacks or of nobody, e.g. on an adopted LoadBalancer, are left alone.
+func (s *loadBalancerSynthesizer) findSDKListenersOnLB(ctx context.Context, lbARN string) ([]ListenerWithTags, error) {
+	sdkLSs, err := s.taggingManager.ListListeners(ctx, lbARN)
+	if err != nil {
+		return nil, err
+	}
+	return filterSDKListenersByTagFilter(sdkLSs, tracking.TagsAsTagFilter(s.trackingProvider.StackTags(s.stack))), nil
+}
+
+// filterSDKListenersByTagFilter returns the listeners among sdkLSs that match tagFilter.
+func filterSDKListenersByTagFilter(sdkLSs []ListenerWithTags, tagFilter tracking.TagFilter) []ListenerWithTags {
+	var matchedSDKLSs []ListenerWithTags
+	for _, sdkLS := range sdkLSs {
+		if tagFilter.Matches(sdkLS.Tags) {
+			matchedSDKLSs = append(matchedSDKLSs, sdkLS)
+		}
+	}
+	return matchedSDKLSs
+}
+
 type resAndSDKLoadBalancerPair struct {
 	resLB *elbv2model.LoadBalancer
 	sdkLB LoadBalancerWithTags
@@ -194,5 +459,45 @@ func isSDKLoadBalancerRequiresReplacement(sdkLB LoadBalancerWithTags, resLB *elb
 	if resLB.Spec.Scheme != nil && string(*resLB.Spec.Scheme) != awssdk.StringValue(sdkLB.LoadBalancer.Scheme) {
 		return true
 	}
+	// an adopted LoadBalancer can only fulfill the LoadBalancer resource that references it, and vice versa.
+	if resLB.Spec.ExistingLoadBalancerARN != "" || isAdoptedSDKLoadBalancer(sdkLB) {
+		return resLB.Spec.ExistingLoadBalancerARN != awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	}
 	return false
 }
+
+// isAdoptedSDKLoadBalancer checks whether a sdk LoadBalancer is adopted rather than created by the controller.
+func isAdoptedSDKLoadBalancer(sdkLB LoadBalancerWithTags) bool {
+	_, adopted := sdkLB.Tags[tracking.AdoptedTagKey]
+	return adopted
+}
+
+// validateLoadBalancerAdoption validates whether a sdk LoadBalancer can be adopted to fulfill a LoadBalancer resource.
+// the sdk LoadBalancer must not be managed already, and it must be of the desired type and scheme.
+// it must not be in subnets that aren't desired either, so that adoption never takes availability zones out of service.
+func validateLoadBalancerAdoption(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags, stackTags map[string]string) error {
+	lbARN := awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn)
+	for tagKey := range stackTags {
+		if tagValue, exists := sdkLB.Tags[tagKey]; exists {
+			return errors.Errorf("loadBalancer %v is already managed, with tag %v: %v", lbARN, tagKey, tagValue)
+		}
+	}
+	if string(resLB.Spec.Type) != awssdk.StringValue(sdkLB.LoadBalancer.Type) {
+		return errors.Errorf("loadBalancer %v is of type %v, expecting %v", lbARN, awssdk.StringValue(sdkLB.LoadBalancer.Type), resLB.Spec.Type)
+	}
+	if resLB.Spec.Scheme != nil && string(*resLB.Spec.Scheme) != awssdk.StringValue(sdkLB.LoadBalancer.Scheme) {
+		return errors.Errorf("loadBalancer %v is of scheme %v, expecting %v", lbARN, awssdk.StringValue(sdkLB.LoadBalancer.Scheme), *resLB.Spec.Scheme)
+	}
+	desiredSubnets := sets.NewString()
+	for _, mapping := range resLB.Spec.SubnetMappings {
+		desiredSubnets.Insert(mapping.SubnetID)
+	}
+	currentSubnets := sets.NewString()
+	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
+		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+	}
+	if undesiredSubnets := currentSubnets.Difference(desiredSubnets); len(undesiredSubnets) != 0 {
+		return errors.Errorf("loadBalancer %v is in subnets %v, expecting subnets among %v", lbARN, undesiredSubnets.List(), desiredSubnets.List())
+	}
+	return nil
+}
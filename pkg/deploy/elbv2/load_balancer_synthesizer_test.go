@@ -7,6 +7,7 @@ import (
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
@@ -587,6 +588,77 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "adopted loadBalancer referenced by resLB shouldn't need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:                    elbv2model.LoadBalancerTypeApplication,
+						Scheme:                  &schemaInternetFacing,
+						Name:                    "my-lb",
+						ExistingLoadBalancerARN: "my-arn",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "adopted loadBalancer no longer referenced by resLB need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/adopted": "true",
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:   elbv2model.LoadBalancerTypeApplication,
+						Scheme: &schemaInternetFacing,
+						Name:   "my-lb",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "loadBalancer not referenced by resLB need replacement",
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn:  awssdk.String("my-arn"),
+						Type:             awssdk.String("application"),
+						Scheme:           awssdk.String("internet-facing"),
+						LoadBalancerName: awssdk.String("my-lb"),
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					Spec: elbv2model.LoadBalancerSpec{
+						Type:                    elbv2model.LoadBalancerTypeApplication,
+						Scheme:                  &schemaInternetFacing,
+						Name:                    "my-lb",
+						ExistingLoadBalancerARN: "my-another-arn",
+					},
+				},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -595,3 +667,183 @@ func Test_isSDKLoadBalancerRequiresReplacement(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateLoadBalancerAdoption(t *testing.T) {
+	schemaInternetFacing := elbv2model.LoadBalancerSchemeInternetFacing
+	stackTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	}
+	resLB := &elbv2model.LoadBalancer{
+		Spec: elbv2model.LoadBalancerSpec{
+			Type:   elbv2model.LoadBalancerTypeApplication,
+			Scheme: &schemaInternetFacing,
+			SubnetMappings: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-a"},
+				{SubnetID: "subnet-b"},
+			},
+			ExistingLoadBalancerARN: "my-arn",
+		},
+	}
+	type args struct {
+		resLB *elbv2model.LoadBalancer
+		sdkLB LoadBalancerWithTags
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "compatible loadBalancer",
+			args: args{
+				resLB: resLB,
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						Scheme:          awssdk.String("internet-facing"),
+						AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+							{SubnetId: awssdk.String("subnet-a")},
+						},
+					},
+					Tags: map[string]string{
+						"team": "my-team",
+					},
+				},
+			},
+		},
+		{
+			name: "loadBalancer managed by another cluster",
+			args: args{
+				resLB: resLB,
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						Scheme:          awssdk.String("internet-facing"),
+					},
+					Tags: map[string]string{
+						"elbv2.k8s.aws/cluster": "another-cluster",
+					},
+				},
+			},
+			wantErr: errors.New("loadBalancer my-arn is already managed, with tag elbv2.k8s.aws/cluster: another-cluster"),
+		},
+		{
+			name: "loadBalancer of different type",
+			args: args{
+				resLB: resLB,
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("network"),
+						Scheme:          awssdk.String("internet-facing"),
+					},
+				},
+			},
+			wantErr: errors.New("loadBalancer my-arn is of type network, expecting application"),
+		},
+		{
+			name: "loadBalancer of different scheme",
+			args: args{
+				resLB: resLB,
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						Scheme:          awssdk.String("internal"),
+					},
+				},
+			},
+			wantErr: errors.New("loadBalancer my-arn is of scheme internal, expecting internet-facing"),
+		},
+		{
+			name: "loadBalancer in undesired subnets",
+			args: args{
+				resLB: resLB,
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2sdk.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+						Type:            awssdk.String("application"),
+						Scheme:          awssdk.String("internet-facing"),
+						AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+							{SubnetId: awssdk.String("subnet-a")},
+							{SubnetId: awssdk.String("subnet-c")},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("loadBalancer my-arn is in subnets [subnet-c], expecting subnets among [subnet-a subnet-b]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLoadBalancerAdoption(tt.args.resLB, tt.args.sdkLB, stackTags)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_filterSDKListenersByTagFilter(t *testing.T) {
+	stackLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String("ls-80"),
+			Port:        awssdk.Int64(80),
+		},
+		Tags: map[string]string{
+			"elbv2.k8s.aws/cluster":    "cluster-name",
+			"ingress.k8s.aws/stack":    "my-group",
+			"ingress.k8s.aws/resource": "80",
+		},
+	}
+	untaggedLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String("ls-8080"),
+			Port:        awssdk.Int64(8080),
+		},
+		Tags: map[string]string{
+			"team": "my-team",
+		},
+	}
+	anotherStackLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String("ls-9090"),
+			Port:        awssdk.Int64(9090),
+		},
+		Tags: map[string]string{
+			"elbv2.k8s.aws/cluster": "cluster-name",
+			"ingress.k8s.aws/stack": "another-group",
+		},
+	}
+	stackTagFilter := tracking.TagsAsTagFilter(map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "my-group",
+	})
+	tests := []struct {
+		name   string
+		sdkLSs []ListenerWithTags
+		want   []ListenerWithTags
+	}{
+		{
+			name:   "only listeners managed for stack match",
+			sdkLSs: []ListenerWithTags{stackLS, untaggedLS, anotherStackLS},
+			want:   []ListenerWithTags{stackLS},
+		},
+		{
+			name:   "no listener managed for stack",
+			sdkLSs: []ListenerWithTags{untaggedLS, anotherStackLS},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterSDKListenersByTagFilter(tt.sdkLSs, stackTagFilter)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -16,7 +16,9 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 )
 
 const (
@@ -91,6 +93,9 @@ type TaggingManager interface {
 	// ListLoadBalancers returns LoadBalancers that matches any of the tagging requirements.
 	ListLoadBalancers(ctx context.Context, tagFilters ...tracking.TagFilter) ([]LoadBalancerWithTags, error)
 
+	// GetLoadBalancer returns the LoadBalancer with lbARN along with tags, the LoadBalancer must be within VPC.
+	GetLoadBalancer(ctx context.Context, lbARN string) (LoadBalancerWithTags, error)
+
 	// ListTargetGroups returns TargetGroups that matches any of the tagging requirements.
 	ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error)
 
@@ -147,17 +152,9 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, d
 		currentTags = tagsByARN[arn]
 	}
 
-	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
-	for _, ignoredTagKey := range reconcileOpts.IgnoredTagKeys {
-		delete(tagsToUpdate, ignoredTagKey)
-		delete(tagsToRemove, ignoredTagKey)
-	}
-
+	tagsToUpdate, tagsToRemove := computeTagsToReconcile(desiredTags, currentTags, reconcileOpts.IgnoredTagKeys)
 	if len(tagsToUpdate) > 0 {
-		req := &elbv2sdk.AddTagsInput{
-			ResourceArns: []*string{awssdk.String(arn)},
-			Tags:         convertTagsToSDKTags(tagsToUpdate),
-		}
+		req := buildSDKAddTagsInput(arn, tagsToUpdate)
 
 		m.logger.Info("adding resource tags",
 			"arn", arn,
@@ -172,10 +169,7 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, d
 
 	if len(tagsToRemove) > 0 {
 		tagKeys := sets.StringKeySet(tagsToRemove).List()
-		req := &elbv2sdk.RemoveTagsInput{
-			ResourceArns: []*string{awssdk.String(arn)},
-			TagKeys:      awssdk.StringSlice(tagKeys),
-		}
+		req := buildSDKRemoveTagsInput(arn, tagKeys)
 
 		m.logger.Info("removing resource tags",
 			"arn", arn,
@@ -190,6 +184,46 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, d
 	return nil
 }
 
+// planReconcileTags computes the calls that ReconcileTags would make to reconcile tags on resource with arn.
+// the current tags must be provided via options, they're considered empty otherwise.
+func planReconcileTags(arn string, desiredTags map[string]string, opts ...ReconcileTagsOption) []plan.Call {
+	reconcileOpts := ReconcileTagsOptions{}
+	reconcileOpts.ApplyOptions(opts)
+	tagsToUpdate, tagsToRemove := computeTagsToReconcile(desiredTags, reconcileOpts.CurrentTags, reconcileOpts.IgnoredTagKeys)
+	var calls []plan.Call
+	if len(tagsToUpdate) > 0 {
+		calls = append(calls, plan.Call{API: "AddTags", Input: buildSDKAddTagsInput(arn, tagsToUpdate)})
+	}
+	if len(tagsToRemove) > 0 {
+		calls = append(calls, plan.Call{API: "RemoveTags", Input: buildSDKRemoveTagsInput(arn, sets.StringKeySet(tagsToRemove).List())})
+	}
+	return calls
+}
+
+// computeTagsToReconcile computes the tags to update and remove to reconcile currentTags into desiredTags, ignoredTagKeys are left alone.
+func computeTagsToReconcile(desiredTags map[string]string, currentTags map[string]string, ignoredTagKeys []string) (map[string]string, map[string]string) {
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range ignoredTagKeys {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+	return tagsToUpdate, tagsToRemove
+}
+
+func buildSDKAddTagsInput(arn string, tags map[string]string) *elbv2sdk.AddTagsInput {
+	return &elbv2sdk.AddTagsInput{
+		ResourceArns: []*string{awssdk.String(arn)},
+		Tags:         convertTagsToSDKTags(tags),
+	}
+}
+
+func buildSDKRemoveTagsInput(arn string, tagKeys []string) *elbv2sdk.RemoveTagsInput {
+	return &elbv2sdk.RemoveTagsInput{
+		ResourceArns: []*string{awssdk.String(arn)},
+		TagKeys:      awssdk.StringSlice(tagKeys),
+	}
+}
+
 func (m *defaultTaggingManager) ListListeners(ctx context.Context, lbARN string) ([]ListenerWithTags, error) {
 	req := &elbv2sdk.DescribeListenersInput{
 		LoadBalancerArn: awssdk.String(lbARN),
@@ -265,6 +299,30 @@ func (m *defaultTaggingManager) ListLoadBalancers(ctx context.Context, tagFilter
 	return m.listLoadBalancersNative(ctx, tagFilters)
 }
 
+func (m *defaultTaggingManager) GetLoadBalancer(ctx context.Context, lbARN string) (LoadBalancerWithTags, error) {
+	req := &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+	}
+	lbs, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, req)
+	if err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	if len(lbs) == 0 {
+		return LoadBalancerWithTags{}, errors.Errorf("loadBalancer not found: %v", lbARN)
+	}
+	if awssdk.StringValue(lbs[0].VpcId) != m.vpcID {
+		return LoadBalancerWithTags{}, errors.Errorf("loadBalancer %v is not within vpc %v", lbARN, m.vpcID)
+	}
+	tagsByARN, err := m.describeResourceTags(ctx, []string{lbARN})
+	if err != nil {
+		return LoadBalancerWithTags{}, err
+	}
+	return LoadBalancerWithTags{
+		LoadBalancer: lbs[0],
+		Tags:         tagsByARN[lbARN],
+	}, nil
+}
+
 func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error) {
 	if m.featureGates.Enabled(config.EnableRGTAPI) {
 		m.logger.V(1).Info("ResourceGroupTagging enabled, list the target groups via RGT API")
@@ -520,3 +578,13 @@ func convertTagFiltersToRGTTagFilters(tagFilter tracking.TagFilter) []*resourceg
 	}
 	return RGTTagFilters
 }
+
+// untrackSDKResource removes the tracking tags for stack from the resource with arn, other tags are left in place.
+func untrackSDKResource(ctx context.Context, taggingManager TaggingManager, trackingProvider tracking.Provider, stack core.Stack,
+	arn string, currentTags map[string]string) error {
+	untrackedTags := tracking.UntrackedTags(trackingProvider, stack, currentTags)
+	if len(untrackedTags) == len(currentTags) {
+		return nil
+	}
+	return taggingManager.ReconcileTags(ctx, arn, untrackedTags, WithCurrentTags(currentTags))
+}
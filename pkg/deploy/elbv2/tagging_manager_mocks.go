@@ -35,6 +35,21 @@ func (m *MockTaggingManager) EXPECT() *MockTaggingManagerMockRecorder {
 	return m.recorder
 }
 
+// GetLoadBalancer mocks base method.
+func (m *MockTaggingManager) GetLoadBalancer(arg0 context.Context, arg1 string) (LoadBalancerWithTags, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancer", arg0, arg1)
+	ret0, _ := ret[0].(LoadBalancerWithTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancer indicates an expected call of GetLoadBalancer.
+func (mr *MockTaggingManagerMockRecorder) GetLoadBalancer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancer", reflect.TypeOf((*MockTaggingManager)(nil).GetLoadBalancer), arg0, arg1)
+}
+
 // ListListenerRules mocks base method.
 func (m *MockTaggingManager) ListListenerRules(arg0 context.Context, arg1 string) ([]ListenerRuleWithTags, error) {
 	m.ctrl.T.Helper()
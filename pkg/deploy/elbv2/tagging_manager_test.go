@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -1608,3 +1609,52 @@ func Test_convertSDKTagsToTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_planReconcileTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		desiredTags map[string]string
+		opts        []ReconcileTagsOption
+		want        []plan.Call
+	}{
+		{
+			name:        "tags are up to date",
+			desiredTags: map[string]string{"keyA": "valueA"},
+			opts:        []ReconcileTagsOption{WithCurrentTags(map[string]string{"keyA": "valueA"})},
+			want:        nil,
+		},
+		{
+			name:        "tags to add and remove",
+			desiredTags: map[string]string{"keyA": "valueA", "keyB": "valueB2"},
+			opts: []ReconcileTagsOption{
+				WithCurrentTags(map[string]string{"keyB": "valueB", "keyC": "valueC", "keyD": "valueD"}),
+				WithIgnoredTagKeys([]string{"keyD"}),
+			},
+			want: []plan.Call{
+				{
+					API: "AddTags",
+					Input: &elbv2sdk.AddTagsInput{
+						ResourceArns: awssdk.StringSlice([]string{"arn"}),
+						Tags: []*elbv2sdk.Tag{
+							{Key: awssdk.String("keyA"), Value: awssdk.String("valueA")},
+							{Key: awssdk.String("keyB"), Value: awssdk.String("valueB2")},
+						},
+					},
+				},
+				{
+					API: "RemoveTags",
+					Input: &elbv2sdk.RemoveTagsInput{
+						ResourceArns: awssdk.StringSlice([]string{"arn"}),
+						TagKeys:      awssdk.StringSlice([]string{"keyC"}),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planReconcileTags("arn", tt.desiredTags, tt.opts...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
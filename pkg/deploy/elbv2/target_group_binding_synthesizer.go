@@ -3,21 +3,28 @@ package elbv2
 import (
 	"context"
 	"github.com/go-logr/logr"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewTargetGroupBindingSynthesizer constructs new targetGroupBindingSynthesizer
-func NewTargetGroupBindingSynthesizer(k8sClient client.Client, trackingProvider tracking.Provider, tgbManager TargetGroupBindingManager, logger logr.Logger, stack core.Stack) *targetGroupBindingSynthesizer {
+// up to maxConcurrency TargetGroupBindings are created, updated or deleted concurrently.
+func NewTargetGroupBindingSynthesizer(k8sClient client.Client, trackingProvider tracking.Provider, tgbManager TargetGroupBindingManager, logger logr.Logger,
+	maxConcurrency int, stack core.Stack) *targetGroupBindingSynthesizer {
 	return &targetGroupBindingSynthesizer{
 		k8sClient:        k8sClient,
 		trackingProvider: trackingProvider,
 		tgbManager:       tgbManager,
 		logger:           logger,
+		maxConcurrency:   maxConcurrency,
 		stack:            stack,
 
 		unmatchedK8sTGBs: nil,
@@ -30,6 +37,7 @@ type targetGroupBindingSynthesizer struct {
 	trackingProvider tracking.Provider
 	tgbManager       TargetGroupBindingManager
 	logger           logr.Logger
+	maxConcurrency   int
 	stack            core.Stack
 
 	unmatchedK8sTGBs []*elbv2api.TargetGroupBinding
@@ -49,30 +57,114 @@ func (s *targetGroupBindingSynthesizer) Synthesize(ctx context.Context) error {
 	}
 	s.unmatchedK8sTGBs = unmatchedK8sTGBs
 
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.maxConcurrency)
 	for _, resTGB := range unmatchedResTGBs {
-		tgbStatus, err := s.tgbManager.Create(ctx, resTGB)
-		if err != nil {
-			return err
-		}
-		resTGB.SetStatus(tgbStatus)
+		eg.Go(func() error {
+			tgbStatus, err := s.tgbManager.Create(egCtx, resTGB)
+			if err != nil {
+				return err
+			}
+			resTGB.SetStatus(tgbStatus)
+			return nil
+		})
 	}
 	for _, resAndK8sTGB := range matchedResAndK8sTGBs {
-		tgbStatus, err := s.tgbManager.Update(ctx, resAndK8sTGB.resTGB, resAndK8sTGB.k8sTGB)
-		if err != nil {
-			return err
-		}
-		resAndK8sTGB.resTGB.SetStatus(tgbStatus)
+		eg.Go(func() error {
+			tgbStatus, err := s.tgbManager.Update(egCtx, resAndK8sTGB.resTGB, resAndK8sTGB.k8sTGB)
+			if err != nil {
+				return err
+			}
+			resAndK8sTGB.resTGB.SetStatus(tgbStatus)
+			return nil
+		})
 	}
-	return nil
+	return eg.Wait()
 }
 
 func (s *targetGroupBindingSynthesizer) PostSynthesize(ctx context.Context) error {
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.maxConcurrency)
 	for _, k8sTGB := range s.unmatchedK8sTGBs {
-		if err := s.tgbManager.Delete(ctx, k8sTGB); err != nil {
-			return err
+		eg.Go(func() error {
+			return s.tgbManager.Delete(egCtx, k8sTGB)
+		})
+	}
+	return eg.Wait()
+}
+
+// Plan computes the changes to TargetGroupBindings of stack without applying them.
+func (s *targetGroupBindingSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resTGBs []*elbv2model.TargetGroupBindingResource
+	s.stack.ListResources(&resTGBs)
+	k8sTGBs, err := s.findK8sTargetGroupBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndK8sTGBs, unmatchedResTGBs, unmatchedK8sTGBs, err := matchResAndK8sTargetGroupBindings(resTGBs, k8sTGBs)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, resTGB := range unmatchedResTGBs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resTGB.Type(),
+			ResourceID:   resTGB.ID(),
+		})
+	}
+	for _, resAndK8sTGB := range matchedResAndK8sTGBs {
+		k8sTGBSpec, err := buildK8sTargetGroupBindingSpec(ctx, resAndK8sTGB.resTGB)
+		if err != nil {
+			return nil, err
+		}
+		if equality.Semantic.DeepEqual(resAndK8sTGB.k8sTGB.Spec, k8sTGBSpec) {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndK8sTGB.resTGB.Type(),
+			ResourceID:   resAndK8sTGB.resTGB.ID(),
+			Identifier:   k8s.NamespacedName(resAndK8sTGB.k8sTGB).String(),
+			Changes:      []string{"spec"},
+		})
+	}
+	for _, k8sTGB := range unmatchedK8sTGBs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "K8S::ElasticLoadBalancingV2::TargetGroupBinding",
+			Identifier:   k8s.NamespacedName(k8sTGB).String(),
+		})
+	}
+	return actions, nil
+}
+
+// Release releases TargetGroupBindings of stack.
+// with untrack, the tracking labels are removed from them so that they're no longer managed for stack.
+func (s *targetGroupBindingSynthesizer) Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error) {
+	k8sTGBs, err := s.findK8sTargetGroupBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var releasedResources []tracking.ReleasedResource
+	for _, k8sTGB := range k8sTGBs {
+		if untrack {
+			oldK8sTGB := k8sTGB.DeepCopy()
+			k8sTGB.Labels = tracking.UntrackedLabels(s.trackingProvider, s.stack, k8sTGB.Labels)
+			if err := s.k8sClient.Patch(ctx, k8sTGB, client.MergeFrom(oldK8sTGB)); err != nil {
+				return nil, err
+			}
+			s.logger.Info("untracked targetGroupBinding",
+				"stackID", s.stack.StackID().String(),
+				"targetGroupBinding", k8s.NamespacedName(k8sTGB))
 		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: "K8S::ElasticLoadBalancingV2::TargetGroupBinding",
+			Identifier:   k8s.NamespacedName(k8sTGB).String(),
+		})
 	}
-	return nil
+	return releasedResources, nil
 }
 
 func (s *targetGroupBindingSynthesizer) findK8sTargetGroupBindings(ctx context.Context) ([]*elbv2api.TargetGroupBinding, error) {
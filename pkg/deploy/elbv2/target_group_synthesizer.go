@@ -5,23 +5,28 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
 // NewTargetGroupSynthesizer constructs targetGroupSynthesizer
+// up to maxConcurrency TargetGroups are created, updated or deleted concurrently.
 func NewTargetGroupSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
-	tgManager TargetGroupManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *targetGroupSynthesizer {
+	tgManager TargetGroupManager, logger logr.Logger, featureGates config.FeatureGates, maxConcurrency int, stack core.Stack) *targetGroupSynthesizer {
 	return &targetGroupSynthesizer{
 		elbv2Client:      elbv2Client,
 		trackingProvider: trackingProvider,
 		taggingManager:   taggingManager,
 		tgManager:        tgManager,
 		featureGates:     featureGates,
+		maxConcurrency:   maxConcurrency,
 		logger:           logger,
 		stack:            stack,
 		unmatchedSDKTGs:  nil,
@@ -35,6 +40,7 @@ type targetGroupSynthesizer struct {
 	taggingManager   TaggingManager
 	tgManager        TargetGroupManager
 	featureGates     config.FeatureGates
+	maxConcurrency   int
 	logger           logr.Logger
 
 	stack           core.Stack
@@ -58,30 +64,139 @@ func (s *targetGroupSynthesizer) Synthesize(ctx context.Context) error {
 	// * unmatched targetGroups might still be use by a listener rule.
 	s.unmatchedSDKTGs = unmatchedSDKTGs
 
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.maxConcurrency)
 	for _, resTG := range unmatchedResTGs {
-		tgStatus, err := s.tgManager.Create(ctx, resTG)
-		if err != nil {
-			return err
-		}
-		resTG.SetStatus(tgStatus)
+		eg.Go(func() error {
+			tgStatus, err := s.tgManager.Create(egCtx, resTG)
+			if err != nil {
+				return err
+			}
+			resTG.SetStatus(tgStatus)
+			return nil
+		})
 	}
 	for _, resAndSDKTG := range matchedResAndSDKTGs {
-		tgStatus, err := s.tgManager.Update(ctx, resAndSDKTG.resTG, resAndSDKTG.sdkTG)
-		if err != nil {
-			return err
-		}
-		resAndSDKTG.resTG.SetStatus(tgStatus)
+		eg.Go(func() error {
+			tgStatus, err := s.tgManager.Update(egCtx, resAndSDKTG.resTG, resAndSDKTG.sdkTG)
+			if err != nil {
+				return err
+			}
+			resAndSDKTG.resTG.SetStatus(tgStatus)
+			return nil
+		})
 	}
-	return nil
+	return eg.Wait()
 }
 
 func (s *targetGroupSynthesizer) PostSynthesize(ctx context.Context) error {
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.maxConcurrency)
 	for _, sdkTG := range s.unmatchedSDKTGs {
-		if err := s.tgManager.Delete(ctx, sdkTG); err != nil {
-			return err
+		eg.Go(func() error {
+			return s.tgManager.Delete(egCtx, sdkTG)
+		})
+	}
+	return eg.Wait()
+}
+
+// Plan computes the changes to TargetGroups of stack without applying them.
+// resources of stack are resolved with the live TargetGroups or placeholders, so that planning of dependent resources can proceed.
+func (s *targetGroupSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resTGs []*elbv2model.TargetGroup
+	s.stack.ListResources(&resTGs)
+	sdkTGs, err := s.findSDKTargetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedResAndSDKTGs, unmatchedResTGs, unmatchedSDKTGs, err := matchResAndSDKTargetGroups(resTGs, sdkTGs,
+		s.trackingProvider.ResourceIDTagKey(), s.featureGates)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, resTG := range unmatchedResTGs {
+		resTG.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: plan.PlaceholderIdentifier(resTG)})
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeCreate,
+			ResourceType: resTG.Type(),
+			ResourceID:   resTG.ID(),
+		})
+	}
+	for _, resAndSDKTG := range matchedResAndSDKTGs {
+		resAndSDKTG.resTG.SetStatus(buildResTargetGroupStatus(resAndSDKTG.sdkTG))
+		changes, err := s.computeTargetGroupChanges(ctx, resAndSDKTG.resTG, resAndSDKTG.sdkTG)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeUpdate,
+			ResourceType: resAndSDKTG.resTG.Type(),
+			ResourceID:   resAndSDKTG.resTG.ID(),
+			Identifier:   awssdk.StringValue(resAndSDKTG.sdkTG.TargetGroup.TargetGroupArn),
+			Changes:      changes,
+		})
+	}
+	for _, sdkTG := range unmatchedSDKTGs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			Identifier:   awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+		})
+	}
+	return actions, nil
+}
+
+// computeTargetGroupChanges computes the settings of sdkTG that need to be updated to fulfill resTG.
+func (s *targetGroupSynthesizer) computeTargetGroupChanges(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) ([]string, error) {
+	var changes []string
+	desiredTags := s.trackingProvider.ResourceTags(resTG.Stack(), resTG, resTG.Spec.Tags)
+	if tagsToUpdate, _ := algorithm.DiffStringMap(desiredTags, sdkTG.Tags); len(tagsToUpdate) != 0 {
+		changes = append(changes, "tags")
+	}
+	if isSDKTargetGroupHealthCheckDrifted(resTG.Spec, sdkTG) {
+		changes = append(changes, "healthCheck")
+	}
+	attributesReconciler := NewDefaultTargetGroupAttributesReconciler(s.elbv2Client, s.logger)
+	desiredAttrs := attributesReconciler.getDesiredTargetGroupAttributes(ctx, resTG)
+	currentAttrs, err := attributesReconciler.getCurrentTargetGroupAttributes(ctx, sdkTG)
+	if err != nil {
+		return nil, err
+	}
+	if attrsToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs); len(attrsToUpdate) != 0 {
+		changes = append(changes, "attributes")
+	}
+	return changes, nil
+}
+
+// Release releases TargetGroups of stack.
+// with untrack, the tracking tags are removed from them so that they're no longer managed for stack.
+func (s *targetGroupSynthesizer) Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error) {
+	sdkTGs, err := s.findSDKTargetGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var releasedResources []tracking.ReleasedResource
+	for _, sdkTG := range sdkTGs {
+		tgARN := awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn)
+		if untrack {
+			if err := untrackSDKResource(ctx, s.taggingManager, s.trackingProvider, s.stack, tgARN, sdkTG.Tags); err != nil {
+				return nil, err
+			}
+			s.logger.Info("untracked targetGroup",
+				"stackID", s.stack.StackID().String(),
+				"arn", tgARN)
 		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			Identifier:   tgARN,
+		})
 	}
-	return nil
+	return releasedResources, nil
 }
 
 // findSDKTargetGroups will find all AWS TargetGroups created for stack.
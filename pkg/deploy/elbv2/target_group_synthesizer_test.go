@@ -1,14 +1,19 @@
 package elbv2
 
 import (
+	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
 
@@ -992,3 +997,58 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 		})
 	}
 }
+
+func Test_targetGroupSynthesizer_Release(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	sdkTGs := []TargetGroupWithTags{
+		{
+			TargetGroup: &elbv2sdk.TargetGroup{
+				TargetGroupArn: awssdk.String("arn-1"),
+			},
+			Tags: map[string]string{
+				"elbv2.k8s.aws/cluster":    "cluster-name",
+				"ingress.k8s.aws/stack":    "namespace/name",
+				"ingress.k8s.aws/resource": "namespace/name-svc:80",
+				"team":                     "my-team",
+			},
+		},
+	}
+	tests := []struct {
+		name                  string
+		untrack               bool
+		wantReconcileTagsCall bool
+	}{
+		{
+			name:                  "release with untrack",
+			untrack:               true,
+			wantReconcileTagsCall: true,
+		},
+		{
+			name:                  "release without untrack",
+			untrack:               false,
+			wantReconcileTagsCall: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			taggingManager := NewMockTaggingManager(ctrl)
+			taggingManager.EXPECT().ListTargetGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkTGs, nil)
+			if tt.wantReconcileTagsCall {
+				taggingManager.EXPECT().ReconcileTags(gomock.Any(), "arn-1", map[string]string{"team": "my-team"}, gomock.Any()).Return(nil)
+			}
+			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+			synthesizer := NewTargetGroupSynthesizer(nil, trackingProvider, taggingManager, nil, logr.New(&log.NullLogSink{}), nil, 1, stack)
+			got, err := synthesizer.Release(context.Background(), tt.untrack)
+			assert.NoError(t, err)
+			assert.Equal(t, []tracking.ReleasedResource{
+				{
+					ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+					Identifier:   "arn-1",
+				},
+			}, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package plan
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMapDataKeyPlan is the ConfigMap data key of the plan JSON.
+	ConfigMapDataKeyPlan = "plan.json"
+	// ConfigMapDataKeySummary is the ConfigMap data key of the plan summary.
+	ConfigMapDataKeySummary = "summary"
+)
+
+// ConfigMapWriter writes plans into ConfigMaps, so that they can be retrieved for review.
+type ConfigMapWriter interface {
+	// Write writes the plan into the ConfigMap with cmKey, the ConfigMap will be created if it doesn't exist.
+	Write(ctx context.Context, cmKey types.NamespacedName, p Plan) error
+}
+
+// NewDefaultConfigMapWriter constructs new defaultConfigMapWriter.
+func NewDefaultConfigMapWriter(k8sClient client.Client) *defaultConfigMapWriter {
+	return &defaultConfigMapWriter{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ ConfigMapWriter = &defaultConfigMapWriter{}
+
+// default implementation for ConfigMapWriter
+type defaultConfigMapWriter struct {
+	k8sClient client.Client
+}
+
+func (w *defaultConfigMapWriter) Write(ctx context.Context, cmKey types.NamespacedName, p Plan) error {
+	planJSON, err := Marshal(p)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{
+		ConfigMapDataKeyPlan:    planJSON,
+		ConfigMapDataKeySummary: p.Summary(),
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := w.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get plan configMap: %v", cmKey)
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+			},
+			Data: data,
+		}
+		if err := w.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create plan configMap: %v", cmKey)
+		}
+		return nil
+	}
+
+	cmOld := cm.DeepCopy()
+	cm.Data = data
+	if err := w.k8sClient.Patch(ctx, cm, client.MergeFrom(cmOld)); err != nil {
+		return errors.Wrapf(err, "failed to update plan configMap: %v", cmKey)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultConfigMapWriter_Write(t *testing.T) {
+	cmKey := types.NamespacedName{Namespace: "awesome-ns", Name: "plan"}
+	p := Plan{
+		StackID: "awesome-ns/ing",
+		Actions: []Action{
+			{Type: ActionTypeCreate, ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer", ResourceID: "LoadBalancer"},
+		},
+	}
+	tests := []struct {
+		name       string
+		existingCM *corev1.ConfigMap
+		wantLabels map[string]string
+	}{
+		{
+			name: "configMap doesn't exist",
+		},
+		{
+			name: "configMap exists",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "plan",
+					Labels:    map[string]string{"app": "awesome"},
+				},
+				Data: map[string]string{
+					ConfigMapDataKeyPlan: `{"stackID":"awesome-ns/ing","actions":[]}`,
+					"other":              "value",
+				},
+			},
+			wantLabels: map[string]string{"app": "awesome"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+
+			w := NewDefaultConfigMapWriter(k8sClient)
+			err := w.Write(ctx, cmKey, p)
+			assert.NoError(t, err)
+
+			gotCM := &corev1.ConfigMap{}
+			assert.NoError(t, k8sClient.Get(ctx, cmKey, gotCM))
+			assert.Equal(t, tt.wantLabels, gotCM.Labels)
+			assert.Equal(t, map[string]string{
+				ConfigMapDataKeyPlan:    `{"stackID":"awesome-ns/ing","actions":[{"type":"create","resourceType":"AWS::ElasticLoadBalancingV2::LoadBalancer","resourceID":"LoadBalancer"}]}`,
+				ConfigMapDataKeySummary: "1 to create, 0 to update, 0 to delete",
+			}, gotCM.Data)
+		})
+	}
+}
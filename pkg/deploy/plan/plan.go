@@ -0,0 +1,102 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// ActionType is the type of change planned for a resource.
+type ActionType string
+
+const (
+	ActionTypeCreate ActionType = "create"
+	ActionTypeUpdate ActionType = "update"
+	ActionTypeDelete ActionType = "delete"
+)
+
+// Action is a change that would be applied to a resource when deploying a stack.
+type Action struct {
+	// Type is the type of change.
+	Type ActionType `json:"type"`
+
+	// ResourceType is the type of the resource, e.g. AWS::ElasticLoadBalancingV2::LoadBalancer.
+	ResourceType string `json:"resourceType"`
+
+	// ResourceID is the ID of the resource within stack, it's empty for resources to be deleted.
+	// +optional
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// Identifier identifies the existing resource, e.g. the ARN of ELBv2 resources or the ID of SecurityGroups.
+	// +optional
+	Identifier string `json:"identifier,omitempty"`
+
+	// Changes are the settings that would be modified by an update.
+	// +optional
+	Changes []string `json:"changes,omitempty"`
+
+	// Calls are the AWS API calls that would be made to apply the change, in the order they would be made.
+	// +optional
+	Calls []Call `json:"calls,omitempty"`
+}
+
+// Call is an AWS API call that would be made when deploying a stack.
+type Call struct {
+	// API is the name of the AWS API, e.g. ModifyListener.
+	API string `json:"api"`
+
+	// Input is the request of the API call.
+	Input interface{} `json:"input"`
+}
+
+// CallAPIs returns the names of APIs of calls, it's used as the changes of an action derived from its calls.
+func CallAPIs(calls []Call) []string {
+	apis := make([]string, 0, len(calls))
+	for _, call := range calls {
+		apis = append(apis, call.API)
+	}
+	return apis
+}
+
+// Plan is the set of changes that would be applied to deploy a stack.
+type Plan struct {
+	// StackID is the ID of the planned stack.
+	StackID string `json:"stackID"`
+
+	// Actions are the planned changes in the order they would be applied.
+	Actions []Action `json:"actions"`
+}
+
+// Summary returns a human readable summary of the plan.
+func (p Plan) Summary() string {
+	countByType := make(map[ActionType]int)
+	for _, action := range p.Actions {
+		countByType[action.Type]++
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete",
+		countByType[ActionTypeCreate], countByType[ActionTypeUpdate], countByType[ActionTypeDelete])
+}
+
+// Marshal marshals the plan into JSON.
+func Marshal(p Plan) (string, error) {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+const placeholderIdentifierPrefix = "planned:"
+
+// PlaceholderIdentifier returns the placeholder identifier for a resource that would be created.
+// it's set into the resource status during planning, so that references from other resources of stack can be resolved.
+func PlaceholderIdentifier(res core.Resource) string {
+	return fmt.Sprintf("%s%s/%s", placeholderIdentifierPrefix, res.Type(), res.ID())
+}
+
+// IsPlaceholderIdentifier checks whether identifier is the placeholder of a resource that would be created.
+func IsPlaceholderIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, placeholderIdentifierPrefix)
+}
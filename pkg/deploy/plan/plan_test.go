@@ -0,0 +1,78 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func TestPlan_Summary(t *testing.T) {
+	tests := []struct {
+		name string
+		plan Plan
+		want string
+	}{
+		{
+			name: "empty plan",
+			plan: Plan{},
+			want: "0 to create, 0 to update, 0 to delete",
+		},
+		{
+			name: "plan with actions",
+			plan: Plan{
+				Actions: []Action{
+					{Type: ActionTypeCreate, ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup", ResourceID: "tg-1"},
+					{Type: ActionTypeCreate, ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup", ResourceID: "tg-2"},
+					{Type: ActionTypeUpdate, ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer", ResourceID: "LoadBalancer", Changes: []string{"tags"}},
+					{Type: ActionTypeDelete, ResourceType: "AWS::ElasticLoadBalancingV2::Listener", Identifier: "my-listener-arn"},
+				},
+			},
+			want: "2 to create, 1 to update, 1 to delete",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.plan.Summary())
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	p := Plan{
+		StackID: "namespace/name",
+		Actions: []Action{
+			{Type: ActionTypeUpdate, ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer", ResourceID: "LoadBalancer", Identifier: "my-lb-arn", Changes: []string{"subnets"}},
+			{Type: ActionTypeDelete, ResourceType: "AWS::ElasticLoadBalancingV2::Listener", Identifier: "my-listener-arn"},
+		},
+	}
+	got, err := Marshal(p)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+  "stackID": "namespace/name",
+  "actions": [
+    {
+      "type": "update",
+      "resourceType": "AWS::ElasticLoadBalancingV2::LoadBalancer",
+      "resourceID": "LoadBalancer",
+      "identifier": "my-lb-arn",
+      "changes": ["subnets"]
+    },
+    {
+      "type": "delete",
+      "resourceType": "AWS::ElasticLoadBalancingV2::Listener",
+      "identifier": "my-listener-arn"
+    }
+  ]
+}`, got)
+}
+
+func TestPlaceholderIdentifier(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	tg := elbv2model.NewTargetGroup(stack, "namespace/name:80", elbv2model.TargetGroupSpec{})
+	got := PlaceholderIdentifier(tg)
+	assert.Equal(t, "planned:AWS::ElasticLoadBalancingV2::TargetGroup/namespace/name:80", got)
+	assert.True(t, IsPlaceholderIdentifier(got))
+	assert.False(t, IsPlaceholderIdentifier("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"))
+}
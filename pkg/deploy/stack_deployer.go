@@ -2,11 +2,13 @@ package deploy
 
 import (
 	"context"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional"
@@ -20,6 +22,13 @@ import (
 type StackDeployer interface {
 	// Deploy a resource stack.
 	Deploy(ctx context.Context, stack core.Stack) error
+
+	// Plan computes the changes to deploy a resource stack without applying them.
+	Plan(ctx context.Context, stack core.Stack) (plan.Plan, error)
+
+	// Release releases the resources deployed for a resource stack.
+	// with untrack, the tracking tags and labels are removed from them so that they're no longer managed by the controller.
+	Release(ctx context.Context, stack core.Stack, untrack bool) ([]tracking.ReleasedResource, error)
 }
 
 // NewDefaultStackDeployer constructs new defaultStackDeployer.
@@ -30,6 +39,7 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 
 	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
 	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
+	wafv2WebACLAssociationManager := wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger)
 
 	return &defaultStackDeployer{
 		cloud:                               cloud,
@@ -44,15 +54,28 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
-		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
+		wafv2WebACLManager:                  wafv2.NewDefaultWebACLManager(cloud.WAFv2(), cloud.RGT(), wafv2WebACLAssociationManager, logger),
+		wafv2WebACLAssociationManager:       wafv2WebACLAssociationManager,
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
 		featureGates:                        config.FeatureGates,
+		elbv2MaxConcurrency:                 config.AWSConfig.ThrottleConfig.MaxConcurrency(elbv2sdk.ServiceID, config.DeployMaxConcurrency),
+		k8sMaxConcurrency:                   max(config.DeployMaxConcurrency, 1),
 		vpcID:                               cloud.VpcID(),
 		logger:                              logger,
 	}
 }
 
+// NewUncachedStackDeployer constructs new defaultStackDeployer that calls AWS through the clients of cloud without the cache of Describe calls.
+// stacks planned by it observe the changes made outside the controller right away, e.g. to detect drift.
+func NewUncachedStackDeployer(cloud aws.Cloud, k8sClient client.Client, config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackDeployer {
+	uncachedCloud := aws.NewUncachedCloud(cloud)
+	networkingSGManager := networking.NewDefaultSecurityGroupManager(uncachedCloud.EC2(), logger)
+	networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, logger)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(uncachedCloud.ELBV2(), uncachedCloud.VpcID(), config.FeatureGates, uncachedCloud.RGT(), logger)
+	return NewDefaultStackDeployer(uncachedCloud, k8sClient, networkingSGManager, networkingSGReconciler, elbv2TaggingManager, config, tagPrefix, logger)
+}
+
 var _ StackDeployer = &defaultStackDeployer{}
 
 // defaultStackDeployer is the default implementation for StackDeployer
@@ -69,11 +92,16 @@ type defaultStackDeployer struct {
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
+	wafv2WebACLManager                  wafv2.WebACLManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
 	featureGates                        config.FeatureGates
-	vpcID                               string
+	// elbv2MaxConcurrency is the maximum number of ELBV2 resources synthesized concurrently, which respects the throttle config of ELBV2 APIs.
+	elbv2MaxConcurrency int
+	// k8sMaxConcurrency is the maximum number of Kubernetes resources synthesized concurrently.
+	k8sMaxConcurrency int
+	vpcID             string
 
 	logger logr.Logger
 }
@@ -83,42 +111,127 @@ type ResourceSynthesizer interface {
 	PostSynthesize(ctx context.Context) error
 }
 
+// ResourcePlanner computes the changes to resources of a stack against live state without applying them.
+type ResourcePlanner interface {
+	Plan(ctx context.Context) ([]plan.Action, error)
+}
+
+// ResourceReleaser releases resources of a stack.
+type ResourceReleaser interface {
+	Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error)
+}
+
 // Deploy a resource stack.
 func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
-	synthesizers := []ResourceSynthesizer{
-		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
-		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack),
-		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
-		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
-		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
-		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
+	synthesizers := []typedResourceSynthesizer{
+		{
+			resType:     resTypeSecurityGroup,
+			synthesizer: ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
+		},
+		{
+			resType:     resTypeTargetGroup,
+			synthesizer: elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, d.elbv2MaxConcurrency, stack),
+		},
+		{
+			resType:     resTypeLoadBalancer,
+			synthesizer: elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
+		},
+		{
+			resType:     resTypeListener,
+			synthesizer: elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LSManager, d.logger, d.featureGates, stack),
+		},
+		{
+			resType:     resTypeListenerRule,
+			synthesizer: elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LRManager, d.logger, d.featureGates, d.elbv2MaxConcurrency, stack),
+		},
+		{
+			resType:     resTypeTargetGroupBinding,
+			synthesizer: elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, d.k8sMaxConcurrency, stack),
+		},
 	}
 
 	if d.addonsConfig.WAFV2Enabled {
-		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
+		synthesizers = append(synthesizers, typedResourceSynthesizer{
+			resType:     resTypeWAFv2WebACL,
+			synthesizer: wafv2.NewWebACLSynthesizer(d.wafv2WebACLManager, d.trackingProvider, d.logger, stack),
+		}, typedResourceSynthesizer{
+			resType:     resTypeWAFv2WebACLAssociation,
+			synthesizer: wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack),
+		})
 	}
 	if d.addonsConfig.WAFEnabled && d.cloud.WAFRegional().Available() {
-		synthesizers = append(synthesizers, wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack))
+		synthesizers = append(synthesizers, typedResourceSynthesizer{
+			resType:     resTypeWAFRegionalACLAssociation,
+			synthesizer: wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack),
+		})
 	}
 	if d.addonsConfig.ShieldEnabled {
 		shieldSubscribed, err := d.shieldProtectionManager.IsSubscribed(ctx)
 		if err != nil {
 			d.logger.Error(err, "unable to determine AWS Shield subscription state, skipping AWS shield reconciliation")
 		} else if shieldSubscribed {
-			synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack))
+			synthesizers = append(synthesizers, typedResourceSynthesizer{
+				resType:     resTypeShieldProtection,
+				synthesizer: shield.NewProtectionSynthesizer(d.shieldProtectionManager, d.logger, stack),
+			})
 		}
 	}
 
-	for _, synthesizer := range synthesizers {
-		if err := synthesizer.Synthesize(ctx); err != nil {
-			return err
-		}
+	return synthesizeResources(ctx, synthesizers)
+}
+
+// Plan computes the changes to deploy a resource stack without applying them.
+// actions are grouped by resource type in the order they're synthesized, WAF Classic and Shield addons are not planned.
+func (d *defaultStackDeployer) Plan(ctx context.Context, stack core.Stack) (plan.Plan, error) {
+	planners := []ResourcePlanner{
+		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
+		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, d.elbv2MaxConcurrency, stack),
+		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
+		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LSManager, d.logger, d.featureGates, stack),
+		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LRManager, d.logger, d.featureGates, d.elbv2MaxConcurrency, stack),
+		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, d.k8sMaxConcurrency, stack),
 	}
-	for i := len(synthesizers) - 1; i >= 0; i-- {
-		if err := synthesizers[i].PostSynthesize(ctx); err != nil {
-			return err
+	if d.addonsConfig.WAFV2Enabled {
+		planners = append(planners,
+			wafv2.NewWebACLSynthesizer(d.wafv2WebACLManager, d.trackingProvider, d.logger, stack),
+			wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack),
+		)
+	}
+
+	stackPlan := plan.Plan{
+		StackID: stack.StackID().String(),
+		Actions: []plan.Action{},
+	}
+	for _, planner := range planners {
+		actions, err := planner.Plan(ctx)
+		if err != nil {
+			return plan.Plan{}, err
 		}
+		stackPlan.Actions = append(stackPlan.Actions, actions...)
 	}
+	return stackPlan, nil
+}
 
-	return nil
+// Release releases the resources deployed for a resource stack, they're left in place rather than deleted.
+// WAFv2 webACLs provisioned for the stack are released as well, WAF and Shield associations are left in place along with the LoadBalancers.
+func (d *defaultStackDeployer) Release(ctx context.Context, stack core.Stack, untrack bool) ([]tracking.ReleasedResource, error) {
+	releasers := []ResourceReleaser{
+		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
+		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, d.elbv2MaxConcurrency, stack),
+		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
+		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, d.k8sMaxConcurrency, stack),
+	}
+	if d.addonsConfig.WAFV2Enabled {
+		releasers = append(releasers, wafv2.NewWebACLSynthesizer(d.wafv2WebACLManager, d.trackingProvider, d.logger, stack))
+	}
+
+	var releasedResources []tracking.ReleasedResource
+	for _, releaser := range releasers {
+		resources, err := releaser.Release(ctx, untrack)
+		if err != nil {
+			return nil, err
+		}
+		releasedResources = append(releasedResources, resources...)
+	}
+	return releasedResources, nil
 }
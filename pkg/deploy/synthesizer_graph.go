@@ -0,0 +1,90 @@
+package deploy
+
+import (
+	"context"
+	"reflect"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core/graph"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
+	wafregionalmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafregional"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+var (
+	resTypeSecurityGroup             = reflect.TypeOf(&ec2model.SecurityGroup{})
+	resTypeTargetGroup               = reflect.TypeOf(&elbv2model.TargetGroup{})
+	resTypeLoadBalancer              = reflect.TypeOf(&elbv2model.LoadBalancer{})
+	resTypeListener                  = reflect.TypeOf(&elbv2model.Listener{})
+	resTypeListenerRule              = reflect.TypeOf(&elbv2model.ListenerRule{})
+	resTypeTargetGroupBinding        = reflect.TypeOf(&elbv2model.TargetGroupBindingResource{})
+	resTypeWAFv2WebACL               = reflect.TypeOf(&wafv2model.WebACL{})
+	resTypeWAFv2WebACLAssociation    = reflect.TypeOf(&wafv2model.WebACLAssociation{})
+	resTypeWAFRegionalACLAssociation = reflect.TypeOf(&wafregionalmodel.WebACLAssociation{})
+	resTypeShieldProtection          = reflect.TypeOf(&shieldmodel.Protection{})
+)
+
+// resTypeDependencies are the resource types that resources of each type can depend on.
+// resources of a type are synthesized after the resource types they depend on, and post synthesized before them.
+// resource types without dependency between them are synthesized concurrently.
+var resTypeDependencies = map[reflect.Type][]reflect.Type{
+	resTypeLoadBalancer:       {resTypeSecurityGroup},
+	resTypeListener:           {resTypeLoadBalancer, resTypeTargetGroup},
+	resTypeListenerRule:       {resTypeListener, resTypeTargetGroup},
+	resTypeTargetGroupBinding: {resTypeTargetGroup, resTypeSecurityGroup},
+	// webACLs are post synthesized before LoadBalancers, so that they're disassociated from LoadBalancers being deleted.
+	resTypeWAFv2WebACL:               {resTypeLoadBalancer},
+	resTypeWAFv2WebACLAssociation:    {resTypeLoadBalancer, resTypeWAFv2WebACL},
+	resTypeWAFRegionalACLAssociation: {resTypeLoadBalancer},
+	resTypeShieldProtection:          {resTypeLoadBalancer},
+}
+
+// typedResourceSynthesizer is a ResourceSynthesizer along with the type of resources it synthesizes.
+type typedResourceSynthesizer struct {
+	resType     reflect.Type
+	synthesizer ResourceSynthesizer
+}
+
+// synthesizeResources synthesizes resources with synthesizers in the order of resTypeDependencies,
+// and then post synthesizes them in the reverse order.
+func synthesizeResources(ctx context.Context, synthesizers []typedResourceSynthesizer) error {
+	synthesizerByResType := make(map[reflect.Type]ResourceSynthesizer, len(synthesizers))
+	for _, synthesizer := range synthesizers {
+		synthesizerByResType[synthesizer.resType] = synthesizer.synthesizer
+	}
+	if err := graph.ParallelTopologicalTraversal(buildSynthesizerGraph(synthesizers, false), len(synthesizers), func(uid graph.ResourceUID) error {
+		return synthesizerByResType[uid.ResType].Synthesize(ctx)
+	}); err != nil {
+		return err
+	}
+	return graph.ParallelTopologicalTraversal(buildSynthesizerGraph(synthesizers, true), len(synthesizers), func(uid graph.ResourceUID) error {
+		return synthesizerByResType[uid.ResType].PostSynthesize(ctx)
+	})
+}
+
+// buildSynthesizerGraph builds the dependency graph between synthesizers, where edges point from a resource type to the
+// resource types depend on it. the edges are reversed with reverse.
+func buildSynthesizerGraph(synthesizers []typedResourceSynthesizer, reverse bool) graph.ResourceGraph {
+	synthesizerGraph := graph.NewDefaultResourceGraph()
+	synthesizedResTypes := make(map[reflect.Type]bool, len(synthesizers))
+	for _, synthesizer := range synthesizers {
+		synthesizerGraph.AddNode(graph.ResourceUID{ResType: synthesizer.resType})
+		synthesizedResTypes[synthesizer.resType] = true
+	}
+	for _, synthesizer := range synthesizers {
+		for _, dependee := range resTypeDependencies[synthesizer.resType] {
+			if !synthesizedResTypes[dependee] {
+				continue
+			}
+			dependeeUID := graph.ResourceUID{ResType: dependee}
+			dependerUID := graph.ResourceUID{ResType: synthesizer.resType}
+			if reverse {
+				synthesizerGraph.AddEdge(dependerUID, dependeeUID)
+			} else {
+				synthesizerGraph.AddEdge(dependeeUID, dependerUID)
+			}
+		}
+	}
+	return synthesizerGraph
+}
@@ -0,0 +1,106 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSynthesizer records the synthesize and post synthesize of resource type into events.
+type fakeSynthesizer struct {
+	resType reflect.Type
+	events  *synthesizeEvents
+	err     error
+}
+
+func (s *fakeSynthesizer) Synthesize(_ context.Context) error {
+	s.events.record("synthesize", s.resType)
+	return s.err
+}
+
+func (s *fakeSynthesizer) PostSynthesize(_ context.Context) error {
+	s.events.record("postSynthesize", s.resType)
+	return nil
+}
+
+type synthesizeEvents struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+func (e *synthesizeEvents) record(phase string, resType reflect.Type) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.events = append(e.events, phase+":"+resType.String())
+}
+
+func (e *synthesizeEvents) indexOf(phase string, resType reflect.Type) int {
+	for idx, event := range e.events {
+		if event == phase+":"+resType.String() {
+			return idx
+		}
+	}
+	return -1
+}
+
+func Test_synthesizeResources(t *testing.T) {
+	resTypes := []reflect.Type{
+		resTypeSecurityGroup,
+		resTypeTargetGroup,
+		resTypeLoadBalancer,
+		resTypeListener,
+		resTypeListenerRule,
+		resTypeTargetGroupBinding,
+		resTypeWAFv2WebACL,
+		resTypeWAFv2WebACLAssociation,
+		resTypeShieldProtection,
+	}
+	t.Run("resources are synthesized in dependency order", func(t *testing.T) {
+		events := &synthesizeEvents{}
+		var synthesizers []typedResourceSynthesizer
+		for _, resType := range resTypes {
+			synthesizers = append(synthesizers, typedResourceSynthesizer{
+				resType:     resType,
+				synthesizer: &fakeSynthesizer{resType: resType, events: events},
+			})
+		}
+		err := synthesizeResources(context.Background(), synthesizers)
+		assert.NoError(t, err)
+		assert.Len(t, events.events, 2*len(resTypes))
+		for _, resType := range resTypes {
+			for _, dependee := range resTypeDependencies[resType] {
+				assert.Less(t, events.indexOf("synthesize", dependee), events.indexOf("synthesize", resType),
+					"%v synthesized before %v", resType, dependee)
+				assert.Greater(t, events.indexOf("postSynthesize", dependee), events.indexOf("postSynthesize", resType),
+					"%v post synthesized after %v", resType, dependee)
+			}
+			assert.Less(t, events.indexOf("synthesize", resType), events.indexOf("postSynthesize", resTypeSecurityGroup))
+		}
+	})
+	t.Run("resources depend on failed synthesizer are not synthesized", func(t *testing.T) {
+		events := &synthesizeEvents{}
+		var synthesizers []typedResourceSynthesizer
+		for _, resType := range resTypes {
+			synthesizer := &fakeSynthesizer{resType: resType, events: events}
+			if resType == resTypeLoadBalancer {
+				synthesizer.err = errors.New("failed to synthesize LoadBalancer")
+			}
+			synthesizers = append(synthesizers, typedResourceSynthesizer{
+				resType:     resType,
+				synthesizer: synthesizer,
+			})
+		}
+		err := synthesizeResources(context.Background(), synthesizers)
+		assert.EqualError(t, err, "failed to synthesize LoadBalancer")
+		for _, resType := range []reflect.Type{resTypeListener, resTypeListenerRule, resTypeShieldProtection} {
+			assert.Equal(t, -1, events.indexOf("synthesize", resType))
+		}
+		for _, resType := range resTypes {
+			assert.Equal(t, -1, events.indexOf("postSynthesize", resType))
+		}
+	})
+}
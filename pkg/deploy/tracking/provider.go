@@ -35,6 +35,10 @@ import (
 // AWS TagKey for cluster resources.
 const clusterNameTagKey = "elbv2.k8s.aws/cluster"
 
+// AdoptedTagKey is the AWS TagKey for existing resources that are adopted rather than created by this controller.
+// adopted resources are released instead of deleted once they're no longer desired.
+const AdoptedTagKey = "elbv2.k8s.aws/adopted"
+
 // Legacy AWS TagKey for cluster resources, which is used by AWSALBIngressController(v1.1.3+)
 const clusterNameTagKeyLegacy = "ingress.k8s.aws/cluster"
 
@@ -0,0 +1,49 @@
+package tracking
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// ReleasedResource identifies a released resource that was provisioned for stack.
+type ReleasedResource struct {
+	// ResourceType is the type of the resource, e.g. AWS::ElasticLoadBalancingV2::LoadBalancer.
+	ResourceType string `json:"resourceType"`
+
+	// Identifier is the ARN or ID of the resource.
+	Identifier string `json:"identifier"`
+}
+
+// String returns a human readable representation of the released resource.
+func (r ReleasedResource) String() string {
+	return fmt.Sprintf("%s(%s)", r.ResourceType, r.Identifier)
+}
+
+// UntrackedTags returns the tags with the tracking tags for stack removed.
+// resources with untracked tags are no longer identified as provisioned for stack.
+func UntrackedTags(provider Provider, stack core.Stack, tags map[string]string) map[string]string {
+	trackingTagKeys := sets.StringKeySet(provider.StackTags(stack)).
+		Union(sets.StringKeySet(provider.StackTagsLegacy(stack))).
+		Insert(provider.ResourceIDTagKey(), AdoptedTagKey)
+	untrackedTags := make(map[string]string, len(tags))
+	for tagKey, tagValue := range tags {
+		if !trackingTagKeys.Has(tagKey) {
+			untrackedTags[tagKey] = tagValue
+		}
+	}
+	return untrackedTags
+}
+
+// UntrackedLabels returns the labels with the tracking labels for stack removed.
+func UntrackedLabels(provider Provider, stack core.Stack, labels map[string]string) map[string]string {
+	trackingLabelKeys := sets.StringKeySet(provider.StackLabels(stack))
+	untrackedLabels := make(map[string]string, len(labels))
+	for labelKey, labelValue := range labels {
+		if !trackingLabelKeys.Has(labelKey) {
+			untrackedLabels[labelKey] = labelValue
+		}
+	}
+	return untrackedLabels
+}
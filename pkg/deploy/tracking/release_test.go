@@ -0,0 +1,106 @@
+package tracking
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"testing"
+)
+
+func TestUntrackedTags(t *testing.T) {
+	type args struct {
+		stack core.Stack
+		tags  map[string]string
+	}
+	tests := []struct {
+		name     string
+		provider *defaultProvider
+		args     args
+		want     map[string]string
+	}{
+		{
+			name:     "tracking tags are removed",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args: args{
+				stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "ingressName"}),
+				tags: map[string]string{
+					"elbv2.k8s.aws/cluster":    "cluster-name",
+					"ingress.k8s.aws/cluster":  "cluster-name",
+					"ingress.k8s.aws/stack":    "namespace/ingressName",
+					"ingress.k8s.aws/resource": "LoadBalancer",
+					"elbv2.k8s.aws/adopted":    "true",
+					"team":                     "my-team",
+				},
+			},
+			want: map[string]string{
+				"team": "my-team",
+			},
+		},
+		{
+			name:     "tracking tags of other controllers are kept",
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			args: args{
+				stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"}),
+				tags: map[string]string{
+					"elbv2.k8s.aws/cluster": "cluster-name",
+					"service.k8s.aws/stack": "namespace/serviceName",
+					"ingress.k8s.aws/stack": "namespace/ingressName",
+				},
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/stack": "namespace/ingressName",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UntrackedTags(tt.provider, tt.args.stack, tt.args.tags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUntrackedLabels(t *testing.T) {
+	type args struct {
+		stack  core.Stack
+		labels map[string]string
+	}
+	tests := []struct {
+		name     string
+		provider *defaultProvider
+		args     args
+		want     map[string]string
+	}{
+		{
+			name:     "tracking labels for explicit IngressGroup are removed",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			args: args{
+				stack: core.NewDefaultStack(core.StackID{Namespace: "", Name: "awesome-group"}),
+				labels: map[string]string{
+					"ingress.k8s.aws/stack": "awesome-group",
+					"app":                   "my-app",
+				},
+			},
+			want: map[string]string{
+				"app": "my-app",
+			},
+		},
+		{
+			name:     "tracking labels for Service are removed",
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			args: args{
+				stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"}),
+				labels: map[string]string{
+					"service.k8s.aws/stack-namespace": "namespace",
+					"service.k8s.aws/stack-name":      "serviceName",
+				},
+			},
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UntrackedLabels(tt.provider, tt.args.stack, tt.args.labels)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
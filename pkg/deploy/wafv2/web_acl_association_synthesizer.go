@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
 )
@@ -53,7 +54,10 @@ func (s *webACLAssociationSynthesizer) synthesizeWebACLAssociationsOnLB(ctx cont
 	if len(resAssociations) != 1 {
 		return errors.Errorf("[should never happen] should be exactly one WAFv2 webACL association on LoadBalancer: %v", lbARN)
 	}
-	desiredWebACLARN := resAssociations[0].Spec.WebACLARN
+	desiredWebACLARN, err := resAssociations[0].Spec.WebACLARN.Resolve(ctx)
+	if err != nil {
+		return err
+	}
 	currentWebACLARN, err := s.associationManager.GetAssociatedWebACL(ctx, lbARN)
 	if err != nil {
 		return errors.Wrap(err, "failed to get WAFv2 webACL association on LoadBalancer")
@@ -75,6 +79,50 @@ func (s *webACLAssociationSynthesizer) synthesizeWebACLAssociationsOnLB(ctx cont
 	return nil
 }
 
+func (s *webACLAssociationSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resAssociations []*wafv2model.WebACLAssociation
+	if err := s.stack.ListResources(&resAssociations); err != nil {
+		return nil, fmt.Errorf("[should never happen] failed to list resources: %w", err)
+	}
+	var actions []plan.Action
+	for _, resAssociation := range resAssociations {
+		desiredWebACLARN, err := resAssociation.Spec.WebACLARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbARN, err := resAssociation.Spec.ResourceARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// LoadBalancers to be created have no webACL associated yet.
+		currentWebACLARN := ""
+		if !plan.IsPlaceholderIdentifier(lbARN) {
+			currentWebACLARN, err = s.associationManager.GetAssociatedWebACL(ctx, lbARN)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get WAFv2 webACL association on LoadBalancer")
+			}
+		}
+		action := plan.Action{
+			ResourceType: resAssociation.Type(),
+			ResourceID:   resAssociation.ID(),
+			Identifier:   lbARN,
+		}
+		switch {
+		case desiredWebACLARN == "" && currentWebACLARN != "":
+			action.Type = plan.ActionTypeDelete
+		case desiredWebACLARN != "" && currentWebACLARN == "":
+			action.Type = plan.ActionTypeCreate
+		case desiredWebACLARN != "" && desiredWebACLARN != currentWebACLARN:
+			action.Type = plan.ActionTypeUpdate
+			action.Changes = []string{"webACL"}
+		default:
+			continue
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
 func mapResWebACLAssociationByResourceARN(resAssociations []*wafv2model.WebACLAssociation) (map[string][]*wafv2model.WebACLAssociation, error) {
 	resAssociationsByResARN := make(map[string][]*wafv2model.WebACLAssociation, len(resAssociations))
 	ctx := context.Background()
@@ -50,7 +50,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "web-acl-arn-1",
+						WebACLARN:   core.LiteralStringToken("web-acl-arn-1"),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -68,7 +68,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "web-acl-arn-1",
+						WebACLARN:   core.LiteralStringToken("web-acl-arn-1"),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -92,7 +92,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "web-acl-arn-1",
+						WebACLARN:   core.LiteralStringToken("web-acl-arn-1"),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -116,7 +116,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "",
+						WebACLARN:   core.LiteralStringToken(""),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -139,7 +139,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "web-acl-arn-1",
+						WebACLARN:   core.LiteralStringToken("web-acl-arn-1"),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -159,7 +159,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "web-acl-arn-1",
+						WebACLARN:   core.LiteralStringToken("web-acl-arn-1"),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
@@ -186,7 +186,7 @@ func Test_webACLAssociationSynthesizer_Synthesize(t *testing.T) {
 			fields: fields{
 				webACLAssociationSpecs: []wafv2model.WebACLAssociationSpec{
 					{
-						WebACLARN:   "",
+						WebACLARN:   core.LiteralStringToken(""),
 						ResourceARN: core.LiteralStringToken("some-lb-arn"),
 					},
 				},
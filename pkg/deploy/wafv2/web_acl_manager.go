@@ -0,0 +1,496 @@
+package wafv2
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+const (
+	// the resource type filter of RGT API for WAFv2 resources.
+	rgtResourceTypeWAFv2 = "wafv2"
+
+	resourceTypeWebACL = "webacl"
+	resourceTypeIPSet  = "ipset"
+)
+
+// ResourceWithTags is a WAFv2 webACL or IPSet along with its tags.
+type ResourceWithTags struct {
+	ARN  string
+	Name string
+	ID   string
+	Tags map[string]string
+}
+
+// WebACLManager is responsible for manage WAFv2 webACLs provisioned by the controller, along with the IPSets referenced by their rules.
+type WebACLManager interface {
+	// ListResources lists the webACLs and IPSets with tags.
+	ListResources(ctx context.Context, tags map[string]string) (webACLs []ResourceWithTags, ipSets []ResourceWithTags, err error)
+
+	// Create creates the webACL, ipSetARNs are the ARNs of IPSets referenced by rules, indexed by rule name.
+	Create(ctx context.Context, resWebACL *wafv2model.WebACL, ipSetARNs map[string]string, tags map[string]string) (wafv2model.WebACLStatus, error)
+
+	// Update updates the webACL, ipSetARNs are the ARNs of IPSets referenced by rules, indexed by rule name.
+	Update(ctx context.Context, resWebACL *wafv2model.WebACL, sdkWebACL ResourceWithTags, ipSetARNs map[string]string, tags map[string]string) (wafv2model.WebACLStatus, error)
+
+	// Delete disassociates the webACL from LoadBalancers and deletes it.
+	Delete(ctx context.Context, sdkWebACL ResourceWithTags) error
+
+	// CreateIPSet creates IPSet with addresses.
+	CreateIPSet(ctx context.Context, name string, ipAddressVersion string, addresses []string, tags map[string]string) (ResourceWithTags, error)
+
+	// UpdateIPSet updates addresses of IPSet.
+	UpdateIPSet(ctx context.Context, sdkIPSet ResourceWithTags, addresses []string, tags map[string]string) error
+
+	// DeleteIPSet deletes IPSet.
+	DeleteIPSet(ctx context.Context, sdkIPSet ResourceWithTags) error
+
+	// ReconcileTags updates the tags of webACL or IPSet to the desired tags.
+	ReconcileTags(ctx context.Context, sdkRes ResourceWithTags, desiredTags map[string]string) error
+}
+
+// NewDefaultWebACLManager constructs new defaultWebACLManager.
+func NewDefaultWebACLManager(wafv2Client services.WAFv2, rgt services.RGT, associationManager WebACLAssociationManager, logger logr.Logger) *defaultWebACLManager {
+	return &defaultWebACLManager{
+		wafv2Client:        wafv2Client,
+		rgt:                rgt,
+		associationManager: associationManager,
+		logger:             logger,
+	}
+}
+
+var _ WebACLManager = &defaultWebACLManager{}
+
+// default implementation for WebACLManager.
+type defaultWebACLManager struct {
+	wafv2Client        services.WAFv2
+	rgt                services.RGT
+	associationManager WebACLAssociationManager
+	logger             logr.Logger
+}
+
+func (m *defaultWebACLManager) ListResources(ctx context.Context, tags map[string]string) ([]ResourceWithTags, []ResourceWithTags, error) {
+	req := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: awssdk.StringSlice([]string{rgtResourceTypeWAFv2}),
+	}
+	for key, value := range tags {
+		req.TagFilters = append(req.TagFilters, &resourcegroupstaggingapi.TagFilter{
+			Key:    awssdk.String(key),
+			Values: awssdk.StringSlice([]string{value}),
+		})
+	}
+	resources, err := m.rgt.GetResourcesAsList(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	var webACLs, ipSets []ResourceWithTags
+	for _, resource := range resources {
+		resARN := awssdk.StringValue(resource.ResourceARN)
+		resType, name, id, err := parseRegionalResourceARN(resARN)
+		if err != nil {
+			return nil, nil, err
+		}
+		res := ResourceWithTags{
+			ARN:  resARN,
+			Name: name,
+			ID:   id,
+			Tags: services.ParseRGTTags(resource.Tags),
+		}
+		switch resType {
+		case resourceTypeWebACL:
+			webACLs = append(webACLs, res)
+		case resourceTypeIPSet:
+			ipSets = append(ipSets, res)
+		}
+	}
+	return webACLs, ipSets, nil
+}
+
+func (m *defaultWebACLManager) Create(ctx context.Context, resWebACL *wafv2model.WebACL, ipSetARNs map[string]string, tags map[string]string) (wafv2model.WebACLStatus, error) {
+	rules, err := buildSDKRules(resWebACL.Spec, ipSetARNs)
+	if err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	req := &wafv2sdk.CreateWebACLInput{
+		Name:                 awssdk.String(resWebACL.Spec.Name),
+		Scope:                awssdk.String(wafv2sdk.ScopeRegional),
+		Description:          buildSDKDescription(resWebACL.Spec.Description),
+		DefaultAction:        buildSDKDefaultAction(resWebACL.Spec.DefaultAction),
+		Rules:                rules,
+		CustomResponseBodies: buildSDKCustomResponseBodies(resWebACL.Spec.CustomResponseBodies),
+		VisibilityConfig:     buildSDKVisibilityConfig(resWebACL.Spec.Name, resWebACL.Spec.MetricsEnabled),
+		Tags:                 buildSDKTags(tags),
+	}
+	m.logger.Info("creating WAFv2 webACL",
+		"resourceID", resWebACL.ID(),
+		"name", resWebACL.Spec.Name)
+	resp, err := m.wafv2Client.CreateWebACLWithContext(ctx, req)
+	if err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	webACLARN := awssdk.StringValue(resp.Summary.ARN)
+	m.logger.Info("created WAFv2 webACL",
+		"resourceID", resWebACL.ID(),
+		"arn", webACLARN)
+	return wafv2model.WebACLStatus{
+		WebACLARN: webACLARN,
+	}, nil
+}
+
+func (m *defaultWebACLManager) Update(ctx context.Context, resWebACL *wafv2model.WebACL, sdkWebACL ResourceWithTags, ipSetARNs map[string]string, tags map[string]string) (wafv2model.WebACLStatus, error) {
+	rules, err := buildSDKRules(resWebACL.Spec, ipSetARNs)
+	if err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	getResp, err := m.wafv2Client.GetWebACLWithContext(ctx, &wafv2sdk.GetWebACLInput{
+		Name:  awssdk.String(sdkWebACL.Name),
+		Id:    awssdk.String(sdkWebACL.ID),
+		Scope: awssdk.String(wafv2sdk.ScopeRegional),
+	})
+	if err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	req := &wafv2sdk.UpdateWebACLInput{
+		Name:                 awssdk.String(sdkWebACL.Name),
+		Id:                   awssdk.String(sdkWebACL.ID),
+		Scope:                awssdk.String(wafv2sdk.ScopeRegional),
+		LockToken:            getResp.LockToken,
+		Description:          buildSDKDescription(resWebACL.Spec.Description),
+		DefaultAction:        buildSDKDefaultAction(resWebACL.Spec.DefaultAction),
+		Rules:                rules,
+		CustomResponseBodies: buildSDKCustomResponseBodies(resWebACL.Spec.CustomResponseBodies),
+		VisibilityConfig:     buildSDKVisibilityConfig(resWebACL.Spec.Name, resWebACL.Spec.MetricsEnabled),
+	}
+	m.logger.Info("modifying WAFv2 webACL",
+		"resourceID", resWebACL.ID(),
+		"arn", sdkWebACL.ARN)
+	if _, err := m.wafv2Client.UpdateWebACLWithContext(ctx, req); err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	if err := m.ReconcileTags(ctx, sdkWebACL, tags); err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	m.logger.Info("modified WAFv2 webACL",
+		"resourceID", resWebACL.ID(),
+		"arn", sdkWebACL.ARN)
+	return wafv2model.WebACLStatus{
+		WebACLARN: sdkWebACL.ARN,
+	}, nil
+}
+
+func (m *defaultWebACLManager) Delete(ctx context.Context, sdkWebACL ResourceWithTags) error {
+	// webACLs can only be deleted once they're no longer associated with any resource.
+	listResp, err := m.wafv2Client.ListResourcesForWebACLWithContext(ctx, &wafv2sdk.ListResourcesForWebACLInput{
+		WebACLArn:    awssdk.String(sdkWebACL.ARN),
+		ResourceType: awssdk.String(wafv2sdk.ResourceTypeApplicationLoadBalancer),
+	})
+	if err != nil {
+		return err
+	}
+	for _, resourceARN := range listResp.ResourceArns {
+		if err := m.associationManager.DisassociateWebACL(ctx, awssdk.StringValue(resourceARN)); err != nil {
+			return err
+		}
+	}
+	getResp, err := m.wafv2Client.GetWebACLWithContext(ctx, &wafv2sdk.GetWebACLInput{
+		Name:  awssdk.String(sdkWebACL.Name),
+		Id:    awssdk.String(sdkWebACL.ID),
+		Scope: awssdk.String(wafv2sdk.ScopeRegional),
+	})
+	if err != nil {
+		return err
+	}
+	req := &wafv2sdk.DeleteWebACLInput{
+		Name:      awssdk.String(sdkWebACL.Name),
+		Id:        awssdk.String(sdkWebACL.ID),
+		Scope:     awssdk.String(wafv2sdk.ScopeRegional),
+		LockToken: getResp.LockToken,
+	}
+	m.logger.Info("deleting WAFv2 webACL",
+		"arn", sdkWebACL.ARN)
+	if _, err := m.wafv2Client.DeleteWebACLWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("deleted WAFv2 webACL",
+		"arn", sdkWebACL.ARN)
+	return nil
+}
+
+func (m *defaultWebACLManager) CreateIPSet(ctx context.Context, name string, ipAddressVersion string, addresses []string, tags map[string]string) (ResourceWithTags, error) {
+	req := &wafv2sdk.CreateIPSetInput{
+		Name:             awssdk.String(name),
+		Scope:            awssdk.String(wafv2sdk.ScopeRegional),
+		IPAddressVersion: awssdk.String(ipAddressVersion),
+		Addresses:        awssdk.StringSlice(addresses),
+		Tags:             buildSDKTags(tags),
+	}
+	m.logger.Info("creating WAFv2 IPSet",
+		"name", name)
+	resp, err := m.wafv2Client.CreateIPSetWithContext(ctx, req)
+	if err != nil {
+		return ResourceWithTags{}, err
+	}
+	sdkIPSet := ResourceWithTags{
+		ARN:  awssdk.StringValue(resp.Summary.ARN),
+		Name: awssdk.StringValue(resp.Summary.Name),
+		ID:   awssdk.StringValue(resp.Summary.Id),
+		Tags: tags,
+	}
+	m.logger.Info("created WAFv2 IPSet",
+		"name", name,
+		"arn", sdkIPSet.ARN)
+	return sdkIPSet, nil
+}
+
+func (m *defaultWebACLManager) UpdateIPSet(ctx context.Context, sdkIPSet ResourceWithTags, addresses []string, tags map[string]string) error {
+	getResp, err := m.wafv2Client.GetIPSetWithContext(ctx, &wafv2sdk.GetIPSetInput{
+		Name:  awssdk.String(sdkIPSet.Name),
+		Id:    awssdk.String(sdkIPSet.ID),
+		Scope: awssdk.String(wafv2sdk.ScopeRegional),
+	})
+	if err != nil {
+		return err
+	}
+	req := &wafv2sdk.UpdateIPSetInput{
+		Name:      awssdk.String(sdkIPSet.Name),
+		Id:        awssdk.String(sdkIPSet.ID),
+		Scope:     awssdk.String(wafv2sdk.ScopeRegional),
+		LockToken: getResp.LockToken,
+		Addresses: awssdk.StringSlice(addresses),
+	}
+	m.logger.Info("modifying WAFv2 IPSet",
+		"arn", sdkIPSet.ARN)
+	if _, err := m.wafv2Client.UpdateIPSetWithContext(ctx, req); err != nil {
+		return err
+	}
+	if err := m.ReconcileTags(ctx, sdkIPSet, tags); err != nil {
+		return err
+	}
+	m.logger.Info("modified WAFv2 IPSet",
+		"arn", sdkIPSet.ARN)
+	return nil
+}
+
+func (m *defaultWebACLManager) DeleteIPSet(ctx context.Context, sdkIPSet ResourceWithTags) error {
+	getResp, err := m.wafv2Client.GetIPSetWithContext(ctx, &wafv2sdk.GetIPSetInput{
+		Name:  awssdk.String(sdkIPSet.Name),
+		Id:    awssdk.String(sdkIPSet.ID),
+		Scope: awssdk.String(wafv2sdk.ScopeRegional),
+	})
+	if err != nil {
+		return err
+	}
+	req := &wafv2sdk.DeleteIPSetInput{
+		Name:      awssdk.String(sdkIPSet.Name),
+		Id:        awssdk.String(sdkIPSet.ID),
+		Scope:     awssdk.String(wafv2sdk.ScopeRegional),
+		LockToken: getResp.LockToken,
+	}
+	m.logger.Info("deleting WAFv2 IPSet",
+		"arn", sdkIPSet.ARN)
+	if _, err := m.wafv2Client.DeleteIPSetWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("deleted WAFv2 IPSet",
+		"arn", sdkIPSet.ARN)
+	return nil
+}
+
+func (m *defaultWebACLManager) ReconcileTags(ctx context.Context, sdkRes ResourceWithTags, desiredTags map[string]string) error {
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, sdkRes.Tags)
+	if len(tagsToUpdate) > 0 {
+		if _, err := m.wafv2Client.TagResourceWithContext(ctx, &wafv2sdk.TagResourceInput{
+			ResourceARN: awssdk.String(sdkRes.ARN),
+			Tags:        buildSDKTags(tagsToUpdate),
+		}); err != nil {
+			return err
+		}
+	}
+	if len(tagsToRemove) > 0 {
+		tagKeys := sets.StringKeySet(tagsToRemove).List()
+		if _, err := m.wafv2Client.UntagResourceWithContext(ctx, &wafv2sdk.UntagResourceInput{
+			ResourceARN: awssdk.String(sdkRes.ARN),
+			TagKeys:     awssdk.StringSlice(tagKeys),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseRegionalResourceARN parses the type, name and id of regional WAFv2 resource,
+// whose ARN is like arn:aws:wafv2:us-west-2:123456789012:regional/webacl/name/id.
+func parseRegionalResourceARN(resARN string) (string, string, string, error) {
+	parsedARN, err := arn.Parse(resARN)
+	if err != nil {
+		return "", "", "", errors.Wrapf(err, "invalid WAFv2 resource ARN: %v", resARN)
+	}
+	segments := strings.Split(parsedARN.Resource, "/")
+	if len(segments) != 4 {
+		return "", "", "", errors.Errorf("invalid WAFv2 resource ARN: %v", resARN)
+	}
+	return segments[1], segments[2], segments[3], nil
+}
+
+func buildSDKRules(spec wafv2model.WebACLSpec, ipSetARNs map[string]string) ([]*wafv2sdk.Rule, error) {
+	sdkRules := make([]*wafv2sdk.Rule, 0, len(spec.Rules))
+	for _, rule := range spec.Rules {
+		sdkRule := &wafv2sdk.Rule{
+			Name:             awssdk.String(rule.Name),
+			Priority:         awssdk.Int64(rule.Priority),
+			Statement:        &wafv2sdk.Statement{},
+			VisibilityConfig: buildSDKVisibilityConfig(rule.Name, spec.MetricsEnabled),
+		}
+		switch {
+		case rule.ManagedRuleGroup != nil:
+			sdkRule.Statement.ManagedRuleGroupStatement = buildSDKManagedRuleGroupStatement(*rule.ManagedRuleGroup)
+			sdkRule.OverrideAction = buildSDKOverrideAction(rule.OverrideAction)
+		case rule.RateBased != nil:
+			sdkRule.Statement.RateBasedStatement = buildSDKRateBasedStatement(*rule.RateBased)
+			sdkRule.Action = buildSDKRuleAction(rule.Action)
+		case rule.IPSetReference != nil:
+			ipSetARN := rule.IPSetReference.ARN
+			if ipSetARN == "" {
+				ipSetARN = ipSetARNs[rule.Name]
+			}
+			if ipSetARN == "" {
+				return nil, errors.Errorf("[should never happen] IPSet of rule %v is not provisioned", rule.Name)
+			}
+			sdkRule.Statement.IPSetReferenceStatement = &wafv2sdk.IPSetReferenceStatement{
+				ARN: awssdk.String(ipSetARN),
+			}
+			sdkRule.Action = buildSDKRuleAction(rule.Action)
+		default:
+			return nil, errors.Errorf("[should never happen] rule %v has no statement", rule.Name)
+		}
+		sdkRules = append(sdkRules, sdkRule)
+	}
+	return sdkRules, nil
+}
+
+func buildSDKManagedRuleGroupStatement(statement wafv2model.ManagedRuleGroupStatement) *wafv2sdk.ManagedRuleGroupStatement {
+	sdkStatement := &wafv2sdk.ManagedRuleGroupStatement{
+		VendorName: awssdk.String(statement.VendorName),
+		Name:       awssdk.String(statement.Name),
+	}
+	if statement.Version != "" {
+		sdkStatement.Version = awssdk.String(statement.Version)
+	}
+	for _, ruleName := range statement.ExcludedRules {
+		sdkStatement.ExcludedRules = append(sdkStatement.ExcludedRules, &wafv2sdk.ExcludedRule{
+			Name: awssdk.String(ruleName),
+		})
+	}
+	return sdkStatement
+}
+
+func buildSDKRateBasedStatement(statement wafv2model.RateBasedStatement) *wafv2sdk.RateBasedStatement {
+	if statement.ForwardedIPHeader == "" {
+		return &wafv2sdk.RateBasedStatement{
+			Limit:            awssdk.Int64(statement.Limit),
+			AggregateKeyType: awssdk.String(wafv2sdk.RateBasedStatementAggregateKeyTypeIp),
+		}
+	}
+	return &wafv2sdk.RateBasedStatement{
+		Limit:            awssdk.Int64(statement.Limit),
+		AggregateKeyType: awssdk.String(wafv2sdk.RateBasedStatementAggregateKeyTypeForwardedIp),
+		ForwardedIPConfig: &wafv2sdk.ForwardedIPConfig{
+			HeaderName:       awssdk.String(statement.ForwardedIPHeader),
+			FallbackBehavior: awssdk.String(wafv2sdk.FallbackBehaviorMatch),
+		},
+	}
+}
+
+func buildSDKOverrideAction(overrideAction *wafv2model.OverrideActionType) *wafv2sdk.OverrideAction {
+	if overrideAction != nil && *overrideAction == wafv2model.OverrideActionTypeCount {
+		return &wafv2sdk.OverrideAction{Count: &wafv2sdk.CountAction{}}
+	}
+	return &wafv2sdk.OverrideAction{None: &wafv2sdk.NoneAction{}}
+}
+
+func buildSDKRuleAction(action *wafv2model.Action) *wafv2sdk.RuleAction {
+	if action == nil {
+		return &wafv2sdk.RuleAction{Block: &wafv2sdk.BlockAction{}}
+	}
+	switch action.Type {
+	case wafv2model.ActionTypeAllow:
+		return &wafv2sdk.RuleAction{Allow: &wafv2sdk.AllowAction{}}
+	case wafv2model.ActionTypeCount:
+		return &wafv2sdk.RuleAction{Count: &wafv2sdk.CountAction{}}
+	default:
+		return &wafv2sdk.RuleAction{Block: buildSDKBlockAction(action.CustomResponse)}
+	}
+}
+
+func buildSDKDefaultAction(action wafv2model.Action) *wafv2sdk.DefaultAction {
+	if action.Type == wafv2model.ActionTypeBlock {
+		return &wafv2sdk.DefaultAction{Block: buildSDKBlockAction(action.CustomResponse)}
+	}
+	return &wafv2sdk.DefaultAction{Allow: &wafv2sdk.AllowAction{}}
+}
+
+func buildSDKBlockAction(customResponse *wafv2model.CustomResponse) *wafv2sdk.BlockAction {
+	if customResponse == nil {
+		return &wafv2sdk.BlockAction{}
+	}
+	sdkCustomResponse := &wafv2sdk.CustomResponse{
+		ResponseCode: awssdk.Int64(customResponse.ResponseCode),
+	}
+	if customResponse.CustomResponseBodyKey != "" {
+		sdkCustomResponse.CustomResponseBodyKey = awssdk.String(customResponse.CustomResponseBodyKey)
+	}
+	return &wafv2sdk.BlockAction{CustomResponse: sdkCustomResponse}
+}
+
+func buildSDKCustomResponseBodies(bodies map[string]wafv2model.CustomResponseBody) map[string]*wafv2sdk.CustomResponseBody {
+	if len(bodies) == 0 {
+		return nil
+	}
+	sdkBodies := make(map[string]*wafv2sdk.CustomResponseBody, len(bodies))
+	for key, body := range bodies {
+		sdkBodies[key] = &wafv2sdk.CustomResponseBody{
+			ContentType: awssdk.String(body.ContentType),
+			Content:     awssdk.String(body.Content),
+		}
+	}
+	return sdkBodies
+}
+
+func buildSDKVisibilityConfig(metricName string, metricsEnabled bool) *wafv2sdk.VisibilityConfig {
+	return &wafv2sdk.VisibilityConfig{
+		MetricName:               awssdk.String(metricName),
+		CloudWatchMetricsEnabled: awssdk.Bool(metricsEnabled),
+		SampledRequestsEnabled:   awssdk.Bool(metricsEnabled),
+	}
+}
+
+func buildSDKDescription(description string) *string {
+	if description == "" {
+		return nil
+	}
+	return awssdk.String(description)
+}
+
+func buildSDKTags(tags map[string]string) []*wafv2sdk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]*wafv2sdk.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, &wafv2sdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
@@ -0,0 +1,356 @@
+package wafv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+const (
+	// specHashTagKey is the AWS TagKey for the hash of desired state a webACL or IPSet is provisioned with.
+	specHashTagKey = "elbv2.k8s.aws/spec-hash"
+
+	// the maximum length of WAFv2 resource names.
+	resourceNameMaxLength = 128
+
+	resourceTypeWebACLCloudFormation = "AWS::WAFv2::WebACL"
+	resourceTypeIPSetCloudFormation  = "AWS::WAFv2::IPSet"
+)
+
+// NewWebACLSynthesizer constructs new webACLSynthesizer.
+func NewWebACLSynthesizer(webACLManager WebACLManager, trackingProvider tracking.Provider, logger logr.Logger, stack core.Stack) *webACLSynthesizer {
+	return &webACLSynthesizer{
+		webACLManager:    webACLManager,
+		trackingProvider: trackingProvider,
+		logger:           logger,
+		stack:            stack,
+	}
+}
+
+// webACLSynthesizer synthesizes the webACLs of stack, along with the IPSets provisioned for their rules.
+type webACLSynthesizer struct {
+	webACLManager    WebACLManager
+	trackingProvider tracking.Provider
+	logger           logr.Logger
+	stack            core.Stack
+
+	unmatchedSDKWebACLs []ResourceWithTags
+	unmatchedSDKIPSets  []ResourceWithTags
+}
+
+func (s *webACLSynthesizer) Synthesize(ctx context.Context) error {
+	var resWebACLs []*wafv2model.WebACL
+	if err := s.stack.ListResources(&resWebACLs); err != nil {
+		return fmt.Errorf("[should never happen] failed to list resources: %w", err)
+	}
+	sdkWebACLs, sdkIPSets, err := s.webACLManager.ListResources(ctx, s.trackingProvider.StackTags(s.stack))
+	if err != nil {
+		return err
+	}
+	for _, resWebACL := range resWebACLs {
+		ipSetARNs, err := s.synthesizeIPSets(ctx, resWebACL, &sdkIPSets)
+		if err != nil {
+			return err
+		}
+		if err := s.synthesizeWebACL(ctx, resWebACL, &sdkWebACLs, ipSetARNs); err != nil {
+			return err
+		}
+	}
+	s.unmatchedSDKWebACLs = sdkWebACLs
+	s.unmatchedSDKIPSets = sdkIPSets
+	return nil
+}
+
+func (s *webACLSynthesizer) PostSynthesize(ctx context.Context) error {
+	// IPSets can only be deleted once they're no longer referenced by webACLs.
+	for _, sdkWebACL := range s.unmatchedSDKWebACLs {
+		if err := s.webACLManager.Delete(ctx, sdkWebACL); err != nil {
+			return errors.Wrap(err, "failed to delete WAFv2 webACL")
+		}
+	}
+	for _, sdkIPSet := range s.unmatchedSDKIPSets {
+		if err := s.webACLManager.DeleteIPSet(ctx, sdkIPSet); err != nil {
+			return errors.Wrap(err, "failed to delete WAFv2 IPSet")
+		}
+	}
+	return nil
+}
+
+// synthesizeWebACL creates or updates the webACL, the matched webACL is removed from sdkWebACLs.
+// webACLs are matched by resourceID and name, webACLs cannot be renamed so that they're replaced on name change.
+func (s *webACLSynthesizer) synthesizeWebACL(ctx context.Context, resWebACL *wafv2model.WebACL,
+	sdkWebACLs *[]ResourceWithTags, ipSetARNs map[string]string) error {
+	specHash, err := computeWebACLSpecHash(resWebACL, ipSetARNs)
+	if err != nil {
+		return err
+	}
+	tags := s.trackingProvider.ResourceTags(s.stack, resWebACL, algorithm.MergeStringMap(map[string]string{specHashTagKey: specHash}, resWebACL.Spec.Tags))
+	sdkWebACL, exists := s.popMatchedResource(sdkWebACLs, resWebACL.ID(), resWebACL.Spec.Name)
+	if !exists {
+		status, err := s.webACLManager.Create(ctx, resWebACL, ipSetARNs, tags)
+		if err != nil {
+			return errors.Wrap(err, "failed to create WAFv2 webACL")
+		}
+		resWebACL.SetStatus(status)
+		return nil
+	}
+	if sdkWebACL.Tags[specHashTagKey] == specHash {
+		resWebACL.SetStatus(wafv2model.WebACLStatus{WebACLARN: sdkWebACL.ARN})
+		return nil
+	}
+	status, err := s.webACLManager.Update(ctx, resWebACL, sdkWebACL, ipSetARNs, tags)
+	if err != nil {
+		return errors.Wrap(err, "failed to update WAFv2 webACL")
+	}
+	resWebACL.SetStatus(status)
+	return nil
+}
+
+// synthesizeIPSets creates or updates the IPSets for rules of webACL with addresses, and returns their ARNs indexed by rule name.
+// the matched IPSets are removed from sdkIPSets.
+func (s *webACLSynthesizer) synthesizeIPSets(ctx context.Context, resWebACL *wafv2model.WebACL, sdkIPSets *[]ResourceWithTags) (map[string]string, error) {
+	resIPSets, err := s.buildResIPSets(resWebACL)
+	if err != nil {
+		return nil, err
+	}
+	ipSetARNs := make(map[string]string)
+	for _, resIPSet := range resIPSets {
+		sdkIPSet, exists := s.popMatchedResource(sdkIPSets, resIPSet.resID, resIPSet.name)
+		if !exists {
+			sdkIPSet, err = s.webACLManager.CreateIPSet(ctx, resIPSet.name, resIPSet.ipAddressVersion, resIPSet.addresses, resIPSet.tags)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create WAFv2 IPSet")
+			}
+			ipSetARNs[resIPSet.ruleName] = sdkIPSet.ARN
+			continue
+		}
+		if sdkIPSet.Tags[specHashTagKey] != resIPSet.tags[specHashTagKey] {
+			if err := s.webACLManager.UpdateIPSet(ctx, sdkIPSet, resIPSet.addresses, resIPSet.tags); err != nil {
+				return nil, errors.Wrap(err, "failed to update WAFv2 IPSet")
+			}
+		}
+		ipSetARNs[resIPSet.ruleName] = sdkIPSet.ARN
+	}
+	return ipSetARNs, nil
+}
+
+func (s *webACLSynthesizer) Plan(ctx context.Context) ([]plan.Action, error) {
+	var resWebACLs []*wafv2model.WebACL
+	if err := s.stack.ListResources(&resWebACLs); err != nil {
+		return nil, fmt.Errorf("[should never happen] failed to list resources: %w", err)
+	}
+	sdkWebACLs, sdkIPSets, err := s.webACLManager.ListResources(ctx, s.trackingProvider.StackTags(s.stack))
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []plan.Action
+	for _, resWebACL := range resWebACLs {
+		resIPSets, err := s.buildResIPSets(resWebACL)
+		if err != nil {
+			return nil, err
+		}
+		ipSetARNs := make(map[string]string)
+		for _, resIPSet := range resIPSets {
+			sdkIPSet, exists := s.popMatchedResource(&sdkIPSets, resIPSet.resID, resIPSet.name)
+			if !exists {
+				ipSetARNs[resIPSet.ruleName] = fmt.Sprintf("%v/%v", plan.PlaceholderIdentifier(resWebACL), resIPSet.ruleName)
+				actions = append(actions, plan.Action{
+					Type:         plan.ActionTypeCreate,
+					ResourceType: resourceTypeIPSetCloudFormation,
+					ResourceID:   resIPSet.resID,
+				})
+				continue
+			}
+			ipSetARNs[resIPSet.ruleName] = sdkIPSet.ARN
+			if sdkIPSet.Tags[specHashTagKey] != resIPSet.tags[specHashTagKey] {
+				actions = append(actions, plan.Action{
+					Type:         plan.ActionTypeUpdate,
+					ResourceType: resourceTypeIPSetCloudFormation,
+					ResourceID:   resIPSet.resID,
+					Identifier:   sdkIPSet.ARN,
+					Changes:      []string{"addresses"},
+				})
+			}
+		}
+
+		specHash, err := computeWebACLSpecHash(resWebACL, ipSetARNs)
+		if err != nil {
+			return nil, err
+		}
+		sdkWebACL, exists := s.popMatchedResource(&sdkWebACLs, resWebACL.ID(), resWebACL.Spec.Name)
+		if !exists {
+			resWebACL.SetStatus(wafv2model.WebACLStatus{WebACLARN: plan.PlaceholderIdentifier(resWebACL)})
+			actions = append(actions, plan.Action{
+				Type:         plan.ActionTypeCreate,
+				ResourceType: resWebACL.Type(),
+				ResourceID:   resWebACL.ID(),
+			})
+			continue
+		}
+		resWebACL.SetStatus(wafv2model.WebACLStatus{WebACLARN: sdkWebACL.ARN})
+		if sdkWebACL.Tags[specHashTagKey] != specHash {
+			actions = append(actions, plan.Action{
+				Type:         plan.ActionTypeUpdate,
+				ResourceType: resWebACL.Type(),
+				ResourceID:   resWebACL.ID(),
+				Identifier:   sdkWebACL.ARN,
+				Changes:      []string{"spec"},
+			})
+		}
+	}
+	for _, sdkWebACL := range sdkWebACLs {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: resourceTypeWebACLCloudFormation,
+			Identifier:   sdkWebACL.ARN,
+		})
+	}
+	for _, sdkIPSet := range sdkIPSets {
+		actions = append(actions, plan.Action{
+			Type:         plan.ActionTypeDelete,
+			ResourceType: resourceTypeIPSetCloudFormation,
+			Identifier:   sdkIPSet.ARN,
+		})
+	}
+	return actions, nil
+}
+
+// Release releases the webACLs of stack along with their IPSets, they're left in place rather than deleted.
+func (s *webACLSynthesizer) Release(ctx context.Context, untrack bool) ([]tracking.ReleasedResource, error) {
+	sdkWebACLs, sdkIPSets, err := s.webACLManager.ListResources(ctx, s.trackingProvider.StackTags(s.stack))
+	if err != nil {
+		return nil, err
+	}
+	var releasedResources []tracking.ReleasedResource
+	for _, sdkWebACL := range sdkWebACLs {
+		if untrack {
+			if err := s.untrackSDKResource(ctx, sdkWebACL); err != nil {
+				return nil, err
+			}
+		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: resourceTypeWebACLCloudFormation,
+			Identifier:   sdkWebACL.ARN,
+		})
+	}
+	for _, sdkIPSet := range sdkIPSets {
+		if untrack {
+			if err := s.untrackSDKResource(ctx, sdkIPSet); err != nil {
+				return nil, err
+			}
+		}
+		releasedResources = append(releasedResources, tracking.ReleasedResource{
+			ResourceType: resourceTypeIPSetCloudFormation,
+			Identifier:   sdkIPSet.ARN,
+		})
+	}
+	return releasedResources, nil
+}
+
+// untrackSDKResource removes the tracking tags for stack from sdkRes.
+func (s *webACLSynthesizer) untrackSDKResource(ctx context.Context, sdkRes ResourceWithTags) error {
+	untrackedTags := tracking.UntrackedTags(s.trackingProvider, s.stack, sdkRes.Tags)
+	if len(untrackedTags) == len(sdkRes.Tags) {
+		return nil
+	}
+	if err := s.webACLManager.ReconcileTags(ctx, sdkRes, untrackedTags); err != nil {
+		return errors.Wrap(err, "failed to untrack WAFv2 resource")
+	}
+	s.logger.Info("untracked WAFv2 resource",
+		"stackID", s.stack.StackID().String(),
+		"arn", sdkRes.ARN)
+	return nil
+}
+
+// resIPSet is the desired state of the IPSet provisioned for a rule of webACL.
+type resIPSet struct {
+	ruleName         string
+	resID            string
+	name             string
+	ipAddressVersion string
+	addresses        []string
+	tags             map[string]string
+}
+
+// buildResIPSets builds the desired state of IPSets for rules of webACL with addresses.
+// the hash of desired state is part of the tags.
+func (s *webACLSynthesizer) buildResIPSets(resWebACL *wafv2model.WebACL) ([]resIPSet, error) {
+	var resIPSets []resIPSet
+	for _, rule := range resWebACL.Spec.Rules {
+		if rule.IPSetReference == nil || rule.IPSetReference.ARN != "" {
+			continue
+		}
+		ipAddressVersion := rule.IPSetReference.IPAddressVersion
+		if ipAddressVersion == "" {
+			ipAddressVersion = wafv2sdk.IPAddressVersionIpv4
+		}
+		ipSetResID := fmt.Sprintf("%v/%v", resWebACL.ID(), rule.Name)
+		// the IP address version of IPSets cannot be changed, so that it's part of the name for IPSets to be replaced on change.
+		ipSetName := fmt.Sprintf("%.*s", resourceNameMaxLength, fmt.Sprintf("%v-%v-%v", resWebACL.Spec.Name, rule.Name, strings.ToLower(ipAddressVersion)))
+		addresses := append([]string(nil), rule.IPSetReference.Addresses...)
+		sort.Strings(addresses)
+		specHash, err := computeSpecHash(struct {
+			IPAddressVersion string   `json:"ipAddressVersion"`
+			Addresses        []string `json:"addresses"`
+		}{ipAddressVersion, addresses})
+		if err != nil {
+			return nil, err
+		}
+		tags := algorithm.MergeStringMap(s.trackingProvider.StackTags(s.stack), map[string]string{
+			s.trackingProvider.ResourceIDTagKey(): ipSetResID,
+			specHashTagKey:                        specHash,
+		}, resWebACL.Spec.Tags)
+		resIPSets = append(resIPSets, resIPSet{
+			ruleName:         rule.Name,
+			resID:            ipSetResID,
+			name:             ipSetName,
+			ipAddressVersion: ipAddressVersion,
+			addresses:        addresses,
+			tags:             tags,
+		})
+	}
+	return resIPSets, nil
+}
+
+// popMatchedResource removes and returns the resource in sdkResources with resID and name.
+func (s *webACLSynthesizer) popMatchedResource(sdkResources *[]ResourceWithTags, resID string, name string) (ResourceWithTags, bool) {
+	resourceIDTagKey := s.trackingProvider.ResourceIDTagKey()
+	for i, sdkRes := range *sdkResources {
+		if sdkRes.Tags[resourceIDTagKey] == resID && sdkRes.Name == name {
+			*sdkResources = append((*sdkResources)[:i:i], (*sdkResources)[i+1:]...)
+			return sdkRes, true
+		}
+	}
+	return ResourceWithTags{}, false
+}
+
+// computeWebACLSpecHash computes the hash of desired state of webACL, ipSetARNs are the ARNs of IPSets referenced by rules.
+func computeWebACLSpecHash(resWebACL *wafv2model.WebACL, ipSetARNs map[string]string) (string, error) {
+	return computeSpecHash(struct {
+		Spec      wafv2model.WebACLSpec `json:"spec"`
+		IPSetARNs map[string]string     `json:"ipSetARNs"`
+	}{resWebACL.Spec, ipSetARNs})
+}
+
+func computeSpecHash(spec interface{}) (string, error) {
+	payload, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	specHash := sha256.Sum256(payload)
+	return hex.EncodeToString(specHash[:]), nil
+}
@@ -0,0 +1,534 @@
+package wafv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+func Test_webACLSynthesizer_Synthesize(t *testing.T) {
+	webACLSpec := wafv2model.WebACLSpec{
+		Name:          "k8s-abcdef0123-my-web-acl",
+		DefaultAction: wafv2model.Action{Type: wafv2model.ActionTypeAllow},
+		Rules: []wafv2model.Rule{
+			{
+				Name:     "office",
+				Priority: 0,
+				IPSetReference: &wafv2model.IPSetReferenceStatement{
+					Addresses: []string{"192.168.0.0/16", "10.0.0.0/8"},
+				},
+			},
+		},
+		MetricsEnabled: true,
+	}
+	ipSetSpecHash, _ := computeSpecHash(struct {
+		IPAddressVersion string   `json:"ipAddressVersion"`
+		Addresses        []string `json:"addresses"`
+	}{"IPV4", []string{"10.0.0.0/8", "192.168.0.0/16"}})
+	webACLSpecHash, _ := computeSpecHash(struct {
+		Spec      wafv2model.WebACLSpec `json:"spec"`
+		IPSetARNs map[string]string     `json:"ipSetARNs"`
+	}{webACLSpec, map[string]string{"office": "ip-set-arn"}})
+	sdkIPSet := ResourceWithTags{
+		ARN:  "ip-set-arn",
+		Name: "k8s-abcdef0123-my-web-acl-office-ipv4",
+		ID:   "ip-set-id",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "WebACL/office",
+			specHashTagKey:             ipSetSpecHash,
+		},
+	}
+	sdkWebACL := ResourceWithTags{
+		ARN:  "web-acl-arn",
+		Name: "k8s-abcdef0123-my-web-acl",
+		ID:   "web-acl-id",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "WebACL",
+			specHashTagKey:             webACLSpecHash,
+		},
+	}
+
+	type listResourcesCall struct {
+		webACLs []ResourceWithTags
+		ipSets  []ResourceWithTags
+		err     error
+	}
+	type createIPSetCall struct {
+		name      string
+		addresses []string
+		ipSet     ResourceWithTags
+		err       error
+	}
+	type createCall struct {
+		ipSetARNs map[string]string
+		status    wafv2model.WebACLStatus
+		err       error
+	}
+	type updateCall struct {
+		sdkWebACL ResourceWithTags
+		ipSetARNs map[string]string
+		status    wafv2model.WebACLStatus
+		err       error
+	}
+	type updateIPSetCall struct {
+		sdkIPSet  ResourceWithTags
+		addresses []string
+	}
+	type fields struct {
+		webACLSpecs        []wafv2model.WebACLSpec
+		listResourcesCalls []listResourcesCall
+		createIPSetCalls   []createIPSetCall
+		updateIPSetCalls   []updateIPSetCall
+		createCalls        []createCall
+		updateCalls        []updateCall
+	}
+	tests := []struct {
+		name                    string
+		fields                  fields
+		wantWebACLARN           string
+		wantUnmatchedSDKWebACLs []ResourceWithTags
+		wantUnmatchedSDKIPSets  []ResourceWithTags
+		wantErr                 error
+	}{
+		{
+			name: "when there is no webACL resource nor existing webACLs",
+			fields: fields{
+				listResourcesCalls: []listResourcesCall{{}},
+			},
+		},
+		{
+			name: "when webACL resource is new",
+			fields: fields{
+				webACLSpecs:        []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{{}},
+				createIPSetCalls: []createIPSetCall{
+					{
+						name:      "k8s-abcdef0123-my-web-acl-office-ipv4",
+						addresses: []string{"10.0.0.0/8", "192.168.0.0/16"},
+						ipSet:     sdkIPSet,
+					},
+				},
+				createCalls: []createCall{
+					{
+						ipSetARNs: map[string]string{"office": "ip-set-arn"},
+						status:    wafv2model.WebACLStatus{WebACLARN: "web-acl-arn"},
+					},
+				},
+			},
+			wantWebACLARN: "web-acl-arn",
+		},
+		{
+			name: "when webACL and IPSet are up to date",
+			fields: fields{
+				webACLSpecs: []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{
+					{
+						webACLs: []ResourceWithTags{sdkWebACL},
+						ipSets:  []ResourceWithTags{sdkIPSet},
+					},
+				},
+			},
+			wantWebACLARN:           "web-acl-arn",
+			wantUnmatchedSDKWebACLs: []ResourceWithTags{},
+			wantUnmatchedSDKIPSets:  []ResourceWithTags{},
+		},
+		{
+			name: "when webACL and IPSet are outdated",
+			fields: fields{
+				webACLSpecs: []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{
+					{
+						webACLs: []ResourceWithTags{
+							{
+								ARN:  "web-acl-arn",
+								Name: "k8s-abcdef0123-my-web-acl",
+								Tags: map[string]string{
+									"ingress.k8s.aws/resource": "WebACL",
+									specHashTagKey:             "outdated",
+								},
+							},
+						},
+						ipSets: []ResourceWithTags{
+							{
+								ARN:  "ip-set-arn",
+								Name: "k8s-abcdef0123-my-web-acl-office-ipv4",
+								Tags: map[string]string{
+									"ingress.k8s.aws/resource": "WebACL/office",
+									specHashTagKey:             "outdated",
+								},
+							},
+						},
+					},
+				},
+				updateIPSetCalls: []updateIPSetCall{
+					{
+						sdkIPSet: ResourceWithTags{
+							ARN:  "ip-set-arn",
+							Name: "k8s-abcdef0123-my-web-acl-office-ipv4",
+							Tags: map[string]string{
+								"ingress.k8s.aws/resource": "WebACL/office",
+								specHashTagKey:             "outdated",
+							},
+						},
+						addresses: []string{"10.0.0.0/8", "192.168.0.0/16"},
+					},
+				},
+				updateCalls: []updateCall{
+					{
+						sdkWebACL: ResourceWithTags{
+							ARN:  "web-acl-arn",
+							Name: "k8s-abcdef0123-my-web-acl",
+							Tags: map[string]string{
+								"ingress.k8s.aws/resource": "WebACL",
+								specHashTagKey:             "outdated",
+							},
+						},
+						ipSetARNs: map[string]string{"office": "ip-set-arn"},
+						status:    wafv2model.WebACLStatus{WebACLARN: "web-acl-arn"},
+					},
+				},
+			},
+			wantWebACLARN:           "web-acl-arn",
+			wantUnmatchedSDKWebACLs: []ResourceWithTags{},
+			wantUnmatchedSDKIPSets:  []ResourceWithTags{},
+		},
+		{
+			name: "when webACL is renamed, a new webACL is created while the existing one is unmatched",
+			fields: fields{
+				webACLSpecs: []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{
+					{
+						webACLs: []ResourceWithTags{
+							{
+								ARN:  "old-web-acl-arn",
+								Name: "k8s-abcdef0123-old-web-acl",
+								Tags: map[string]string{
+									"ingress.k8s.aws/resource": "WebACL",
+								},
+							},
+						},
+						ipSets: []ResourceWithTags{sdkIPSet},
+					},
+				},
+				createCalls: []createCall{
+					{
+						ipSetARNs: map[string]string{"office": "ip-set-arn"},
+						status:    wafv2model.WebACLStatus{WebACLARN: "web-acl-arn"},
+					},
+				},
+			},
+			wantWebACLARN: "web-acl-arn",
+			wantUnmatchedSDKWebACLs: []ResourceWithTags{
+				{
+					ARN:  "old-web-acl-arn",
+					Name: "k8s-abcdef0123-old-web-acl",
+					Tags: map[string]string{
+						"ingress.k8s.aws/resource": "WebACL",
+					},
+				},
+			},
+			wantUnmatchedSDKIPSets: []ResourceWithTags{},
+		},
+		{
+			name: "when failed to create webACL",
+			fields: fields{
+				webACLSpecs:        []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{{ipSets: []ResourceWithTags{sdkIPSet}}},
+				createCalls: []createCall{
+					{
+						ipSetARNs: map[string]string{"office": "ip-set-arn"},
+						err:       errors.New("some error"),
+					},
+				},
+			},
+			wantErr: errors.New("failed to create WAFv2 webACL: some error"),
+		},
+		{
+			name: "when failed to list resources",
+			fields: fields{
+				webACLSpecs:        []wafv2model.WebACLSpec{webACLSpec},
+				listResourcesCalls: []listResourcesCall{{err: errors.New("some error")}},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			webACLManager := NewMockWebACLManager(ctrl)
+			for _, call := range tt.fields.listResourcesCalls {
+				webACLManager.EXPECT().ListResources(gomock.Any(), gomock.Any()).Return(call.webACLs, call.ipSets, call.err)
+			}
+			for _, call := range tt.fields.createIPSetCalls {
+				webACLManager.EXPECT().CreateIPSet(gomock.Any(), call.name, "IPV4", call.addresses, gomock.Any()).Return(call.ipSet, call.err)
+			}
+			for _, call := range tt.fields.updateIPSetCalls {
+				webACLManager.EXPECT().UpdateIPSet(gomock.Any(), call.sdkIPSet, call.addresses, gomock.Any()).Return(nil)
+			}
+			for _, call := range tt.fields.createCalls {
+				webACLManager.EXPECT().Create(gomock.Any(), gomock.Any(), call.ipSetARNs, gomock.Any()).Return(call.status, call.err)
+			}
+			for _, call := range tt.fields.updateCalls {
+				webACLManager.EXPECT().Update(gomock.Any(), gomock.Any(), call.sdkWebACL, call.ipSetARNs, gomock.Any()).Return(call.status, call.err)
+			}
+
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-stack"})
+			var resWebACLs []*wafv2model.WebACL
+			for _, spec := range tt.fields.webACLSpecs {
+				resWebACLs = append(resWebACLs, wafv2model.NewWebACL(stack, "WebACL", spec))
+			}
+			s := NewWebACLSynthesizer(webACLManager, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"), logr.Discard(), stack)
+			err := s.Synthesize(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			for _, resWebACL := range resWebACLs {
+				webACLARN, err := resWebACL.WebACLARN().Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantWebACLARN, webACLARN)
+			}
+			assert.Equal(t, tt.wantUnmatchedSDKWebACLs, s.unmatchedSDKWebACLs)
+			assert.Equal(t, tt.wantUnmatchedSDKIPSets, s.unmatchedSDKIPSets)
+		})
+	}
+}
+
+func Test_webACLSynthesizer_PostSynthesize(t *testing.T) {
+	sdkWebACL := ResourceWithTags{ARN: "web-acl-arn", Name: "web-acl", ID: "web-acl-id"}
+	sdkIPSet := ResourceWithTags{ARN: "ip-set-arn", Name: "ip-set", ID: "ip-set-id"}
+	tests := []struct {
+		name                string
+		unmatchedSDKWebACLs []ResourceWithTags
+		unmatchedSDKIPSets  []ResourceWithTags
+		deleteErr           error
+		wantErr             error
+	}{
+		{
+			name: "when there is no unmatched resources",
+		},
+		{
+			name:                "webACLs are deleted before IPSets",
+			unmatchedSDKWebACLs: []ResourceWithTags{sdkWebACL},
+			unmatchedSDKIPSets:  []ResourceWithTags{sdkIPSet},
+		},
+		{
+			name:                "when failed to delete webACL",
+			unmatchedSDKWebACLs: []ResourceWithTags{sdkWebACL},
+			unmatchedSDKIPSets:  []ResourceWithTags{sdkIPSet},
+			deleteErr:           errors.New("some error"),
+			wantErr:             errors.New("failed to delete WAFv2 webACL: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			webACLManager := NewMockWebACLManager(ctrl)
+			var calls []*gomock.Call
+			for _, sdkWebACL := range tt.unmatchedSDKWebACLs {
+				calls = append(calls, webACLManager.EXPECT().Delete(gomock.Any(), sdkWebACL).Return(tt.deleteErr))
+			}
+			if tt.deleteErr == nil {
+				for _, sdkIPSet := range tt.unmatchedSDKIPSets {
+					calls = append(calls, webACLManager.EXPECT().DeleteIPSet(gomock.Any(), sdkIPSet).Return(nil))
+				}
+			}
+			gomock.InOrder(calls...)
+
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+			s := NewWebACLSynthesizer(webACLManager, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"), logr.Discard(), stack)
+			s.unmatchedSDKWebACLs = tt.unmatchedSDKWebACLs
+			s.unmatchedSDKIPSets = tt.unmatchedSDKIPSets
+			err := s.PostSynthesize(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_webACLSynthesizer_Plan(t *testing.T) {
+	webACLSpec := wafv2model.WebACLSpec{
+		Name:          "k8s-abcdef0123-my-web-acl",
+		DefaultAction: wafv2model.Action{Type: wafv2model.ActionTypeAllow},
+		Rules: []wafv2model.Rule{
+			{
+				Name:     "office",
+				Priority: 0,
+				IPSetReference: &wafv2model.IPSetReferenceStatement{
+					Addresses: []string{"10.0.0.0/8"},
+				},
+			},
+		},
+	}
+	ipSetSpecHash, _ := computeSpecHash(struct {
+		IPAddressVersion string   `json:"ipAddressVersion"`
+		Addresses        []string `json:"addresses"`
+	}{"IPV4", []string{"10.0.0.0/8"}})
+	webACLSpecHash, _ := computeSpecHash(struct {
+		Spec      wafv2model.WebACLSpec `json:"spec"`
+		IPSetARNs map[string]string     `json:"ipSetARNs"`
+	}{webACLSpec, map[string]string{"office": "ip-set-arn"}})
+	sdkIPSet := ResourceWithTags{
+		ARN:  "ip-set-arn",
+		Name: "k8s-abcdef0123-my-web-acl-office-ipv4",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "WebACL/office",
+			specHashTagKey:             ipSetSpecHash,
+		},
+	}
+	sdkWebACL := ResourceWithTags{
+		ARN:  "web-acl-arn",
+		Name: "k8s-abcdef0123-my-web-acl",
+		Tags: map[string]string{
+			"ingress.k8s.aws/resource": "WebACL",
+			specHashTagKey:             webACLSpecHash,
+		},
+	}
+	tests := []struct {
+		name          string
+		webACLSpecs   []wafv2model.WebACLSpec
+		sdkWebACLs    []ResourceWithTags
+		sdkIPSets     []ResourceWithTags
+		wantActions   []plan.Action
+		wantWebACLARN string
+	}{
+		{
+			name:        "when webACL resource is new",
+			webACLSpecs: []wafv2model.WebACLSpec{webACLSpec},
+			wantActions: []plan.Action{
+				{Type: plan.ActionTypeCreate, ResourceType: "AWS::WAFv2::IPSet", ResourceID: "WebACL/office"},
+				{Type: plan.ActionTypeCreate, ResourceType: "AWS::WAFv2::WebACL", ResourceID: "WebACL"},
+			},
+			wantWebACLARN: "planned:AWS::WAFv2::WebACL/WebACL",
+		},
+		{
+			name:          "when webACL and IPSet are up to date",
+			webACLSpecs:   []wafv2model.WebACLSpec{webACLSpec},
+			sdkWebACLs:    []ResourceWithTags{sdkWebACL},
+			sdkIPSets:     []ResourceWithTags{sdkIPSet},
+			wantWebACLARN: "web-acl-arn",
+		},
+		{
+			name:        "when IPSet is outdated",
+			webACLSpecs: []wafv2model.WebACLSpec{webACLSpec},
+			sdkWebACLs:  []ResourceWithTags{sdkWebACL},
+			sdkIPSets: []ResourceWithTags{
+				{
+					ARN:  "ip-set-arn",
+					Name: "k8s-abcdef0123-my-web-acl-office-ipv4",
+					Tags: map[string]string{
+						"ingress.k8s.aws/resource": "WebACL/office",
+						specHashTagKey:             "outdated",
+					},
+				},
+			},
+			wantActions: []plan.Action{
+				{Type: plan.ActionTypeUpdate, ResourceType: "AWS::WAFv2::IPSet", ResourceID: "WebACL/office", Identifier: "ip-set-arn", Changes: []string{"addresses"}},
+			},
+			wantWebACLARN: "web-acl-arn",
+		},
+		{
+			name:       "when webACL resource is removed",
+			sdkWebACLs: []ResourceWithTags{sdkWebACL},
+			sdkIPSets:  []ResourceWithTags{sdkIPSet},
+			wantActions: []plan.Action{
+				{Type: plan.ActionTypeDelete, ResourceType: "AWS::WAFv2::WebACL", Identifier: "web-acl-arn"},
+				{Type: plan.ActionTypeDelete, ResourceType: "AWS::WAFv2::IPSet", Identifier: "ip-set-arn"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			webACLManager := NewMockWebACLManager(ctrl)
+			webACLManager.EXPECT().ListResources(gomock.Any(), gomock.Any()).Return(tt.sdkWebACLs, tt.sdkIPSets, nil)
+
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-stack"})
+			var resWebACLs []*wafv2model.WebACL
+			for _, spec := range tt.webACLSpecs {
+				resWebACLs = append(resWebACLs, wafv2model.NewWebACL(stack, "WebACL", spec))
+			}
+			s := NewWebACLSynthesizer(webACLManager, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"), logr.Discard(), stack)
+			actions, err := s.Plan(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantActions, actions)
+			for _, resWebACL := range resWebACLs {
+				webACLARN, err := resWebACL.WebACLARN().Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantWebACLARN, webACLARN)
+			}
+		})
+	}
+}
+
+func Test_webACLSynthesizer_Release(t *testing.T) {
+	sdkWebACL := ResourceWithTags{
+		ARN:  "web-acl-arn",
+		Name: "web-acl",
+		Tags: map[string]string{
+			"elbv2.k8s.aws/cluster":    "cluster-name",
+			"ingress.k8s.aws/stack":    "awesome-stack",
+			"ingress.k8s.aws/resource": "WebACL",
+			"team":                     "awesome",
+		},
+	}
+	sdkIPSet := ResourceWithTags{
+		ARN:  "ip-set-arn",
+		Name: "ip-set",
+		Tags: map[string]string{
+			"elbv2.k8s.aws/cluster":    "cluster-name",
+			"ingress.k8s.aws/stack":    "awesome-stack",
+			"ingress.k8s.aws/resource": "WebACL/office",
+		},
+	}
+	wantReleasedResources := []tracking.ReleasedResource{
+		{ResourceType: "AWS::WAFv2::WebACL", Identifier: "web-acl-arn"},
+		{ResourceType: "AWS::WAFv2::IPSet", Identifier: "ip-set-arn"},
+	}
+	tests := []struct {
+		name    string
+		untrack bool
+	}{
+		{
+			name:    "resources are untracked",
+			untrack: true,
+		},
+		{
+			name:    "resources are left as is",
+			untrack: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			webACLManager := NewMockWebACLManager(ctrl)
+			webACLManager.EXPECT().ListResources(gomock.Any(), gomock.Any()).Return([]ResourceWithTags{sdkWebACL}, []ResourceWithTags{sdkIPSet}, nil)
+			if tt.untrack {
+				webACLManager.EXPECT().ReconcileTags(gomock.Any(), sdkWebACL, map[string]string{"team": "awesome"}).Return(nil)
+				webACLManager.EXPECT().ReconcileTags(gomock.Any(), sdkIPSet, map[string]string{}).Return(nil)
+			}
+
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+			s := NewWebACLSynthesizer(webACLManager, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"), logr.Discard(), stack)
+			releasedResources, err := s.Release(context.Background(), tt.untrack)
+			assert.NoError(t, err)
+			assert.Equal(t, wantReleasedResources, releasedResources)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package drift
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// MetricsCollector collects the metrics of drift detection.
+type MetricsCollector interface {
+	// ObserveDrifts records the drifts detected for stack, replacing the drifts recorded previously.
+	ObserveDrifts(kind core.StackKind, stackID string, drifts []Drift)
+
+	// ObserveRemediation records a remediation requested for stack.
+	ObserveRemediation(kind core.StackKind, stackID string)
+
+	// Reset removes the metrics recorded for stack.
+	Reset(kind core.StackKind, stackID string)
+}
+
+// NewCollector constructs new collector that registers metrics to registerer.
+func NewCollector(registerer prometheus.Registerer) (*collector, error) {
+	instruments, err := newInstruments(registerer)
+	if err != nil {
+		return nil, err
+	}
+	return &collector{
+		instruments: instruments,
+	}, nil
+}
+
+var _ MetricsCollector = &collector{}
+
+// default implementation for MetricsCollector.
+type collector struct {
+	instruments *instruments
+}
+
+func (c *collector) ObserveDrifts(kind core.StackKind, stackID string, drifts []Drift) {
+	c.instruments.detectedChanges.DeletePartialMatch(stackLabels(kind, stackID))
+	for _, drift := range drifts {
+		for _, change := range drift.Changes {
+			c.instruments.detectedChanges.With(map[string]string{
+				labelKind:         string(kind),
+				labelStack:        stackID,
+				labelResourceType: drift.ResourceType,
+				labelResource:     drift.Resource,
+				labelChange:       change,
+			}).Set(1)
+		}
+	}
+	if len(drifts) != 0 {
+		c.instruments.detectionsTotal.With(stackLabels(kind, stackID)).Inc()
+	}
+}
+
+func (c *collector) ObserveRemediation(kind core.StackKind, stackID string) {
+	c.instruments.remediationsTotal.With(stackLabels(kind, stackID)).Inc()
+}
+
+func (c *collector) Reset(kind core.StackKind, stackID string) {
+	c.instruments.detectedChanges.DeletePartialMatch(stackLabels(kind, stackID))
+	c.instruments.detectionsTotal.DeletePartialMatch(stackLabels(kind, stackID))
+	c.instruments.remediationsTotal.DeletePartialMatch(stackLabels(kind, stackID))
+}
+
+func stackLabels(kind core.StackKind, stackID string) prometheus.Labels {
+	return prometheus.Labels{
+		labelKind:  string(kind),
+		labelStack: stackID,
+	}
+}
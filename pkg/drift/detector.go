@@ -0,0 +1,221 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Target is a deployed model stack to detect drift for.
+type Target struct {
+	// StackID is the ID of the model stack that was last deployed.
+	StackID core.StackID
+
+	// BuildStack rebuilds the model stack that was last deployed.
+	// the stack is rebuilt for every detection, since planning resolves the status of resources into the stack it plans,
+	// which mustn't happen to the stack deployed by reconcile.
+	BuildStack BuildStackFunc
+
+	// Objects are the Kubernetes objects the stack is built for, drift is reported on these objects.
+	Objects []client.Object
+
+	// Remediate specifies whether to remediate drift once detected.
+	Remediate bool
+
+	// StackPlanner plans the stack, e.g. through the clients of the AWS account the stack is deployed to.
+	// the StackPlanner of the Detector is used if nil.
+	StackPlanner StackPlanner
+}
+
+// StackPlanner computes the changes to deploy a model stack without applying them.
+type StackPlanner interface {
+	Plan(ctx context.Context, stack core.Stack) (plan.Plan, error)
+}
+
+// BuildStackFunc builds the model stack to detect drift for.
+type BuildStackFunc func(ctx context.Context) (core.Stack, error)
+
+// RemediateFunc requests the remediation of drift for objects, e.g. by enqueueing them for reconcile.
+type RemediateFunc func(ctx context.Context, objs []client.Object) error
+
+// Detector periodically detects drift of deployed resources from the model stacks they're deployed from.
+type Detector interface {
+	// Register registers target for drift detection, it replaces the target previously registered for the same stack.
+	Register(target Target)
+
+	// Unregister stops drift detection for stack.
+	Unregister(stackID core.StackID)
+}
+
+// NewDefaultDetector constructs new defaultDetector.
+// drift detection is disabled if interval isn't positive, and drift is never remediated if remediateFunc is nil.
+func NewDefaultDetector(kind core.StackKind, stackPlanner StackPlanner, eventRecorder record.EventRecorder,
+	metricsCollector MetricsCollector, interval time.Duration, remediateFunc RemediateFunc, logger logr.Logger) *defaultDetector {
+	return &defaultDetector{
+		kind:             kind,
+		stackPlanner:     stackPlanner,
+		eventRecorder:    eventRecorder,
+		metricsCollector: metricsCollector,
+		interval:         interval,
+		remediateFunc:    remediateFunc,
+		logger:           logger,
+		targets:          make(map[core.StackID]*Target),
+	}
+}
+
+var _ Detector = &defaultDetector{}
+var _ manager.Runnable = &defaultDetector{}
+
+// default implementation for Detector.
+type defaultDetector struct {
+	kind             core.StackKind
+	stackPlanner     StackPlanner
+	eventRecorder    record.EventRecorder
+	metricsCollector MetricsCollector
+	interval         time.Duration
+	remediateFunc    RemediateFunc
+	logger           logr.Logger
+
+	targetsMutex sync.Mutex
+	targets      map[core.StackID]*Target
+}
+
+// Enabled checks whether drift detection is enabled.
+func (d *defaultDetector) Enabled() bool {
+	return d.interval > 0
+}
+
+func (d *defaultDetector) Register(target Target) {
+	if !d.Enabled() {
+		return
+	}
+	d.targetsMutex.Lock()
+	defer d.targetsMutex.Unlock()
+	d.targets[target.StackID] = &target
+}
+
+func (d *defaultDetector) Unregister(stackID core.StackID) {
+	if !d.Enabled() {
+		return
+	}
+	d.targetsMutex.Lock()
+	defer d.targetsMutex.Unlock()
+	if _, exists := d.targets[stackID]; !exists {
+		return
+	}
+	delete(d.targets, stackID)
+	d.metricsCollector.Reset(d.kind, stackID.String())
+}
+
+// Start runs drift detection on registered targets every interval until ctx is done.
+func (d *defaultDetector) Start(ctx context.Context) error {
+	if !d.Enabled() {
+		return nil
+	}
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.detectAll(ctx)
+		}
+	}
+}
+
+func (d *defaultDetector) detectAll(ctx context.Context) {
+	for _, stackID := range d.listStackIDs() {
+		if err := d.detect(ctx, stackID); err != nil {
+			d.logger.Error(err, "failed to detect drift", "stackID", stackID.String())
+		}
+	}
+}
+
+// detect detects and reports drift for the target registered for stackID, and remediates it if requested.
+func (d *defaultDetector) detect(ctx context.Context, stackID core.StackID) error {
+	target, exists := d.getTarget(stackID)
+	if !exists {
+		return nil
+	}
+	stackPlanner := d.stackPlanner
+	if target.StackPlanner != nil {
+		stackPlanner = target.StackPlanner
+	}
+	stack, err := target.BuildStack(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build stack: %v", stackID.String())
+	}
+	stackPlan, err := stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		return errors.Wrapf(err, "failed to plan stack: %v", stackID.String())
+	}
+	drifts := BuildDrifts(stackPlan)
+
+	// the target might be replaced or unregistered by reconcile while planning, in which case the result is stale.
+	d.targetsMutex.Lock()
+	if d.targets[stackID] != target {
+		d.targetsMutex.Unlock()
+		return nil
+	}
+	d.metricsCollector.ObserveDrifts(d.kind, stackID.String(), drifts)
+	d.targetsMutex.Unlock()
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	d.logger.Info("detected drift", "kind", d.kind, "stackID", stackID.String(), "drifts", drifts)
+	message := fmt.Sprintf("Detected drift of deployed resources: %v", FormatDrifts(drifts))
+	for _, obj := range target.Objects {
+		d.eventRecorder.Event(obj, corev1.EventTypeWarning, d.eventReason(), message)
+	}
+	if !target.Remediate || d.remediateFunc == nil {
+		return nil
+	}
+	if err := d.remediateFunc(ctx, target.Objects); err != nil {
+		return errors.Wrapf(err, "failed to remediate drift of stack: %v", stackID.String())
+	}
+	d.metricsCollector.ObserveRemediation(d.kind, stackID.String())
+	return nil
+}
+
+// eventReason returns the reason of events that report drift on objects of kind.
+func (d *defaultDetector) eventReason() string {
+	if d.kind == core.StackKindService {
+		return k8s.ServiceEventReasonDriftDetected
+	}
+	return k8s.IngressEventReasonDriftDetected
+}
+
+func (d *defaultDetector) getTarget(stackID core.StackID) (*Target, bool) {
+	d.targetsMutex.Lock()
+	defer d.targetsMutex.Unlock()
+	target, exists := d.targets[stackID]
+	return target, exists
+}
+
+// listStackIDs returns the stackIDs of registered targets in a stable order.
+func (d *defaultDetector) listStackIDs() []core.StackID {
+	d.targetsMutex.Lock()
+	defer d.targetsMutex.Unlock()
+	stackIDs := make([]core.StackID, 0, len(d.targets))
+	for stackID := range d.targets {
+		stackIDs = append(stackIDs, stackID)
+	}
+	sort.Slice(stackIDs, func(i, j int) bool {
+		return stackIDs[i].String() < stackIDs[j].String()
+	})
+	return stackIDs
+}
@@ -0,0 +1,281 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeStackPlanner struct {
+	stackPlan plan.Plan
+	err       error
+	onPlan    func()
+}
+
+func (p *fakeStackPlanner) Plan(_ context.Context, _ core.Stack) (plan.Plan, error) {
+	if p.onPlan != nil {
+		p.onPlan()
+	}
+	return p.stackPlan, p.err
+}
+
+type fakeMetricsCollector struct {
+	drifts       map[string][]Drift
+	remediations map[string]int
+}
+
+func (c *fakeMetricsCollector) ObserveDrifts(_ core.StackKind, stackID string, drifts []Drift) {
+	c.drifts[stackID] = drifts
+}
+
+func (c *fakeMetricsCollector) ObserveRemediation(_ core.StackKind, stackID string) {
+	c.remediations[stackID]++
+}
+
+func (c *fakeMetricsCollector) Reset(_ core.StackKind, stackID string) {
+	delete(c.drifts, stackID)
+	delete(c.remediations, stackID)
+}
+
+func Test_defaultDetector_detect(t *testing.T) {
+	stackID := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "ing-1"})
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ing-1",
+		},
+	}
+	driftedPlan := plan.Plan{
+		StackID: stackID.String(),
+		Actions: []plan.Action{
+			{
+				Type:         plan.ActionTypeUpdate,
+				ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+				ResourceID:   "80",
+				Changes:      []string{"settings"},
+			},
+		},
+	}
+	wantDrifts := []Drift{
+		{
+			ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+			Resource:     "80",
+			Changes:      []string{"settings"},
+		},
+	}
+	tests := []struct {
+		name              string
+		stackPlan         plan.Plan
+		buildErr          error
+		planErr           error
+		targetPlanErr     error
+		remediate         bool
+		remediateErr      error
+		unregisterOnPlan  bool
+		wantErr           error
+		wantDrifts        map[string][]Drift
+		wantEvents        []string
+		wantRemediatedObj []client.Object
+		wantRemediations  map[string]int
+	}{
+		{
+			name: "no drift",
+			stackPlan: plan.Plan{
+				StackID: stackID.String(),
+				Actions: []plan.Action{},
+			},
+			wantDrifts: map[string][]Drift{
+				stackID.String(): nil,
+			},
+			wantRemediations: map[string]int{},
+		},
+		{
+			name:      "drift detected",
+			stackPlan: driftedPlan,
+			wantDrifts: map[string][]Drift{
+				stackID.String(): wantDrifts,
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of deployed resources: AWS::ElasticLoadBalancingV2::Listener/80 [settings]",
+			},
+			wantRemediations: map[string]int{},
+		},
+		{
+			name:      "drift detected and remediated",
+			stackPlan: driftedPlan,
+			remediate: true,
+			wantDrifts: map[string][]Drift{
+				stackID.String(): wantDrifts,
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of deployed resources: AWS::ElasticLoadBalancingV2::Listener/80 [settings]",
+			},
+			wantRemediatedObj: []client.Object{ing},
+			wantRemediations: map[string]int{
+				stackID.String(): 1,
+			},
+		},
+		{
+			name:         "drift detected and failed to remediate",
+			stackPlan:    driftedPlan,
+			remediate:    true,
+			remediateErr: errors.New("some error"),
+			wantErr:      errors.New("failed to remediate drift of stack: ns-1/ing-1: some error"),
+			wantDrifts: map[string][]Drift{
+				stackID.String(): wantDrifts,
+			},
+			wantEvents: []string{
+				"Warning DriftDetected Detected drift of deployed resources: AWS::ElasticLoadBalancingV2::Listener/80 [settings]",
+			},
+			wantRemediatedObj: []client.Object{ing},
+			wantRemediations:  map[string]int{},
+		},
+		{
+			name:             "target unregistered while planning",
+			stackPlan:        driftedPlan,
+			remediate:        true,
+			unregisterOnPlan: true,
+			wantDrifts:       map[string][]Drift{},
+			wantRemediations: map[string]int{},
+		},
+		{
+			name:             "failed to build stack",
+			stackPlan:        driftedPlan,
+			buildErr:         errors.New("some error"),
+			wantErr:          errors.New("failed to build stack: ns-1/ing-1: some error"),
+			wantDrifts:       map[string][]Drift{},
+			wantRemediations: map[string]int{},
+		},
+		{
+			name:             "failed to plan",
+			planErr:          errors.New("some error"),
+			wantErr:          errors.New("failed to plan stack: ns-1/ing-1: some error"),
+			wantDrifts:       map[string][]Drift{},
+			wantRemediations: map[string]int{},
+		},
+		{
+			name:             "stack planner of target takes priority",
+			stackPlan:        driftedPlan,
+			targetPlanErr:    errors.New("some error"),
+			wantErr:          errors.New("failed to plan stack: ns-1/ing-1: some error"),
+			wantDrifts:       map[string][]Drift{},
+			wantRemediations: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRecorder := record.NewFakeRecorder(10)
+			metricsCollector := &fakeMetricsCollector{
+				drifts:       map[string][]Drift{},
+				remediations: map[string]int{},
+			}
+			var remediatedObjs []client.Object
+			remediateFunc := func(_ context.Context, objs []client.Object) error {
+				remediatedObjs = append(remediatedObjs, objs...)
+				return tt.remediateErr
+			}
+			stackPlanner := &fakeStackPlanner{
+				stackPlan: tt.stackPlan,
+				err:       tt.planErr,
+			}
+			d := NewDefaultDetector(core.StackKindIngressGroup, stackPlanner, eventRecorder, metricsCollector,
+				time.Minute, remediateFunc, logr.New(&log.NullLogSink{}))
+			if tt.unregisterOnPlan {
+				stackPlanner.onPlan = func() {
+					d.Unregister(stackID)
+				}
+			}
+			target := Target{
+				StackID: stackID,
+				BuildStack: func(_ context.Context) (core.Stack, error) {
+					return core.NewDefaultStack(stackID), tt.buildErr
+				},
+				Objects:   []client.Object{ing},
+				Remediate: tt.remediate,
+			}
+			if tt.targetPlanErr != nil {
+				target.StackPlanner = &fakeStackPlanner{
+					err: tt.targetPlanErr,
+				}
+			}
+			d.Register(target)
+
+			err := d.detect(context.Background(), stackID)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			close(eventRecorder.Events)
+			var gotEvents []string
+			for event := range eventRecorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+			assert.Equal(t, tt.wantDrifts, metricsCollector.drifts)
+			assert.Equal(t, tt.wantRemediations, metricsCollector.remediations)
+			assert.Equal(t, tt.wantRemediatedObj, remediatedObjs)
+		})
+	}
+}
+
+func Test_defaultDetector_Register(t *testing.T) {
+	stackIDA := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "svc-a"})
+	stackIDB := core.StackID(types.NamespacedName{Namespace: "ns-1", Name: "svc-b"})
+	tests := []struct {
+		name         string
+		interval     time.Duration
+		register     []core.StackID
+		unregister   []core.StackID
+		wantStackIDs []core.StackID
+	}{
+		{
+			name:         "register and unregister targets",
+			interval:     time.Minute,
+			register:     []core.StackID{stackIDB, stackIDA, stackIDB},
+			unregister:   []core.StackID{stackIDB},
+			wantStackIDs: []core.StackID{stackIDA},
+		},
+		{
+			name:         "targets are ordered by stackID",
+			interval:     time.Minute,
+			register:     []core.StackID{stackIDB, stackIDA},
+			wantStackIDs: []core.StackID{stackIDA, stackIDB},
+		},
+		{
+			name:         "drift detection disabled",
+			interval:     0,
+			register:     []core.StackID{stackIDA},
+			wantStackIDs: []core.StackID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsCollector := &fakeMetricsCollector{
+				drifts:       map[string][]Drift{},
+				remediations: map[string]int{},
+			}
+			d := NewDefaultDetector(core.StackKindService, &fakeStackPlanner{}, record.NewFakeRecorder(10), metricsCollector,
+				tt.interval, nil, logr.New(&log.NullLogSink{}))
+			for _, stackID := range tt.register {
+				d.Register(Target{StackID: stackID})
+			}
+			for _, stackID := range tt.unregister {
+				d.Unregister(stackID)
+			}
+			assert.Equal(t, tt.wantStackIDs, d.listStackIDs())
+		})
+	}
+}
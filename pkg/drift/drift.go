@@ -0,0 +1,63 @@
+package drift
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+)
+
+const (
+	// ChangeMissing is the change reported for resources of stack that no longer exist.
+	ChangeMissing = "missing"
+	// ChangeUnexpected is the change reported for resources tagged for stack that aren't part of it.
+	ChangeUnexpected = "unexpected"
+)
+
+// Drift is the drift of a deployed resource from the model stack it's deployed from.
+type Drift struct {
+	// ResourceType is the type of the resource, e.g. AWS::ElasticLoadBalancingV2::Listener.
+	ResourceType string `json:"resourceType"`
+
+	// Resource is the ID of the resource within stack, or the identifier of unexpected resources.
+	Resource string `json:"resource"`
+
+	// Changes are the settings of the resource that drifted.
+	Changes []string `json:"changes"`
+}
+
+// String returns a human readable representation of the drift.
+func (d Drift) String() string {
+	return fmt.Sprintf("%s/%s [%s]", d.ResourceType, d.Resource, strings.Join(d.Changes, ", "))
+}
+
+// BuildDrifts builds the drifts from the plan to deploy the model stack over the deployed resources.
+func BuildDrifts(stackPlan plan.Plan) []Drift {
+	var drifts []Drift
+	for _, action := range stackPlan.Actions {
+		drift := Drift{
+			ResourceType: action.ResourceType,
+			Resource:     action.ResourceID,
+		}
+		switch action.Type {
+		case plan.ActionTypeCreate:
+			drift.Changes = []string{ChangeMissing}
+		case plan.ActionTypeDelete:
+			drift.Resource = action.Identifier
+			drift.Changes = []string{ChangeUnexpected}
+		default:
+			drift.Changes = action.Changes
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts
+}
+
+// FormatDrifts returns a human readable representation of drifts.
+func FormatDrifts(drifts []Drift) string {
+	formatted := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		formatted = append(formatted, drift.String())
+	}
+	return strings.Join(formatted, "; ")
+}
@@ -0,0 +1,102 @@
+package drift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+)
+
+func Test_BuildDrifts(t *testing.T) {
+	tests := []struct {
+		name      string
+		stackPlan plan.Plan
+		want      []Drift
+	}{
+		{
+			name: "no changes",
+			stackPlan: plan.Plan{
+				StackID: "ns-1/ing-1",
+				Actions: []plan.Action{},
+			},
+			want: nil,
+		},
+		{
+			name: "updated, missing and unexpected resources",
+			stackPlan: plan.Plan{
+				StackID: "ns-1/ing-1",
+				Actions: []plan.Action{
+					{
+						Type:         plan.ActionTypeUpdate,
+						ResourceType: "AWS::EC2::SecurityGroup",
+						ResourceID:   "ManagedLBSecurityGroup",
+						Identifier:   "sg-a",
+						Changes:      []string{"ingress"},
+					},
+					{
+						Type:         plan.ActionTypeUpdate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						ResourceID:   "ns-1/ing-1-svc-1:http",
+						Identifier:   "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-tg-a/a",
+						Changes:      []string{"healthCheck", "attributes"},
+					},
+					{
+						Type:         plan.ActionTypeCreate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::ListenerRule",
+						ResourceID:   "80:1",
+					},
+					{
+						Type:         plan.ActionTypeDelete,
+						ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+						Identifier:   "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/k8s-lb-a/a/8080",
+					},
+				},
+			},
+			want: []Drift{
+				{
+					ResourceType: "AWS::EC2::SecurityGroup",
+					Resource:     "ManagedLBSecurityGroup",
+					Changes:      []string{"ingress"},
+				},
+				{
+					ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+					Resource:     "ns-1/ing-1-svc-1:http",
+					Changes:      []string{"healthCheck", "attributes"},
+				},
+				{
+					ResourceType: "AWS::ElasticLoadBalancingV2::ListenerRule",
+					Resource:     "80:1",
+					Changes:      []string{ChangeMissing},
+				},
+				{
+					ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+					Resource:     "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/k8s-lb-a/a/8080",
+					Changes:      []string{ChangeUnexpected},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildDrifts(tt.stackPlan)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_FormatDrifts(t *testing.T) {
+	drifts := []Drift{
+		{
+			ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+			Resource:     "443",
+			Changes:      []string{"settings", "extraCertificates"},
+		},
+		{
+			ResourceType: "AWS::ElasticLoadBalancingV2::ListenerRule",
+			Resource:     "443:1",
+			Changes:      []string{ChangeMissing},
+		},
+	}
+	got := FormatDrifts(drifts)
+	assert.Equal(t, "AWS::ElasticLoadBalancingV2::Listener/443 [settings, extraCertificates]; AWS::ElasticLoadBalancingV2::ListenerRule/443:1 [missing]", got)
+}
@@ -0,0 +1,61 @@
+package drift
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemDrift = "drift"
+
+	metricDetectedChanges   = "detected_changes"
+	metricDetectionsTotal   = "detections_total"
+	metricRemediationsTotal = "remediations_total"
+)
+
+const (
+	labelKind         = "kind"
+	labelStack        = "stack"
+	labelResourceType = "resource_type"
+	labelResource     = "resource"
+	labelChange       = "change"
+)
+
+type instruments struct {
+	detectedChanges   *prometheus.GaugeVec
+	detectionsTotal   *prometheus.CounterVec
+	remediationsTotal *prometheus.CounterVec
+}
+
+// newInstruments allocates and register new metrics to registerer
+func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
+	detectedChanges := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemDrift,
+		Name:      metricDetectedChanges,
+		Help:      "Settings of deployed resources that drifted from the model stack during the last drift detection",
+	}, []string{labelKind, labelStack, labelResourceType, labelResource, labelChange})
+	detectionsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemDrift,
+		Name:      metricDetectionsTotal,
+		Help:      "Total number of drift detections that found drifted resources",
+	}, []string{labelKind, labelStack})
+	remediationsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemDrift,
+		Name:      metricRemediationsTotal,
+		Help:      "Total number of remediations requested for drifted resources",
+	}, []string{labelKind, labelStack})
+
+	if err := registerer.Register(detectedChanges); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(detectionsTotal); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(remediationsTotal); err != nil {
+		return nil, err
+	}
+	return &instruments{
+		detectedChanges:   detectedChanges,
+		detectionsTotal:   detectionsTotal,
+		remediationsTotal: remediationsTotal,
+	}, nil
+}
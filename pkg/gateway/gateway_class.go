@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// ALBGatewayClassControllerName is the controllerName of GatewayClasses whose Gateways are implemented by ALBs.
+	ALBGatewayClassControllerName gwv1.GatewayController = "gateway.k8s.aws/alb"
+	// NLBGatewayClassControllerName is the controllerName of GatewayClasses whose Gateways are implemented by NLBs.
+	NLBGatewayClassControllerName gwv1.GatewayController = "gateway.k8s.aws/nlb"
+)
+
+// ALBSupportedKindsByProtocol defines the route kinds can be attached to ALB Gateway listeners of each protocol.
+var ALBSupportedKindsByProtocol = map[gwv1.ProtocolType][]RouteKind{
+	gwv1.HTTPProtocolType:  {RouteKindHTTPRoute, RouteKindGRPCRoute},
+	gwv1.HTTPSProtocolType: {RouteKindHTTPRoute, RouteKindGRPCRoute},
+}
+
+// NLBSupportedKindsByProtocol defines the route kinds can be attached to NLB Gateway listeners of each protocol.
+var NLBSupportedKindsByProtocol = map[gwv1.ProtocolType][]RouteKind{
+	gwv1.TCPProtocolType: {RouteKindTCPRoute},
+	gwv1.UDPProtocolType: {RouteKindUDPRoute},
+	gwv1.TLSProtocolType: {RouteKindTLSRoute, RouteKindTCPRoute},
+}
+
+// IsGatewayClassManaged checks whether the GatewayClass is managed by controller with controllerName.
+func IsGatewayClassManaged(gwClass *gwv1.GatewayClass, controllerName gwv1.GatewayController) bool {
+	return gwClass.Spec.ControllerName == controllerName
+}
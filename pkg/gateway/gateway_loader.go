@@ -0,0 +1,442 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// kindGateway is the kind of Gateway resource.
+	kindGateway = "Gateway"
+	// kindService is the kind of Service resource.
+	kindService = "Service"
+)
+
+// Gateway is a Gateway along with the routes attached to its listeners.
+type Gateway struct {
+	Gateway *gwv1.Gateway
+	// Listeners of the Gateway with their attached routes, in the order of spec.listeners.
+	Listeners []Listener
+	// RouteParents contains the attachment result for every route parentRef pointing at the Gateway.
+	RouteParents []RouteParent
+}
+
+// Listener is a listener of Gateway along with the routes attached to it.
+type Listener struct {
+	gwv1.Listener
+	// SupportedKinds are the route kinds that can be attached to this listener.
+	SupportedKinds []RouteKind
+	// Routes attached to this listener.
+	Routes []AttachedRoute
+}
+
+// AttachedRoute is a route attached to a listener.
+type AttachedRoute struct {
+	*Route
+	// Hostnames are the route hostnames accepted by the listener, nil means any hostname.
+	Hostnames []string
+}
+
+// RouteParent is the attachment result of a route for one of its parentRefs.
+type RouteParent struct {
+	Route     *Route
+	ParentRef gwv1.ParentReference
+	Accepted  bool
+	Reason    gwv1.RouteConditionReason
+	Message   string
+}
+
+// GatewayLoader loads the routes attached to a Gateway.
+type GatewayLoader interface {
+	// Load the Gateway along with the routes attached to it.
+	Load(ctx context.Context, gw *gwv1.Gateway) (Gateway, error)
+}
+
+// NewDefaultGatewayLoader constructs new defaultGatewayLoader.
+// supportedKindsByProtocol defines the route kinds can be attached to listeners of each protocol.
+func NewDefaultGatewayLoader(k8sClient client.Client, supportedKindsByProtocol map[gwv1.ProtocolType][]RouteKind, logger logr.Logger) *defaultGatewayLoader {
+	return &defaultGatewayLoader{
+		k8sClient:                k8sClient,
+		supportedKindsByProtocol: supportedKindsByProtocol,
+		logger:                   logger,
+	}
+}
+
+var _ GatewayLoader = &defaultGatewayLoader{}
+
+// default implementation for GatewayLoader
+type defaultGatewayLoader struct {
+	k8sClient                client.Client
+	supportedKindsByProtocol map[gwv1.ProtocolType][]RouteKind
+	logger                   logr.Logger
+}
+
+func (l *defaultGatewayLoader) Load(ctx context.Context, gw *gwv1.Gateway) (Gateway, error) {
+	listeners := make([]Listener, 0, len(gw.Spec.Listeners))
+	for _, ls := range gw.Spec.Listeners {
+		listeners = append(listeners, Listener{
+			Listener:       ls,
+			SupportedKinds: l.computeListenerSupportedKinds(ls),
+		})
+	}
+	if !gw.DeletionTimestamp.IsZero() {
+		return Gateway{Gateway: gw, Listeners: listeners}, nil
+	}
+
+	var routeParents []RouteParent
+	for _, kind := range l.supportedRouteKinds() {
+		routes, err := routeListers[kind](ctx, l.k8sClient)
+		if err != nil {
+			return Gateway{}, err
+		}
+		sortRoutes(routes)
+		for i := range routes {
+			route := &routes[i]
+			parentRefs := parentRefsForGateway(route, gw)
+			if len(parentRefs) == 0 {
+				continue
+			}
+			if err := l.resolveRouteBackends(ctx, route); err != nil {
+				return Gateway{}, err
+			}
+			for _, parentRef := range parentRefs {
+				routeParent, err := l.attachRoute(ctx, gw, listeners, route, parentRef)
+				if err != nil {
+					return Gateway{}, err
+				}
+				routeParents = append(routeParents, routeParent)
+			}
+		}
+	}
+	return Gateway{
+		Gateway:      gw,
+		Listeners:    listeners,
+		RouteParents: routeParents,
+	}, nil
+}
+
+// supportedRouteKinds returns all route kinds supported by this loader in a stable order.
+func (l *defaultGatewayLoader) supportedRouteKinds() []RouteKind {
+	kindSet := make(map[RouteKind]struct{})
+	for _, kinds := range l.supportedKindsByProtocol {
+		for _, kind := range kinds {
+			kindSet[kind] = struct{}{}
+		}
+	}
+	kinds := make([]RouteKind, 0, len(kindSet))
+	for kind := range kindSet {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i] < kinds[j]
+	})
+	return kinds
+}
+
+// computeListenerSupportedKinds computes the route kinds that can be attached to the listener.
+func (l *defaultGatewayLoader) computeListenerSupportedKinds(ls gwv1.Listener) []RouteKind {
+	protocolKinds := l.supportedKindsByProtocol[ls.Protocol]
+	if ls.AllowedRoutes == nil || len(ls.AllowedRoutes.Kinds) == 0 {
+		return protocolKinds
+	}
+	var supportedKinds []RouteKind
+	for _, kind := range protocolKinds {
+		for _, allowedKind := range ls.AllowedRoutes.Kinds {
+			if allowedKind.Group != nil && *allowedKind.Group != gwv1.GroupName {
+				continue
+			}
+			if string(allowedKind.Kind) == string(kind) {
+				supportedKinds = append(supportedKinds, kind)
+				break
+			}
+		}
+	}
+	return supportedKinds
+}
+
+// attachRoute attaches the route to matching listeners of the Gateway per the parentRef.
+func (l *defaultGatewayLoader) attachRoute(ctx context.Context, gw *gwv1.Gateway, listeners []Listener, route *Route, parentRef gwv1.ParentReference) (RouteParent, error) {
+	routeParent := RouteParent{
+		Route:     route,
+		ParentRef: parentRef,
+	}
+	if route.UnsupportedReason != "" {
+		routeParent.Reason = gwv1.RouteReasonUnsupportedValue
+		routeParent.Message = route.UnsupportedReason
+		return routeParent, nil
+	}
+
+	var matchedParent, allowedByListener, conflictedRoute bool
+	for i := range listeners {
+		ls := &listeners[i]
+		if parentRef.SectionName != nil && *parentRef.SectionName != ls.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != ls.Port {
+			continue
+		}
+		matchedParent = true
+		allowed, err := l.isRouteAllowedByListener(ctx, gw, ls, route)
+		if err != nil {
+			return RouteParent{}, err
+		}
+		if !allowed {
+			continue
+		}
+		allowedByListener = true
+		hostnames, matched := computeListenerRouteHostnames(ls.Hostname, route.Hostnames)
+		if !matched {
+			continue
+		}
+		if isL4RouteKind(route.Kind) && hasOtherL4RouteOnPort(listeners, ls.Port, route) {
+			conflictedRoute = true
+			continue
+		}
+		ls.Routes = append(ls.Routes, AttachedRoute{
+			Route:     route,
+			Hostnames: hostnames,
+		})
+		routeParent.Accepted = true
+	}
+
+	switch {
+	case routeParent.Accepted:
+		routeParent.Reason = gwv1.RouteReasonAccepted
+		routeParent.Message = "Route is accepted"
+	case !matchedParent:
+		routeParent.Reason = gwv1.RouteReasonNoMatchingParent
+		routeParent.Message = "No listener matches the parentRef"
+	case !allowedByListener:
+		routeParent.Reason = gwv1.RouteReasonNotAllowedByListeners
+		routeParent.Message = "Route is not allowed by any listener"
+	case conflictedRoute:
+		routeParent.Reason = gwv1.RouteReasonUnsupportedValue
+		routeParent.Message = "Another route with the same protocol is already attached to the listener port"
+	default:
+		routeParent.Reason = gwv1.RouteReasonNoMatchingListenerHostname
+		routeParent.Message = "No listener hostname matches the route hostnames"
+	}
+	return routeParent, nil
+}
+
+// hasOtherL4RouteOnPort checks whether a route other than route with the same transport protocol is already attached to the listeners on port.
+// NLB listeners cannot route by content, so only the first route in precedence order per transport protocol can be attached to a port,
+// TCP and UDP routes on the same port are served together by a TCP_UDP listener.
+func hasOtherL4RouteOnPort(listeners []Listener, port gwv1.PortNumber, route *Route) bool {
+	for _, ls := range listeners {
+		if ls.Port != port {
+			continue
+		}
+		for _, attachedRoute := range ls.Routes {
+			if attachedRoute.Route != route && isL4RouteKind(attachedRoute.Kind) &&
+				l4RouteTransportProtocol(attachedRoute.Kind) == l4RouteTransportProtocol(route.Kind) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRouteAllowedByListener checks whether route's kind and namespace are allowed by the listener.
+func (l *defaultGatewayLoader) isRouteAllowedByListener(ctx context.Context, gw *gwv1.Gateway, ls *Listener, route *Route) (bool, error) {
+	kindSupported := false
+	for _, kind := range ls.SupportedKinds {
+		if kind == route.Kind {
+			kindSupported = true
+			break
+		}
+	}
+	if !kindSupported {
+		return false, nil
+	}
+
+	from := gwv1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if ls.AllowedRoutes != nil && ls.AllowedRoutes.Namespaces != nil {
+		if ls.AllowedRoutes.Namespaces.From != nil {
+			from = *ls.AllowedRoutes.Namespaces.From
+		}
+		selector = ls.AllowedRoutes.Namespaces.Selector
+	}
+	switch from {
+	case gwv1.NamespacesFromAll:
+		return true, nil
+	case gwv1.NamespacesFromSame:
+		return route.Object.GetNamespace() == gw.Namespace, nil
+	case gwv1.NamespacesFromSelector:
+		if selector == nil {
+			return false, nil
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, err
+		}
+		ns := &corev1.Namespace{}
+		if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: route.Object.GetNamespace()}, ns); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return labelSelector.Matches(labels.Set(ns.Labels)), nil
+	default:
+		return false, nil
+	}
+}
+
+// resolveRouteBackends resolves the backendRefs of each route rule into Services.
+// Unresolvable backendRefs are excluded from rule backends and reported on the route.
+func (l *defaultGatewayLoader) resolveRouteBackends(ctx context.Context, route *Route) error {
+	route.UnresolvedRefsReason = ""
+	route.UnresolvedRefsMessage = ""
+	for i := range route.Rules {
+		rule := &route.Rules[i]
+		rule.Backends = nil
+		for _, backendRef := range rule.BackendRefs {
+			backend, reason, message, err := l.resolveBackendRef(ctx, route, backendRef)
+			if err != nil {
+				return err
+			}
+			if reason != "" {
+				if route.UnresolvedRefsReason == "" {
+					route.UnresolvedRefsReason = reason
+					route.UnresolvedRefsMessage = message
+				}
+				continue
+			}
+			rule.Backends = append(rule.Backends, backend)
+		}
+	}
+	return nil
+}
+
+func (l *defaultGatewayLoader) resolveBackendRef(ctx context.Context, route *Route, backendRef gwv1.BackendRef) (RouteBackend, gwv1.RouteConditionReason, string, error) {
+	if (backendRef.Group != nil && *backendRef.Group != "") || (backendRef.Kind != nil && *backendRef.Kind != kindService) {
+		return RouteBackend{}, gwv1.RouteReasonInvalidKind, fmt.Sprintf("unsupported backend kind: %v", backendKindString(backendRef)), nil
+	}
+	svcKey := types.NamespacedName{
+		Namespace: route.Object.GetNamespace(),
+		Name:      string(backendRef.Name),
+	}
+	if backendRef.Namespace != nil {
+		svcKey.Namespace = string(*backendRef.Namespace)
+	}
+	if svcKey.Namespace != route.Object.GetNamespace() {
+		permitted, err := l.isBackendRefPermitted(ctx, route, svcKey)
+		if err != nil {
+			return RouteBackend{}, "", "", err
+		}
+		if !permitted {
+			return RouteBackend{}, gwv1.RouteReasonRefNotPermitted, fmt.Sprintf("reference to service %v is not permitted by any ReferenceGrant", svcKey), nil
+		}
+	}
+	if backendRef.Port == nil {
+		return RouteBackend{}, gwv1.RouteReasonUnsupportedValue, fmt.Sprintf("port is required for service %v", svcKey), nil
+	}
+	svc := &corev1.Service{}
+	if err := l.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return RouteBackend{}, gwv1.RouteReasonBackendNotFound, fmt.Sprintf("service %v not found", svcKey), nil
+		}
+		return RouteBackend{}, "", "", err
+	}
+	port := intstr.FromInt(int(*backendRef.Port))
+	if _, err := k8s.LookupServicePort(svc, port); err != nil {
+		return RouteBackend{}, gwv1.RouteReasonBackendNotFound, err.Error(), nil
+	}
+	weight := int64(1)
+	if backendRef.Weight != nil {
+		weight = int64(*backendRef.Weight)
+	}
+	return RouteBackend{
+		Service: svc,
+		Port:    port,
+		Weight:  weight,
+	}, "", "", nil
+}
+
+// isBackendRefPermitted checks whether a ReferenceGrant in service namespace permits the route to reference the service.
+func (l *defaultGatewayLoader) isBackendRefPermitted(ctx context.Context, route *Route, svcKey types.NamespacedName) (bool, error) {
+	refGrantList := &gwv1beta1.ReferenceGrantList{}
+	if err := l.k8sClient.List(ctx, refGrantList, client.InNamespace(svcKey.Namespace)); err != nil {
+		return false, err
+	}
+	for _, refGrant := range refGrantList.Items {
+		fromPermitted := false
+		for _, from := range refGrant.Spec.From {
+			if string(from.Group) == gwv1.GroupName && string(from.Kind) == string(route.Kind) &&
+				string(from.Namespace) == route.Object.GetNamespace() {
+				fromPermitted = true
+				break
+			}
+		}
+		if !fromPermitted {
+			continue
+		}
+		for _, to := range refGrant.Spec.To {
+			if to.Group == "" && to.Kind == kindService && (to.Name == nil || string(*to.Name) == svcKey.Name) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// parentRefsForGateway returns the parentRefs of route that refer to the Gateway.
+func parentRefsForGateway(route *Route, gw *gwv1.Gateway) []gwv1.ParentReference {
+	var parentRefs []gwv1.ParentReference
+	for _, parentRef := range route.ParentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gwv1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != kindGateway {
+			continue
+		}
+		namespace := route.Object.GetNamespace()
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		if namespace != gw.Namespace || string(parentRef.Name) != gw.Name {
+			continue
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+	return parentRefs
+}
+
+// sortRoutes sorts routes by creationTimestamp then by namespace/name, which is the precedence order defined by Gateway API.
+func sortRoutes(routes []Route) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		tsI := routes[i].Object.GetCreationTimestamp()
+		tsJ := routes[j].Object.GetCreationTimestamp()
+		if !tsI.Equal(&tsJ) {
+			return tsI.Before(&tsJ)
+		}
+		return k8s.NamespacedName(routes[i].Object).String() < k8s.NamespacedName(routes[j].Object).String()
+	})
+}
+
+func backendKindString(backendRef gwv1.BackendRef) string {
+	group := ""
+	if backendRef.Group != nil {
+		group = string(*backendRef.Group)
+	}
+	kind := kindService
+	if backendRef.Kind != nil {
+		kind = string(*backendRef.Kind)
+	}
+	if group == "" {
+		return kind
+	}
+	return fmt.Sprintf("%v.%v", kind, group)
+}
@@ -0,0 +1,461 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultGatewayLoader_Load(t *testing.T) {
+	fromAll := gwv1.NamespacesFromAll
+	fromSame := gwv1.NamespacesFromSame
+	otherNamespace := gwv1.Namespace("other-ns")
+	listenerHTTP := gwv1.SectionName("http")
+	listenerInternal := gwv1.SectionName("internal")
+	port80 := gwv1.PortNumber(80)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port: 80,
+				},
+			},
+		},
+	}
+	otherSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port: 80,
+				},
+			},
+		},
+	}
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "alb",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: gwv1.HTTPProtocolType,
+					AllowedRoutes: &gwv1.AllowedRoutes{
+						Namespaces: &gwv1.RouteNamespaces{From: &fromAll},
+					},
+				},
+				{
+					Name:     "internal",
+					Port:     8080,
+					Protocol: gwv1.HTTPProtocolType,
+					AllowedRoutes: &gwv1.AllowedRoutes{
+						Namespaces: &gwv1.RouteNamespaces{From: &fromSame},
+					},
+				},
+				{
+					Name:     "tcp",
+					Port:     9090,
+					Protocol: gwv1.TCPProtocolType,
+				},
+			},
+		},
+	}
+	newBackendRef := func(namespace *gwv1.Namespace, name string, port *gwv1.PortNumber) gwv1.HTTPBackendRef {
+		return gwv1.HTTPBackendRef{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Namespace: namespace,
+					Name:      gwv1.ObjectName(name),
+					Port:      port,
+				},
+			},
+		}
+	}
+	newHTTPRoute := func(namespace string, name string, sectionName *gwv1.SectionName, backendRefs ...gwv1.HTTPBackendRef) *gwv1.HTTPRoute {
+		gwNamespace := gwv1.Namespace("gw-ns")
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{
+						{
+							Namespace:   &gwNamespace,
+							Name:        "gw",
+							SectionName: sectionName,
+						},
+					},
+				},
+				Rules: []gwv1.HTTPRouteRule{
+					{
+						BackendRefs: backendRefs,
+					},
+				},
+			},
+		}
+	}
+
+	type routeParentSummary struct {
+		route    string
+		accepted bool
+		reason   gwv1.RouteConditionReason
+	}
+	type listenerSummary struct {
+		name           gwv1.SectionName
+		supportedKinds []RouteKind
+		routes         []string
+	}
+	tests := []struct {
+		name                 string
+		routes               []*gwv1.HTTPRoute
+		refGrants            []*gwv1beta1.ReferenceGrant
+		wantRouteParents     []routeParentSummary
+		wantListeners        []listenerSummary
+		wantUnresolvedReason map[string]gwv1.RouteConditionReason
+	}{
+		{
+			name: "routes attached per listener allowedRoutes",
+			routes: []*gwv1.HTTPRoute{
+				newHTTPRoute("gw-ns", "route-a", nil, newBackendRef(nil, "svc", &port80)),
+				newHTTPRoute("other-ns", "route-b", &listenerHTTP),
+				newHTTPRoute("other-ns", "route-c", &listenerInternal),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "other-ns/route-b", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "other-ns/route-c", accepted: false, reason: gwv1.RouteReasonNotAllowedByListeners},
+			},
+			wantListeners: []listenerSummary{
+				{name: "http", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: []string{"gw-ns/route-a", "other-ns/route-b"}},
+				{name: "internal", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: []string{"gw-ns/route-a"}},
+				{name: "tcp", supportedKinds: nil, routes: nil},
+			},
+			wantUnresolvedReason: map[string]gwv1.RouteConditionReason{
+				"gw-ns/route-a":    "",
+				"other-ns/route-b": "",
+				"other-ns/route-c": "",
+			},
+		},
+		{
+			name: "unresolvable backendRefs",
+			routes: []*gwv1.HTTPRoute{
+				newHTTPRoute("gw-ns", "route-a", &listenerHTTP, newBackendRef(nil, "svc", &port80), newBackendRef(nil, "missing-svc", &port80)),
+				newHTTPRoute("gw-ns", "route-b", &listenerHTTP, newBackendRef(&otherNamespace, "svc", &port80)),
+				newHTTPRoute("gw-ns", "route-c", &listenerHTTP, newBackendRef(nil, "svc", nil)),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "gw-ns/route-b", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "gw-ns/route-c", accepted: true, reason: gwv1.RouteReasonAccepted},
+			},
+			wantListeners: []listenerSummary{
+				{name: "http", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: []string{"gw-ns/route-a", "gw-ns/route-b", "gw-ns/route-c"}},
+				{name: "internal", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: nil},
+				{name: "tcp", supportedKinds: nil, routes: nil},
+			},
+			wantUnresolvedReason: map[string]gwv1.RouteConditionReason{
+				"gw-ns/route-a": gwv1.RouteReasonBackendNotFound,
+				"gw-ns/route-b": gwv1.RouteReasonRefNotPermitted,
+				"gw-ns/route-c": gwv1.RouteReasonUnsupportedValue,
+			},
+		},
+		{
+			name: "cross namespace backendRef permitted by ReferenceGrant",
+			routes: []*gwv1.HTTPRoute{
+				newHTTPRoute("gw-ns", "route-a", &listenerHTTP, newBackendRef(&otherNamespace, "svc", &port80)),
+			},
+			refGrants: []*gwv1beta1.ReferenceGrant{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "grant"},
+					Spec: gwv1beta1.ReferenceGrantSpec{
+						From: []gwv1beta1.ReferenceGrantFrom{
+							{
+								Group:     gwv1.GroupName,
+								Kind:      "HTTPRoute",
+								Namespace: "gw-ns",
+							},
+						},
+						To: []gwv1beta1.ReferenceGrantTo{
+							{
+								Group: "",
+								Kind:  "Service",
+							},
+						},
+					},
+				},
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+			},
+			wantListeners: []listenerSummary{
+				{name: "http", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: []string{"gw-ns/route-a"}},
+				{name: "internal", supportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute}, routes: nil},
+				{name: "tcp", supportedKinds: nil, routes: nil},
+			},
+			wantUnresolvedReason: map[string]gwv1.RouteConditionReason{
+				"gw-ns/route-a": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, otherSvc.DeepCopy()))
+			for _, route := range tt.routes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+			for _, refGrant := range tt.refGrants {
+				assert.NoError(t, k8sClient.Create(ctx, refGrant.DeepCopy()))
+			}
+
+			l := NewDefaultGatewayLoader(k8sClient, ALBSupportedKindsByProtocol, logr.New(&log.NullLogSink{}))
+			got, err := l.Load(ctx, gw.DeepCopy())
+			assert.NoError(t, err)
+
+			var gotRouteParents []routeParentSummary
+			gotUnresolvedReason := make(map[string]gwv1.RouteConditionReason)
+			for _, routeParent := range got.RouteParents {
+				routeKey := k8s.NamespacedName(routeParent.Route.Object).String()
+				gotRouteParents = append(gotRouteParents, routeParentSummary{
+					route:    routeKey,
+					accepted: routeParent.Accepted,
+					reason:   routeParent.Reason,
+				})
+				gotUnresolvedReason[routeKey] = routeParent.Route.UnresolvedRefsReason
+			}
+			assert.Equal(t, tt.wantRouteParents, gotRouteParents)
+			assert.Equal(t, tt.wantUnresolvedReason, gotUnresolvedReason)
+
+			var gotListeners []listenerSummary
+			for _, ls := range got.Listeners {
+				var routes []string
+				for _, route := range ls.Routes {
+					routes = append(routes, k8s.NamespacedName(route.Object).String())
+				}
+				gotListeners = append(gotListeners, listenerSummary{
+					name:           ls.Name,
+					supportedKinds: ls.SupportedKinds,
+					routes:         routes,
+				})
+			}
+			assert.Equal(t, tt.wantListeners, gotListeners)
+		})
+	}
+}
+
+func Test_defaultGatewayLoader_Load_L4Routes(t *testing.T) {
+	port53 := gwv1.PortNumber(53)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port: 53,
+				},
+			},
+		},
+	}
+	otherSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "other-svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port: 53,
+				},
+			},
+		},
+	}
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "gw"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "nlb",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "dns",
+					Port:     53,
+					Protocol: gwv1.TCPProtocolType,
+				},
+				{
+					Name:     "dns-udp",
+					Port:     53,
+					Protocol: gwv1.UDPProtocolType,
+				},
+			},
+		},
+	}
+	newBackendRef := func(name string, weight *int32) gwv1.BackendRef {
+		return gwv1.BackendRef{
+			BackendObjectReference: gwv1.BackendObjectReference{
+				Name: gwv1.ObjectName(name),
+				Port: &port53,
+			},
+			Weight: weight,
+		}
+	}
+	newTCPRoute := func(name string, creationTimestamp metav1.Time, backendRefs ...gwv1.BackendRef) *gwv1alpha2.TCPRoute {
+		return &gwv1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: name, CreationTimestamp: creationTimestamp},
+			Spec: gwv1alpha2.TCPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{
+						{
+							Name: "gw",
+						},
+					},
+				},
+				Rules: []gwv1alpha2.TCPRouteRule{
+					{
+						BackendRefs: backendRefs,
+					},
+				},
+			},
+		}
+	}
+	newUDPRoute := func(name string, creationTimestamp metav1.Time, backendRefs ...gwv1.BackendRef) *gwv1alpha2.UDPRoute {
+		return &gwv1alpha2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: name, CreationTimestamp: creationTimestamp},
+			Spec: gwv1alpha2.UDPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{
+						{
+							Name: "gw",
+						},
+					},
+				},
+				Rules: []gwv1alpha2.UDPRouteRule{
+					{
+						BackendRefs: backendRefs,
+					},
+				},
+			},
+		}
+	}
+	zeroWeight := int32(0)
+	oneWeight := int32(1)
+	earlier := metav1.NewTime(metav1.Now().Add(-time.Hour))
+	later := metav1.Now()
+
+	type routeParentSummary struct {
+		route    string
+		accepted bool
+		reason   gwv1.RouteConditionReason
+	}
+	tests := []struct {
+		name             string
+		routes           []*gwv1alpha2.TCPRoute
+		udpRoutes        []*gwv1alpha2.UDPRoute
+		wantRouteParents []routeParentSummary
+		wantRoutes       []string
+		wantUDPRoutes    []string
+	}{
+		{
+			name: "single backendRef, and backendRefs with zero weight",
+			routes: []*gwv1alpha2.TCPRoute{
+				newTCPRoute("route-a", earlier, newBackendRef("svc", &oneWeight), newBackendRef("other-svc", &zeroWeight)),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+			},
+			wantRoutes: []string{"gw-ns/route-a"},
+		},
+		{
+			name: "multiple weighted backendRefs",
+			routes: []*gwv1alpha2.TCPRoute{
+				newTCPRoute("route-a", earlier, newBackendRef("svc", nil), newBackendRef("other-svc", &oneWeight)),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+			},
+			wantRoutes: []string{"gw-ns/route-a"},
+		},
+		{
+			name: "only the first route is attached to a listener port",
+			routes: []*gwv1alpha2.TCPRoute{
+				newTCPRoute("route-b", later, newBackendRef("other-svc", nil)),
+				newTCPRoute("route-a", earlier, newBackendRef("svc", nil)),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "gw-ns/route-b", accepted: false, reason: gwv1.RouteReasonUnsupportedValue},
+			},
+			wantRoutes: []string{"gw-ns/route-a"},
+		},
+		{
+			name: "TCP and UDP routes share a listener port",
+			routes: []*gwv1alpha2.TCPRoute{
+				newTCPRoute("route-a", later, newBackendRef("svc", nil)),
+			},
+			udpRoutes: []*gwv1alpha2.UDPRoute{
+				newUDPRoute("route-b", earlier, newBackendRef("svc", nil)),
+			},
+			wantRouteParents: []routeParentSummary{
+				{route: "gw-ns/route-a", accepted: true, reason: gwv1.RouteReasonAccepted},
+				{route: "gw-ns/route-b", accepted: true, reason: gwv1.RouteReasonAccepted},
+			},
+			wantRoutes:    []string{"gw-ns/route-a"},
+			wantUDPRoutes: []string{"gw-ns/route-b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1.AddToScheme(k8sSchema)
+			gwv1alpha2.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, otherSvc.DeepCopy()))
+			for _, route := range tt.routes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+			for _, route := range tt.udpRoutes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+
+			l := NewDefaultGatewayLoader(k8sClient, NLBSupportedKindsByProtocol, logr.New(&log.NullLogSink{}))
+			got, err := l.Load(ctx, gw.DeepCopy())
+			assert.NoError(t, err)
+
+			var gotRouteParents []routeParentSummary
+			for _, routeParent := range got.RouteParents {
+				gotRouteParents = append(gotRouteParents, routeParentSummary{
+					route:    k8s.NamespacedName(routeParent.Route.Object).String(),
+					accepted: routeParent.Accepted,
+					reason:   routeParent.Reason,
+				})
+			}
+			assert.Equal(t, tt.wantRouteParents, gotRouteParents)
+			var gotRoutes []string
+			for _, route := range got.Listeners[0].Routes {
+				gotRoutes = append(gotRoutes, k8s.NamespacedName(route.Object).String())
+			}
+			assert.Equal(t, tt.wantRoutes, gotRoutes)
+			var gotUDPRoutes []string
+			for _, route := range got.Listeners[1].Routes {
+				gotUDPRoutes = append(gotUDPRoutes, k8s.NamespacedName(route.Object).String())
+			}
+			assert.Equal(t, tt.wantUDPRoutes, gotUDPRoutes)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// buildL4Listener builds the NLB listener for a port of the Gateway.
+// NLB listeners cannot exist without a TargetGroup, so no listener will be built if there is no backend for the port.
+func (t *defaultModelBuildTask) buildL4Listener(ctx context.Context, lb *elbv2model.LoadBalancer, port int64, config listenPortConfig) (*elbv2model.Listener, error) {
+	defaultActions, err := t.buildL4ListenerDefaultActions(ctx, lb, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(defaultActions) == 0 {
+		return nil, nil
+	}
+	tags, err := t.buildListenerTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var certs []elbv2model.Certificate
+	var sslPolicy *string
+	if config.protocol == elbv2model.ProtocolTLS {
+		for _, certARN := range config.tlsCerts {
+			certs = append(certs, elbv2model.Certificate{
+				CertificateARN: awssdk.String(certARN),
+			})
+		}
+		sslPolicy = config.sslPolicy
+	}
+	lsSpec := elbv2model.ListenerSpec{
+		LoadBalancerARN: lb.LoadBalancerARN(),
+		Port:            port,
+		Protocol:        config.protocol,
+		DefaultActions:  defaultActions,
+		Certificates:    certs,
+		SSLPolicy:       sslPolicy,
+		Tags:            tags,
+	}
+	lsResID := fmt.Sprintf("%v", port)
+	return elbv2model.NewListener(t.stack, lsResID, lsSpec), nil
+}
+
+// buildL4ListenerDefaultActions builds the forward action to the backends of routes attached to the Gateway listeners on a port.
+// each backend gets its own TargetGroup, and the backendRef weights are applied across the TargetGroups.
+func (t *defaultModelBuildTask) buildL4ListenerDefaultActions(ctx context.Context, lb *elbv2model.LoadBalancer, config listenPortConfig) ([]elbv2model.Action, error) {
+	tgProtocol := config.protocol
+	if tgProtocol == elbv2model.ProtocolTLS {
+		tgProtocol = elbv2model.ProtocolTCP
+	}
+
+	var tgResIDs []string
+	tgByResID := make(map[string]*elbv2model.TargetGroup)
+	weightByTGResID := make(map[string]int64)
+	for _, backend := range computeL4ListenerBackends(config) {
+		if backend.Weight == 0 {
+			continue
+		}
+		tg, err := t.buildL4TargetGroup(ctx, lb, backend, tgProtocol)
+		if err != nil {
+			return nil, err
+		}
+		tgResID := tg.ID()
+		if _, exists := tgByResID[tgResID]; !exists {
+			tgResIDs = append(tgResIDs, tgResID)
+			tgByResID[tgResID] = tg
+		}
+		weightByTGResID[tgResID] += backend.Weight
+	}
+	if len(tgResIDs) == 0 {
+		return nil, nil
+	}
+
+	tgTuples := make([]elbv2model.TargetGroupTuple, 0, len(tgResIDs))
+	for _, tgResID := range tgResIDs {
+		tgTuple := elbv2model.TargetGroupTuple{
+			TargetGroupARN: tgByResID[tgResID].TargetGroupARN(),
+		}
+		if len(tgResIDs) > 1 {
+			weight := weightByTGResID[tgResID]
+			tgTuple.Weight = &weight
+		}
+		tgTuples = append(tgTuples, tgTuple)
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: tgTuples,
+			},
+		},
+	}, nil
+}
+
+// buildL4TargetGroup builds the TargetGroup for a route backend, TargetGroups are shared by listeners that reference the same service port with the same protocol.
+func (t *defaultModelBuildTask) buildL4TargetGroup(ctx context.Context, lb *elbv2model.LoadBalancer, backend RouteBackend, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	tgResID := t.buildL4TargetGroupResourceID(backend.Service, backend.Port, tgProtocol)
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svcPort, err := lookupServicePortForProtocol(backend.Service, backend.Port, tgProtocol)
+	if err != nil {
+		return nil, err
+	}
+	tg, err := t.nlbTargetGroupBuilder.BuildTargetGroup(ctx, t.stack, lb, t.backendSGIDToken, tgResID, backend.Service, svcPort, tgProtocol)
+	if err != nil {
+		return nil, err
+	}
+	t.tgByResID[tgResID] = tg
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) buildL4TargetGroupResourceID(svc *corev1.Service, port intstr.IntOrString, tgProtocol elbv2model.Protocol) string {
+	return fmt.Sprintf("%s/%s:%s:%s", svc.Namespace, svc.Name, port.String(), tgProtocol)
+}
+
+// computeL4ListenerBackends computes the backends for Gateway listeners on the same port.
+// L4 traffic cannot be routed by content, so at most one route per transport protocol is attached to a port,
+// and a TCP_UDP port carries the backends of both its TCP and UDP routes.
+func computeL4ListenerBackends(config listenPortConfig) []RouteBackend {
+	var backends []RouteBackend
+	visitedRoutes := make(map[*Route]bool)
+	for _, ls := range config.listeners {
+		for _, route := range ls.Routes {
+			if visitedRoutes[route.Route] {
+				continue
+			}
+			visitedRoutes[route.Route] = true
+			for _, rule := range route.Rules {
+				backends = append(backends, rule.Backends...)
+			}
+		}
+	}
+	return backends
+}
+
+// lookupServicePortForProtocol finds the ServicePort of svc that matches port and can carry the traffic of tgProtocol.
+func lookupServicePortForProtocol(svc *corev1.Service, port intstr.IntOrString, tgProtocol elbv2model.Protocol) (corev1.ServicePort, error) {
+	svcPortProtocol := corev1.ProtocolTCP
+	// TCP_UDP TargetGroups use the UDP ServicePort, so that both UDP traffic and TCP health checks are allowed to the targets.
+	if tgProtocol == elbv2model.ProtocolUDP || tgProtocol == elbv2model.ProtocolTCP_UDP {
+		svcPortProtocol = corev1.ProtocolUDP
+	}
+	for _, svcPort := range svc.Spec.Ports {
+		if svcPort.Protocol != svcPortProtocol && !(svcPort.Protocol == "" && svcPortProtocol == corev1.ProtocolTCP) {
+			continue
+		}
+		if (port.Type == intstr.Int && svcPort.Port == port.IntVal) || (port.Type == intstr.String && svcPort.Name == port.StrVal) {
+			return svcPort, nil
+		}
+	}
+	return corev1.ServicePort{}, errors.Errorf("unable to find %v port %v on service %v", svcPortProtocol, port.String(), k8s.NamespacedName(svc))
+}
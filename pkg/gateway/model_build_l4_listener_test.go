@@ -0,0 +1,257 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// stubTargetGroupBuilder builds bare TargetGroups that only carry the resource ID, protocol and port.
+type stubTargetGroupBuilder struct{}
+
+func (b *stubTargetGroupBuilder) BuildTargetGroup(_ context.Context, stack core.Stack, _ *elbv2model.LoadBalancer, _ core.StringToken,
+	tgResID string, _ *corev1.Service, port corev1.ServicePort, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	return elbv2model.NewTargetGroup(stack, tgResID, elbv2model.TargetGroupSpec{
+		Protocol: tgProtocol,
+		Port:     int64(port.Port),
+	}), nil
+}
+
+func Test_defaultModelBuildTask_buildL4ListenerDefaultActions(t *testing.T) {
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-a"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "tcp", Port: 80, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-b"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "tcp", Port: 80, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	svcDNS := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-dns"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	newConfig := func(protocol elbv2model.Protocol, backends ...RouteBackend) listenPortConfig {
+		return listenPortConfig{
+			protocol: protocol,
+			listeners: []*Listener{
+				{
+					Routes: []AttachedRoute{
+						{Route: &Route{Kind: RouteKindTCPRoute, Rules: []RouteRule{{Backends: backends}}}},
+					},
+				},
+			},
+		}
+	}
+	type wantTGTuple struct {
+		tgResID string
+		weight  *int64
+	}
+	tests := []struct {
+		name         string
+		config       listenPortConfig
+		wantTGTuples []wantTGTuple
+		wantErr      error
+	}{
+		{
+			name:         "no backends",
+			config:       newConfig(elbv2model.ProtocolTCP),
+			wantTGTuples: nil,
+		},
+		{
+			name:   "single backend",
+			config: newConfig(elbv2model.ProtocolTCP, RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 1}),
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-a:80:TCP"},
+			},
+		},
+		{
+			name: "weighted backends",
+			config: newConfig(elbv2model.ProtocolTCP,
+				RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 80},
+				RouteBackend{Service: svcB, Port: intstr.FromString("tcp"), Weight: 20},
+				RouteBackend{Service: svcB, Port: intstr.FromInt(80), Weight: 0},
+			),
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-a:80:TCP", weight: awssdk.Int64(80)},
+				{tgResID: "ns/svc-b:tcp:TCP", weight: awssdk.Int64(20)},
+			},
+		},
+		{
+			name: "zero weight backends are skipped",
+			config: newConfig(elbv2model.ProtocolTCP,
+				RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 0},
+				RouteBackend{Service: svcB, Port: intstr.FromString("tcp"), Weight: 20},
+			),
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-b:tcp:TCP"},
+			},
+		},
+		{
+			name: "only zero weight backends",
+			config: newConfig(elbv2model.ProtocolTCP,
+				RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 0},
+			),
+			wantTGTuples: nil,
+		},
+		{
+			name: "duplicate backends are merged",
+			config: newConfig(elbv2model.ProtocolTCP,
+				RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 1},
+				RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 1},
+				RouteBackend{Service: svcB, Port: intstr.FromInt(80), Weight: 1},
+			),
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-a:80:TCP", weight: awssdk.Int64(2)},
+				{tgResID: "ns/svc-b:80:TCP", weight: awssdk.Int64(1)},
+			},
+		},
+		{
+			name: "TCP_UDP listener forwards to the backends of both TCP and UDP routes",
+			config: listenPortConfig{
+				protocol: elbv2model.ProtocolTCP_UDP,
+				listeners: []*Listener{
+					{
+						Routes: []AttachedRoute{
+							{Route: &Route{Kind: RouteKindTCPRoute, Rules: []RouteRule{{Backends: []RouteBackend{{Service: svcDNS, Port: intstr.FromInt(53), Weight: 1}}}}}},
+						},
+					},
+					{
+						Routes: []AttachedRoute{
+							{Route: &Route{Kind: RouteKindUDPRoute, Rules: []RouteRule{{Backends: []RouteBackend{{Service: svcDNS, Port: intstr.FromInt(53), Weight: 1}}}}}},
+						},
+					},
+				},
+			},
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-dns:53:TCP_UDP"},
+			},
+		},
+		{
+			name:   "TLS listener forwards to TCP TargetGroups",
+			config: newConfig(elbv2model.ProtocolTLS, RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 1}),
+			wantTGTuples: []wantTGTuple{
+				{tgResID: "ns/svc-a:80:TCP"},
+			},
+		},
+		{
+			name:    "UDP backend without UDP service port",
+			config:  newConfig(elbv2model.ProtocolUDP, RouteBackend{Service: svcA, Port: intstr.FromInt(80), Weight: 1}),
+			wantErr: errors.New("unable to find UDP port 80 on service ns/svc-a"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "gw"})
+			task := &defaultModelBuildTask{
+				stack:                 stack,
+				nlbTargetGroupBuilder: &stubTargetGroupBuilder{},
+				tgByResID:             make(map[string]*elbv2model.TargetGroup),
+			}
+			lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+			got, err := task.buildL4ListenerDefaultActions(context.Background(), lb, tt.config)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if len(tt.wantTGTuples) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Len(t, got, 1)
+			assert.Equal(t, elbv2model.ActionTypeForward, got[0].Type)
+			var gotTGTuples []wantTGTuple
+			for _, tgTuple := range got[0].ForwardConfig.TargetGroups {
+				tgResID := ""
+				for _, dep := range tgTuple.TargetGroupARN.Dependencies() {
+					tgResID = dep.ID()
+				}
+				gotTGTuples = append(gotTGTuples, wantTGTuple{tgResID: tgResID, weight: tgTuple.Weight})
+			}
+			assert.Equal(t, tt.wantTGTuples, gotTGTuples)
+		})
+	}
+}
+
+func Test_lookupServicePortForProtocol(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, Protocol: corev1.ProtocolUDP},
+				{Name: "http", Port: 80},
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		port       intstr.IntOrString
+		tgProtocol elbv2model.Protocol
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "TCP port by number",
+			port:       intstr.FromInt(53),
+			tgProtocol: elbv2model.ProtocolTCP,
+			want:       "dns-tcp",
+		},
+		{
+			name:       "UDP port by number",
+			port:       intstr.FromInt(53),
+			tgProtocol: elbv2model.ProtocolUDP,
+			want:       "dns-udp",
+		},
+		{
+			name:       "TCP_UDP port by number",
+			port:       intstr.FromInt(53),
+			tgProtocol: elbv2model.ProtocolTCP_UDP,
+			want:       "dns-udp",
+		},
+		{
+			name:       "port without protocol defaults to TCP",
+			port:       intstr.FromString("http"),
+			tgProtocol: elbv2model.ProtocolTCP,
+			want:       "http",
+		},
+		{
+			name:       "port protocol mismatch",
+			port:       intstr.FromString("http"),
+			tgProtocol: elbv2model.ProtocolUDP,
+			wantErr:    errors.New("unable to find UDP port http on service ns/svc"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lookupServicePortForProtocol(svc, tt.port, tt.tgProtocol)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// TLSOptionCertificateARN is the listener TLS option that specifies comma separated ACM certificate ARNs.
+	TLSOptionCertificateARN gwv1.AnnotationKey = "gateway.k8s.aws/certificate-arn"
+)
+
+// the listen port config for specific listener port.
+type listenPortConfig struct {
+	protocol       elbv2model.Protocol
+	listeners      []*Listener
+	inboundCIDRv4s []string
+	inboundCIDRv6s []string
+	sslPolicy      *string
+	tlsCerts       []string
+}
+
+func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig) (*elbv2model.Listener, error) {
+	lsSpec, err := t.buildListenerSpec(ctx, lbARN, port, config)
+	if err != nil {
+		return nil, err
+	}
+	lsResID := fmt.Sprintf("%v", port)
+	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
+	return ls, nil
+}
+
+func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig) (elbv2model.ListenerSpec, error) {
+	tags, err := t.buildListenerTags(ctx)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
+	for _, certARN := range config.tlsCerts {
+		certs = append(certs, elbv2model.Certificate{
+			CertificateARN: awssdk.String(certARN),
+		})
+	}
+	return elbv2model.ListenerSpec{
+		LoadBalancerARN: lbARN,
+		Port:            port,
+		Protocol:        config.protocol,
+		DefaultActions:  []elbv2model.Action{t.build404Action(ctx)},
+		Certificates:    certs,
+		SSLPolicy:       config.sslPolicy,
+		Tags:            tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildListenerTags(_ context.Context) (map[string]string, error) {
+	gwTags, err := t.buildGatewayResourceTags()
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, gwTags), nil
+}
+
+// computeListenPortConfigByPort computes the listen port config for each port of the Gateway.
+// Gateway listeners that share the same port are merged into a single ALB listener.
+func (t *defaultModelBuildTask) computeListenPortConfigByPort(ctx context.Context) (map[int64]listenPortConfig, error) {
+	inboundCIDRv4s, inboundCIDRv6s, err := t.computeInboundCIDRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var sslPolicy *string
+	rawSSLPolicy := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, &rawSSLPolicy, t.gw.Gateway.Annotations); exists {
+		sslPolicy = &rawSSLPolicy
+	}
+
+	listenPortConfigByPort := make(map[int64]listenPortConfig)
+	for i := range t.gw.Listeners {
+		ls := &t.gw.Listeners[i]
+		if len(ls.SupportedKinds) == 0 {
+			continue
+		}
+		protocol, err := t.computeListenerProtocol(ls)
+		if err != nil {
+			return nil, err
+		}
+		port := int64(ls.Port)
+		cfg, exists := listenPortConfigByPort[port]
+		if !exists {
+			cfg = listenPortConfig{
+				protocol:       protocol,
+				inboundCIDRv4s: inboundCIDRv4s,
+				inboundCIDRv6s: inboundCIDRv6s,
+			}
+		} else if cfg.protocol != protocol {
+			mergedProtocol, ok := mergeL4ListenerProtocols(cfg.protocol, protocol)
+			if !ok {
+				return nil, errors.Errorf("conflicting protocol for port %v: %v | %v", port, cfg.protocol, protocol)
+			}
+			cfg.protocol = mergedProtocol
+		}
+		cfg.listeners = append(cfg.listeners, ls)
+		if protocol == elbv2model.ProtocolHTTPS || protocol == elbv2model.ProtocolTLS {
+			certARNs, err := t.computeListenerTLSCertARNs(ctx, ls)
+			if err != nil {
+				return nil, err
+			}
+			for _, certARN := range certARNs {
+				if !sets.NewString(cfg.tlsCerts...).Has(certARN) {
+					cfg.tlsCerts = append(cfg.tlsCerts, certARN)
+				}
+			}
+			cfg.sslPolicy = sslPolicy
+			if cfg.sslPolicy == nil {
+				cfg.sslPolicy = awssdk.String(t.defaultSSLPolicy)
+			}
+		}
+		listenPortConfigByPort[port] = cfg
+	}
+	for port, cfg := range listenPortConfigByPort {
+		if (cfg.protocol == elbv2model.ProtocolHTTPS || cfg.protocol == elbv2model.ProtocolTLS) && len(cfg.tlsCerts) == 0 {
+			return nil, errors.Errorf("no certificate found for %v listener on port %v", cfg.protocol, port)
+		}
+	}
+	return listenPortConfigByPort, nil
+}
+
+// mergeL4ListenerProtocols merges the protocols of Gateway listeners on the same port.
+// TCP and UDP listeners are served by a single TCP_UDP listener, other protocols cannot share a port.
+func mergeL4ListenerProtocols(protocol elbv2model.Protocol, otherProtocol elbv2model.Protocol) (elbv2model.Protocol, bool) {
+	isTCPOrUDP := func(protocol elbv2model.Protocol) bool {
+		return protocol == elbv2model.ProtocolTCP || protocol == elbv2model.ProtocolUDP || protocol == elbv2model.ProtocolTCP_UDP
+	}
+	if isTCPOrUDP(protocol) && isTCPOrUDP(otherProtocol) {
+		return elbv2model.ProtocolTCP_UDP, true
+	}
+	return "", false
+}
+
+func (t *defaultModelBuildTask) computeListenerProtocol(ls *Listener) (elbv2model.Protocol, error) {
+	switch ls.Protocol {
+	case gwv1.HTTPProtocolType:
+		return elbv2model.ProtocolHTTP, nil
+	case gwv1.HTTPSProtocolType:
+		if ls.TLS != nil && ls.TLS.Mode != nil && *ls.TLS.Mode != gwv1.TLSModeTerminate {
+			return "", errors.Errorf("unsupported TLS mode for listener %v: %v", ls.Name, *ls.TLS.Mode)
+		}
+		return elbv2model.ProtocolHTTPS, nil
+	case gwv1.TCPProtocolType:
+		return elbv2model.ProtocolTCP, nil
+	case gwv1.UDPProtocolType:
+		return elbv2model.ProtocolUDP, nil
+	case gwv1.TLSProtocolType:
+		// with Passthrough mode, the TLS connection is forwarded as is to backends.
+		if ls.TLS != nil && ls.TLS.Mode != nil && *ls.TLS.Mode == gwv1.TLSModePassthrough {
+			return elbv2model.ProtocolTCP, nil
+		}
+		return elbv2model.ProtocolTLS, nil
+	default:
+		return "", errors.Errorf("unsupported protocol for listener %v: %v", ls.Name, ls.Protocol)
+	}
+}
+
+// computeListenerTLSCertARNs computes the certificates of a HTTPS or TLS listener.
+// Certificates can be specified explicitly via the TLS option, otherwise they are discovered from ACM by hostnames.
+func (t *defaultModelBuildTask) computeListenerTLSCertARNs(ctx context.Context, ls *Listener) ([]string, error) {
+	if ls.TLS != nil {
+		if rawCertARNs, exists := ls.TLS.Options[TLSOptionCertificateARN]; exists {
+			var certARNs []string
+			for _, certARN := range strings.Split(string(rawCertARNs), ",") {
+				if certARN = strings.TrimSpace(certARN); certARN != "" {
+					certARNs = append(certARNs, certARN)
+				}
+			}
+			return certARNs, nil
+		}
+	}
+
+	hosts := sets.NewString()
+	if ls.Hostname != nil && *ls.Hostname != "" {
+		hosts.Insert(string(*ls.Hostname))
+	} else {
+		for _, route := range ls.Routes {
+			hosts.Insert(route.Hostnames...)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	return t.certDiscovery.Discover(ctx, hosts.List())
+}
+
+// computeInboundCIDRs computes the CIDRs allowed to access the Gateway.
+func (t *defaultModelBuildTask) computeInboundCIDRs(_ context.Context) ([]string, []string, error) {
+	var rawInboundCIDRs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, t.gw.Gateway.Annotations); !exists {
+		return []string{"0.0.0.0/0"}, []string{"::/0"}, nil
+	}
+	var inboundCIDRv4s, inboundCIDRv6s []string
+	for _, cidr := range rawInboundCIDRs {
+		_, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid %v settings on Gateway: %v", annotations.IngressSuffixInboundCIDRs, t.stack.StackID())
+		}
+		if strings.Contains(cidr, ":") {
+			inboundCIDRv6s = append(inboundCIDRv6s, cidr)
+		} else {
+			inboundCIDRv4s = append(inboundCIDRv4s, cidr)
+		}
+	}
+	return inboundCIDRv4s, inboundCIDRv6s, nil
+}
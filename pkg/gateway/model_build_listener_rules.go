@@ -0,0 +1,298 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// maxForwardTargetGroups is the maximum number of target groups in a single forward action.
+const maxForwardTargetGroups = 5
+
+// routeRuleMatch is a single match of route rule on a listener, which translates into a single listener rule.
+type routeRuleMatch struct {
+	route     *Route
+	rule      *RouteRule
+	match     RouteMatch
+	hostnames []string
+	// index of rule and match within the route, used as tie-breaker for precedence.
+	ruleIndex  int
+	matchIndex int
+	// index of route within the listeners, used as tie-breaker for precedence.
+	routeIndex int
+}
+
+func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, cfg listenPortConfig) error {
+	ruleMatches := t.computeRouteRuleMatches(cfg.listeners)
+	priority := int64(1)
+	for _, ruleMatch := range ruleMatches {
+		conditions := t.buildRuleConditions(ctx, ruleMatch)
+		actions, err := t.buildRuleActions(ctx, ruleMatch)
+		if err != nil {
+			return errors.Wrapf(err, "%v: %v", ruleMatch.route.Kind, k8s.NamespacedName(ruleMatch.route.Object))
+		}
+		tags, err := t.buildListenerRuleTags(ctx)
+		if err != nil {
+			return err
+		}
+		ruleResID := fmt.Sprintf("%v:%v", port, priority)
+		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
+			ListenerARN: lsARN,
+			Priority:    priority,
+			Conditions:  conditions,
+			Actions:     actions,
+			Tags:        tags,
+		})
+		priority += 1
+	}
+	return nil
+}
+
+// computeRouteRuleMatches computes the route rule matches of listeners, sorted by the precedence defined by Gateway API.
+func (t *defaultModelBuildTask) computeRouteRuleMatches(listeners []*Listener) []routeRuleMatch {
+	var ruleMatches []routeRuleMatch
+	routeIndex := 0
+	for _, ls := range listeners {
+		for _, attachedRoute := range ls.Routes {
+			for ruleIdx := range attachedRoute.Rules {
+				rule := &attachedRoute.Rules[ruleIdx]
+				matches := rule.Matches
+				if len(matches) == 0 {
+					matches = []RouteMatch{{PathType: PathMatchTypePrefix, Path: "/"}}
+				}
+				for matchIdx, match := range matches {
+					ruleMatches = append(ruleMatches, routeRuleMatch{
+						route:      attachedRoute.Route,
+						rule:       rule,
+						match:      match,
+						hostnames:  attachedRoute.Hostnames,
+						ruleIndex:  ruleIdx,
+						matchIndex: matchIdx,
+						routeIndex: routeIndex,
+					})
+				}
+			}
+			routeIndex++
+		}
+	}
+	sort.SliceStable(ruleMatches, func(i, j int) bool {
+		return isHigherPrecedence(ruleMatches[i], ruleMatches[j])
+	})
+	return ruleMatches
+}
+
+// isHigherPrecedence checks whether ruleMatch a takes precedence over ruleMatch b.
+// Precedence is given to the most specific hostname, then exact path, longest path, method, most headers and most query params.
+// Remaining ties are broken by route order, then rule and match order within route.
+func isHigherPrecedence(a routeRuleMatch, b routeRuleMatch) bool {
+	if hostnameRankA, hostnameRankB := hostnamesRank(a.hostnames), hostnamesRank(b.hostnames); hostnameRankA != hostnameRankB {
+		return hostnameRankA > hostnameRankB
+	}
+	if pathRankA, pathRankB := pathTypeRank(a.match.PathType), pathTypeRank(b.match.PathType); pathRankA != pathRankB {
+		return pathRankA > pathRankB
+	}
+	if len(a.match.Path) != len(b.match.Path) {
+		return len(a.match.Path) > len(b.match.Path)
+	}
+	if (a.match.Method != nil) != (b.match.Method != nil) {
+		return a.match.Method != nil
+	}
+	if len(a.match.Headers) != len(b.match.Headers) {
+		return len(a.match.Headers) > len(b.match.Headers)
+	}
+	if len(a.match.QueryParams) != len(b.match.QueryParams) {
+		return len(a.match.QueryParams) > len(b.match.QueryParams)
+	}
+	if a.routeIndex != b.routeIndex {
+		return a.routeIndex < b.routeIndex
+	}
+	if a.ruleIndex != b.ruleIndex {
+		return a.ruleIndex < b.ruleIndex
+	}
+	return a.matchIndex < b.matchIndex
+}
+
+// hostnamesRank ranks hostnames by specificity: exact hostnames > wildcard hostnames > any hostname.
+func hostnamesRank(hostnames []string) int {
+	if len(hostnames) == 0 {
+		return 0
+	}
+	for _, hostname := range hostnames {
+		if !strings.HasPrefix(hostname, "*") {
+			return 2
+		}
+	}
+	return 1
+}
+
+func pathTypeRank(pathType PathMatchType) int {
+	switch pathType {
+	case PathMatchTypeExact:
+		return 2
+	case PathMatchTypePattern:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (t *defaultModelBuildTask) buildRuleConditions(_ context.Context, ruleMatch routeRuleMatch) []elbv2model.RuleCondition {
+	var conditions []elbv2model.RuleCondition
+	if len(ruleMatch.hostnames) != 0 {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: ruleMatch.hostnames,
+			},
+		})
+	}
+	conditions = append(conditions, elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldPathPattern,
+		PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+			Values: buildPathPatterns(ruleMatch.match.PathType, ruleMatch.match.Path),
+		},
+	})
+	for _, header := range ruleMatch.match.Headers {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: header.Name,
+				Values:         []string{header.Value},
+			},
+		})
+	}
+	for _, queryParam := range ruleMatch.match.QueryParams {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldQueryString,
+			QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+				Values: []elbv2model.QueryStringKeyValuePair{
+					{
+						Key:   awssdk.String(queryParam.Name),
+						Value: queryParam.Value,
+					},
+				},
+			},
+		})
+	}
+	if ruleMatch.match.Method != nil {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: []string{*ruleMatch.match.Method},
+			},
+		})
+	}
+	return conditions
+}
+
+// buildPathPatterns builds the ALB path patterns for a path match.
+func buildPathPatterns(pathType PathMatchType, path string) []string {
+	switch pathType {
+	case PathMatchTypeExact, PathMatchTypePattern:
+		return []string{path}
+	default:
+		path = strings.TrimSuffix(path, "/")
+		if path == "" {
+			return []string{"/*"}
+		}
+		return []string{path, path + "/*"}
+	}
+}
+
+func (t *defaultModelBuildTask) buildRuleActions(ctx context.Context, ruleMatch routeRuleMatch) ([]elbv2model.Action, error) {
+	if ruleMatch.rule.Redirect != nil {
+		return []elbv2model.Action{t.buildRedirectAction(ctx, ruleMatch)}, nil
+	}
+	if len(ruleMatch.rule.Backends) == 0 {
+		return []elbv2model.Action{t.build500Action(ctx)}, nil
+	}
+	if len(ruleMatch.rule.Backends) > maxForwardTargetGroups {
+		return nil, errors.Errorf("at most %v backendRefs are supported per rule", maxForwardTargetGroups)
+	}
+	protocolVersion := elbv2model.ProtocolVersionHTTP1
+	if ruleMatch.route.Kind == RouteKindGRPCRoute {
+		protocolVersion = elbv2model.ProtocolVersionGRPC
+	}
+	targetGroupTuples := make([]elbv2model.TargetGroupTuple, 0, len(ruleMatch.rule.Backends))
+	for _, backend := range ruleMatch.rule.Backends {
+		tg, err := t.buildTargetGroup(ctx, backend, protocolVersion)
+		if err != nil {
+			return nil, err
+		}
+		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tg.TargetGroupARN(),
+			Weight:         awssdk.Int64(backend.Weight),
+		})
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: targetGroupTuples,
+			},
+		},
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildRedirectAction(_ context.Context, ruleMatch routeRuleMatch) elbv2model.Action {
+	redirect := ruleMatch.rule.Redirect
+	redirectConfig := &elbv2model.RedirectActionConfig{
+		StatusCode: "HTTP_302",
+	}
+	if redirect.StatusCode != nil && *redirect.StatusCode == 301 {
+		redirectConfig.StatusCode = "HTTP_301"
+	}
+	if redirect.Scheme != nil {
+		redirectConfig.Protocol = awssdk.String(strings.ToUpper(*redirect.Scheme))
+	}
+	if redirect.Hostname != nil {
+		redirectConfig.Host = awssdk.String(string(*redirect.Hostname))
+	}
+	if redirect.Port != nil {
+		redirectConfig.Port = awssdk.String(fmt.Sprintf("%v", *redirect.Port))
+	}
+	if redirect.Path != nil && redirect.Path.ReplaceFullPath != nil {
+		redirectConfig.Path = redirect.Path.ReplaceFullPath
+	}
+	return elbv2model.Action{
+		Type:           elbv2model.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}
+}
+
+func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "404",
+		},
+	}
+}
+
+// build500Action builds the action for rules without valid backends, as required by Gateway API.
+func (t *defaultModelBuildTask) build500Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "500",
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildListenerRuleTags(_ context.Context) (map[string]string, error) {
+	gwTags, err := t.buildGatewayResourceTags()
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, gwTags), nil
+}
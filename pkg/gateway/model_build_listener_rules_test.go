@@ -0,0 +1,110 @@
+package gateway
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildPathPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		pathType PathMatchType
+		path     string
+		want     []string
+	}{
+		{
+			name:     "exact path",
+			pathType: PathMatchTypeExact,
+			path:     "/foo",
+			want:     []string{"/foo"},
+		},
+		{
+			name:     "root prefix",
+			pathType: PathMatchTypePrefix,
+			path:     "/",
+			want:     []string{"/*"},
+		},
+		{
+			name:     "prefix",
+			pathType: PathMatchTypePrefix,
+			path:     "/foo",
+			want:     []string{"/foo", "/foo/*"},
+		},
+		{
+			name:     "prefix with trailing slash",
+			pathType: PathMatchTypePrefix,
+			path:     "/foo/",
+			want:     []string{"/foo", "/foo/*"},
+		},
+		{
+			name:     "pattern",
+			pathType: PathMatchTypePattern,
+			path:     "/*/Baz",
+			want:     []string{"/*/Baz"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPathPatterns(tt.pathType, tt.path)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeRouteRuleMatches(t *testing.T) {
+	routeA := &Route{
+		Kind: RouteKindHTTPRoute,
+		Rules: []RouteRule{
+			{
+				Matches: []RouteMatch{
+					{PathType: PathMatchTypePrefix, Path: "/"},
+					{PathType: PathMatchTypePrefix, Path: "/api"},
+				},
+			},
+			{
+				Matches: []RouteMatch{
+					{PathType: PathMatchTypePrefix, Path: "/api", Method: awssdk.String("GET")},
+				},
+			},
+		},
+	}
+	routeB := &Route{
+		Kind: RouteKindHTTPRoute,
+		Rules: []RouteRule{
+			{
+				Matches: []RouteMatch{
+					{PathType: PathMatchTypeExact, Path: "/"},
+				},
+			},
+			{
+				// empty matches matches all requests.
+			},
+		},
+	}
+	listeners := []*Listener{
+		{
+			Routes: []AttachedRoute{
+				{Route: routeA},
+				{Route: routeB, Hostnames: []string{"*.example.com"}},
+			},
+		},
+	}
+	task := &defaultModelBuildTask{}
+	got := task.computeRouteRuleMatches(listeners)
+	var gotMatches []RouteMatch
+	for _, ruleMatch := range got {
+		gotMatches = append(gotMatches, ruleMatch.match)
+	}
+	assert.Equal(t, []RouteMatch{
+		{PathType: PathMatchTypeExact, Path: "/"},
+		{PathType: PathMatchTypePrefix, Path: "/"},
+		{PathType: PathMatchTypePrefix, Path: "/api", Method: awssdk.String("GET")},
+		{PathType: PathMatchTypePrefix, Path: "/api"},
+		{PathType: PathMatchTypePrefix, Path: "/"},
+	}, gotMatches)
+	assert.Equal(t, routeB, got[0].route)
+	assert.Equal(t, routeB, got[1].route)
+	assert.Equal(t, routeA, got[4].route)
+}
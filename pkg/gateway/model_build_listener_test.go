@@ -0,0 +1,52 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_mergeL4ListenerProtocols(t *testing.T) {
+	tests := []struct {
+		name          string
+		protocol      elbv2model.Protocol
+		otherProtocol elbv2model.Protocol
+		want          elbv2model.Protocol
+		wantOK        bool
+	}{
+		{
+			name:          "TCP and UDP",
+			protocol:      elbv2model.ProtocolTCP,
+			otherProtocol: elbv2model.ProtocolUDP,
+			want:          elbv2model.ProtocolTCP_UDP,
+			wantOK:        true,
+		},
+		{
+			name:          "TCP_UDP and TCP",
+			protocol:      elbv2model.ProtocolTCP_UDP,
+			otherProtocol: elbv2model.ProtocolTCP,
+			want:          elbv2model.ProtocolTCP_UDP,
+			wantOK:        true,
+		},
+		{
+			name:          "TLS and UDP",
+			protocol:      elbv2model.ProtocolTLS,
+			otherProtocol: elbv2model.ProtocolUDP,
+			wantOK:        false,
+		},
+		{
+			name:          "HTTP and HTTPS",
+			protocol:      elbv2model.ProtocolHTTP,
+			otherProtocol: elbv2model.ProtocolHTTPS,
+			wantOK:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOK := mergeL4ListenerProtocols(tt.protocol, tt.otherProtocol)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDLoadBalancer         = "LoadBalancer"
+	minimalAvailableIPAddressCount = int64(8)
+)
+
+func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	lbSpec, err := t.buildLoadBalancerSpec(ctx, listenPortConfigByPort)
+	if err != nil {
+		return nil, err
+	}
+	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	t.loadBalancer = lb
+	return lb, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (elbv2model.LoadBalancerSpec, error) {
+	scheme, err := t.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	name, err := t.buildLoadBalancerName(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	return elbv2model.LoadBalancerSpec{
+		Name:                   name,
+		Type:                   t.loadBalancerType,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SubnetMappings:         subnetMappings,
+		SecurityGroups:         securityGroups,
+		LoadBalancerAttributes: loadBalancerAttributes,
+		Tags:                   tags,
+	}, nil
+}
+
+var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) (string, error) {
+	var name string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerName, &name, t.gw.Gateway.Annotations); exists {
+		// The name of the loadbalancer can only have up to 32 characters
+		if len(name) > 32 {
+			return "", errors.New("load balancer name cannot be longer than 32 characters")
+		}
+		return name, nil
+	}
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(scheme))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid), nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv2model.LoadBalancerScheme, error) {
+	rawScheme := string(t.defaultScheme)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixScheme, &rawScheme, t.gw.Gateway.Annotations)
+	switch rawScheme {
+	case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		return elbv2model.LoadBalancerSchemeInternetFacing, nil
+	case string(elbv2model.LoadBalancerSchemeInternal):
+		return elbv2model.LoadBalancerSchemeInternal, nil
+	default:
+		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
+	rawIPAddressType := string(t.defaultIPAddressType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAddressType, &rawIPAddressType, t.gw.Gateway.Annotations)
+	switch rawIPAddressType {
+	case string(elbv2model.IPAddressTypeIPV4):
+		return elbv2model.IPAddressTypeIPV4, nil
+	case string(elbv2model.IPAddressTypeDualStack):
+		return elbv2model.IPAddressTypeDualStack, nil
+	case string(elbv2model.IPAddressTypeDualStackWithoutPublicIPV4):
+		return elbv2model.IPAddressTypeDualStackWithoutPublicIPV4, nil
+	default:
+		return "", errors.Errorf("unknown IPAddressType: %v", rawIPAddressType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
+	var rawSubnetNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, t.gw.Gateway.Annotations); exists {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
+			networking.WithSubnetsResolveLBType(t.loadBalancerType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithALBSingleSubnet(t.featureGates.Enabled(config.ALBSingleSubnet)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) == 0 || (string(scheme) != awssdk.StringValue(sdkLBs[0].LoadBalancer.Scheme)) {
+		resolveOpts := []networking.SubnetsResolveOption{
+			networking.WithSubnetsResolveLBType(t.loadBalancerType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		}
+		// ALBs scale by adding nodes into subnets, thus need free IP addresses within subnets.
+		if t.loadBalancerType == elbv2model.LoadBalancerTypeApplication {
+			resolveOpts = append(resolveOpts, networking.WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount))
+		}
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx, resolveOpts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't auto-discover subnets")
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(sdkLBs[0].LoadBalancer.AvailabilityZones))
+	for _, availabilityZone := range sdkLBs[0].LoadBalancer.AvailabilityZones {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(availabilityZone.SubnetId),
+		})
+	}
+	return subnetMappings, nil
+}
+
+// buildLoadBalancerSecurityGroups builds the LoadBalancer securityGroups.
+// When securityGroups are not specified explicitly, a managed securityGroup will be created and used as backend securityGroup as well.
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
+	var sgNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroups, &sgNameOrIDs, t.gw.Gateway.Annotations); exists {
+		sgIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDs)
+		if err != nil {
+			return nil, err
+		}
+		lbSGTokens := make([]core.StringToken, 0, len(sgIDs))
+		for _, sgID := range sgIDs {
+			lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+		}
+		return lbSGTokens, nil
+	}
+	managedSG, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+	t.backendSGIDToken = managedSG.GroupID()
+	return []core.StringToken{managedSG.GroupID()}, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerTags(_ context.Context) (map[string]string, error) {
+	gwTags, err := t.buildGatewayResourceTags()
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, gwTags), nil
+}
+
+func buildLoadBalancerSubnetMappingsWithSubnets(subnets []*ec2sdk.Subnet) []elbv2model.SubnetMapping {
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(subnet.SubnetId),
+		})
+	}
+	return subnetMappings
+}
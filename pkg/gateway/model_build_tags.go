@@ -0,0 +1,47 @@
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// buildGatewayResourceTags builds the AWS Tags used for resources of the Gateway. e.g. LoadBalancer, SecurityGroup, Listener
+func (t *defaultModelBuildTask) buildGatewayResourceTags() (map[string]string, error) {
+	var annotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &annotationTags, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(annotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v", k8s.NamespacedName(t.gw.Gateway).String())
+	}
+	return annotationTags, nil
+}
+
+// buildGatewayBackendResourceTags builds the AWS Tags used for a single backend of the Gateway. e.g. TargetGroup
+// the Tags annotation of Service takes higher priority if there is conflict between the tags of Gateway and Service.
+func (t *defaultModelBuildTask) buildGatewayBackendResourceTags(svc *corev1.Service) (map[string]string, error) {
+	gwTags, err := t.buildGatewayResourceTags()
+	if err != nil {
+		return nil, err
+	}
+	var svcTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &svcTags, svc.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(svcTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Service %v", k8s.NamespacedName(svc).String())
+	}
+	return algorithm.MergeStringMap(svcTags, gwTags), nil
+}
+
+func (t *defaultModelBuildTask) validateTagCollisionWithExternalManagedTags(tags map[string]string) error {
+	for tagKey := range tags {
+		if t.externalManagedTags.Has(tagKey) {
+			return errors.Errorf("external managed tag key %v cannot be specified", tagKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,362 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	healthCheckPortTrafficPort = "traffic-port"
+)
+
+// buildTargetGroup builds the TargetGroup for a route backend.
+// TargetGroups are shared by all routes of the Gateway that reference the same service port with the same protocol version.
+func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, backend RouteBackend, tgProtocolVersion elbv2model.ProtocolVersion) (*elbv2model.TargetGroup, error) {
+	svc := backend.Service
+	tgResID := t.buildTargetGroupResourceID(svc, backend.Port, tgProtocolVersion)
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, backend.Port)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildTargetGroupSpec(ctx, svc, backend.Port, svcPort, tgProtocolVersion)
+	if err != nil {
+		return nil, err
+	}
+	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
+	t.tgByResID[tgResID] = tg
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, backend.Port, svcPort)
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) *elbv2model.TargetGroupBindingResource {
+	tgbSpec := t.buildTargetGroupBindingSpec(ctx, tg, svc, port, svcPort)
+	tgb := elbv2model.NewTargetGroupBindingResource(t.stack, tg.ID(), tgbSpec)
+	return tgb
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) elbv2model.TargetGroupBindingResourceSpec {
+	targetType := elbv2api.TargetType(tg.Spec.TargetType)
+	targetPort := svcPort.TargetPort
+	if targetType == elbv2api.TargetTypeInstance {
+		targetPort = intstr.FromInt(int(svcPort.NodePort))
+	}
+	tgbNetworking := t.buildTargetGroupBindingNetworking(ctx, targetPort, *tg.Spec.HealthCheckConfig.Port)
+	return elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: svc.Namespace,
+				Name:      tg.Spec.Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				TargetType:     &targetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: svc.Name,
+					Port: port,
+				},
+				Networking:    tgbNetworking,
+				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+				VpcID:         t.vpcID,
+			},
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Context, targetPort intstr.IntOrString, healthCheckPort intstr.IntOrString) *elbv2model.TargetGroupBindingNetworking {
+	if t.backendSGIDToken == nil {
+		return nil
+	}
+	protocolTCP := elbv2api.NetworkingProtocolTCP
+	from := []elbv2model.NetworkingPeer{
+		{
+			SecurityGroup: &elbv2model.SecurityGroup{
+				GroupID: t.backendSGIDToken,
+			},
+		},
+	}
+	if t.disableRestrictedSGRules {
+		return &elbv2model.TargetGroupBindingNetworking{
+			Ingress: []elbv2model.NetworkingIngressRule{
+				{
+					From: from,
+					Ports: []elbv2api.NetworkingPort{
+						{
+							Protocol: &protocolTCP,
+							Port:     nil,
+						},
+					},
+				},
+			},
+		}
+	}
+	networkingPorts := []elbv2api.NetworkingPort{
+		{
+			Protocol: &protocolTCP,
+			Port:     &targetPort,
+		},
+	}
+	if healthCheckPort.String() != healthCheckPortTrafficPort {
+		networkingPorts = append(networkingPorts, elbv2api.NetworkingPort{
+			Protocol: &protocolTCP,
+			Port:     &healthCheckPort,
+		})
+	}
+	networkingRules := make([]elbv2model.NetworkingIngressRule, 0, len(networkingPorts))
+	for _, port := range networkingPorts {
+		networkingRules = append(networkingRules, elbv2model.NetworkingIngressRule{
+			From:  from,
+			Ports: []elbv2api.NetworkingPort{port},
+		})
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: networkingRules,
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, svc *corev1.Service, port intstr.IntOrString,
+	svcPort corev1.ServicePort, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupSpec, error) {
+	svcAndGWAnnotations := algorithm.MergeStringMap(svc.Annotations, t.gw.Gateway.Annotations)
+	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndGWAnnotations, targetType, tgProtocol, tgProtocolVersion)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tags, err := t.buildTargetGroupTags(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
+	name := t.buildTargetGroupName(ctx, svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion)
+	return elbv2model.TargetGroupSpec{
+		Name:                  name,
+		TargetType:            targetType,
+		Port:                  tgPort,
+		Protocol:              tgProtocol,
+		ProtocolVersion:       &tgProtocolVersion,
+		IPAddressType:         &ipAddressType,
+		HealthCheckConfig:     &healthCheckConfig,
+		TargetGroupAttributes: tgAttributes,
+		Tags:                  tags,
+	}, nil
+}
+
+var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// buildTargetGroupName will calculate the targetGroup's name.
+func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(svc.UID))
+	_, _ = uuidHash.Write([]byte(port.String()))
+	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
+	_, _ = uuidHash.Write([]byte(targetType))
+	_, _ = uuidHash.Write([]byte(tgProtocol))
+	_, _ = uuidHash.Write([]byte(tgProtocolVersion))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(svc.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndGWAnnotations map[string]string) (elbv2model.TargetType, error) {
+	rawTargetType := string(t.defaultTargetType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndGWAnnotations)
+	switch rawTargetType {
+	case string(elbv2model.TargetTypeInstance):
+		return elbv2model.TargetTypeInstance, nil
+	case string(elbv2model.TargetTypeIP):
+		if !t.enableIPTargetType {
+			return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", rawTargetType, t.enableIPTargetType)
+		}
+		return elbv2model.TargetTypeIP, nil
+	default:
+		return "", errors.Errorf("unknown targetType: %v", rawTargetType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+	for _, ipFamily := range svc.Spec.IPFamilies {
+		if ipFamily == corev1.IPv6Protocol {
+			if !isIPv6Supported(*t.loadBalancer.Spec.IPAddressType) {
+				return "", errors.New("unsupported IPv6 configuration, lb not dual-stack")
+			}
+			return elbv2model.TargetGroupIPAddressTypeIPv6, nil
+		}
+	}
+	return elbv2model.TargetGroupIPAddressTypeIPv4, nil
+}
+
+// buildTargetGroupPort constructs the TargetGroup's port.
+// Note: TargetGroup's port is not in the data path as we always register targets with port specified.
+func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetType elbv2model.TargetType, svcPort corev1.ServicePort) int64 {
+	if targetType == elbv2model.TargetTypeInstance {
+		return int64(svcPort.NodePort)
+	}
+	if svcPort.TargetPort.Type == intstr.Int {
+		return int64(svcPort.TargetPort.IntValue())
+	}
+	return 1
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcAndGWAnnotations map[string]string) (elbv2model.Protocol, error) {
+	rawBackendProtocol := string(t.defaultBackendProtocol)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocol, &rawBackendProtocol, svcAndGWAnnotations)
+	switch rawBackendProtocol {
+	case string(elbv2model.ProtocolHTTP):
+		return elbv2model.ProtocolHTTP, nil
+	case string(elbv2model.ProtocolHTTPS):
+		return elbv2model.ProtocolHTTPS, nil
+	default:
+		return "", errors.Errorf("backend protocol must be within [%v, %v]: %v", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS, rawBackendProtocol)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndGWAnnotations map[string]string,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndGWAnnotations, targetType)
+	if err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckProtocol := tgProtocol
+	rawHealthCheckProtocol := string(tgProtocol)
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckProtocol, &rawHealthCheckProtocol, svcAndGWAnnotations); exists {
+		switch rawHealthCheckProtocol {
+		case string(elbv2model.ProtocolHTTP):
+			healthCheckProtocol = elbv2model.ProtocolHTTP
+		case string(elbv2model.ProtocolHTTPS):
+			healthCheckProtocol = elbv2model.ProtocolHTTPS
+		default:
+			return elbv2model.TargetGroupHealthCheckConfig{}, errors.Errorf("healthCheckProtocol must be within [%v, %v]", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS)
+		}
+	}
+	healthCheckPath := t.defaultHealthCheckPathHTTP
+	healthCheckMatcherCode := t.defaultHealthCheckMatcherHTTPCode
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckPath = t.defaultHealthCheckPathGRPC
+		healthCheckMatcherCode = t.defaultHealthCheckMatcherGRPCCode
+	}
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPath, &healthCheckPath, svcAndGWAnnotations)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSuccessCodes, &healthCheckMatcherCode, svcAndGWAnnotations)
+	healthCheckMatcher := elbv2model.HealthCheckMatcher{
+		HTTPCode: &healthCheckMatcherCode,
+	}
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckMatcher = elbv2model.HealthCheckMatcher{
+			GRPCCode: &healthCheckMatcherCode,
+		}
+	}
+
+	healthCheckIntervalSeconds := t.defaultHealthCheckIntervalSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckIntervalSeconds, &healthCheckIntervalSeconds, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckTimeoutSeconds := t.defaultHealthCheckTimeoutSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckTimeoutSeconds, &healthCheckTimeoutSeconds, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckHealthyThresholdCount := t.defaultHealthCheckHealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthyThresholdCount, &healthCheckHealthyThresholdCount, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthCheckUnhealthyThresholdCount := t.defaultHealthCheckUnhealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixUnhealthyThresholdCount, &healthCheckUnhealthyThresholdCount, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	return elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		Protocol:                &healthCheckProtocol,
+		Path:                    &healthCheckPath,
+		Matcher:                 &healthCheckMatcher,
+		IntervalSeconds:         &healthCheckIntervalSeconds,
+		TimeoutSeconds:          &healthCheckTimeoutSeconds,
+		HealthyThresholdCount:   &healthCheckHealthyThresholdCount,
+		UnhealthyThresholdCount: &healthCheckUnhealthyThresholdCount,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndGWAnnotations map[string]string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
+	rawHealthCheckPort := ""
+	if exist := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAndGWAnnotations); !exist {
+		return intstr.FromString(healthCheckPortTrafficPort), nil
+	}
+	if rawHealthCheckPort == healthCheckPortTrafficPort {
+		return intstr.FromString(healthCheckPortTrafficPort), nil
+	}
+	healthCheckPort := intstr.Parse(rawHealthCheckPort)
+	if healthCheckPort.Type == intstr.Int {
+		return healthCheckPort, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, healthCheckPort)
+	if err != nil {
+		return intstr.IntOrString{}, errors.Wrap(err, "failed to resolve healthCheckPort")
+	}
+	if targetType == elbv2model.TargetTypeInstance {
+		return intstr.FromInt(int(svcPort.NodePort)), nil
+	}
+	if svcPort.TargetPort.Type == intstr.Int {
+		return svcPort.TargetPort, nil
+	}
+	return intstr.IntOrString{}, errors.New("cannot use named healthCheckPort for IP TargetType when service's targetPort is a named port")
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndGWAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndGWAnnotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.TargetGroupAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	return attributes, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, svc *corev1.Service) (map[string]string, error) {
+	svcTags, err := t.buildGatewayBackendResourceTags(svc)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, svcTags), nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupResourceID(svc *corev1.Service, port intstr.IntOrString, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	return fmt.Sprintf("%s/%s:%s:%s", svc.Namespace, svc.Name, port.String(), tgProtocolVersion)
+}
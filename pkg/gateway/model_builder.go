@@ -0,0 +1,201 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+)
+
+// ModelBuilder is responsible for build mode stack for a Gateway.
+type ModelBuilder interface {
+	// build mode stack for a Gateway.
+	Build(ctx context.Context, gw Gateway) (core.Stack, *elbv2model.LoadBalancer, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+// Gateways are implemented by LoadBalancers of loadBalancerType, the nlbTargetGroupBuilder is only required for NLBs.
+func NewDefaultModelBuilder(loadBalancerType elbv2model.LoadBalancerType, nlbTargetGroupBuilder service.TargetGroupBuilder, acmClient services.ACM, annotationParser annotations.Parser,
+	subnetsResolver networkingpkg.SubnetsResolver, sgResolver networkingpkg.SecurityGroupResolver,
+	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
+	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string,
+	disableRestrictedSGRules bool, certDiscoveryFilters ingress.CertDiscoveryFilters, enableIPTargetType bool, logger logr.Logger) *defaultModelBuilder {
+	certDiscovery := ingress.NewACMCertDiscovery(acmClient, certDiscoveryFilters, logger)
+	return &defaultModelBuilder{
+		loadBalancerType:         loadBalancerType,
+		nlbTargetGroupBuilder:    nlbTargetGroupBuilder,
+		annotationParser:         annotationParser,
+		subnetsResolver:          subnetsResolver,
+		sgResolver:               sgResolver,
+		certDiscovery:            certDiscovery,
+		trackingProvider:         trackingProvider,
+		elbv2TaggingManager:      elbv2TaggingManager,
+		featureGates:             featureGates,
+		vpcID:                    vpcID,
+		clusterName:              clusterName,
+		defaultTags:              defaultTags,
+		externalManagedTags:      sets.NewString(externalManagedTags...),
+		defaultSSLPolicy:         defaultSSLPolicy,
+		defaultTargetType:        elbv2model.TargetType(defaultTargetType),
+		disableRestrictedSGRules: disableRestrictedSGRules,
+		enableIPTargetType:       enableIPTargetType,
+		logger:                   logger,
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	loadBalancerType      elbv2model.LoadBalancerType
+	nlbTargetGroupBuilder service.TargetGroupBuilder
+	annotationParser      annotations.Parser
+	subnetsResolver       networkingpkg.SubnetsResolver
+	sgResolver            networkingpkg.SecurityGroupResolver
+	certDiscovery         ingress.CertDiscovery
+	trackingProvider      tracking.Provider
+	elbv2TaggingManager   elbv2deploy.TaggingManager
+	featureGates          config.FeatureGates
+
+	vpcID       string
+	clusterName string
+
+	defaultTags              map[string]string
+	externalManagedTags      sets.String
+	defaultSSLPolicy         string
+	defaultTargetType        elbv2model.TargetType
+	disableRestrictedSGRules bool
+	enableIPTargetType       bool
+
+	logger logr.Logger
+}
+
+// build mode stack for a Gateway.
+func (b *defaultModelBuilder) Build(ctx context.Context, gw Gateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw.Gateway)))
+	task := &defaultModelBuildTask{
+		loadBalancerType:         b.loadBalancerType,
+		nlbTargetGroupBuilder:    b.nlbTargetGroupBuilder,
+		annotationParser:         b.annotationParser,
+		subnetsResolver:          b.subnetsResolver,
+		sgResolver:               b.sgResolver,
+		certDiscovery:            b.certDiscovery,
+		trackingProvider:         b.trackingProvider,
+		elbv2TaggingManager:      b.elbv2TaggingManager,
+		featureGates:             b.featureGates,
+		vpcID:                    b.vpcID,
+		clusterName:              b.clusterName,
+		disableRestrictedSGRules: b.disableRestrictedSGRules,
+		enableIPTargetType:       b.enableIPTargetType,
+		logger:                   b.logger,
+
+		gw:    gw,
+		stack: stack,
+
+		defaultTags:                               b.defaultTags,
+		externalManagedTags:                       b.externalManagedTags,
+		defaultIPAddressType:                      elbv2model.IPAddressTypeIPV4,
+		defaultScheme:                             elbv2model.LoadBalancerSchemeInternal,
+		defaultSSLPolicy:                          b.defaultSSLPolicy,
+		defaultTargetType:                         b.defaultTargetType,
+		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+		defaultHealthCheckPathHTTP:                "/",
+		defaultHealthCheckPathGRPC:                "/AWS.ALB/healthcheck",
+		defaultHealthCheckIntervalSeconds:         15,
+		defaultHealthCheckTimeoutSeconds:          5,
+		defaultHealthCheckHealthyThresholdCount:   2,
+		defaultHealthCheckUnhealthyThresholdCount: 2,
+		defaultHealthCheckMatcherHTTPCode:         "200",
+		defaultHealthCheckMatcherGRPCCode:         "12",
+
+		tgByResID: make(map[string]*elbv2model.TargetGroup),
+	}
+	if err := task.run(ctx); err != nil {
+		return nil, nil, err
+	}
+	return task.stack, task.loadBalancer, nil
+}
+
+// the default model build task
+type defaultModelBuildTask struct {
+	loadBalancerType      elbv2model.LoadBalancerType
+	nlbTargetGroupBuilder service.TargetGroupBuilder
+	annotationParser      annotations.Parser
+	subnetsResolver       networkingpkg.SubnetsResolver
+	sgResolver            networkingpkg.SecurityGroupResolver
+	certDiscovery         ingress.CertDiscovery
+	trackingProvider      tracking.Provider
+	elbv2TaggingManager   elbv2deploy.TaggingManager
+	featureGates          config.FeatureGates
+	logger                logr.Logger
+
+	vpcID                    string
+	clusterName              string
+	disableRestrictedSGRules bool
+	enableIPTargetType       bool
+
+	gw               Gateway
+	stack            core.Stack
+	backendSGIDToken core.StringToken
+
+	defaultTags                               map[string]string
+	externalManagedTags                       sets.String
+	defaultIPAddressType                      elbv2model.IPAddressType
+	defaultScheme                             elbv2model.LoadBalancerScheme
+	defaultSSLPolicy                          string
+	defaultTargetType                         elbv2model.TargetType
+	defaultBackendProtocol                    elbv2model.Protocol
+	defaultHealthCheckPathHTTP                string
+	defaultHealthCheckPathGRPC                string
+	defaultHealthCheckTimeoutSeconds          int64
+	defaultHealthCheckIntervalSeconds         int64
+	defaultHealthCheckHealthyThresholdCount   int64
+	defaultHealthCheckUnhealthyThresholdCount int64
+	defaultHealthCheckMatcherHTTPCode         string
+	defaultHealthCheckMatcherGRPCCode         string
+
+	loadBalancer *elbv2model.LoadBalancer
+	tgByResID    map[string]*elbv2model.TargetGroup
+}
+
+func (t *defaultModelBuildTask) run(ctx context.Context) error {
+	if !t.gw.Gateway.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	listenPortConfigByPort, err := t.computeListenPortConfigByPort(ctx)
+	if err != nil {
+		return err
+	}
+	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+	for port, cfg := range listenPortConfigByPort {
+		if t.loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
+			if _, err := t.buildL4Listener(ctx, lb, port, cfg); err != nil {
+				return err
+			}
+			continue
+		}
+		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg)
+		if err != nil {
+			return err
+		}
+		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
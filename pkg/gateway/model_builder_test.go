@@ -0,0 +1,405 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_defaultModelBuilder_Build(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+			UID:       "svc-uuid",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port:       80,
+					TargetPort: intstr.FromInt(8080),
+					NodePort:   32768,
+				},
+			},
+		},
+	}
+	httpRoute := &Route{
+		Kind:   RouteKindHTTPRoute,
+		Object: &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "http-route"}},
+		Rules: []RouteRule{
+			{
+				Matches: []RouteMatch{
+					{PathType: PathMatchTypePrefix, Path: "/api"},
+				},
+				Backends: []RouteBackend{
+					{Service: svc, Port: intstr.FromInt(80), Weight: 1},
+				},
+			},
+		},
+	}
+	grpcRoute := &Route{
+		Kind:   RouteKindGRPCRoute,
+		Object: &gwv1.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "grpc-route"}},
+		Rules: []RouteRule{
+			{
+				Matches: []RouteMatch{
+					{PathType: PathMatchTypeExact, Path: "/foo.Bar/Baz"},
+				},
+				Backends: []RouteBackend{
+					{Service: svc, Port: intstr.FromInt(80), Weight: 1},
+				},
+			},
+		},
+	}
+	gw := Gateway{
+		Gateway: &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-gw",
+				Annotations: map[string]string{
+					"gateway.k8s.aws/scheme":  "internet-facing",
+					"gateway.k8s.aws/subnets": "subnet-a,subnet-b",
+				},
+			},
+		},
+		Listeners: []Listener{
+			{
+				Listener: gwv1.Listener{
+					Name:     "http",
+					Port:     80,
+					Protocol: gwv1.HTTPProtocolType,
+				},
+				SupportedKinds: []RouteKind{RouteKindHTTPRoute, RouteKindGRPCRoute},
+				Routes: []AttachedRoute{
+					{Route: httpRoute},
+					{Route: grpcRoute, Hostnames: []string{"grpc.example.com"}},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	subnetsResolver := networkingpkg.NewMockSubnetsResolver(ctrl)
+	subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), []string{"subnet-a", "subnet-b"}, gomock.Any()).Return([]*ec2sdk.Subnet{
+		{SubnetId: awssdk.String("subnet-a")},
+		{SubnetId: awssdk.String("subnet-b")},
+	}, nil)
+	elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+	sgResolver := networkingpkg.NewMockSecurityGroupResolver(ctrl)
+	var acmClient services.ACM
+
+	b := NewDefaultModelBuilder(elbv2model.LoadBalancerTypeApplication, nil, acmClient, annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixGateway),
+		subnetsResolver, sgResolver, tracking.NewDefaultProvider("gateway.k8s.aws", "cluster-name"),
+		elbv2TaggingManager, config.NewFeatureGates(), "vpc-dummy", "cluster-name", nil, nil,
+		"ELBSecurityPolicy-2016-08", "instance", false, ingress.CertDiscoveryFilters{}, true, logr.New(&log.NullLogSink{}))
+	stack, lb, err := b.Build(context.Background(), gw)
+	assert.NoError(t, err)
+	assert.NotNil(t, lb)
+	stackJSON, err := deploy.NewDefaultStackMarshaller().Marshal(stack)
+	assert.NoError(t, err)
+	assert.JSONEq(t, wantStackJSON, stackJSON)
+}
+
+const wantStackJSON = `
+{
+    "id": "awesome-ns/awesome-gw",
+    "resources": {
+        "AWS::EC2::SecurityGroup": {
+            "ManagedLBSecurityGroup": {
+                "spec": {
+                    "groupName": "k8s-awesomen-awesomeg-e3dc3efd9f",
+                    "description": "[k8s] Managed SecurityGroup for LoadBalancer",
+                    "ingress": [
+                        {
+                            "ipProtocol": "tcp",
+                            "fromPort": 80,
+                            "toPort": 80,
+                            "ipRanges": [
+                                {
+                                    "cidrIP": "0.0.0.0/0"
+                                }
+                            ]
+                        }
+                    ]
+                }
+            }
+        },
+        "AWS::ElasticLoadBalancingV2::Listener": {
+            "80": {
+                "spec": {
+                    "loadBalancerARN": {
+                        "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+                    },
+                    "port": 80,
+                    "protocol": "HTTP",
+                    "defaultActions": [
+                        {
+                            "type": "fixed-response",
+                            "fixedResponseConfig": {
+                                "contentType": "text/plain",
+                                "statusCode": "404"
+                            }
+                        }
+                    ]
+                }
+            }
+        },
+        "AWS::ElasticLoadBalancingV2::ListenerRule": {
+            "80:1": {
+                "spec": {
+                    "listenerARN": {
+                        "$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+                    },
+                    "priority": 1,
+                    "actions": [
+                        {
+                            "type": "forward",
+                            "forwardConfig": {
+                                "targetGroups": [
+                                    {
+                                        "targetGroupARN": {
+                                            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/awesome-ns/awesome-svc:80:GRPC/status/targetGroupARN"
+                                        },
+                                        "weight": 1
+                                    }
+                                ]
+                            }
+                        }
+                    ],
+                    "conditions": [
+                        {
+                            "field": "host-header",
+                            "hostHeaderConfig": {
+                                "values": [
+                                    "grpc.example.com"
+                                ]
+                            }
+                        },
+                        {
+                            "field": "path-pattern",
+                            "pathPatternConfig": {
+                                "values": [
+                                    "/foo.Bar/Baz"
+                                ]
+                            }
+                        }
+                    ]
+                }
+            },
+            "80:2": {
+                "spec": {
+                    "listenerARN": {
+                        "$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+                    },
+                    "priority": 2,
+                    "actions": [
+                        {
+                            "type": "forward",
+                            "forwardConfig": {
+                                "targetGroups": [
+                                    {
+                                        "targetGroupARN": {
+                                            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/awesome-ns/awesome-svc:80:HTTP1/status/targetGroupARN"
+                                        },
+                                        "weight": 1
+                                    }
+                                ]
+                            }
+                        }
+                    ],
+                    "conditions": [
+                        {
+                            "field": "path-pattern",
+                            "pathPatternConfig": {
+                                "values": [
+                                    "/api",
+                                    "/api/*"
+                                ]
+                            }
+                        }
+                    ]
+                }
+            }
+        },
+        "AWS::ElasticLoadBalancingV2::LoadBalancer": {
+            "LoadBalancer": {
+                "spec": {
+                    "name": "k8s-awesomen-awesomeg-41b565211e",
+                    "type": "application",
+                    "scheme": "internet-facing",
+                    "ipAddressType": "ipv4",
+                    "subnetMapping": [
+                        {
+                            "subnetID": "subnet-a"
+                        },
+                        {
+                            "subnetID": "subnet-b"
+                        }
+                    ],
+                    "securityGroups": [
+                        {
+                            "$ref": "#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                        }
+                    ]
+                }
+            }
+        },
+        "AWS::ElasticLoadBalancingV2::TargetGroup": {
+            "awesome-ns/awesome-svc:80:GRPC": {
+                "spec": {
+                    "name": "k8s-awesomen-awesomes-099f446143",
+                    "targetType": "instance",
+                    "port": 32768,
+                    "protocol": "HTTP",
+                    "protocolVersion": "GRPC",
+                    "ipAddressType": "ipv4",
+                    "healthCheckConfig": {
+                        "port": "traffic-port",
+                        "protocol": "HTTP",
+                        "path": "/AWS.ALB/healthcheck",
+                        "matcher": {
+                            "grpcCode": "12"
+                        },
+                        "intervalSeconds": 15,
+                        "timeoutSeconds": 5,
+                        "healthyThresholdCount": 2,
+                        "unhealthyThresholdCount": 2
+                    }
+                }
+            },
+            "awesome-ns/awesome-svc:80:HTTP1": {
+                "spec": {
+                    "name": "k8s-awesomen-awesomes-80c3868b98",
+                    "targetType": "instance",
+                    "port": 32768,
+                    "protocol": "HTTP",
+                    "protocolVersion": "HTTP1",
+                    "ipAddressType": "ipv4",
+                    "healthCheckConfig": {
+                        "port": "traffic-port",
+                        "protocol": "HTTP",
+                        "path": "/",
+                        "matcher": {
+                            "httpCode": "200"
+                        },
+                        "intervalSeconds": 15,
+                        "timeoutSeconds": 5,
+                        "healthyThresholdCount": 2,
+                        "unhealthyThresholdCount": 2
+                    }
+                }
+            }
+        },
+        "K8S::ElasticLoadBalancingV2::TargetGroupBinding": {
+            "awesome-ns/awesome-svc:80:GRPC": {
+                "spec": {
+                    "template": {
+                        "metadata": {
+                            "name": "k8s-awesomen-awesomes-099f446143",
+                            "namespace": "awesome-ns",
+                            "creationTimestamp": null
+                        },
+                        "spec": {
+                            "targetGroupARN": {
+                                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/awesome-ns/awesome-svc:80:GRPC/status/targetGroupARN"
+                            },
+                            "targetType": "instance",
+                            "serviceRef": {
+                                "name": "awesome-svc",
+                                "port": 80
+                            },
+                            "networking": {
+                                "ingress": [
+                                    {
+                                        "from": [
+                                            {
+                                                "securityGroup": {
+                                                    "groupID": {
+                                                        "$ref": "#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                                                    }
+                                                }
+                                            }
+                                        ],
+                                        "ports": [
+                                            {
+                                                "protocol": "TCP",
+                                                "port": 32768
+                                            }
+                                        ]
+                                    }
+                                ]
+                            },
+                            "ipAddressType": "ipv4",
+                            "vpcID": "vpc-dummy"
+                        }
+                    }
+                }
+            },
+            "awesome-ns/awesome-svc:80:HTTP1": {
+                "spec": {
+                    "template": {
+                        "metadata": {
+                            "name": "k8s-awesomen-awesomes-80c3868b98",
+                            "namespace": "awesome-ns",
+                            "creationTimestamp": null
+                        },
+                        "spec": {
+                            "targetGroupARN": {
+                                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/awesome-ns/awesome-svc:80:HTTP1/status/targetGroupARN"
+                            },
+                            "targetType": "instance",
+                            "serviceRef": {
+                                "name": "awesome-svc",
+                                "port": 80
+                            },
+                            "networking": {
+                                "ingress": [
+                                    {
+                                        "from": [
+                                            {
+                                                "securityGroup": {
+                                                    "groupID": {
+                                                        "$ref": "#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                                                    }
+                                                }
+                                            }
+                                        ],
+                                        "ports": [
+                                            {
+                                                "protocol": "TCP",
+                                                "port": 32768
+                                            }
+                                        ]
+                                    }
+                                ]
+                            },
+                            "ipAddressType": "ipv4",
+                            "vpcID": "vpc-dummy"
+                        }
+                    }
+                }
+            }
+        }
+    }
+}
+`
@@ -0,0 +1,460 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// RouteKind is the kind of Gateway API route.
+type RouteKind string
+
+const (
+	RouteKindHTTPRoute RouteKind = "HTTPRoute"
+	RouteKindGRPCRoute RouteKind = "GRPCRoute"
+	RouteKindTCPRoute  RouteKind = "TCPRoute"
+	RouteKindUDPRoute  RouteKind = "UDPRoute"
+	RouteKindTLSRoute  RouteKind = "TLSRoute"
+)
+
+// PathMatchType is the type of path match within a RouteMatch.
+type PathMatchType string
+
+const (
+	// PathMatchTypeExact matches the request path exactly.
+	PathMatchTypeExact PathMatchType = "Exact"
+	// PathMatchTypePrefix matches the request path by path element prefix.
+	PathMatchTypePrefix PathMatchType = "PathPrefix"
+	// PathMatchTypePattern matches the request path with an ALB path pattern, which supports '*' and '?' wildcards.
+	PathMatchTypePattern PathMatchType = "Pattern"
+)
+
+// Route is the protocol independent representation of a Gateway API route.
+type Route struct {
+	// Kind of the route.
+	Kind RouteKind
+	// Object is the route object, e.g. *gwv1.HTTPRoute.
+	Object client.Object
+	// ParentRefs are the parents the route wants to attach to.
+	ParentRefs []gwv1.ParentReference
+	// Hostnames are the hostnames the route matches, empty means any hostname.
+	Hostnames []gwv1.Hostname
+	// Rules of the route, with backendRefs resolved.
+	Rules []RouteRule
+
+	// UnsupportedReason is set when the route uses a feature we cannot implement.
+	UnsupportedReason string
+	// UnresolvedRefsReason is set when some backendRefs of the route cannot be resolved.
+	UnresolvedRefsReason gwv1.RouteConditionReason
+	// UnresolvedRefsMessage explains why some backendRefs of the route cannot be resolved.
+	UnresolvedRefsMessage string
+}
+
+// RouteRule is a single rule of route.
+type RouteRule struct {
+	// Matches are ORed together, empty matches means all requests are matched.
+	Matches []RouteMatch
+	// Redirect is the RequestRedirect filter of this rule, it takes precedence over BackendRefs.
+	Redirect *gwv1.HTTPRequestRedirectFilter
+	// BackendRefs are the raw backendRefs of this rule.
+	BackendRefs []gwv1.BackendRef
+	// Backends are the resolved valid backends of this rule.
+	Backends []RouteBackend
+}
+
+// RouteMatch is a single match of route rule, all conditions are ANDed together.
+type RouteMatch struct {
+	PathType    PathMatchType
+	Path        string
+	Headers     []NameValueMatch
+	QueryParams []NameValueMatch
+	Method      *string
+}
+
+// NameValueMatch is an exact match on the value of a named header or query parameter.
+type NameValueMatch struct {
+	Name  string
+	Value string
+}
+
+// RouteBackend is a resolved backendRef of route rule.
+type RouteBackend struct {
+	Service *corev1.Service
+	Port    intstr.IntOrString
+	Weight  int64
+}
+
+// RouteStatus returns the shared RouteStatus of route object.
+func RouteStatus(obj client.Object) (*gwv1.RouteStatus, error) {
+	switch route := obj.(type) {
+	case *gwv1.HTTPRoute:
+		return &route.Status.RouteStatus, nil
+	case *gwv1.GRPCRoute:
+		return &route.Status.RouteStatus, nil
+	case *gwv1alpha2.TCPRoute:
+		return &route.Status.RouteStatus, nil
+	case *gwv1alpha2.UDPRoute:
+		return &route.Status.RouteStatus, nil
+	case *gwv1alpha2.TLSRoute:
+		return &route.Status.RouteStatus, nil
+	default:
+		return nil, errors.Errorf("unsupported route type: %T", obj)
+	}
+}
+
+// routeLister lists all routes of a specific kind.
+type routeLister func(ctx context.Context, k8sClient client.Client) ([]Route, error)
+
+// routeListers contains the routeLister for each supported route kind.
+var routeListers = map[RouteKind]routeLister{
+	RouteKindHTTPRoute: listHTTPRoutes,
+	RouteKindGRPCRoute: listGRPCRoutes,
+	RouteKindTCPRoute:  listTCPRoutes,
+	RouteKindUDPRoute:  listUDPRoutes,
+	RouteKindTLSRoute:  listTLSRoutes,
+}
+
+// ListRoutes lists all routes of kind.
+func ListRoutes(ctx context.Context, k8sClient client.Client, kind RouteKind) ([]Route, error) {
+	lister, exists := routeListers[kind]
+	if !exists {
+		return nil, errors.Errorf("unsupported route kind: %v", kind)
+	}
+	return lister(ctx, k8sClient)
+}
+
+func listHTTPRoutes(ctx context.Context, k8sClient client.Client) ([]Route, error) {
+	routeList := &gwv1.HTTPRouteList{}
+	if err := k8sClient.List(ctx, routeList); err != nil {
+		return nil, err
+	}
+	routes := make([]Route, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		routes = append(routes, newHTTPRoute(&routeList.Items[i]))
+	}
+	return routes, nil
+}
+
+func listGRPCRoutes(ctx context.Context, k8sClient client.Client) ([]Route, error) {
+	routeList := &gwv1.GRPCRouteList{}
+	if err := k8sClient.List(ctx, routeList); err != nil {
+		return nil, err
+	}
+	routes := make([]Route, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		routes = append(routes, newGRPCRoute(&routeList.Items[i]))
+	}
+	return routes, nil
+}
+
+func listTCPRoutes(ctx context.Context, k8sClient client.Client) ([]Route, error) {
+	routeList := &gwv1alpha2.TCPRouteList{}
+	if err := k8sClient.List(ctx, routeList); err != nil {
+		return nil, err
+	}
+	routes := make([]Route, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		tcpRoute := &routeList.Items[i]
+		var backendRefsOfRules [][]gwv1.BackendRef
+		for _, rule := range tcpRoute.Spec.Rules {
+			backendRefsOfRules = append(backendRefsOfRules, rule.BackendRefs)
+		}
+		routes = append(routes, newL4Route(RouteKindTCPRoute, tcpRoute, tcpRoute.Spec.ParentRefs, nil, backendRefsOfRules))
+	}
+	return routes, nil
+}
+
+func listUDPRoutes(ctx context.Context, k8sClient client.Client) ([]Route, error) {
+	routeList := &gwv1alpha2.UDPRouteList{}
+	if err := k8sClient.List(ctx, routeList); err != nil {
+		return nil, err
+	}
+	routes := make([]Route, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		udpRoute := &routeList.Items[i]
+		var backendRefsOfRules [][]gwv1.BackendRef
+		for _, rule := range udpRoute.Spec.Rules {
+			backendRefsOfRules = append(backendRefsOfRules, rule.BackendRefs)
+		}
+		routes = append(routes, newL4Route(RouteKindUDPRoute, udpRoute, udpRoute.Spec.ParentRefs, nil, backendRefsOfRules))
+	}
+	return routes, nil
+}
+
+func listTLSRoutes(ctx context.Context, k8sClient client.Client) ([]Route, error) {
+	routeList := &gwv1alpha2.TLSRouteList{}
+	if err := k8sClient.List(ctx, routeList); err != nil {
+		return nil, err
+	}
+	routes := make([]Route, 0, len(routeList.Items))
+	for i := range routeList.Items {
+		tlsRoute := &routeList.Items[i]
+		var backendRefsOfRules [][]gwv1.BackendRef
+		for _, rule := range tlsRoute.Spec.Rules {
+			backendRefsOfRules = append(backendRefsOfRules, rule.BackendRefs)
+		}
+		routes = append(routes, newL4Route(RouteKindTLSRoute, tlsRoute, tlsRoute.Spec.ParentRefs, tlsRoute.Spec.Hostnames, backendRefsOfRules))
+	}
+	return routes, nil
+}
+
+// newL4Route converts TCPRoute, UDPRoute or TLSRoute into Route.
+// L4 routes have no matches, the backendRefs of all rules receive the traffic of the listener.
+func newL4Route(kind RouteKind, obj client.Object, parentRefs []gwv1.ParentReference, hostnames []gwv1.Hostname, backendRefsOfRules [][]gwv1.BackendRef) Route {
+	route := Route{
+		Kind:       kind,
+		Object:     obj,
+		ParentRefs: parentRefs,
+		Hostnames:  hostnames,
+	}
+	for _, backendRefs := range backendRefsOfRules {
+		route.Rules = append(route.Rules, RouteRule{
+			BackendRefs: backendRefs,
+		})
+	}
+	return route
+}
+
+// isL4RouteKind checks whether routes of kind are implemented by NLB listeners.
+func isL4RouteKind(kind RouteKind) bool {
+	return kind == RouteKindTCPRoute || kind == RouteKindUDPRoute || kind == RouteKindTLSRoute
+}
+
+// l4RouteTransportProtocol returns the transport protocol of L4 routes of kind, TLSRoutes are carried over TCP.
+func l4RouteTransportProtocol(kind RouteKind) corev1.Protocol {
+	if kind == RouteKindUDPRoute {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
+
+func newHTTPRoute(httpRoute *gwv1.HTTPRoute) Route {
+	route := Route{
+		Kind:       RouteKindHTTPRoute,
+		Object:     httpRoute,
+		ParentRefs: httpRoute.Spec.ParentRefs,
+		Hostnames:  httpRoute.Spec.Hostnames,
+	}
+	for _, rule := range httpRoute.Spec.Rules {
+		routeRule, err := buildHTTPRouteRule(rule)
+		if err != nil {
+			route.UnsupportedReason = err.Error()
+			route.Rules = nil
+			return route
+		}
+		route.Rules = append(route.Rules, routeRule)
+	}
+	return route
+}
+
+func newGRPCRoute(grpcRoute *gwv1.GRPCRoute) Route {
+	route := Route{
+		Kind:       RouteKindGRPCRoute,
+		Object:     grpcRoute,
+		ParentRefs: grpcRoute.Spec.ParentRefs,
+		Hostnames:  grpcRoute.Spec.Hostnames,
+	}
+	for _, rule := range grpcRoute.Spec.Rules {
+		routeRule, err := buildGRPCRouteRule(rule)
+		if err != nil {
+			route.UnsupportedReason = err.Error()
+			route.Rules = nil
+			return route
+		}
+		route.Rules = append(route.Rules, routeRule)
+	}
+	return route
+}
+
+func buildHTTPRouteRule(rule gwv1.HTTPRouteRule) (RouteRule, error) {
+	routeRule := RouteRule{}
+	for _, match := range rule.Matches {
+		routeMatch, err := buildHTTPRouteMatch(match)
+		if err != nil {
+			return RouteRule{}, err
+		}
+		routeRule.Matches = append(routeRule.Matches, routeMatch)
+	}
+	for _, filter := range rule.Filters {
+		if filter.Type != gwv1.HTTPRouteFilterRequestRedirect || filter.RequestRedirect == nil {
+			return RouteRule{}, errors.Errorf("unsupported filter type: %v", filter.Type)
+		}
+		if filter.RequestRedirect.Path != nil && filter.RequestRedirect.Path.Type != gwv1.FullPathHTTPPathModifier {
+			return RouteRule{}, errors.Errorf("unsupported redirect path modifier: %v", filter.RequestRedirect.Path.Type)
+		}
+		routeRule.Redirect = filter.RequestRedirect
+	}
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) != 0 {
+			return RouteRule{}, errors.New("unsupported backendRef filters")
+		}
+		routeRule.BackendRefs = append(routeRule.BackendRefs, backendRef.BackendRef)
+	}
+	return routeRule, nil
+}
+
+func buildHTTPRouteMatch(match gwv1.HTTPRouteMatch) (RouteMatch, error) {
+	routeMatch := RouteMatch{
+		PathType: PathMatchTypePrefix,
+		Path:     "/",
+	}
+	if match.Path != nil {
+		if match.Path.Value != nil {
+			routeMatch.Path = *match.Path.Value
+		}
+		pathMatchType := gwv1.PathMatchPathPrefix
+		if match.Path.Type != nil {
+			pathMatchType = *match.Path.Type
+		}
+		switch pathMatchType {
+		case gwv1.PathMatchExact:
+			routeMatch.PathType = PathMatchTypeExact
+		case gwv1.PathMatchPathPrefix:
+			routeMatch.PathType = PathMatchTypePrefix
+		default:
+			return RouteMatch{}, errors.Errorf("unsupported path match type: %v", pathMatchType)
+		}
+	}
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1.HeaderMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported header match type: %v", *header.Type)
+		}
+		routeMatch.Headers = append(routeMatch.Headers, NameValueMatch{
+			Name:  string(header.Name),
+			Value: header.Value,
+		})
+	}
+	for _, queryParam := range match.QueryParams {
+		if queryParam.Type != nil && *queryParam.Type != gwv1.QueryParamMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported query param match type: %v", *queryParam.Type)
+		}
+		routeMatch.QueryParams = append(routeMatch.QueryParams, NameValueMatch{
+			Name:  string(queryParam.Name),
+			Value: queryParam.Value,
+		})
+	}
+	if match.Method != nil {
+		method := string(*match.Method)
+		routeMatch.Method = &method
+	}
+	return routeMatch, nil
+}
+
+func buildGRPCRouteRule(rule gwv1.GRPCRouteRule) (RouteRule, error) {
+	routeRule := RouteRule{}
+	for _, match := range rule.Matches {
+		routeMatch, err := buildGRPCRouteMatch(match)
+		if err != nil {
+			return RouteRule{}, err
+		}
+		routeRule.Matches = append(routeRule.Matches, routeMatch)
+	}
+	if len(rule.Filters) != 0 {
+		return RouteRule{}, errors.New("unsupported GRPCRoute filters")
+	}
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) != 0 {
+			return RouteRule{}, errors.New("unsupported backendRef filters")
+		}
+		routeRule.BackendRefs = append(routeRule.BackendRefs, backendRef.BackendRef)
+	}
+	return routeRule, nil
+}
+
+// buildGRPCRouteMatch converts GRPCRouteMatch into RouteMatch.
+// gRPC requests are HTTP/2 POST requests with path "/<service>/<method>", so method matches are translated into path matches.
+func buildGRPCRouteMatch(match gwv1.GRPCRouteMatch) (RouteMatch, error) {
+	routeMatch := RouteMatch{
+		PathType: PathMatchTypePrefix,
+		Path:     "/",
+	}
+	if match.Method != nil {
+		if match.Method.Type != nil && *match.Method.Type != gwv1.GRPCMethodMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported method match type: %v", *match.Method.Type)
+		}
+		service := ""
+		if match.Method.Service != nil {
+			service = *match.Method.Service
+		}
+		method := ""
+		if match.Method.Method != nil {
+			method = *match.Method.Method
+		}
+		switch {
+		case service != "" && method != "":
+			routeMatch.PathType = PathMatchTypeExact
+			routeMatch.Path = fmt.Sprintf("/%s/%s", service, method)
+		case service != "":
+			routeMatch.PathType = PathMatchTypePrefix
+			routeMatch.Path = fmt.Sprintf("/%s", service)
+		case method != "":
+			routeMatch.PathType = PathMatchTypePattern
+			routeMatch.Path = fmt.Sprintf("/*/%s", method)
+		}
+	}
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1.HeaderMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported header match type: %v", *header.Type)
+		}
+		routeMatch.Headers = append(routeMatch.Headers, NameValueMatch{
+			Name:  string(header.Name),
+			Value: header.Value,
+		})
+	}
+	return routeMatch, nil
+}
+
+// computeListenerRouteHostnames computes the hostnames of route that are accepted by a listener.
+// Returns nil with true if any hostname is accepted, and false if no hostname is accepted.
+func computeListenerRouteHostnames(listenerHostname *gwv1.Hostname, routeHostnames []gwv1.Hostname) ([]string, bool) {
+	if listenerHostname == nil || *listenerHostname == "" {
+		if len(routeHostnames) == 0 {
+			return nil, true
+		}
+		hostnames := make([]string, 0, len(routeHostnames))
+		for _, hostname := range routeHostnames {
+			hostnames = append(hostnames, string(hostname))
+		}
+		return hostnames, true
+	}
+	if len(routeHostnames) == 0 {
+		return []string{string(*listenerHostname)}, true
+	}
+	var hostnames []string
+	for _, routeHostname := range routeHostnames {
+		if hostname, ok := intersectHostnames(string(*listenerHostname), string(routeHostname)); ok {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames, len(hostnames) != 0
+}
+
+// intersectHostnames returns the most specific hostname matched by both hostnames, which can contain a leading wildcard label.
+func intersectHostnames(hostnameA string, hostnameB string) (string, bool) {
+	if hostnameA == hostnameB {
+		return hostnameA, true
+	}
+	if wildcardMatches(hostnameA, hostnameB) {
+		return hostnameB, true
+	}
+	if wildcardMatches(hostnameB, hostnameA) {
+		return hostnameA, true
+	}
+	return "", false
+}
+
+// wildcardMatches checks whether the hostname is covered by the wildcard hostname, e.g. "*.example.com" covers "foo.bar.example.com" and "*.bar.example.com".
+func wildcardMatches(wildcardHostname string, hostname string) bool {
+	if !strings.HasPrefix(wildcardHostname, "*.") {
+		return false
+	}
+	suffix := strings.TrimPrefix(wildcardHostname, "*")
+	return strings.HasSuffix(hostname, suffix) && len(strings.TrimPrefix(hostname, "*")) > len(suffix)
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_computeListenerRouteHostnames(t *testing.T) {
+	type args struct {
+		listenerHostname *gwv1.Hostname
+		routeHostnames   []gwv1.Hostname
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantHostnames []string
+		wantMatched   bool
+	}{
+		{
+			name: "listener and route without hostnames",
+			args: args{
+				listenerHostname: nil,
+				routeHostnames:   nil,
+			},
+			wantHostnames: nil,
+			wantMatched:   true,
+		},
+		{
+			name: "listener without hostname",
+			args: args{
+				listenerHostname: nil,
+				routeHostnames:   []gwv1.Hostname{"a.example.com", "*.example.com"},
+			},
+			wantHostnames: []string{"a.example.com", "*.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "route without hostnames",
+			args: args{
+				listenerHostname: (*gwv1.Hostname)(awssdk.String("*.example.com")),
+				routeHostnames:   nil,
+			},
+			wantHostnames: []string{"*.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "wildcard listener hostname matches route hostnames",
+			args: args{
+				listenerHostname: (*gwv1.Hostname)(awssdk.String("*.example.com")),
+				routeHostnames:   []gwv1.Hostname{"a.example.com", "b.a.example.com", "example.com", "a.example.org"},
+			},
+			wantHostnames: []string{"a.example.com", "b.a.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "wildcard route hostname matches listener hostname",
+			args: args{
+				listenerHostname: (*gwv1.Hostname)(awssdk.String("a.example.com")),
+				routeHostnames:   []gwv1.Hostname{"*.example.com"},
+			},
+			wantHostnames: []string{"a.example.com"},
+			wantMatched:   true,
+		},
+		{
+			name: "no hostname matches",
+			args: args{
+				listenerHostname: (*gwv1.Hostname)(awssdk.String("a.example.com")),
+				routeHostnames:   []gwv1.Hostname{"b.example.com"},
+			},
+			wantHostnames: nil,
+			wantMatched:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHostnames, gotMatched := computeListenerRouteHostnames(tt.args.listenerHostname, tt.args.routeHostnames)
+			assert.Equal(t, tt.wantHostnames, gotHostnames)
+			assert.Equal(t, tt.wantMatched, gotMatched)
+		})
+	}
+}
+
+func Test_newHTTPRoute(t *testing.T) {
+	pathExact := gwv1.PathMatchExact
+	pathRegex := gwv1.PathMatchRegularExpression
+	methodGet := gwv1.HTTPMethodGet
+	tests := []struct {
+		name      string
+		httpRoute *gwv1.HTTPRoute
+		want      Route
+	}{
+		{
+			name: "supported matches",
+			httpRoute: &gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &pathExact,
+										Value: awssdk.String("/exact"),
+									},
+									Headers: []gwv1.HTTPHeaderMatch{
+										{
+											Name:  "x-env",
+											Value: "dev",
+										},
+									},
+									Method: &methodGet,
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: gwv1.BackendRef{
+										BackendObjectReference: gwv1.BackendObjectReference{
+											Name: "svc",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: Route{
+				Kind: RouteKindHTTPRoute,
+				Rules: []RouteRule{
+					{
+						Matches: []RouteMatch{
+							{
+								PathType: PathMatchTypeExact,
+								Path:     "/exact",
+								Headers: []NameValueMatch{
+									{
+										Name:  "x-env",
+										Value: "dev",
+									},
+								},
+								Method: awssdk.String("GET"),
+							},
+						},
+						BackendRefs: []gwv1.BackendRef{
+							{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "svc",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported regex path match",
+			httpRoute: &gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route"},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &pathRegex,
+										Value: awssdk.String("/.*"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: Route{
+				Kind:              RouteKindHTTPRoute,
+				UnsupportedReason: "unsupported path match type: RegularExpression",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newHTTPRoute(tt.httpRoute)
+			assert.Equal(t, tt.want.Kind, got.Kind)
+			assert.Equal(t, tt.want.Rules, got.Rules)
+			assert.Equal(t, tt.want.UnsupportedReason, got.UnsupportedReason)
+		})
+	}
+}
+
+func Test_buildGRPCRouteMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		match   gwv1.GRPCRouteMatch
+		want    RouteMatch
+		wantErr error
+	}{
+		{
+			name:  "match any",
+			match: gwv1.GRPCRouteMatch{},
+			want: RouteMatch{
+				PathType: PathMatchTypePrefix,
+				Path:     "/",
+			},
+		},
+		{
+			name: "match service and method",
+			match: gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Service: awssdk.String("foo.Bar"),
+					Method:  awssdk.String("Baz"),
+				},
+			},
+			want: RouteMatch{
+				PathType: PathMatchTypeExact,
+				Path:     "/foo.Bar/Baz",
+			},
+		},
+		{
+			name: "match service only",
+			match: gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Service: awssdk.String("foo.Bar"),
+				},
+			},
+			want: RouteMatch{
+				PathType: PathMatchTypePrefix,
+				Path:     "/foo.Bar",
+			},
+		},
+		{
+			name: "match method only",
+			match: gwv1.GRPCRouteMatch{
+				Method: &gwv1.GRPCMethodMatch{
+					Method: awssdk.String("Baz"),
+				},
+			},
+			want: RouteMatch{
+				PathType: PathMatchTypePattern,
+				Path:     "/*/Baz",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildGRPCRouteMatch(tt.match)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package ingress
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+)
+
+const (
+	// AnnotationDeployedIAMRole records the IAM role that the LoadBalancer resources of an Ingress are deployed with.
+	// it's set by the controller, so that the LoadBalancer resources are cleaned up in the AWS account they're deployed to.
+	AnnotationDeployedIAMRole = "ingress.k8s.aws/deployed-iam-role"
+)
+
+// BuildAssumeRoleConfig builds the IAM role to assume to provision LoadBalancer resources of ingGroup in another AWS account.
+// the IAM role is specified by the IngressClassParams of Ingresses, and it's an error if Ingresses specify different IAM roles.
+// once deployed, LoadBalancer resources are managed with the IAM role recorded on Ingresses by AnnotationDeployedIAMRole:
+//   - a deleted IngressGroup has no members left, so its LoadBalancer resources are cleaned up with the recorded IAM role.
+//   - it's an error if members specify another IAM role, since LoadBalancer resources can't be moved to another AWS account.
+//
+// a deleted IngressGroup that doesn't record the IAM role builds it from the IngressClassParams of its inactive members instead.
+// returns nil if the LoadBalancer resources are provisioned in the controller's own AWS account.
+func BuildAssumeRoleConfig(ctx context.Context, classLoader ClassLoader, ingGroup Group) (*aws.AssumeRoleConfig, error) {
+	deployedAssumeRole, deployed, err := buildDeployedAssumeRoleConfig(ingGroup)
+	if err != nil {
+		return nil, err
+	}
+	if len(ingGroup.Members) == 0 && deployed {
+		return deployedAssumeRole, nil
+	}
+
+	var classConfigs []ClassConfiguration
+	if len(ingGroup.Members) != 0 {
+		for _, member := range ingGroup.Members {
+			classConfigs = append(classConfigs, member.IngClassConfig)
+		}
+	} else {
+		for _, ing := range ingGroup.InactiveMembers {
+			classConfig, err := classLoader.Load(ctx, ing)
+			if err != nil && !errors.Is(err, ErrInvalidIngressClass) {
+				return nil, err
+			}
+			classConfigs = append(classConfigs, classConfig)
+		}
+	}
+	assumeRole, err := buildAssumeRoleConfigFromClassConfigs(classConfigs)
+	if err != nil {
+		return nil, err
+	}
+	if deployed && aws.MarshalAssumeRoleConfig(assumeRole) != aws.MarshalAssumeRoleConfig(deployedAssumeRole) {
+		return nil, errors.Errorf("IAM role to assume changed from %v to %v, LoadBalancer resources can't be moved to another AWS account",
+			aws.MarshalAssumeRoleConfig(deployedAssumeRole), aws.MarshalAssumeRoleConfig(assumeRole))
+	}
+	return assumeRole, nil
+}
+
+// buildAssumeRoleConfigFromClassConfigs builds the IAM role specified by the IngressClassParams of classConfigs.
+func buildAssumeRoleConfigFromClassConfigs(classConfigs []ClassConfiguration) (*aws.AssumeRoleConfig, error) {
+	assumeRoles := make(map[aws.AssumeRoleConfig]struct{})
+	for _, classConfig := range classConfigs {
+		assumeRole := aws.AssumeRoleConfig{}
+		if classConfig.IngClassParams != nil && classConfig.IngClassParams.Spec.AssumeRole != nil {
+			assumeRole = aws.AssumeRoleConfig{
+				RoleARN:    classConfig.IngClassParams.Spec.AssumeRole.RoleARN,
+				ExternalID: classConfig.IngClassParams.Spec.AssumeRole.ExternalID,
+				VpcID:      classConfig.IngClassParams.Spec.AssumeRole.VpcID,
+			}
+		}
+		assumeRoles[assumeRole] = struct{}{}
+	}
+	if len(assumeRoles) > 1 {
+		roleARNs := make([]string, 0, len(assumeRoles))
+		for assumeRole := range assumeRoles {
+			roleARNs = append(roleARNs, assumeRole.RoleARN)
+		}
+		sort.Strings(roleARNs)
+		return nil, errors.Errorf("conflicting IAM role to assume: %q", roleARNs)
+	}
+	for assumeRole := range assumeRoles {
+		if assumeRole.RoleARN != "" {
+			return &assumeRole, nil
+		}
+	}
+	return nil, nil
+}
+
+// buildDeployedAssumeRoleConfig builds the IAM role that LoadBalancer resources of ingGroup are deployed with, as recorded on its Ingresses.
+// returns false if no Ingress records it, e.g. the LoadBalancer resources aren't deployed yet.
+func buildDeployedAssumeRoleConfig(ingGroup Group) (*aws.AssumeRoleConfig, bool, error) {
+	ings := make([]*networking.Ingress, 0, len(ingGroup.Members)+len(ingGroup.InactiveMembers))
+	for _, member := range ingGroup.Members {
+		ings = append(ings, member.Ing)
+	}
+	ings = append(ings, ingGroup.InactiveMembers...)
+
+	deployedAssumeRoles := sets.NewString()
+	for _, ing := range ings {
+		if rawAssumeRole, exists := ing.Annotations[AnnotationDeployedIAMRole]; exists {
+			deployedAssumeRoles.Insert(rawAssumeRole)
+		}
+	}
+	if len(deployedAssumeRoles) == 0 {
+		return nil, false, nil
+	}
+	if len(deployedAssumeRoles) > 1 {
+		return nil, false, errors.Errorf("conflicting IAM roles deployed with: %q", deployedAssumeRoles.List())
+	}
+	assumeRole, err := aws.UnmarshalAssumeRoleConfig(deployedAssumeRoles.List()[0])
+	if err != nil {
+		return nil, false, err
+	}
+	return assumeRole, true, nil
+}
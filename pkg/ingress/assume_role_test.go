@@ -0,0 +1,189 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_BuildAssumeRoleConfig(t *testing.T) {
+	ingClassWithParams := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "class-with-params",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: IngressClassControllerALB,
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String(elbv2api.GroupVersion.Group),
+				Kind:     ingressClassParamsKind,
+				Name:     "params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			AssumeRole: &elbv2api.AssumeRole{
+				RoleARN:    "arn:aws:iam::123456789012:role/ingress",
+				ExternalID: "external-id",
+			},
+		},
+	}
+	newRecordedIngress := func(name string, ingClassName *string, deployedIAMRole string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns-1",
+				Name:        name,
+				Annotations: map[string]string{"ingress.k8s.aws/deployed-iam-role": deployedIAMRole},
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: ingClassName,
+			},
+		}
+	}
+	newIngress := func(name string, ingClassName *string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      name,
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: ingClassName,
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		members         []ClassifiedIngress
+		inactiveMembers []*networking.Ingress
+		want            *aws.AssumeRoleConfig
+		wantErr         error
+	}{
+		{
+			name: "IAM role not specified",
+			members: []ClassifiedIngress{
+				{Ing: newIngress("ing-1", nil)},
+			},
+			want: nil,
+		},
+		{
+			name: "IAM role specified by IngressClassParams of members",
+			members: []ClassifiedIngress{
+				{
+					Ing:            newIngress("ing-1", awssdk.String("class-with-params")),
+					IngClassConfig: ClassConfiguration{IngClass: ingClassWithParams, IngClassParams: ingClassParams},
+				},
+				{
+					Ing:            newIngress("ing-2", awssdk.String("class-with-params")),
+					IngClassConfig: ClassConfiguration{IngClass: ingClassWithParams, IngClassParams: ingClassParams},
+				},
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN:    "arn:aws:iam::123456789012:role/ingress",
+				ExternalID: "external-id",
+			},
+		},
+		{
+			name: "IAM role specified by IngressClassParams of inactive members",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", awssdk.String("class-with-params")),
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN:    "arn:aws:iam::123456789012:role/ingress",
+				ExternalID: "external-id",
+			},
+		},
+		{
+			name: "conflicting IAM role",
+			members: []ClassifiedIngress{
+				{
+					Ing:            newIngress("ing-1", awssdk.String("class-with-params")),
+					IngClassConfig: ClassConfiguration{IngClass: ingClassWithParams, IngClassParams: ingClassParams},
+				},
+				{Ing: newIngress("ing-2", nil)},
+			},
+			wantErr: errors.New(`conflicting IAM role to assume: ["" "arn:aws:iam::123456789012:role/ingress"]`),
+		},
+		{
+			name: "IAM role specified by members and recorded",
+			members: []ClassifiedIngress{
+				{
+					Ing:            newRecordedIngress("ing-1", awssdk.String("class-with-params"), `{"roleARN":"arn:aws:iam::123456789012:role/ingress","externalID":"external-id"}`),
+					IngClassConfig: ClassConfiguration{IngClass: ingClassWithParams, IngClassParams: ingClassParams},
+				},
+				{
+					Ing:            newIngress("ing-2", awssdk.String("class-with-params")),
+					IngClassConfig: ClassConfiguration{IngClass: ingClassWithParams, IngClassParams: ingClassParams},
+				},
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN:    "arn:aws:iam::123456789012:role/ingress",
+				ExternalID: "external-id",
+			},
+		},
+		{
+			name: "IAM role of members changed since recorded",
+			members: []ClassifiedIngress{
+				{Ing: newRecordedIngress("ing-1", nil, `{"roleARN":"arn:aws:iam::123456789012:role/ingress","externalID":"external-id"}`)},
+			},
+			wantErr: errors.New(`IAM role to assume changed from {"roleARN":"arn:aws:iam::123456789012:role/ingress","externalID":"external-id"} to {}, LoadBalancer resources can't be moved to another AWS account`),
+		},
+		{
+			name: "IAM role recorded on inactive members",
+			inactiveMembers: []*networking.Ingress{
+				newRecordedIngress("ing-1", awssdk.String("missing-class"), `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`),
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN: "arn:aws:iam::123456789012:role/ingress",
+			},
+		},
+		{
+			name: "controller's own account recorded on inactive members",
+			inactiveMembers: []*networking.Ingress{
+				newRecordedIngress("ing-1", awssdk.String("class-with-params"), `{}`),
+			},
+			want: nil,
+		},
+		{
+			name: "conflicting IAM roles recorded",
+			inactiveMembers: []*networking.Ingress{
+				newRecordedIngress("ing-1", nil, `{}`),
+				newRecordedIngress("ing-2", nil, `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`),
+			},
+			wantErr: errors.New(`conflicting IAM roles deployed with: ["{\"roleARN\":\"arn:aws:iam::123456789012:role/ingress\"}" "{}"]`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(ingClassWithParams, ingClassParams).Build()
+			classLoader := NewDefaultClassLoader(k8sClient, true)
+			ingGroup := Group{
+				ID:              GroupID{Namespace: "", Name: "awesome-group"},
+				Members:         tt.members,
+				InactiveMembers: tt.inactiveMembers,
+			}
+			got, err := BuildAssumeRoleConfig(context.Background(), classLoader, ingGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -2,12 +2,14 @@ package ingress
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
@@ -17,37 +19,66 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 )
 
 const (
 	certARNsCacheKey = "certARNs"
-	// the certARNs in AWS account will be cached for 1 minute.
-	defaultCertARNsCacheTTL = 1 * time.Minute
-	// the domain names for imported certificates will be cached for 5 minute.
-	defaultImportedCertDomainsCacheTTL = 5 * time.Minute
-	// the domain names for private certificates won't change, cache for a longer time.
-	defaultPrivateCertDomainsCacheTTL = 10 * time.Hour
+	// the certARNs in AWS account will be cached for 10 minute, they're refreshed earlier if no certificate is found for a host.
+	defaultCertARNsCacheTTL = 10 * time.Minute
+	// the certARNs won't be refreshed more than once per 10 seconds when no certificate is found for hosts.
+	defaultCertARNsMinRefreshInterval = 10 * time.Second
+	// the details for imported certificates will be cached for 5 minute, since they can be re-imported.
+	defaultImportedCertDetailsCacheTTL = 5 * time.Minute
+	// the details for amazon issued and private certificates won't change until renewal, cache for a longer time.
+	defaultPrivateCertDetailsCacheTTL = 10 * time.Hour
 )
 
 // CertDiscovery is responsible for auto-discover TLS certificates for tls hosts.
 type CertDiscovery interface {
 	// Discover will try to find valid certificateARNs for each tlsHost.
+	// a single certificate is found per tlsHost, hosts sharing the best certificate share its certificateARN.
 	Discover(ctx context.Context, tlsHosts []string) ([]string, error)
 }
 
+// CertDiscoveryFilters are the filters for certificates to be discovered.
+type CertDiscoveryFilters struct {
+	// AllowedCAARNs are the ARNs of CAs that discovered certificates must be issued from, all CAs are allowed if empty.
+	AllowedCAARNs []string
+	// KeyAlgorithms are the key algorithms that discovered certificates must use, all key algorithms are allowed if empty.
+	KeyAlgorithms []string
+	// Tags are the tags that discovered certificates must have.
+	Tags map[string]string
+	// ExcludeTLSSecretCerts specifies whether to exclude the certificates imported from TLS secrets,
+	// which requires the tags of imported certificates. It's only needed while TLS secrets import is enabled.
+	ExcludeTLSSecretCerts bool
+}
+
+// BuildCertDiscoveryFilters builds the CertDiscoveryFilters from Ingress configuration.
+func BuildCertDiscoveryFilters(ingressConfig config.IngressConfig) CertDiscoveryFilters {
+	return CertDiscoveryFilters{
+		AllowedCAARNs:         ingressConfig.AllowedCertificateAuthorityARNs,
+		KeyAlgorithms:         ingressConfig.CertDiscoveryKeyAlgorithms,
+		Tags:                  ingressConfig.CertDiscoveryTags,
+		ExcludeTLSSecretCerts: ingressConfig.EnableTLSSecretsImport,
+	}
+}
+
 // NewACMCertDiscovery constructs new acmCertDiscovery
-func NewACMCertDiscovery(acmClient services.ACM, allowedCAARNs []string, logger logr.Logger) *acmCertDiscovery {
+func NewACMCertDiscovery(acmClient services.ACM, filters CertDiscoveryFilters, logger logr.Logger) *acmCertDiscovery {
 	return &acmCertDiscovery{
 		acmClient: acmClient,
 		logger:    logger,
 
-		loadDomainsByCertARNMutex:   sync.Mutex{},
+		loadCertsMutex:              sync.Mutex{},
 		certARNsCache:               cache.NewExpiring(),
 		certARNsCacheTTL:            defaultCertARNsCacheTTL,
-		certDomainsCache:            cache.NewExpiring(),
-		importedCertDomainsCacheTTL: defaultImportedCertDomainsCacheTTL,
-		privateCertDomainsCacheTTL:  defaultPrivateCertDomainsCacheTTL,
-		allowedCAARNs:               allowedCAARNs,
+		certARNsMinRefreshInterval:  defaultCertARNsMinRefreshInterval,
+		certDetailsCache:            cache.NewExpiring(),
+		importedCertDetailsCacheTTL: defaultImportedCertDetailsCacheTTL,
+		privateCertDetailsCacheTTL:  defaultPrivateCertDetailsCacheTTL,
+		filters:                     filters,
+		clock:                       time.Now,
 	}
 }
 
@@ -58,71 +89,150 @@ type acmCertDiscovery struct {
 	acmClient services.ACM
 	logger    logr.Logger
 
-	// mutex to serialize the call to loadDomainsForAllCertificates
-	loadDomainsByCertARNMutex   sync.Mutex
+	// mutex to serialize the call to loadAllCertificates
+	loadCertsMutex              sync.Mutex
 	certARNsCache               *cache.Expiring
 	certARNsCacheTTL            time.Duration
-	certDomainsCache            *cache.Expiring
-	allowedCAARNs               []string
-	importedCertDomainsCacheTTL time.Duration
-	privateCertDomainsCacheTTL  time.Duration
+	certARNsMinRefreshInterval  time.Duration
+	certARNsLastRefreshTime     time.Time
+	certDetailsCache            *cache.Expiring
+	importedCertDetailsCacheTTL time.Duration
+	privateCertDetailsCacheTTL  time.Duration
+	filters                     CertDiscoveryFilters
+	clock                       func() time.Time
+}
+
+// certDetail is the detail of an ACM certificate that's relevant for discovery.
+type certDetail struct {
+	certARN      string
+	domains      sets.String
+	status       string
+	keyAlgorithm string
+	caARN        string
+	revoked      bool
+	notBefore    time.Time
+	notAfter     time.Time
+	tags         map[string]string
 }
 
 func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts []string) ([]string, error) {
-	domainsByCertARN, err := d.loadDomainsForAllCertificates(ctx)
+	certs, err := d.loadAllCertificates(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 	certARNs := sets.NewString()
+	refreshed := false
 	for _, host := range tlsHosts {
-		var certARNsForHost []string
-		for certARN, domains := range domainsByCertARN {
-			for domain := range domains {
-				if d.domainMatchesHost(domain, host) {
-					certARNsForHost = append(certARNsForHost, certARN)
-					break
-				}
+		cert := d.findBestCertificateForHost(certs, host)
+		if cert == nil && !refreshed {
+			// the certificate for host might be issued after certARNs are cached, refresh them before giving up.
+			refreshed = true
+			if certs, err = d.loadAllCertificates(ctx, true); err != nil {
+				return nil, err
 			}
+			cert = d.findBestCertificateForHost(certs, host)
 		}
-
-		if len(certARNsForHost) == 0 {
+		if cert == nil {
 			return nil, errors.Errorf("no certificate found for host: %s", host)
 		}
-		certARNs.Insert(certARNsForHost...)
+		certARNs.Insert(cert.certARN)
 	}
 	return certARNs.List(), nil
 }
 
-func (d *acmCertDiscovery) loadDomainsForAllCertificates(ctx context.Context) (map[string]sets.String, error) {
-	d.loadDomainsByCertARNMutex.Lock()
-	defer d.loadDomainsByCertARNMutex.Unlock()
+// findBestCertificateForHost finds the best certificate among valid certs for host.
+// exact domain matches are preferred over wildcard matches, and the newest certificate is preferred among the same kind of matches.
+func (d *acmCertDiscovery) findBestCertificateForHost(certs []certDetail, host string) *certDetail {
+	var bestCert *certDetail
+	bestMatchRank := 0
+	for i := range certs {
+		cert := &certs[i]
+		if !d.isCertificateValid(cert) {
+			continue
+		}
+		matchRank := 0
+		for domain := range cert.domains {
+			matchRank = max(matchRank, d.domainMatchRank(domain, host))
+		}
+		if matchRank == 0 {
+			continue
+		}
+		if bestCert == nil || matchRank > bestMatchRank ||
+			(matchRank == bestMatchRank && cert.notBefore.After(bestCert.notBefore)) {
+			bestCert = cert
+			bestMatchRank = matchRank
+		}
+	}
+	return bestCert
+}
+
+// isCertificateValid checks whether cert is an issued, unexpired and unrevoked certificate matching the filters, which isn't imported from a TLS secret.
+func (d *acmCertDiscovery) isCertificateValid(cert *certDetail) bool {
+	if cert.status != acm.CertificateStatusIssued || cert.revoked {
+		return false
+	}
+	if !cert.notAfter.IsZero() && !d.clock().Before(cert.notAfter) {
+		return false
+	}
+	if len(d.filters.AllowedCAARNs) != 0 && !slices.Contains(d.filters.AllowedCAARNs, cert.caARN) {
+		return false
+	}
+	if len(d.filters.KeyAlgorithms) != 0 && !slices.Contains(d.filters.KeyAlgorithms, cert.keyAlgorithm) {
+		return false
+	}
+	// certificates imported from TLS secrets are owned by the IngressGroup they're imported for, and deleted once it no longer uses them.
+	if _, ok := cert.tags[tlsSecretHashTagKey]; ok {
+		return false
+	}
+	for tagKey, tagValue := range d.filters.Tags {
+		if value, ok := cert.tags[tagKey]; !ok || value != tagValue {
+			return false
+		}
+	}
+	return true
+}
+
+// loadAllCertificates loads the details of all certificates, the cached certARNs are refreshed if forceRefresh.
+// the results are sorted by certARN.
+func (d *acmCertDiscovery) loadAllCertificates(ctx context.Context, forceRefresh bool) ([]certDetail, error) {
+	d.loadCertsMutex.Lock()
+	defer d.loadCertsMutex.Unlock()
 
+	if forceRefresh && d.clock().Sub(d.certARNsLastRefreshTime) >= d.certARNsMinRefreshInterval {
+		d.certARNsCache.Delete(certARNsCacheKey)
+	}
 	certARNs, err := d.loadAllCertificateARNs(ctx)
 	if err != nil {
 		return nil, err
 	}
-	domainsByCertARN := make(map[string]sets.String, len(certARNs))
+	certs := make([]certDetail, 0, len(certARNs))
 	for _, certARN := range certARNs {
-		certDomains, err := d.loadDomainsForCertificate(ctx, certARN)
+		cert, err := d.loadCertificateDetail(ctx, certARN)
 		if err != nil {
 			return nil, err
 		}
-		if len(certDomains) > 0 {
-			domainsByCertARN[certARN] = certDomains
+		if cert != nil {
+			certs = append(certs, *cert)
 		}
-
 	}
-	return domainsByCertARN, nil
+	sort.Slice(certs, func(i, j int) bool {
+		return certs[i].certARN < certs[j].certARN
+	})
+	return certs, nil
 }
 
 func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string, error) {
 	if rawCacheItem, ok := d.certARNsCache.Get(certARNsCacheKey); ok {
 		return rawCacheItem.([]string), nil
 	}
+	keyTypes := d.filters.KeyAlgorithms
+	if len(keyTypes) == 0 {
+		keyTypes = acm.KeyAlgorithm_Values()
+	}
 	req := &acm.ListCertificatesInput{
 		CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued}),
 		Includes: &acm.Filters{
-			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+			KeyTypes: aws.StringSlice(keyTypes),
 		},
 	}
 	certSummaries, err := d.acmClient.ListCertificatesAsList(ctx, req)
@@ -136,35 +246,82 @@ func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string
 		certARNs = append(certARNs, certARN)
 	}
 	d.certARNsCache.Set(certARNsCacheKey, certARNs, d.certARNsCacheTTL)
+	d.certARNsLastRefreshTime = d.clock()
 	return certARNs, nil
 }
 
-func (d *acmCertDiscovery) loadDomainsForCertificate(ctx context.Context, certARN string) (sets.String, error) {
-	if rawCacheItem, ok := d.certDomainsCache.Get(certARN); ok {
-		return rawCacheItem.(sets.String), nil
+// loadCertificateDetail loads the detail of certificate, returns nil if the certificate no longer exists.
+func (d *acmCertDiscovery) loadCertificateDetail(ctx context.Context, certARN string) (*certDetail, error) {
+	if rawCacheItem, ok := d.certDetailsCache.Get(certARN); ok {
+		return rawCacheItem.(*certDetail), nil
 	}
 	req := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(certARN),
 	}
 	resp, err := d.acmClient.DescribeCertificateWithContext(ctx, req)
 	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == acm.ErrCodeResourceNotFoundException {
+			// the certificate is deleted after certARNs are cached, they'll be refreshed on next load.
+			d.certARNsCache.Delete(certARNsCacheKey)
+			return nil, nil
+		}
 		return nil, err
 	}
-	certDetail := resp.Certificate
-
-	// check if cert is issued from an allowed CA
-	// otherwise empty-out the list of domains
-	domains := sets.String{}
-	if len(d.allowedCAARNs) == 0 || slices.Contains(d.allowedCAARNs, awssdk.StringValue(certDetail.CertificateAuthorityArn)) {
-		domains = sets.NewString(aws.StringValueSlice(certDetail.SubjectAlternativeNames)...)
+	sdkCert := resp.Certificate
+	cert := &certDetail{
+		certARN:      certARN,
+		domains:      sets.NewString(aws.StringValueSlice(sdkCert.SubjectAlternativeNames)...),
+		status:       awssdk.StringValue(sdkCert.Status),
+		keyAlgorithm: awssdk.StringValue(sdkCert.KeyAlgorithm),
+		caARN:        awssdk.StringValue(sdkCert.CertificateAuthorityArn),
+		revoked:      sdkCert.RevokedAt != nil,
+		notBefore:    awssdk.TimeValue(sdkCert.NotBefore),
+		notAfter:     awssdk.TimeValue(sdkCert.NotAfter),
 	}
-	switch aws.StringValue(certDetail.Type) {
+	// the tags of imported certificates are needed to tell apart the ones imported from TLS secrets.
+	if len(d.filters.Tags) != 0 || (d.filters.ExcludeTLSSecretCerts && aws.StringValue(sdkCert.Type) == acm.CertificateTypeImported) {
+		tagsResp, err := d.acmClient.ListTagsForCertificateWithContext(ctx, &acm.ListTagsForCertificateInput{
+			CertificateArn: aws.String(certARN),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cert.tags = make(map[string]string, len(tagsResp.Tags))
+		for _, tag := range tagsResp.Tags {
+			cert.tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+	}
+
+	var cacheTTL time.Duration
+	switch aws.StringValue(sdkCert.Type) {
 	case acm.CertificateTypeImported:
-		d.certDomainsCache.Set(certARN, domains, d.importedCertDomainsCacheTTL)
+		cacheTTL = d.importedCertDetailsCacheTTL
 	case acm.CertificateTypeAmazonIssued, acm.CertificateTypePrivate:
-		d.certDomainsCache.Set(certARN, domains, d.privateCertDomainsCacheTTL)
+		cacheTTL = d.privateCertDetailsCacheTTL
+	default:
+		return cert, nil
+	}
+	// the detail is refreshed once the certificate expires, since it's expected to be renewed by then.
+	if !cert.notAfter.IsZero() {
+		if untilExpiry := cert.notAfter.Sub(d.clock()); untilExpiry > 0 && untilExpiry < cacheTTL {
+			cacheTTL = untilExpiry
+		}
+	}
+	d.certDetailsCache.Set(certARN, cert, cacheTTL)
+	return cert, nil
+}
+
+// domainMatchRank ranks how domainName matches tlsHost, exact matches are ranked 2 and wildcard matches are ranked 1.
+// it returns 0 if domainName doesn't match tlsHost.
+func (d *acmCertDiscovery) domainMatchRank(domainName string, tlsHost string) int {
+	if domainName == tlsHost {
+		return 2
+	}
+	if d.domainMatchesHost(domainName, tlsHost) {
+		return 1
 	}
-	return domains, nil
+	return 0
 }
 
 func (d *acmCertDiscovery) domainMatchesHost(domainName string, tlsHost string) bool {
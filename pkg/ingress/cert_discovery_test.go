@@ -1,8 +1,18 @@
 package ingress
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
 	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func Test_acmCertDiscovery_domainMatchesHost(t *testing.T) {
@@ -64,3 +74,240 @@ func Test_acmCertDiscovery_domainMatchesHost(t *testing.T) {
 		})
 	}
 }
+
+// fakeDiscoveryACM is an in-memory ACM with the APIs used by certificate discovery.
+type fakeDiscoveryACM struct {
+	services.ACM
+	certs     []*acm.CertificateDetail
+	tags      map[string]map[string]string
+	listCalls int
+}
+
+func (c *fakeDiscoveryACM) ListCertificatesAsList(_ context.Context, _ *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error) {
+	c.listCalls++
+	var result []*acm.CertificateSummary
+	for _, cert := range c.certs {
+		result = append(result, &acm.CertificateSummary{CertificateArn: cert.CertificateArn})
+	}
+	return result, nil
+}
+
+func (c *fakeDiscoveryACM) DescribeCertificateWithContext(_ context.Context, input *acm.DescribeCertificateInput, _ ...request.Option) (*acm.DescribeCertificateOutput, error) {
+	for _, cert := range c.certs {
+		if awssdk.StringValue(cert.CertificateArn) == awssdk.StringValue(input.CertificateArn) {
+			return &acm.DescribeCertificateOutput{Certificate: cert}, nil
+		}
+	}
+	return nil, awserr.New(acm.ErrCodeResourceNotFoundException, "certificate not found", nil)
+}
+
+func (c *fakeDiscoveryACM) ListTagsForCertificateWithContext(_ context.Context, input *acm.ListTagsForCertificateInput, _ ...request.Option) (*acm.ListTagsForCertificateOutput, error) {
+	var tags []*acm.Tag
+	for k, v := range c.tags[awssdk.StringValue(input.CertificateArn)] {
+		tags = append(tags, &acm.Tag{Key: awssdk.String(k), Value: awssdk.String(v)})
+	}
+	return &acm.ListTagsForCertificateOutput{Tags: tags}, nil
+}
+
+func Test_acmCertDiscovery_Discover(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCert := func(name string, sans []string, mutate func(cert *acm.CertificateDetail)) *acm.CertificateDetail {
+		cert := &acm.CertificateDetail{
+			CertificateArn:          awssdk.String("arn:aws:acm:us-west-2:123456789012:certificate/" + name),
+			SubjectAlternativeNames: awssdk.StringSlice(sans),
+			Status:                  awssdk.String(acm.CertificateStatusIssued),
+			Type:                    awssdk.String(acm.CertificateTypeAmazonIssued),
+			KeyAlgorithm:            awssdk.String(acm.KeyAlgorithmRsa2048),
+			NotBefore:               awssdk.Time(now.Add(-30 * 24 * time.Hour)),
+			NotAfter:                awssdk.Time(now.Add(365 * 24 * time.Hour)),
+		}
+		if mutate != nil {
+			mutate(cert)
+		}
+		return cert
+	}
+	tests := []struct {
+		name     string
+		certs    []*acm.CertificateDetail
+		tags     map[string]map[string]string
+		filters  CertDiscoveryFilters
+		tlsHosts []string
+		want     []string
+		wantErr  string
+	}{
+		{
+			name: "exact match is preferred over wildcard match",
+			certs: []*acm.CertificateDetail{
+				newCert("wildcard", []string{"*.example.com"}, nil),
+				newCert("exact", []string{"www.example.com"}, nil),
+			},
+			tlsHosts: []string{"www.example.com", "api.example.com"},
+			want: []string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/exact",
+				"arn:aws:acm:us-west-2:123456789012:certificate/wildcard",
+			},
+		},
+		{
+			name: "newest certificate is preferred among matches",
+			certs: []*acm.CertificateDetail{
+				newCert("older", []string{"www.example.com"}, nil),
+				newCert("newer", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.NotBefore = awssdk.Time(now.Add(-24 * time.Hour))
+				}),
+			},
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/newer"},
+		},
+		{
+			name: "expired, revoked and pending validation certificates are skipped",
+			certs: []*acm.CertificateDetail{
+				newCert("expired", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.NotBefore = awssdk.Time(now.Add(-24 * time.Hour))
+					cert.NotAfter = awssdk.Time(now.Add(-time.Hour))
+				}),
+				newCert("revoked", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.NotBefore = awssdk.Time(now.Add(-24 * time.Hour))
+					cert.RevokedAt = awssdk.Time(now.Add(-time.Hour))
+				}),
+				newCert("pending", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.NotBefore = nil
+					cert.NotAfter = nil
+					cert.Status = awssdk.String(acm.CertificateStatusPendingValidation)
+				}),
+				newCert("wildcard", []string{"*.example.com"}, nil),
+			},
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/wildcard"},
+		},
+		{
+			name: "certificates are filtered by CA, key algorithm and tags",
+			certs: []*acm.CertificateDetail{
+				newCert("other-ca", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.CertificateAuthorityArn = awssdk.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/other")
+				}),
+				newCert("ec", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.CertificateAuthorityArn = awssdk.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/ca")
+					cert.KeyAlgorithm = awssdk.String(acm.KeyAlgorithmEcPrime256v1)
+				}),
+				newCert("untagged", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.CertificateAuthorityArn = awssdk.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/ca")
+				}),
+				newCert("tagged", []string{"*.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.CertificateAuthorityArn = awssdk.String("arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/ca")
+				}),
+			},
+			tags: map[string]map[string]string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/tagged": {"team": "awesome"},
+			},
+			filters: CertDiscoveryFilters{
+				AllowedCAARNs: []string{"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/ca"},
+				KeyAlgorithms: []string{acm.KeyAlgorithmRsa2048},
+				Tags:          map[string]string{"team": "awesome"},
+			},
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/tagged"},
+		},
+		{
+			name: "certificates imported from TLS secrets are skipped",
+			certs: []*acm.CertificateDetail{
+				newCert("from-tls-secret", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.Type = awssdk.String(acm.CertificateTypeImported)
+				}),
+				newCert("imported", []string{"*.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.Type = awssdk.String(acm.CertificateTypeImported)
+				}),
+			},
+			tags: map[string]map[string]string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/from-tls-secret": {
+					"ingress.k8s.aws/stack":           "awesome-group",
+					"ingress.k8s.aws/tls-secret-hash": "hash",
+				},
+			},
+			filters: CertDiscoveryFilters{
+				ExcludeTLSSecretCerts: true,
+			},
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/imported"},
+		},
+		{
+			name: "certificates imported from TLS secrets aren't told apart without TLS secrets import",
+			certs: []*acm.CertificateDetail{
+				newCert("from-tls-secret", []string{"www.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.Type = awssdk.String(acm.CertificateTypeImported)
+				}),
+				newCert("imported", []string{"*.example.com"}, func(cert *acm.CertificateDetail) {
+					cert.Type = awssdk.String(acm.CertificateTypeImported)
+				}),
+			},
+			tags: map[string]map[string]string{
+				"arn:aws:acm:us-west-2:123456789012:certificate/from-tls-secret": {
+					"ingress.k8s.aws/stack":           "awesome-group",
+					"ingress.k8s.aws/tls-secret-hash": "hash",
+				},
+			},
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/from-tls-secret"},
+		},
+		{
+			name: "no certificate found for host",
+			certs: []*acm.CertificateDetail{
+				newCert("other", []string{"www.other.com"}, nil),
+			},
+			tlsHosts: []string{"www.example.com"},
+			wantErr:  "no certificate found for host: www.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewACMCertDiscovery(&fakeDiscoveryACM{certs: tt.certs, tags: tt.tags}, tt.filters, logr.New(&log.NullLogSink{}))
+			d.clock = func() time.Time { return now }
+			got, err := d.Discover(context.Background(), tt.tlsHosts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_acmCertDiscovery_Discover_cacheInvalidation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	acmClient := &fakeDiscoveryACM{
+		certs: []*acm.CertificateDetail{
+			{
+				CertificateArn:          awssdk.String("arn:aws:acm:us-west-2:123456789012:certificate/www"),
+				SubjectAlternativeNames: awssdk.StringSlice([]string{"www.example.com"}),
+				Status:                  awssdk.String(acm.CertificateStatusIssued),
+				Type:                    awssdk.String(acm.CertificateTypeAmazonIssued),
+			},
+		},
+	}
+	d := NewACMCertDiscovery(acmClient, CertDiscoveryFilters{}, logr.New(&log.NullLogSink{}))
+	d.clock = func() time.Time { return now }
+
+	// certificates are listed once and cached afterwards.
+	for i := 0; i < 2; i++ {
+		got, err := d.Discover(context.Background(), []string{"www.example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"arn:aws:acm:us-west-2:123456789012:certificate/www"}, got)
+	}
+	assert.Equal(t, 1, acmClient.listCalls)
+
+	// certificates are refreshed when no certificate is found for host, no more than once per refresh interval.
+	acmClient.certs = append(acmClient.certs, &acm.CertificateDetail{
+		CertificateArn:          awssdk.String("arn:aws:acm:us-west-2:123456789012:certificate/api"),
+		SubjectAlternativeNames: awssdk.StringSlice([]string{"api.example.com"}),
+		Status:                  awssdk.String(acm.CertificateStatusIssued),
+		Type:                    awssdk.String(acm.CertificateTypeAmazonIssued),
+	})
+	_, err := d.Discover(context.Background(), []string{"api.example.com"})
+	assert.EqualError(t, err, "no certificate found for host: api.example.com")
+	assert.Equal(t, 1, acmClient.listCalls)
+	now = now.Add(defaultCertARNsMinRefreshInterval)
+	got, err := d.Discover(context.Background(), []string{"api.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:acm:us-west-2:123456789012:certificate/api"}, got)
+	assert.Equal(t, 2, acmClient.listCalls)
+}
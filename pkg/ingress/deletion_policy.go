@@ -0,0 +1,50 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// BuildDeletionPolicy builds the deletion policy for LoadBalancer resources of ingGroup once the IngressGroup is deleted.
+// there are no members left in a deleted IngressGroup, so the policy is built from its inactive members.
+// the IngressClassParams of an Ingress takes priority over its annotation, and it's an error if Ingresses specify different policies.
+func BuildDeletionPolicy(ctx context.Context, annotationParser annotations.Parser, classLoader ClassLoader, ingGroup Group) (elbv2api.DeletionPolicy, error) {
+	explicitPolicies := sets.NewString()
+	for _, ing := range ingGroup.InactiveMembers {
+		classConfig, err := classLoader.Load(ctx, ing)
+		if err != nil {
+			// the IngressClass might be deleted along with the Ingress, the annotation still applies in that case.
+			if !errors.Is(err, ErrInvalidIngressClass) {
+				return "", err
+			}
+		}
+		if classConfig.IngClassParams != nil && classConfig.IngClassParams.Spec.DeletionPolicy != nil {
+			explicitPolicies.Insert(string(*classConfig.IngClassParams.Spec.DeletionPolicy))
+			continue
+		}
+		rawPolicy := ""
+		if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixDeletionPolicy, &rawPolicy, ing.Annotations); !exists {
+			continue
+		}
+		switch elbv2api.DeletionPolicy(rawPolicy) {
+		case elbv2api.DeletionPolicyDelete, elbv2api.DeletionPolicyRetain, elbv2api.DeletionPolicyOrphan:
+			explicitPolicies.Insert(rawPolicy)
+		default:
+			return "", errors.Errorf("unknown deletion policy %v, ingress: %v", rawPolicy, k8s.NamespacedName(ing))
+		}
+	}
+
+	if len(explicitPolicies) == 0 {
+		return elbv2api.DeletionPolicyDelete, nil
+	}
+	if len(explicitPolicies) > 1 {
+		return "", errors.Errorf("conflicting deletion policy: %v", explicitPolicies.List())
+	}
+	rawPolicy, _ := explicitPolicies.PopAny()
+	return elbv2api.DeletionPolicy(rawPolicy), nil
+}
@@ -0,0 +1,130 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_BuildDeletionPolicy(t *testing.T) {
+	deletionPolicyOrphan := elbv2api.DeletionPolicyOrphan
+	ingClassWithParams := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "class-with-params",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: IngressClassControllerALB,
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String(elbv2api.GroupVersion.Group),
+				Kind:     ingressClassParamsKind,
+				Name:     "params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			DeletionPolicy: &deletionPolicyOrphan,
+		},
+	}
+	newIngress := func(name string, ingClassName *string, policy string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns-1",
+				Name:        name,
+				Annotations: map[string]string{},
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: ingClassName,
+			},
+		}
+		if policy != "" {
+			ing.Annotations["alb.ingress.kubernetes.io/deletion-policy"] = policy
+		}
+		return ing
+	}
+	tests := []struct {
+		name            string
+		inactiveMembers []*networking.Ingress
+		want            elbv2api.DeletionPolicy
+		wantErr         error
+	}{
+		{
+			name: "deletion policy not specified",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", nil, ""),
+			},
+			want: elbv2api.DeletionPolicyDelete,
+		},
+		{
+			name: "deletion policy specified by annotation",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", nil, "Retain"),
+				newIngress("ing-2", nil, ""),
+			},
+			want: elbv2api.DeletionPolicyRetain,
+		},
+		{
+			name: "deletion policy specified by IngressClassParams takes priority",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", awssdk.String("class-with-params"), "Retain"),
+			},
+			want: elbv2api.DeletionPolicyOrphan,
+		},
+		{
+			name: "annotation applies if IngressClass is deleted",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", awssdk.String("deleted-class"), "Retain"),
+			},
+			want: elbv2api.DeletionPolicyRetain,
+		},
+		{
+			name: "unknown deletion policy",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", nil, "Keep"),
+			},
+			wantErr: errors.New("unknown deletion policy Keep, ingress: ns-1/ing-1"),
+		},
+		{
+			name: "conflicting deletion policy",
+			inactiveMembers: []*networking.Ingress{
+				newIngress("ing-1", nil, "Retain"),
+				newIngress("ing-2", awssdk.String("class-with-params"), ""),
+			},
+			wantErr: errors.New("conflicting deletion policy: [Orphan Retain]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(ingClassWithParams, ingClassParams).Build()
+			classLoader := NewDefaultClassLoader(k8sClient, true)
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			ingGroup := Group{
+				ID:              GroupID{Namespace: "", Name: "awesome-group"},
+				InactiveMembers: tt.inactiveMembers,
+			}
+			got, err := BuildDeletionPolicy(context.Background(), annotationParser, classLoader, ingGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package ingress
+
+import (
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+// IsDriftRemediationEnabled checks whether drift of the LoadBalancer resources of ingGroup should be remediated.
+// drift is remediated if the IngressClassParams of any member Ingress enables it, inactive members are not considered.
+func IsDriftRemediationEnabled(ingGroup Group) bool {
+	for _, member := range ingGroup.Members {
+		ingClassParams := member.IngClassConfig.IngClassParams
+		if ingClassParams == nil || ingClassParams.Spec.DriftRemediation == nil {
+			continue
+		}
+		if *ingClassParams.Spec.DriftRemediation == elbv2api.DriftRemediationAuto {
+			return true
+		}
+	}
+	return false
+}
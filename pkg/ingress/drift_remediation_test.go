@@ -0,0 +1,82 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_IsDriftRemediationEnabled(t *testing.T) {
+	newMember := func(name string, driftRemediation *elbv2api.DriftRemediation) ClassifiedIngress {
+		member := ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      name,
+				},
+			},
+		}
+		if driftRemediation != nil {
+			member.IngClassConfig.IngClassParams = &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DriftRemediation: driftRemediation,
+				},
+			}
+		}
+		return member
+	}
+	driftRemediationNone := elbv2api.DriftRemediationNone
+	driftRemediationAuto := elbv2api.DriftRemediationAuto
+	tests := []struct {
+		name            string
+		members         []ClassifiedIngress
+		inactiveMembers []*networking.Ingress
+		want            bool
+	}{
+		{
+			name: "members without IngressClassParams",
+			members: []ClassifiedIngress{
+				newMember("ing-1", nil),
+			},
+			want: false,
+		},
+		{
+			name: "members with drift remediation None",
+			members: []ClassifiedIngress{
+				newMember("ing-1", nil),
+				newMember("ing-2", &driftRemediationNone),
+			},
+			want: false,
+		},
+		{
+			name: "drift remediation Auto by one member",
+			members: []ClassifiedIngress{
+				newMember("ing-1", &driftRemediationNone),
+				newMember("ing-2", &driftRemediationAuto),
+			},
+			want: true,
+		},
+		{
+			name:    "group without members",
+			members: nil,
+			inactiveMembers: []*networking.Ingress{
+				newMember("ing-1", nil).Ing,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingGroup := Group{
+				ID:              GroupID{Namespace: "ns-1", Name: "ing-1"},
+				Members:         tt.members,
+				InactiveMembers: tt.inactiveMembers,
+			}
+			got := IsDriftRemediationEnabled(ingGroup)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package ingress
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// DryRunConfig is the plan-only configuration of an IngressGroup.
+type DryRunConfig struct {
+	// Enabled is whether the IngressGroup is in plan-only mode.
+	// changes to the LoadBalancer resources are only planned but not applied when enabled.
+	Enabled bool
+
+	// PlanConfigMaps are the ConfigMaps to write the plan into.
+	PlanConfigMaps []types.NamespacedName
+}
+
+// BuildDryRunConfig builds the plan-only configuration of ingGroup from annotations of its members.
+// the whole IngressGroup is in plan-only mode if any member Ingress enables it, inactive or deleting members are not considered.
+func BuildDryRunConfig(annotationParser annotations.Parser, ingGroup Group) (DryRunConfig, error) {
+	var cfg DryRunConfig
+	for _, member := range ingGroup.Members {
+		if !member.Ing.DeletionTimestamp.IsZero() {
+			continue
+		}
+		dryRun := false
+		if _, err := annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, member.Ing.Annotations); err != nil {
+			return DryRunConfig{}, errors.Wrapf(err, "failed to parse dry-run annotation of ingress: %v", k8s.NamespacedName(member.Ing))
+		}
+		if !dryRun {
+			continue
+		}
+		cfg.Enabled = true
+		var cmName string
+		if annotationParser.ParseStringAnnotation(annotations.IngressSuffixDryRunConfigMap, &cmName, member.Ing.Annotations) && cmName != "" {
+			cfg.PlanConfigMaps = append(cfg.PlanConfigMaps, types.NamespacedName{
+				Namespace: member.Ing.Namespace,
+				Name:      cmName,
+			})
+		}
+	}
+	return cfg, nil
+}
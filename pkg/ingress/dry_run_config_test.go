@@ -0,0 +1,121 @@
+package ingress
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_BuildDryRunConfig(t *testing.T) {
+	newMember := func(namespace string, name string, ingAnnotations map[string]string) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   namespace,
+					Name:        name,
+					Annotations: ingAnnotations,
+				},
+			},
+		}
+	}
+	deletionTimestamp := metav1.Now()
+	tests := []struct {
+		name    string
+		members []ClassifiedIngress
+		want    DryRunConfig
+		wantErr error
+	}{
+		{
+			name: "dry-run not enabled",
+			members: []ClassifiedIngress{
+				newMember("ns-1", "ing-1", nil),
+				newMember("ns-1", "ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/dry-run":           "false",
+					"alb.ingress.kubernetes.io/dry-run-configmap": "plan",
+				}),
+			},
+			want: DryRunConfig{},
+		},
+		{
+			name: "dry-run enabled by one member",
+			members: []ClassifiedIngress{
+				newMember("ns-1", "ing-1", nil),
+				newMember("ns-1", "ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/dry-run": "true",
+				}),
+			},
+			want: DryRunConfig{
+				Enabled: true,
+			},
+		},
+		{
+			name: "dry-run enabled with configmaps",
+			members: []ClassifiedIngress{
+				newMember("ns-1", "ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/dry-run":           "true",
+					"alb.ingress.kubernetes.io/dry-run-configmap": "plan-1",
+				}),
+				newMember("ns-2", "ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/dry-run":           "true",
+					"alb.ingress.kubernetes.io/dry-run-configmap": "plan-2",
+				}),
+			},
+			want: DryRunConfig{
+				Enabled: true,
+				PlanConfigMaps: []types.NamespacedName{
+					{Namespace: "ns-1", Name: "plan-1"},
+					{Namespace: "ns-2", Name: "plan-2"},
+				},
+			},
+		},
+		{
+			name: "dry-run enabled by deleting member only",
+			members: []ClassifiedIngress{
+				newMember("ns-1", "ing-1", nil),
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:         "ns-1",
+							Name:              "ing-2",
+							DeletionTimestamp: &deletionTimestamp,
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/dry-run": "true",
+							},
+						},
+					},
+				},
+			},
+			want: DryRunConfig{},
+		},
+		{
+			name: "invalid dry-run annotation",
+			members: []ClassifiedIngress{
+				newMember("ns-1", "ing-1", map[string]string{
+					"alb.ingress.kubernetes.io/dry-run": "yes",
+				}),
+			},
+			wantErr: errors.New("failed to parse dry-run annotation of ingress: ns-1/ing-1: failed to parse bool annotation, alb.ingress.kubernetes.io/dry-run: yes: strconv.ParseBool: parsing \"yes\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			ingGroup := Group{
+				ID:      GroupID{Name: "awesome-group"},
+				Members: tt.members,
+			}
+			got, err := BuildDryRunConfig(annotationParser, ingGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
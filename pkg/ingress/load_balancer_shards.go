@@ -0,0 +1,238 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// maxListenerRuleConditionValues is the maximum count of condition values per listener rule allowed by ELBV2.
+	maxListenerRuleConditionValues = 5
+)
+
+// ListenerRulesLimitExceededError is returned when listener rules of IngressGroup cannot fit into the LoadBalancer.
+type ListenerRulesLimitExceededError struct {
+	// Limit is the maximum count of listener rules per LoadBalancer.
+	Limit int
+	// Ingresses are the members that push IngressGroup over the limit.
+	Ingresses []types.NamespacedName
+}
+
+func (e *ListenerRulesLimitExceededError) Error() string {
+	ingKeys := make([]string, 0, len(e.Ingresses))
+	for _, ingKey := range e.Ingresses {
+		ingKeys = append(ingKeys, ingKey.String())
+	}
+	return fmt.Sprintf("listener rules exceed the limit of %v per load balancer, ingresses over the limit: %v",
+		e.Limit, strings.Join(ingKeys, ", "))
+}
+
+// buildLoadBalancerShards partitions members of IngressGroup into shards, each shard is served by its own LoadBalancer.
+// Only a single shard is built unless overflow LoadBalancers are enabled for the IngressGroup, in which case:
+//   - members stay in the shard of the LoadBalancer they're deployed to, as long as the listener rules of that shard are within the limit.
+//   - other members are assigned in order into the first shard with room for their listener rules, or a new shard.
+//
+// shards keep their index, so a shard is empty if all its members are gone.
+func (t *defaultModelBuildTask) buildLoadBalancerShards(ctx context.Context,
+	listenPortConfigByPortByIngress map[types.NamespacedName]map[int64]listenPortConfig) ([][]ClassifiedIngress, error) {
+	if t.listenerRulesLimit <= 0 {
+		return [][]ClassifiedIngress{t.ingGroup.Members}, nil
+	}
+	overflowEnabled, err := t.buildOverflowLoadBalancerEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleCountByIngress := make(map[types.NamespacedName]int, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		ruleCount := t.countIngressListenerRules(member, listenPortConfigByPortByIngress[ingKey])
+		if ruleCount > t.listenerRulesLimit {
+			return nil, &ListenerRulesLimitExceededError{
+				Limit:     t.listenerRulesLimit,
+				Ingresses: []types.NamespacedName{ingKey},
+			}
+		}
+		ruleCountByIngress[ingKey] = ruleCount
+	}
+
+	if !overflowEnabled {
+		var shardMembers []ClassifiedIngress
+		var ingsOverLimit []types.NamespacedName
+		shardRuleCount := 0
+		for _, member := range t.ingGroup.Members {
+			ingKey := k8s.NamespacedName(member.Ing)
+			if shardRuleCount+ruleCountByIngress[ingKey] > t.listenerRulesLimit {
+				ingsOverLimit = append(ingsOverLimit, ingKey)
+				continue
+			}
+			shardMembers = append(shardMembers, member)
+			shardRuleCount += ruleCountByIngress[ingKey]
+		}
+		if len(ingsOverLimit) != 0 {
+			return nil, &ListenerRulesLimitExceededError{
+				Limit:     t.listenerRulesLimit,
+				Ingresses: ingsOverLimit,
+			}
+		}
+		return [][]ClassifiedIngress{shardMembers}, nil
+	}
+
+	deployedShardIndexByIngress, err := t.buildDeployedShardIndexByIngress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var shards [][]ClassifiedIngress
+	var shardRuleCounts []int
+	assignToShard := func(member ClassifiedIngress, shardIndex int) {
+		for len(shards) <= shardIndex {
+			shards = append(shards, nil)
+			shardRuleCounts = append(shardRuleCounts, 0)
+		}
+		shards[shardIndex] = append(shards[shardIndex], member)
+		shardRuleCounts[shardIndex] += ruleCountByIngress[k8s.NamespacedName(member.Ing)]
+	}
+	var unassignedMembers []ClassifiedIngress
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		shardIndex, deployed := deployedShardIndexByIngress[ingKey]
+		if !deployed || (shardIndex < len(shards) && shardRuleCounts[shardIndex]+ruleCountByIngress[ingKey] > t.listenerRulesLimit) {
+			unassignedMembers = append(unassignedMembers, member)
+			continue
+		}
+		assignToShard(member, shardIndex)
+	}
+	for _, member := range unassignedMembers {
+		ruleCount := ruleCountByIngress[k8s.NamespacedName(member.Ing)]
+		shardIndex := 0
+		for shardIndex < len(shards) && shardRuleCounts[shardIndex]+ruleCount > t.listenerRulesLimit {
+			shardIndex++
+		}
+		assignToShard(member, shardIndex)
+	}
+	return shards, nil
+}
+
+// buildDeployedShardIndexByIngress builds the shard of the LoadBalancer that each member of IngressGroup is deployed to.
+// the LoadBalancer of a member is identified by the hostname in its status, and its shard by the resourceID tag of the LoadBalancer.
+func (t *defaultModelBuildTask) buildDeployedShardIndexByIngress(ctx context.Context) (map[types.NamespacedName]int, error) {
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	shardIndexByDNSName := make(map[string]int, len(sdkLBs))
+	for _, sdkLB := range sdkLBs {
+		shardIndex, ok := parseShardIndex(sdkLB.Tags[t.trackingProvider.ResourceIDTagKey()])
+		if !ok {
+			continue
+		}
+		shardIndexByDNSName[awssdk.StringValue(sdkLB.LoadBalancer.DNSName)] = shardIndex
+	}
+
+	shardIndexByIngress := make(map[types.NamespacedName]int, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		for _, lbIngress := range member.Ing.Status.LoadBalancer.Ingress {
+			if shardIndex, exists := shardIndexByDNSName[lbIngress.Hostname]; exists {
+				shardIndexByIngress[k8s.NamespacedName(member.Ing)] = shardIndex
+				break
+			}
+		}
+	}
+	return shardIndexByIngress, nil
+}
+
+// buildOverflowLoadBalancerEnabled checks whether members of IngressGroup can spill into overflow LoadBalancers.
+func (t *defaultModelBuildTask) buildOverflowLoadBalancerEnabled(_ context.Context) (bool, error) {
+	explicitOverflowEnabled := make(map[bool]struct{})
+	for _, member := range t.ingGroup.Members {
+		var rawOverflowEnabled bool
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixOverflowLoadBalancer, &rawOverflowEnabled, member.Ing.Annotations)
+		if err != nil {
+			return false, errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(member.Ing))
+		}
+		if exists {
+			explicitOverflowEnabled[rawOverflowEnabled] = struct{}{}
+		}
+	}
+	if len(explicitOverflowEnabled) > 1 {
+		return false, errors.New("conflicting overflow load balancer settings")
+	}
+	_, enabled := explicitOverflowEnabled[true]
+	return enabled, nil
+}
+
+// countIngressListenerRules counts the listener rules that will be created for ing, an upper bound before rules are optimized.
+// HTTP listeners don't have rules when SSLRedirect is enabled.
+func (t *defaultModelBuildTask) countIngressListenerRules(ing ClassifiedIngress, listenPortConfigByPort map[int64]listenPortConfig) int {
+	pathCount := 0
+	for _, rule := range ing.Ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		pathCount += len(rule.HTTP.Paths)
+	}
+	ruleCount := 0
+	for _, cfg := range listenPortConfigByPort {
+		if t.sslRedirectConfig != nil && cfg.protocol == elbv2model.ProtocolHTTP {
+			continue
+		}
+		ruleCount += pathCount
+	}
+	return ruleCount
+}
+
+// parseShardIndex parses the shard from the resourceID of a LoadBalancer built by buildShardResourceID.
+func parseShardIndex(lbResID string) (int, bool) {
+	if lbResID == resourceIDLoadBalancer {
+		return 0, true
+	}
+	var shardIndex int
+	if _, err := fmt.Sscanf(lbResID, "overflow-%d/"+resourceIDLoadBalancer, &shardIndex); err != nil || shardIndex <= 0 {
+		return 0, false
+	}
+	return shardIndex, true
+}
+
+// buildShardResourceID builds the resource ID of resource that belongs to the LoadBalancer of current shard.
+// resources of the primary LoadBalancer keep their resource ID, while resources of overflow LoadBalancers are prefixed by their shard.
+func (t *defaultModelBuildTask) buildShardResourceID(resID string) string {
+	if t.shardIndex == 0 {
+		return resID
+	}
+	return fmt.Sprintf("overflow-%v/%v", t.shardIndex, resID)
+}
+
+// validateRuleConditionValues validates the count of condition values of listener rule is within the limit of ELBV2.
+func validateRuleConditionValues(conditions []elbv2model.RuleCondition) error {
+	valueCount := 0
+	for _, condition := range conditions {
+		switch {
+		case condition.HostHeaderConfig != nil:
+			valueCount += len(condition.HostHeaderConfig.Values)
+		case condition.HTTPHeaderConfig != nil:
+			valueCount += len(condition.HTTPHeaderConfig.Values)
+		case condition.HTTPRequestMethodConfig != nil:
+			valueCount += len(condition.HTTPRequestMethodConfig.Values)
+		case condition.PathPatternConfig != nil:
+			valueCount += len(condition.PathPatternConfig.Values)
+		case condition.QueryStringConfig != nil:
+			valueCount += len(condition.QueryStringConfig.Values)
+		case condition.SourceIPConfig != nil:
+			valueCount += len(condition.SourceIPConfig.Values)
+		}
+	}
+	if valueCount > maxListenerRuleConditionValues {
+		return errors.Errorf("listener rule has %v condition values, exceeds the limit of %v", valueCount, maxListenerRuleConditionValues)
+	}
+	return nil
+}
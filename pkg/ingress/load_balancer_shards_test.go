@@ -0,0 +1,323 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildLoadBalancerShards(t *testing.T) {
+	newIngress := func(name string, pathCount int, annotations map[string]string) ClassifiedIngress {
+		var paths []networking.HTTPIngressPath
+		for i := 0; i < pathCount; i++ {
+			paths = append(paths, networking.HTTPIngressPath{Path: fmt.Sprintf("/path-%v", i)})
+		}
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        name,
+					Annotations: annotations,
+				},
+				Spec: networking.IngressSpec{
+					Rules: []networking.IngressRule{
+						{
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{Paths: paths},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	overflowEnabled := map[string]string{"alb.ingress.kubernetes.io/overflow-load-balancer": "true"}
+	ing1 := newIngress("ing-1", 3, overflowEnabled)
+	ing2 := newIngress("ing-2", 4, nil)
+	ing3 := newIngress("ing-3", 2, nil)
+	ing4 := newIngress("ing-4", 6, nil)
+	ing2WithOverflowDisabled := newIngress("ing-2", 4, map[string]string{"alb.ingress.kubernetes.io/overflow-load-balancer": "false"})
+	ing5 := newIngress("ing-5", 5, nil)
+	deployedTo := func(member ClassifiedIngress, lbDNS string) ClassifiedIngress {
+		ing := member.Ing.DeepCopy()
+		ing.Status.LoadBalancer.Ingress = []networking.IngressLoadBalancerIngress{{Hostname: lbDNS}}
+		return ClassifiedIngress{Ing: ing}
+	}
+	newSDKLB := func(lbDNS string, resID string) elbv2deploy.LoadBalancerWithTags {
+		return elbv2deploy.LoadBalancerWithTags{
+			LoadBalancer: &elbv2sdk.LoadBalancer{DNSName: awssdk.String(lbDNS)},
+			Tags: map[string]string{
+				"elbv2.k8s.aws/cluster":    "cluster-name",
+				"ingress.k8s.aws/stack":    "awesome-group",
+				"ingress.k8s.aws/resource": resID,
+			},
+		}
+	}
+	deployedLBs := []elbv2deploy.LoadBalancerWithTags{
+		newSDKLB("lb-0.elb.amazonaws.com", "LoadBalancer"),
+		newSDKLB("lb-1.elb.amazonaws.com", "overflow-1/LoadBalancer"),
+	}
+	deployedIng1 := deployedTo(ing1, "lb-0.elb.amazonaws.com")
+	deployedIng2 := deployedTo(ing2, "lb-0.elb.amazonaws.com")
+	deployedIng4 := deployedTo(ing4, "lb-1.elb.amazonaws.com")
+	httpAndHTTPS := map[int64]listenPortConfig{
+		80:  {protocol: elbv2model.ProtocolHTTP},
+		443: {protocol: elbv2model.ProtocolHTTPS},
+	}
+	https := map[int64]listenPortConfig{
+		443: {protocol: elbv2model.ProtocolHTTPS},
+	}
+
+	tests := []struct {
+		name                            string
+		listenerRulesLimit              int
+		members                         []ClassifiedIngress
+		sslRedirectConfig               *SSLRedirectConfig
+		listenPortConfigByPortByIngress map[types.NamespacedName]map[int64]listenPortConfig
+		deployedLBs                     []elbv2deploy.LoadBalancerWithTags
+		want                            [][]ClassifiedIngress
+		wantErr                         error
+	}{
+		{
+			name:               "limit disabled",
+			listenerRulesLimit: 0,
+			members:            []ClassifiedIngress{ing2, ing3, ing4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing2.Ing): https,
+				k8s.NamespacedName(ing3.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			want: [][]ClassifiedIngress{{ing2, ing3, ing4}},
+		},
+		{
+			name:               "rules within limit",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing2, ing4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing2.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			want: [][]ClassifiedIngress{{ing2, ing4}},
+		},
+		{
+			name:               "rules over limit without overflow",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing2, ing3, ing4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing2.Ing): httpAndHTTPS,
+				k8s.NamespacedName(ing3.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			wantErr: &ListenerRulesLimitExceededError{
+				Limit:     10,
+				Ingresses: []types.NamespacedName{k8s.NamespacedName(ing4.Ing)},
+			},
+		},
+		{
+			name:               "rules of HTTP listeners are not counted with SSLRedirect",
+			listenerRulesLimit: 7,
+			members:            []ClassifiedIngress{ing2, ing3},
+			sslRedirectConfig:  &SSLRedirectConfig{SSLPort: 443},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing2.Ing): httpAndHTTPS,
+				k8s.NamespacedName(ing3.Ing): https,
+			},
+			want: [][]ClassifiedIngress{{ing2, ing3}},
+		},
+		{
+			name:               "rules over limit spill into overflow load balancers",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing1, ing2, ing3, ing4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing1.Ing): https,
+				k8s.NamespacedName(ing2.Ing): https,
+				k8s.NamespacedName(ing3.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			want: [][]ClassifiedIngress{{ing1, ing2, ing3}, {ing4}},
+		},
+		{
+			name:               "inserted ingress doesn't move deployed ingresses",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing5, deployedIng1, deployedIng2, deployedIng4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing5.Ing): https,
+				k8s.NamespacedName(ing1.Ing): https,
+				k8s.NamespacedName(ing2.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			deployedLBs: deployedLBs,
+			want:        [][]ClassifiedIngress{{deployedIng1, deployedIng2}, {deployedIng4}, {ing5}},
+		},
+		{
+			name:               "inserted ingress fills the first load balancer with room",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing3, deployedIng1, deployedIng2, deployedIng4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing3.Ing): https,
+				k8s.NamespacedName(ing1.Ing): https,
+				k8s.NamespacedName(ing2.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			deployedLBs: deployedLBs,
+			want:        [][]ClassifiedIngress{{deployedIng1, deployedIng2, ing3}, {deployedIng4}},
+		},
+		{
+			name:               "removed ingresses leave their load balancer empty",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{deployedTo(ing1, "lb-1.elb.amazonaws.com")},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing1.Ing): https,
+			},
+			deployedLBs: deployedLBs,
+			want:        [][]ClassifiedIngress{nil, {deployedTo(ing1, "lb-1.elb.amazonaws.com")}},
+		},
+		{
+			name:               "single ingress over limit",
+			listenerRulesLimit: 5,
+			members:            []ClassifiedIngress{ing1, ing4},
+			listenPortConfigByPortByIngress: map[types.NamespacedName]map[int64]listenPortConfig{
+				k8s.NamespacedName(ing1.Ing): https,
+				k8s.NamespacedName(ing4.Ing): https,
+			},
+			wantErr: &ListenerRulesLimitExceededError{
+				Limit:     5,
+				Ingresses: []types.NamespacedName{k8s.NamespacedName(ing4.Ing)},
+			},
+		},
+		{
+			name:               "conflicting overflow settings",
+			listenerRulesLimit: 10,
+			members:            []ClassifiedIngress{ing1, ing2WithOverflowDisabled},
+			wantErr:            fmt.Errorf("conflicting overflow load balancer settings"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(tt.deployedLBs, nil).AnyTimes()
+			task := &defaultModelBuildTask{
+				annotationParser:    annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				trackingProvider:    tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				elbv2TaggingManager: elbv2TaggingManager,
+				stack:               core.NewDefaultStack(core.StackID{Name: "awesome-group"}),
+				listenerRulesLimit:  tt.listenerRulesLimit,
+				sslRedirectConfig:   tt.sslRedirectConfig,
+				ingGroup:            Group{Members: tt.members},
+			}
+			got, err := task.buildLoadBalancerShards(context.Background(), tt.listenPortConfigByPortByIngress)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestListenerRulesLimitExceededError_Error(t *testing.T) {
+	err := &ListenerRulesLimitExceededError{
+		Limit: 100,
+		Ingresses: []types.NamespacedName{
+			{Namespace: "ns-1", Name: "ing-1"},
+			{Namespace: "ns-2", Name: "ing-2"},
+		},
+	}
+	assert.Equal(t, "listener rules exceed the limit of 100 per load balancer, ingresses over the limit: ns-1/ing-1, ns-2/ing-2", err.Error())
+}
+
+func Test_defaultModelBuildTask_buildShardResourceID(t *testing.T) {
+	tests := []struct {
+		name       string
+		shardIndex int
+		resID      string
+		want       string
+	}{
+		{
+			name:       "primary load balancer",
+			shardIndex: 0,
+			resID:      "LoadBalancer",
+			want:       "LoadBalancer",
+		},
+		{
+			name:       "overflow load balancer",
+			shardIndex: 2,
+			resID:      "443:0c0332b8728af1cc",
+			want:       "overflow-2/443:0c0332b8728af1cc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{shardIndex: tt.shardIndex}
+			assert.Equal(t, tt.want, task.buildShardResourceID(tt.resID))
+		})
+	}
+}
+
+func Test_validateRuleConditionValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []elbv2model.RuleCondition
+		wantErr    string
+	}{
+		{
+			name: "within limit",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field:            elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: []string{"a.example.com", "b.example.com"}},
+				},
+				{
+					Field:             elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/a", "/b", "/c"}},
+				},
+			},
+		},
+		{
+			name: "over limit",
+			conditions: []elbv2model.RuleCondition{
+				{
+					Field:            elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: []string{"a.example.com", "b.example.com"}},
+				},
+				{
+					Field:             elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/a", "/b", "/c"}},
+				},
+				{
+					Field:          elbv2model.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2model.SourceIPConditionConfig{Values: []string{"10.0.0.0/8"}},
+				},
+			},
+			wantErr: "listener rule has 6 condition values, exceeds the limit of 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleConditionValues(tt.conditions)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -26,7 +27,7 @@ func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.St
 	if err != nil {
 		return nil, err
 	}
-	lsResID := fmt.Sprintf("%v", port)
+	lsResID := t.buildShardResourceID(fmt.Sprintf("%v", port))
 	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
 	return ls, nil
 }
@@ -110,8 +111,11 @@ type listenPortConfig struct {
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *ClassifiedIngress) (map[int64]listenPortConfig, error) {
-	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing)
-	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
+	listenerConfigByPort, err := t.computeIngressListenerConfigurationByPort(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
+	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing, nil)
 	var prefixListIDs []string
 	t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroupPrefixLists, &prefixListIDs, ing.Ing.Annotations)
 	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing)
@@ -122,22 +126,36 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 	if err != nil {
 		return nil, err
 	}
-	preferTLS := len(explicitTLSCertARNs) != 0
-	listenPorts, err := t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
-	if err != nil {
-		return nil, err
+	var importedTLSCertARNs []string
+	importedTLSHosts := sets.NewString()
+	if len(explicitTLSCertARNs) == 0 {
+		importedTLSCertARNs, importedTLSHosts, err = t.computeIngressImportedTLSCertARNs(ctx, ing.Ing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var listenPorts map[int64]elbv2model.Protocol
+	if len(listenerConfigByPort) != 0 {
+		listenPorts = computeListenPortsFromListenerConfigurations(listenerConfigByPort)
+	} else {
+		preferTLS := len(explicitTLSCertARNs) != 0 || len(importedTLSCertARNs) != 0
+		listenPorts, err = t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	containsHTTPSPort := false
-	for _, protocol := range listenPorts {
-		if protocol == elbv2model.ProtocolHTTPS {
-			containsHTTPSPort = true
+	// certificates are inferred only if there is HTTPS port without explicit certificates.
+	requiresInferredTLSCerts := false
+	for port, protocol := range listenPorts {
+		if protocol == elbv2model.ProtocolHTTPS && len(t.computeIngressExplicitTLSCertARNs(ctx, ing, listenerConfigByPort[port])) == 0 {
+			requiresInferredTLSCerts = true
 			break
 		}
 	}
 	var inferredTLSCertARNs []string
-	if containsHTTPSPort && len(explicitTLSCertARNs) == 0 {
-		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, ing.Ing)
+	if requiresInferredTLSCerts {
+		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, ing.Ing, importedTLSHosts)
 		if err != nil {
 			return nil, err
 		}
@@ -152,12 +170,13 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 			prefixLists:    prefixListIDs,
 		}
 		if protocol == elbv2model.ProtocolHTTPS {
-			if len(explicitTLSCertARNs) == 0 {
-				cfg.tlsCerts = inferredTLSCertARNs
+			listenerConfig := listenerConfigByPort[port]
+			if portTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing, listenerConfig); len(portTLSCertARNs) == 0 {
+				cfg.tlsCerts = append(append([]string{}, importedTLSCertARNs...), inferredTLSCertARNs...)
 			} else {
-				cfg.tlsCerts = explicitTLSCertARNs
+				cfg.tlsCerts = portTLSCertARNs
 			}
-			cfg.sslPolicy = explicitSSLPolicy
+			cfg.sslPolicy = t.computeIngressExplicitSSLPolicy(ctx, ing, listenerConfig)
 			cfg.mutualAuthentication = mutualAuthenticationAttributes[port]
 		}
 		listenPortConfigByPort[port] = cfg
@@ -166,16 +185,57 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 	return listenPortConfigByPort, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *ClassifiedIngress) []string {
+// computeIngressExplicitTLSCertARNs computes the explicit certificates for Ingress, along with the listenerConfig from LoadBalancerConfiguration for the port if any.
+// the certificates in IngressClassParams take higher priority than the ones in listenerConfig, which take higher priority than the ones in annotation.
+func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *ClassifiedIngress, listenerConfig *elbv2api.ListenerConfiguration) []string {
 	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.CertificateArn) != 0 {
 		return ing.IngClassConfig.IngClassParams.Spec.CertificateArn
 	}
+	if listenerConfig != nil && len(listenerConfig.CertificateARNs) != 0 {
+		return listenerConfig.CertificateARNs
+	}
 	var rawTLSCertARNs []string
 	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
 	return rawTLSCertARNs
 }
 
-func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Context, ing *networking.Ingress) ([]string, error) {
+// computeIngressImportedTLSCertARNs imports the TLS secrets of Ingress TLS blocks into ACM when TLS secrets import is enabled.
+// it returns the imported certificate ARNs in the order of TLS blocks, along with the hosts covered by them.
+// TLS secrets are only looked up without being imported if the model is built only to be planned.
+func (t *defaultModelBuildTask) computeIngressImportedTLSCertARNs(ctx context.Context, ing *networking.Ingress) ([]string, sets.String, error) {
+	importedTLSHosts := sets.NewString()
+	if t.tlsSecretCertImporter == nil {
+		return nil, importedTLSHosts, nil
+	}
+	var importedTLSCertARNs []string
+	importedTLSCertARNSet := sets.NewString()
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.SecretName) == 0 {
+			continue
+		}
+		secretKey := types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName}
+		var certARN string
+		var err error
+		if t.planOnly {
+			certARN, err = t.tlsSecretCertImporter.Lookup(ctx, t.ingGroup.ID, secretKey)
+		} else {
+			certARN, err = t.tlsSecretCertImporter.Import(ctx, t.ingGroup.ID, secretKey)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		t.secretKeys = append(t.secretKeys, secretKey)
+		importedTLSHosts.Insert(tls.Hosts...)
+		if !importedTLSCertARNSet.Has(certARN) {
+			importedTLSCertARNSet.Insert(certARN)
+			importedTLSCertARNs = append(importedTLSCertARNs, certARN)
+		}
+	}
+	return importedTLSCertARNs, importedTLSHosts, nil
+}
+
+// computeIngressInferredTLSCertARNs discovers certificates for hosts of Ingress, except for the importedTLSHosts covered by imported TLS secrets.
+func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Context, ing *networking.Ingress, importedTLSHosts sets.String) ([]string, error) {
 	hosts := sets.NewString()
 	for _, r := range ing.Spec.Rules {
 		if len(r.Host) != 0 {
@@ -185,6 +245,12 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 	for _, t := range ing.Spec.TLS {
 		hosts.Insert(t.Hosts...)
 	}
+	if importedTLSHosts.Len() != 0 {
+		hosts = hosts.Difference(importedTLSHosts)
+		if hosts.Len() == 0 {
+			return nil, nil
+		}
+	}
 	return t.certDiscovery.Discover(ctx, hosts.List())
 }
 
@@ -225,6 +291,15 @@ func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing
 	return portAndProtocols, nil
 }
 
+// computeListenPortsFromListenerConfigurations computes the listen ports from listener settings in LoadBalancerConfiguration.
+func computeListenPortsFromListenerConfigurations(listenerConfigByPort map[int64]*elbv2api.ListenerConfiguration) map[int64]elbv2model.Protocol {
+	portAndProtocols := make(map[int64]elbv2model.Protocol, len(listenerConfigByPort))
+	for port, listenerConfig := range listenerConfigByPort {
+		portAndProtocols[port] = elbv2model.Protocol(*listenerConfig.Protocol)
+	}
+	return portAndProtocols
+}
+
 func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Context, ing *ClassifiedIngress) ([]string, []string, error) {
 	var rawInboundCIDRs []string
 	fromIngressClassParams := false
@@ -253,11 +328,16 @@ func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Con
 	return inboundCIDRv4s, inboundCIDRv6s, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Context, ing *ClassifiedIngress) *string {
+// computeIngressExplicitSSLPolicy computes the explicit SSL policy for Ingress, along with the listenerConfig from LoadBalancerConfiguration for the port if any.
+// the SSL policy in IngressClassParams takes higher priority than the one in listenerConfig, which takes higher priority than the one in annotation.
+func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Context, ing *ClassifiedIngress, listenerConfig *elbv2api.ListenerConfiguration) *string {
 	var rawSSLPolicy string
 	if ing.IngClassConfig.IngClassParams != nil && ing.IngClassConfig.IngClassParams.Spec.SSLPolicy != "" {
 		return &ing.IngClassConfig.IngClassParams.Spec.SSLPolicy
 	}
+	if listenerConfig != nil && listenerConfig.SSLPolicy != nil {
+		return listenerConfig.SSLPolicy
+	}
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, &rawSSLPolicy, ing.Ing.Annotations); !exists {
 		return nil
 	}
@@ -2,12 +2,16 @@ package ingress
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -19,8 +23,12 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 		return nil
 	}
 
-	var rules []Rule
+	var rules []orderedRule
 	for _, ing := range ingList {
+		ruleOrders, err := t.buildRuleOrders(ctx, ing.Ing)
+		if err != nil {
+			return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+		}
 		for _, rule := range ing.Ing.Spec.Rules {
 			if rule.HTTP == nil {
 				continue
@@ -40,6 +48,9 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
+				if err := validateRuleConditionValues(conditions); err != nil {
+					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+				}
 				actions, err := t.buildActions(ctx, protocol, ing, enhancedBackend)
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
@@ -48,23 +59,34 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
-				rules = append(rules, Rule{
-					Conditions: conditions,
-					Actions:    actions,
-					Tags:       tags,
+				rules = append(rules, orderedRule{
+					rule: Rule{
+						Conditions: conditions,
+						Actions:    actions,
+						Tags:       tags,
+					},
+					order: lookupRuleOrder(ruleOrders, rule.Host, path.Path),
 				})
 			}
 		}
 	}
-	optimizedRules, err := t.ruleOptimizer.Optimize(ctx, port, protocol, rules)
+	sortedRules, err := sortRulesByOrder(rules)
+	if err != nil {
+		return err
+	}
+	optimizedRules, err := t.ruleOptimizer.Optimize(ctx, port, protocol, sortedRules)
 	if err != nil {
 		return err
 	}
 
 	priority := int64(1)
+	ruleResIDs := sets.NewString()
 	for _, rule := range optimizedRules {
-		ruleResID := fmt.Sprintf("%v:%v", port, priority)
-		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
+		ruleResID, err := buildListenerRuleResID(port, rule.Conditions, ruleResIDs)
+		if err != nil {
+			return err
+		}
+		_ = elbv2model.NewListenerRule(t.stack, t.buildShardResourceID(ruleResID), elbv2model.ListenerRuleSpec{
 			ListenerARN: lsARN,
 			Priority:    priority,
 			Conditions:  rule.Conditions,
@@ -77,6 +99,24 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 	return nil
 }
 
+// buildListenerRuleResID builds the resource ID of listener rule from its conditions,
+// so that the rule keeps its identity and priority when other rules are inserted or removed on the listener.
+// rules with identical conditions are disambiguated by the order they're built.
+func buildListenerRuleResID(port int64, conditions []elbv2model.RuleCondition, existingResIDs sets.String) (string, error) {
+	payload, err := json.Marshal(conditions)
+	if err != nil {
+		return "", err
+	}
+	conditionsHash := sha256.Sum256(payload)
+	baseResID := fmt.Sprintf("%v:%.16s", port, hex.EncodeToString(conditionsHash[:]))
+	ruleResID := baseResID
+	for idx := 2; existingResIDs.Has(ruleResID); idx++ {
+		ruleResID = fmt.Sprintf("%v-%v", baseResID, idx)
+	}
+	existingResIDs.Insert(ruleResID)
+	return ruleResID, nil
+}
+
 // sortIngressPaths will sort the paths following the strategy:
 // all exact match paths come first, no need to sort since exact match has to be unique
 // followed by prefix paths, sort by lengths - longer paths get precedence
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
@@ -34,7 +35,7 @@ func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPor
 	if err != nil {
 		return nil, err
 	}
-	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	lb := elbv2model.NewLoadBalancer(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), lbSpec)
 	t.loadBalancer = lb
 	return lb, nil
 }
@@ -72,16 +73,21 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	existingLBARN, err := t.buildLoadBalancerExistingARN(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	return elbv2model.LoadBalancerSpec{
-		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeApplication,
-		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
-		SubnetMappings:         subnetMappings,
-		SecurityGroups:         securityGroups,
-		CustomerOwnedIPv4Pool:  coIPv4Pool,
-		LoadBalancerAttributes: loadBalancerAttributes,
-		Tags:                   tags,
+		Name:                    name,
+		Type:                    elbv2model.LoadBalancerTypeApplication,
+		Scheme:                  &scheme,
+		IPAddressType:           &ipAddressType,
+		SubnetMappings:          subnetMappings,
+		SecurityGroups:          securityGroups,
+		CustomerOwnedIPv4Pool:   coIPv4Pool,
+		LoadBalancerAttributes:  loadBalancerAttributes,
+		Tags:                    tags,
+		ExistingLoadBalancerARN: existingLBARN,
 	}, nil
 }
 
@@ -102,6 +108,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 		if len(name) > 32 {
 			return "", errors.New("load balancer name cannot be longer than 32 characters")
 		}
+		if t.shardIndex != 0 {
+			suffix := fmt.Sprintf("-%v", t.shardIndex)
+			return fmt.Sprintf("%.*s%v", 32-len(suffix), name, suffix), nil
+		}
 		return name, nil
 	}
 	if len(explicitNames) > 1 {
@@ -111,6 +121,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
 	_, _ = uuidHash.Write([]byte(scheme))
+	if t.shardIndex != 0 {
+		_, _ = uuidHash.Write([]byte(strconv.Itoa(t.shardIndex)))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	if t.ingGroup.ID.IsExplicit() {
@@ -131,6 +144,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv
 			explicitSchemes.Insert(scheme)
 			continue
 		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.Scheme != nil {
+			explicitSchemes.Insert(string(*lbConfig.Scheme))
+			continue
+		}
 		rawSchema := ""
 		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixScheme, &rawSchema, member.Ing.Annotations); !exists {
 			continue
@@ -163,6 +180,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 			explicitIPAddressTypes.Insert(ipAddressType)
 			continue
 		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.IPAddressType != nil {
+			explicitIPAddressTypes.Insert(string(*lbConfig.IPAddressType))
+			continue
+		}
 		rawIPAddressType := ""
 		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAddressType, &rawIPAddressType, member.Ing.Annotations); !exists {
 			continue
@@ -196,6 +217,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 			explicitSubnetSelectorList = append(explicitSubnetSelectorList, member.IngClassConfig.IngClassParams.Spec.Subnets)
 			continue
 		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.Subnets != nil {
+			explicitSubnetSelectorList = append(explicitSubnetSelectorList, lbConfig.Subnets)
+			continue
+		}
 		var rawSubnetNameOrIDs []string
 		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, member.Ing.Annotations); !exists {
 			continue
@@ -205,12 +230,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 
 	if len(explicitSubnetSelectorList) != 0 {
 		if len(explicitSubnetNameOrIDsList) != 0 {
-			return nil, errors.Errorf("conflicting subnet specifications: IngressClassParams versus annotation")
+			return nil, errors.Errorf("conflicting subnet specifications: IngressClassParams or LoadBalancerConfiguration versus annotation")
 		}
 		chosenSubnetSelector := explicitSubnetSelectorList[0]
 		for _, subnetSelector := range explicitSubnetSelectorList[1:] {
 			if !cmp.Equal(*chosenSubnetSelector, *subnetSelector) {
-				return nil, errors.Errorf("conflicting IngressClassParams subnet specifications")
+				return nil, errors.Errorf("conflicting IngressClassParams or LoadBalancerConfiguration subnet specifications")
 			}
 		}
 		chosenSubnets, err := t.subnetsResolver.ResolveViaSelector(ctx, chosenSubnetSelector,
@@ -273,12 +298,12 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
-	sgNameOrIDsViaAnnotation, err := t.buildFrontendSGNameOrIDsFromAnnotation(ctx)
+	explicitSGNameOrIDs, err := t.buildFrontendSGNameOrIDs(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var lbSGTokens []core.StringToken
-	if len(sgNameOrIDsViaAnnotation) == 0 {
+	if len(explicitSGNameOrIDs) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
 		if err != nil {
 			return nil, err
@@ -301,7 +326,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 		if err != nil {
 			return nil, err
 		}
-		frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDsViaAnnotation)
+		frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, explicitSGNameOrIDs)
 		if err != nil {
 			return nil, err
 		}
@@ -321,14 +346,20 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 			t.backendSGAllocated = true
 			lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
 		}
-		t.logger.Info("SG configured via annotation", "LB SGs", lbSGTokens, "backend SG", t.backendSGIDToken)
+		t.logger.Info("SG configured explicitly", "LB SGs", lbSGTokens, "backend SG", t.backendSGIDToken)
 	}
 	return lbSGTokens, nil
 }
 
-func (t *defaultModelBuildTask) buildFrontendSGNameOrIDsFromAnnotation(ctx context.Context) ([]string, error) {
+// buildFrontendSGNameOrIDs builds the frontend security groups specified via LoadBalancerConfiguration or annotation.
+// Note: the security groups specified via LoadBalancerConfiguration takes higher priority than the ones specified via annotation on Ingress.
+func (t *defaultModelBuildTask) buildFrontendSGNameOrIDs(_ context.Context) ([]string, error) {
 	var explicitSGNameOrIDsList [][]string
 	for _, member := range t.ingGroup.Members {
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && len(lbConfig.SecurityGroups) != 0 {
+			explicitSGNameOrIDsList = append(explicitSGNameOrIDsList, lbConfig.SecurityGroups)
+			continue
+		}
 		var rawSGNameOrIDs []string
 		if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroups, &rawSGNameOrIDs, member.Ing.Annotations); !exists {
 			continue
@@ -372,6 +403,34 @@ func (t *defaultModelBuildTask) buildLoadBalancerCOIPv4Pool(_ context.Context) (
 	return &rawCOIPv4Pool, nil
 }
 
+// buildLoadBalancerExistingARN builds the ARN of an existing LoadBalancer to adopt, it's empty if not specified.
+func (t *defaultModelBuildTask) buildLoadBalancerExistingARN(_ context.Context) (string, error) {
+	// only the primary LoadBalancer can be adopted, overflow LoadBalancers are always provisioned by controller.
+	if t.shardIndex != 0 {
+		return "", nil
+	}
+	explicitARNs := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		rawARN := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerARN, &rawARN, member.Ing.Annotations); !exists {
+			continue
+		}
+		if len(rawARN) == 0 {
+			return "", errors.Errorf("cannot use empty value for %s annotation, ingress: %v",
+				annotations.IngressSuffixLoadBalancerARN, k8s.NamespacedName(member.Ing))
+		}
+		explicitARNs.Insert(rawARN)
+	}
+	if len(explicitARNs) == 0 {
+		return "", nil
+	}
+	if len(explicitARNs) > 1 {
+		return "", errors.Errorf("conflicting load balancer ARN: %v", explicitARNs.List())
+	}
+	rawARN, _ := explicitARNs.PopAny()
+	return rawARN, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
 	ingGroupAttributes, err := t.buildIngressGroupLoadBalancerAttributes(t.ingGroup.Members)
 	if err != nil {
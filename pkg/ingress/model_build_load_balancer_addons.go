@@ -31,14 +31,48 @@ func (t *defaultModelBuildTask) buildLoadBalancerAddOns(ctx context.Context, lbA
 	return nil
 }
 
-func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, lbARN core.StringToken) (*wafv2model.WebACLAssociation, error) {
-	explicitWebACLARNs := sets.NewString()
+func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(ctx context.Context, lbARN core.StringToken) (*wafv2model.WebACLAssociation, error) {
+	webACLName, webACLViaIngClassParams, err := t.buildWAFv2WebACLRef(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the WebACL ARN in IngressClassParams takes higher priority than the ones in annotation on Ingresses.
+	// the WebACL ARN in LoadBalancerConfiguration replaces the one in annotation on the same Ingress.
+	explicitWebACLARNsViaIngClassParams := sets.NewString()
+	explicitWebACLARNsViaAnnotation := sets.NewString()
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WAFv2ACLArn != "" {
+			explicitWebACLARNsViaIngClassParams.Insert(member.IngClassConfig.IngClassParams.Spec.WAFv2ACLArn)
+			continue
+		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.WAFv2ACLArn != "" {
+			explicitWebACLARNsViaAnnotation.Insert(lbConfig.WAFv2ACLArn)
+			continue
+		}
 		rawWebACLARN := ""
 		_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, member.Ing.Annotations)
 		if rawWebACLARN != "" {
-			explicitWebACLARNs.Insert(rawWebACLARN)
+			explicitWebACLARNsViaAnnotation.Insert(rawWebACLARN)
+		}
+	}
+	explicitWebACLARNs, webACLARNViaIngClassParams := explicitWebACLARNsViaAnnotation, false
+	if len(explicitWebACLARNsViaIngClassParams) != 0 {
+		explicitWebACLARNs, webACLARNViaIngClassParams = explicitWebACLARNsViaIngClassParams, true
+	}
+	// settings in IngressClassParams take higher priority than annotations, but they cannot be mixed at the same level.
+	if webACLName != "" && webACLViaIngClassParams == webACLARNViaIngClassParams && len(explicitWebACLARNs) != 0 {
+		return nil, errors.Errorf("conflicting WAFv2 WebACL %v and WebACL ARNs: %v", webACLName, explicitWebACLARNs.List())
+	}
+	if webACLName != "" && (webACLViaIngClassParams || !webACLARNViaIngClassParams) {
+		webACL, err := t.buildWAFv2WebACL(ctx, webACLName)
+		if err != nil {
+			return nil, err
 		}
+		association := wafv2model.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafv2model.WebACLAssociationSpec{
+			WebACLARN:   webACL.WebACLARN(),
+			ResourceARN: lbARN,
+		})
+		return association, nil
 	}
 	if len(explicitWebACLARNs) == 0 {
 		return nil, nil
@@ -49,14 +83,14 @@ func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, l
 	webACLARN, _ := explicitWebACLARNs.PopAny()
 	switch webACLARN {
 	case wafv2ACLARNNone:
-		association := wafv2model.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
-			WebACLARN:   "",
+		association := wafv2model.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafv2model.WebACLAssociationSpec{
+			WebACLARN:   core.LiteralStringToken(""),
 			ResourceARN: lbARN,
 		})
 		return association, nil
 	default:
-		association := wafv2model.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
-			WebACLARN:   webACLARN,
+		association := wafv2model.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafv2model.WebACLAssociationSpec{
+			WebACLARN:   core.LiteralStringToken(webACLARN),
 			ResourceARN: lbARN,
 		})
 		return association, nil
@@ -64,16 +98,31 @@ func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, l
 }
 
 func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Context, lbARN core.StringToken) (*wafregionalmodel.WebACLAssociation, error) {
-	explicitWebACLIDs := sets.NewString()
+	// the WebACL ID in IngressClassParams takes higher priority than the ones in annotation on Ingresses.
+	// the WebACL ID in LoadBalancerConfiguration replaces the one in annotation on the same Ingress.
+	explicitWebACLIDsViaIngClassParams := sets.NewString()
+	explicitWebACLIDsViaAnnotation := sets.NewString()
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WAFACLID != "" {
+			explicitWebACLIDsViaIngClassParams.Insert(member.IngClassConfig.IngClassParams.Spec.WAFACLID)
+			continue
+		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.WAFACLID != "" {
+			explicitWebACLIDsViaAnnotation.Insert(lbConfig.WAFACLID)
+			continue
+		}
 		rawWebACLID := ""
 		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFACLID, &rawWebACLID, member.Ing.Annotations); !exists {
 			_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWebACLID, &rawWebACLID, member.Ing.Annotations)
 		}
 		if rawWebACLID != "" {
-			explicitWebACLIDs.Insert(rawWebACLID)
+			explicitWebACLIDsViaAnnotation.Insert(rawWebACLID)
 		}
 	}
+	explicitWebACLIDs := explicitWebACLIDsViaAnnotation
+	if len(explicitWebACLIDsViaIngClassParams) != 0 {
+		explicitWebACLIDs = explicitWebACLIDsViaIngClassParams
+	}
 	if len(explicitWebACLIDs) == 0 {
 		return nil, nil
 	}
@@ -83,13 +132,13 @@ func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Cont
 	webACLID, _ := explicitWebACLIDs.PopAny()
 	switch webACLID {
 	case webACLIDNone:
-		association := wafregionalmodel.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafregionalmodel.WebACLAssociationSpec{
+		association := wafregionalmodel.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafregionalmodel.WebACLAssociationSpec{
 			WebACLID:    "",
 			ResourceARN: lbARN,
 		})
 		return association, nil
 	default:
-		association := wafregionalmodel.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafregionalmodel.WebACLAssociationSpec{
+		association := wafregionalmodel.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafregionalmodel.WebACLAssociationSpec{
 			WebACLID:    webACLID,
 			ResourceARN: lbARN,
 		})
@@ -98,17 +147,32 @@ func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Cont
 }
 
 func (t *defaultModelBuildTask) buildShieldProtection(_ context.Context, lbARN core.StringToken) (*shieldmodel.Protection, error) {
-	explicitEnableProtections := make(map[bool]struct{})
+	// the shield protection setting in IngressClassParams takes higher priority than the ones in annotation on Ingresses.
+	// the shield protection setting in LoadBalancerConfiguration replaces the one in annotation on the same Ingress.
+	explicitEnableProtectionsViaIngClassParams := make(map[bool]struct{})
+	explicitEnableProtectionsViaAnnotation := make(map[bool]struct{})
 	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.ShieldAdvancedProtection != nil {
+			explicitEnableProtectionsViaIngClassParams[*member.IngClassConfig.IngClassParams.Spec.ShieldAdvancedProtection] = struct{}{}
+			continue
+		}
+		if lbConfig := t.loadBalancerConfigurationOf(member.Ing); lbConfig != nil && lbConfig.ShieldAdvancedProtection != nil {
+			explicitEnableProtectionsViaAnnotation[*lbConfig.ShieldAdvancedProtection] = struct{}{}
+			continue
+		}
 		rawEnableProtection := false
 		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixShieldAdvancedProtection, &rawEnableProtection, member.Ing.Annotations)
 		if err != nil {
 			return nil, err
 		}
 		if exists {
-			explicitEnableProtections[rawEnableProtection] = struct{}{}
+			explicitEnableProtectionsViaAnnotation[rawEnableProtection] = struct{}{}
 		}
 	}
+	explicitEnableProtections := explicitEnableProtectionsViaAnnotation
+	if len(explicitEnableProtectionsViaIngClassParams) != 0 {
+		explicitEnableProtections = explicitEnableProtectionsViaIngClassParams
+	}
 	if len(explicitEnableProtections) == 0 {
 		return nil, nil
 	}
@@ -116,7 +180,7 @@ func (t *defaultModelBuildTask) buildShieldProtection(_ context.Context, lbARN c
 		return nil, errors.New("conflicting enable shield advanced protection")
 	}
 	_, enableProtection := explicitEnableProtections[true]
-	protection := shieldmodel.NewProtection(t.stack, resourceIDLoadBalancer, shieldmodel.ProtectionSpec{
+	protection := shieldmodel.NewProtection(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), shieldmodel.ProtectionSpec{
 		Enabled:     enableProtection,
 		ResourceARN: lbARN,
 	})
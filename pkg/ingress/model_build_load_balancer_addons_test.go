@@ -3,11 +3,13 @@ package ingress
 import (
 	"context"
 	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
@@ -97,7 +99,7 @@ func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
 			},
 			want: &wafv2model.WebACLAssociation{
 				Spec: wafv2model.WebACLAssociationSpec{
-					WebACLARN:   "wafv2-arn-1",
+					WebACLARN:   core.LiteralStringToken("wafv2-arn-1"),
 					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
 				},
 			},
@@ -136,7 +138,7 @@ func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
 			},
 			want: &wafv2model.WebACLAssociation{
 				Spec: wafv2model.WebACLAssociationSpec{
-					WebACLARN:   "wafv2-arn-1",
+					WebACLARN:   core.LiteralStringToken("wafv2-arn-1"),
 					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
 				},
 			},
@@ -177,7 +179,7 @@ func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
 			},
 			want: &wafv2model.WebACLAssociation{
 				Spec: wafv2model.WebACLAssociationSpec{
-					WebACLARN:   "",
+					WebACLARN:   core.LiteralStringToken(""),
 					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
 				},
 			},
@@ -216,7 +218,7 @@ func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
 			},
 			want: &wafv2model.WebACLAssociation{
 				Spec: wafv2model.WebACLAssociationSpec{
-					WebACLARN:   "",
+					WebACLARN:   core.LiteralStringToken(""),
 					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
 				},
 			},
@@ -260,6 +262,95 @@ func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
 				return false
 			},
 		},
+		{
+			name: "when IngressClassParams has wafv2AclArn set, it takes precedence over annotations",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/wafv2-acl-arn": "wafv2-arn-1",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "awesome-class-params",
+									},
+									Spec: elbv2api.IngressClassParamsSpec{
+										WAFv2ACLArn: "wafv2-arn-2",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/wafv2-acl-arn": "none",
+										"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &wafv2model.WebACLAssociation{
+				Spec: wafv2model.WebACLAssociationSpec{
+					WebACLARN:   core.LiteralStringToken("wafv2-arn-2"),
+					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "when IngressClassParams has both webACL and wafv2AclArn set",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:   "awesome-ns",
+									Name:        "awesome-ing-0",
+									Annotations: map[string]string{},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "awesome-class-params",
+									},
+									Spec: elbv2api.IngressClassParamsSpec{
+										WebACL:      "my-web-acl",
+										WAFv2ACLArn: "wafv2-arn-2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, msgAndArgs ...interface{}) bool {
+				assert.EqualError(t, err, "conflicting WAFv2 WebACL my-web-acl and WebACL ARNs: [wafv2-arn-2]", msgAndArgs...)
+				return false
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -554,6 +645,57 @@ func Test_defaultModelBuildTask_buildWAFRegionalWebACLAssociation(t *testing.T)
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "when IngressClassParams has wafAclId set, it takes precedence over annotations",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/waf-acl-id": "web-acl-id-1",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "awesome-class-params",
+									},
+									Spec: elbv2api.IngressClassParamsSpec{
+										WAFACLID: "none",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/waf-acl-id": "web-acl-id-2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &wafregionalmodel.WebACLAssociation{
+				Spec: wafregionalmodel.WebACLAssociationSpec{
+					WebACLID:    "",
+					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -818,6 +960,57 @@ func Test_defaultModelBuildTask_buildShieldProtection(t *testing.T) {
 				return false
 			},
 		},
+		{
+			name: "when IngressClassParams has shieldAdvancedProtection set, it takes precedence over annotations",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-0",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection": "false",
+									},
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &elbv2api.IngressClassParams{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "awesome-class-params",
+									},
+									Spec: elbv2api.IngressClassParamsSpec{
+										ShieldAdvancedProtection: awssdk.Bool(true),
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "awesome-ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/shield-advanced-protection": "false",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbARN: core.LiteralStringToken("awesome-lb-arn"),
+			},
+			want: &shieldmodel.Protection{
+				Spec: shieldmodel.ProtectionSpec{
+					Enabled:     true,
+					ResourceARN: core.LiteralStringToken("awesome-lb-arn"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
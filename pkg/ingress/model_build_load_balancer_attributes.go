@@ -35,11 +35,15 @@ func (t *defaultModelBuildTask) buildIngressGroupLoadBalancerAttributes(ingList
 
 // buildIngressLoadBalancerAttributes builds the LB attributes used for a single Ingress
 // Note: the Attributes specified via IngressClass takes higher priority than the attributes specified via annotation on Ingress or Service.
+// the Attributes specified via LoadBalancerConfiguration takes higher priority than the attributes specified via annotation on Ingress.
 func (t *defaultModelBuildTask) buildIngressLoadBalancerAttributes(ing ClassifiedIngress) (map[string]string, error) {
 	var annotationAttributes map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &annotationAttributes, ing.Ing.Annotations); err != nil {
 		return nil, err
 	}
+	if lbConfig := t.loadBalancerConfigurationOf(ing.Ing); lbConfig != nil {
+		return algorithm.MergeStringMap(buildLoadBalancerConfigurationAttributes(lbConfig), annotationAttributes), nil
+	}
 	return annotationAttributes, nil
 }
 
@@ -0,0 +1,101 @@
+package ingress
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	lbAttrsAccessLogsS3Enabled = "access_logs.s3.enabled"
+	lbAttrsAccessLogsS3Bucket  = "access_logs.s3.bucket"
+	lbAttrsAccessLogsS3Prefix  = "access_logs.s3.prefix"
+)
+
+// loadLoadBalancerConfigurations loads the LoadBalancerConfiguration for each member Ingress.
+// the LoadBalancerConfiguration referenced by IngressClassParams takes higher priority than the one referenced by annotation on Ingress.
+func (t *defaultModelBuildTask) loadLoadBalancerConfigurations(ctx context.Context) error {
+	t.lbConfigByIngress = make(map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		lbConfigKey, exists := t.buildLoadBalancerConfigurationKey(member)
+		if !exists {
+			continue
+		}
+		lbConfig := &elbv2api.LoadBalancerConfiguration{}
+		if err := t.k8sClient.Get(ctx, lbConfigKey, lbConfig); err != nil {
+			return errors.Wrapf(err, "failed to load LoadBalancerConfiguration %v for ingress: %v", lbConfigKey, k8s.NamespacedName(member.Ing))
+		}
+		t.lbConfigByIngress[k8s.NamespacedName(member.Ing)] = &lbConfig.Spec
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerConfigurationKey(member ClassifiedIngress) (types.NamespacedName, bool) {
+	if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.LoadBalancerConfiguration != nil {
+		lbConfigRef := member.IngClassConfig.IngClassParams.Spec.LoadBalancerConfiguration
+		return types.NamespacedName{Namespace: lbConfigRef.Namespace, Name: lbConfigRef.Name}, true
+	}
+	lbConfigName := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerConfiguration, &lbConfigName, member.Ing.Annotations); !exists || lbConfigName == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: member.Ing.Namespace, Name: lbConfigName}, true
+}
+
+// loadBalancerConfigurationOf returns the LoadBalancerConfiguration settings for Ingress, or nil if there is none.
+func (t *defaultModelBuildTask) loadBalancerConfigurationOf(ing *networking.Ingress) *elbv2api.LoadBalancerConfigurationSpec {
+	return t.lbConfigByIngress[k8s.NamespacedName(ing)]
+}
+
+// computeIngressListenerConfigurationByPort computes the listener settings by port from the LoadBalancerConfiguration for Ingress.
+func (t *defaultModelBuildTask) computeIngressListenerConfigurationByPort(_ context.Context, ing *ClassifiedIngress) (map[int64]*elbv2api.ListenerConfiguration, error) {
+	lbConfig := t.loadBalancerConfigurationOf(ing.Ing)
+	if lbConfig == nil || len(lbConfig.Listeners) == 0 {
+		return nil, nil
+	}
+	listenerConfigByPort := make(map[int64]*elbv2api.ListenerConfiguration, len(lbConfig.Listeners))
+	for i := range lbConfig.Listeners {
+		listenerConfig := &lbConfig.Listeners[i]
+		port := int64(listenerConfig.Port)
+		if listenerConfig.Protocol == nil {
+			return nil, errors.Errorf("listener protocol must be specified in LoadBalancerConfiguration for port: %v", port)
+		}
+		switch *listenerConfig.Protocol {
+		case elbv2api.ListenerProtocolHTTP, elbv2api.ListenerProtocolHTTPS:
+		default:
+			return nil, errors.Errorf("listen protocol must be within [%v, %v]: %v", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS, *listenerConfig.Protocol)
+		}
+		if _, exists := listenerConfigByPort[port]; exists {
+			return nil, errors.Errorf("duplicate listeners in LoadBalancerConfiguration for port: %v", port)
+		}
+		listenerConfigByPort[port] = listenerConfig
+	}
+	return listenerConfigByPort, nil
+}
+
+// buildLoadBalancerConfigurationAttributes builds the LB attributes from the LoadBalancerConfiguration settings.
+// Note: the access logs settings takes higher priority than the access_logs.s3 attributes.
+func buildLoadBalancerConfigurationAttributes(lbConfig *elbv2api.LoadBalancerConfigurationSpec) map[string]string {
+	lbConfigAttributes := make(map[string]string, len(lbConfig.LoadBalancerAttributes))
+	for _, attr := range lbConfig.LoadBalancerAttributes {
+		lbConfigAttributes[attr.Key] = attr.Value
+	}
+	if lbConfig.AccessLogs != nil {
+		accessLogsEnabled := lbConfig.AccessLogs.Enabled == nil || *lbConfig.AccessLogs.Enabled
+		lbConfigAttributes[lbAttrsAccessLogsS3Enabled] = strconv.FormatBool(accessLogsEnabled)
+		if lbConfig.AccessLogs.S3Bucket != "" {
+			lbConfigAttributes[lbAttrsAccessLogsS3Bucket] = lbConfig.AccessLogs.S3Bucket
+		}
+		if lbConfig.AccessLogs.S3Prefix != "" {
+			lbConfigAttributes[lbAttrsAccessLogsS3Prefix] = lbConfig.AccessLogs.S3Prefix
+		}
+	}
+	return lbConfigAttributes
+}
@@ -0,0 +1,429 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_loadLoadBalancerConfigurations(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	lbConfigs := []*elbv2api.LoadBalancerConfiguration{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-config"},
+			Spec:       elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternetFacing},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared-ns", Name: "class-config"},
+			Spec:       elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternal},
+		},
+	}
+	ingClassParamsWithConfig := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+		Spec: elbv2api.IngressClassParamsSpec{
+			LoadBalancerConfiguration: &elbv2api.LoadBalancerConfigurationReference{
+				Namespace: "shared-ns",
+				Name:      "class-config",
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		members []ClassifiedIngress
+		want    map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec
+		wantErr error
+	}{
+		{
+			name: "no LoadBalancerConfiguration referenced",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"}},
+				},
+			},
+			want: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{},
+		},
+		{
+			name: "LoadBalancerConfiguration referenced via annotation",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-configuration": "ing-config",
+						},
+					}},
+				},
+				{
+					Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-2"}},
+				},
+			},
+			want: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{
+				{Namespace: "awesome-ns", Name: "ing-1"}: {Scheme: &schemeInternetFacing},
+			},
+		},
+		{
+			name: "LoadBalancerConfiguration referenced via IngressClassParams takes precedence",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-configuration": "ing-config",
+						},
+					}},
+					IngClassConfig: ClassConfiguration{IngClassParams: ingClassParamsWithConfig},
+				},
+			},
+			want: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{
+				{Namespace: "awesome-ns", Name: "ing-1"}: {Scheme: &schemeInternal},
+			},
+		},
+		{
+			name: "referenced LoadBalancerConfiguration not found",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+						Namespace: "other-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-configuration": "ing-config",
+						},
+					}},
+				},
+			},
+			wantErr: errors.New("failed to load LoadBalancerConfiguration other-ns/ing-config for ingress: other-ns/ing-1: loadbalancerconfigurations.elbv2.k8s.aws \"ing-config\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, lbConfig := range lbConfigs {
+				assert.NoError(t, k8sClient.Create(context.Background(), lbConfig.DeepCopy()))
+			}
+			task := &defaultModelBuildTask{
+				k8sClient:        k8sClient,
+				ingGroup:         Group{ID: GroupID{Name: "explicit-group"}, Members: tt.members},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			err := task.loadLoadBalancerConfigurations(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, task.lbConfigByIngress)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_computeIngressListenPortConfigByPort_LoadBalancerConfiguration(t *testing.T) {
+	protocolHTTP := elbv2api.ListenerProtocolHTTP
+	protocolHTTPS := elbv2api.ListenerProtocolHTTPS
+	protocolTCP := elbv2api.ListenerProtocolTCP
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "ing-1",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/listen-ports":    `[{"HTTPS": 8443}]`,
+				"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/annotation",
+				"alb.ingress.kubernetes.io/ssl-policy":      "annotation-policy",
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		ingClassParams *elbv2api.IngressClassParams
+		lbConfig       *elbv2api.LoadBalancerConfigurationSpec
+		want           map[int64]listenPortConfig
+		wantErr        error
+	}{
+		{
+			name: "listeners via annotation",
+			want: map[int64]listenPortConfig{
+				8443: {
+					protocol:  elbv2model.ProtocolHTTPS,
+					tlsCerts:  []string{"arn:aws:acm:us-west-2:123456789012:certificate/annotation"},
+					sslPolicy: awssdk.String("annotation-policy"),
+				},
+			},
+		},
+		{
+			name: "listeners via LoadBalancerConfiguration take precedence over annotation",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.ListenerConfiguration{
+					{
+						Port:     80,
+						Protocol: &protocolHTTP,
+					},
+					{
+						Port:            443,
+						Protocol:        &protocolHTTPS,
+						CertificateARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/config"},
+						SSLPolicy:       awssdk.String("config-policy"),
+					},
+					{
+						Port:     9443,
+						Protocol: &protocolHTTPS,
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				80: {
+					protocol: elbv2model.ProtocolHTTP,
+				},
+				443: {
+					protocol:  elbv2model.ProtocolHTTPS,
+					tlsCerts:  []string{"arn:aws:acm:us-west-2:123456789012:certificate/config"},
+					sslPolicy: awssdk.String("config-policy"),
+				},
+				9443: {
+					protocol:  elbv2model.ProtocolHTTPS,
+					tlsCerts:  []string{"arn:aws:acm:us-west-2:123456789012:certificate/annotation"},
+					sslPolicy: awssdk.String("annotation-policy"),
+				},
+			},
+		},
+		{
+			name: "certificates and sslPolicy via IngressClassParams take precedence over LoadBalancerConfiguration",
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+				Spec: elbv2api.IngressClassParamsSpec{
+					CertificateArn: []string{"arn:aws:acm:us-west-2:123456789012:certificate/class"},
+					SSLPolicy:      "class-policy",
+				},
+			},
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.ListenerConfiguration{
+					{
+						Port:            443,
+						Protocol:        &protocolHTTPS,
+						CertificateARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/config"},
+						SSLPolicy:       awssdk.String("config-policy"),
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				443: {
+					protocol:  elbv2model.ProtocolHTTPS,
+					tlsCerts:  []string{"arn:aws:acm:us-west-2:123456789012:certificate/class"},
+					sslPolicy: awssdk.String("class-policy"),
+				},
+			},
+		},
+		{
+			name: "listener protocol not supported by ALB",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.ListenerConfiguration{
+					{
+						Port:     80,
+						Protocol: &protocolTCP,
+					},
+				},
+			},
+			wantErr: errors.New("listen protocol must be within [HTTP, HTTPS]: TCP"),
+		},
+		{
+			name: "listener protocol not specified",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.ListenerConfiguration{
+					{
+						Port: 80,
+					},
+				},
+			},
+			wantErr: errors.New("listener protocol must be specified in LoadBalancerConfiguration for port: 80"),
+		},
+		{
+			name: "duplicate listeners",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.ListenerConfiguration{
+					{
+						Port:     80,
+						Protocol: &protocolHTTP,
+					},
+					{
+						Port:     80,
+						Protocol: &protocolHTTPS,
+					},
+				},
+			},
+			wantErr: errors.New("duplicate listeners in LoadBalancerConfiguration for port: 80"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := ClassifiedIngress{
+				Ing:            ing,
+				IngClassConfig: ClassConfiguration{IngClassParams: tt.ingClassParams},
+			}
+			task := &defaultModelBuildTask{
+				ingGroup:          Group{ID: GroupID{Name: "explicit-group"}, Members: []ClassifiedIngress{member}},
+				annotationParser:  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				lbConfigByIngress: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{},
+			}
+			if tt.lbConfig != nil {
+				task.lbConfigByIngress[types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}] = tt.lbConfig
+			}
+			got, err := task.computeIngressListenPortConfigByPort(context.Background(), &member)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerScheme_LoadBalancerConfiguration(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	tests := []struct {
+		name           string
+		ingClassParams *elbv2api.IngressClassParams
+		lbConfig       *elbv2api.LoadBalancerConfigurationSpec
+		want           elbv2model.LoadBalancerScheme
+	}{
+		{
+			name:     "scheme via LoadBalancerConfiguration takes precedence over annotation",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternetFacing},
+			want:     elbv2model.LoadBalancerSchemeInternetFacing,
+		},
+		{
+			name: "scheme via IngressClassParams takes precedence over LoadBalancerConfiguration",
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "awesome-class"},
+				Spec:       elbv2api.IngressClassParamsSpec{Scheme: &schemeInternal},
+			},
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternetFacing},
+			want:     elbv2model.LoadBalancerSchemeInternal,
+		},
+		{
+			name:     "LoadBalancerConfiguration without scheme",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{},
+			want:     elbv2model.LoadBalancerSchemeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			member := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/scheme": "internal",
+						},
+					},
+				},
+				IngClassConfig: ClassConfiguration{IngClassParams: tt.ingClassParams},
+			}
+			task := &defaultModelBuildTask{
+				ingGroup:         Group{ID: GroupID{Name: "explicit-group"}, Members: []ClassifiedIngress{member}},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				lbConfigByIngress: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{
+					{Namespace: "awesome-ns", Name: "ing-1"}: tt.lbConfig,
+				},
+				defaultScheme: elbv2model.LoadBalancerSchemeInternal,
+			}
+			got, err := task.buildLoadBalancerScheme(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildIngressLoadBalancerAttributes_LoadBalancerConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		lbConfig *elbv2api.LoadBalancerConfigurationSpec
+		want     map[string]string
+	}{
+		{
+			name: "attributes via LoadBalancerConfiguration take precedence over annotation",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: []elbv2api.Attribute{
+					{Key: "idle_timeout.timeout_seconds", Value: "120"},
+				},
+			},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "120",
+				"routing.http2.enabled":        "false",
+				"access_logs.s3.enabled":       "false",
+			},
+		},
+		{
+			name: "access logs take precedence over attributes",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				LoadBalancerAttributes: []elbv2api.Attribute{
+					{Key: "access_logs.s3.bucket", Value: "attribute-bucket"},
+				},
+				AccessLogs: &elbv2api.AccessLogsConfiguration{
+					S3Bucket: "config-bucket",
+					S3Prefix: "config-prefix",
+				},
+			},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "60",
+				"routing.http2.enabled":        "false",
+				"access_logs.s3.enabled":       "true",
+				"access_logs.s3.bucket":        "config-bucket",
+				"access_logs.s3.prefix":        "config-prefix",
+			},
+		},
+		{
+			name: "access logs disabled",
+			lbConfig: &elbv2api.LoadBalancerConfigurationSpec{
+				AccessLogs: &elbv2api.AccessLogsConfiguration{
+					Enabled: awssdk.Bool(false),
+				},
+			},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "60",
+				"routing.http2.enabled":        "false",
+				"access_logs.s3.enabled":       "false",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ing := &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "ing-1",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=60,routing.http2.enabled=false,access_logs.s3.enabled=false",
+					},
+				},
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				lbConfigByIngress: map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec{
+					{Namespace: "awesome-ns", Name: "ing-1"}: tt.lbConfig,
+				},
+			}
+			got, err := task.buildIngressLoadBalancerAttributes(ClassifiedIngress{Ing: ing})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
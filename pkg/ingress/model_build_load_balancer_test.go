@@ -239,6 +239,137 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildLoadBalancerExistingARN(t *testing.T) {
+	type fields struct {
+		ingGroup Group
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr error
+	}{
+		{
+			name: "load balancer ARN not configured",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:   "awesome-ns",
+									Name:        "ing-1",
+									Annotations: map[string]string{},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: "",
+		},
+		{
+			name: "load balancer ARN configured on one Ingress among IngressGroup",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-arn": "my-arn",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:   "awesome-ns",
+									Name:        "ing-2",
+									Annotations: map[string]string{},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: "my-arn",
+		},
+		{
+			name: "specified empty load balancer ARN",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-arn": "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("cannot use empty value for load-balancer-arn annotation, ingress: awesome-ns/ing-1"),
+		},
+		{
+			name: "load balancer ARN configured on multiple Ingress among IngressGroup - with different value",
+			fields: fields{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-arn": "my-arn",
+									},
+								},
+							},
+						},
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-2",
+									Annotations: map[string]string{
+										"alb.ingress.kubernetes.io/load-balancer-arn": "my-another-arn",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting load balancer ARN: [my-another-arn my-arn]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t1 *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			task := &defaultModelBuildTask{
+				annotationParser: annotationParser,
+				ingGroup:         tt.fields.ingGroup,
+			}
+			got, err := task.buildLoadBalancerExistingARN(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildLoadBalancerTags(t *testing.T) {
 	type fields struct {
 		ingGroup            Group
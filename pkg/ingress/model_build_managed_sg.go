@@ -6,6 +6,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
@@ -23,7 +25,7 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, l
 		return nil, err
 	}
 
-	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	sg := ec2model.NewSecurityGroup(t.stack, t.buildShardResourceID(resourceIDManagedSecurityGroup), sgSpec)
 	return sg, nil
 }
 
@@ -48,6 +50,9 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context)
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	if t.shardIndex != 0 {
+		_, _ = uuidHash.Write([]byte(strconv.Itoa(t.shardIndex)))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	if t.ingGroup.ID.IsExplicit() {
@@ -69,8 +74,17 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupTags(_ context.Context)
 }
 
 func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) []ec2model.IPPermission {
+	// ports are sorted so that the permissions are built in a stable order.
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
 	var permissions []ec2model.IPPermission
-	for port, cfg := range listenPortConfigByPort {
+	for _, port := range ports {
+		cfg := listenPortConfigByPort[port]
 		for _, cidr := range cfg.inboundCIDRv4s {
 			permissions = append(permissions, ec2model.IPPermission{
 				IPProtocol: "tcp",
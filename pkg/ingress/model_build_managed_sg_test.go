@@ -2,12 +2,15 @@ package ingress
 
 import (
 	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
 
@@ -265,3 +268,79 @@ func Test_defaultModelBuildTask_buildManagedSecurityGroupTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupIngressPermissions(t *testing.T) {
+	tests := []struct {
+		name                   string
+		listenPortConfigByPort map[int64]listenPortConfig
+		ipAddressType          elbv2model.IPAddressType
+		want                   []ec2model.IPPermission
+	}{
+		{
+			name: "permissions are ordered by port",
+			listenPortConfigByPort: map[int64]listenPortConfig{
+				8443: {inboundCIDRv4s: []string{"10.0.0.0/16"}},
+				443:  {inboundCIDRv4s: []string{"0.0.0.0/0"}, inboundCIDRv6s: []string{"::/0"}},
+				80:   {inboundCIDRv4s: []string{"0.0.0.0/0"}, prefixLists: []string{"pl-xxx"}},
+			},
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-xxx"}},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPv6Range:  []ec2model.IPv6Range{{CIDRIPv6: "::/0"}},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(8443),
+					ToPort:     awssdk.Int64(8443),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/16"}},
+				},
+			},
+		},
+		{
+			name: "ipv6 ranges are omitted for ipv4 load balancers",
+			listenPortConfigByPort: map[int64]listenPortConfig{
+				443: {inboundCIDRv4s: []string{"0.0.0.0/0"}, inboundCIDRv6s: []string{"::/0"}},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			// the permissions are built repeatedly, as map iteration order would otherwise hide unstable ordering.
+			for i := 0; i < 10; i++ {
+				got := task.buildManagedSecurityGroupIngressPermissions(context.Background(), tt.listenPortConfigByPort, tt.ipAddressType)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
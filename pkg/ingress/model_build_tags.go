@@ -30,6 +30,7 @@ func (t *defaultModelBuildTask) buildIngressGroupResourceTags(ingList []Classifi
 
 // buildIngressResourceTags builds the AWS Tags used for a single Ingress. e.g. ListenerRule
 // Note: the Tags specified via IngressClass takes higher priority than tags specified via annotation on Ingress or Service.
+// the Tags specified via LoadBalancerConfiguration takes higher priority than tags specified via annotation on Ingress or Service.
 func (t *defaultModelBuildTask) buildIngressResourceTags(ing ClassifiedIngress) (map[string]string, error) {
 	var annotationTags map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &annotationTags, ing.Ing.Annotations); err != nil {
@@ -44,11 +45,16 @@ func (t *defaultModelBuildTask) buildIngressResourceTags(ing ClassifiedIngress)
 	if err != nil {
 		return nil, err
 	}
-	return algorithm.MergeStringMap(ingClassTags, annotationTags), nil
+	lbConfigTags, err := t.buildLoadBalancerConfigurationResourceTags(ing)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(ingClassTags, lbConfigTags, annotationTags), nil
 }
 
 // buildIngressBackendResourceTags builds the AWS Tags used for a single Ingress and Backend. e.g. TargetGroup.
 // Note: the Tags specified via IngressClass takes higher priority than tags specified via annotation on Ingress or Service.
+// the Tags specified via LoadBalancerConfiguration takes higher priority than tags specified via annotation on Ingress or Service.
 //
 //	the target group will have the merged tags specified by the annotations of both Ingress and Service
 //	the Tags annotation of Service takes higher priority if there is conflict between the tags of Ingress and Service
@@ -71,8 +77,12 @@ func (t *defaultModelBuildTask) buildIngressBackendResourceTags(ing ClassifiedIn
 	if err != nil {
 		return nil, err
 	}
+	lbConfigTags, err := t.buildLoadBalancerConfigurationResourceTags(ing)
+	if err != nil {
+		return nil, err
+	}
 
-	return algorithm.MergeStringMap(ingClassTags, mergedAnnotationTags), nil
+	return algorithm.MergeStringMap(ingClassTags, lbConfigTags, mergedAnnotationTags), nil
 }
 
 // buildIngressClassResourceTags builds the AWS Tags for a IngressClass.
@@ -92,6 +102,23 @@ func (t *defaultModelBuildTask) buildIngressClassResourceTags(ingClassConfig Cla
 	return ingClassTags, nil
 }
 
+// buildLoadBalancerConfigurationResourceTags builds the AWS Tags for the LoadBalancerConfiguration of Ingress.
+func (t *defaultModelBuildTask) buildLoadBalancerConfigurationResourceTags(ing ClassifiedIngress) (map[string]string, error) {
+	lbConfig := t.loadBalancerConfigurationOf(ing.Ing)
+	if lbConfig == nil || len(lbConfig.Tags) == 0 {
+		return nil, nil
+	}
+	lbConfigTags := make(map[string]string, len(lbConfig.Tags))
+	for _, tag := range lbConfig.Tags {
+		lbConfigTags[tag.Key] = tag.Value
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(lbConfigTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for LoadBalancerConfiguration of Ingress %v",
+			k8s.NamespacedName(ing.Ing).String())
+	}
+	return lbConfigTags, nil
+}
+
 func (t *defaultModelBuildTask) validateTagCollisionWithExternalManagedTags(tags map[string]string) error {
 	for tagKey := range tags {
 		if t.externalManagedTags.Has(tagKey) {
@@ -61,24 +61,29 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 		targetPort = intstr.FromInt(int(svcPort.NodePort))
 	}
 	tgbNetworking := t.buildTargetGroupBindingNetworking(ctx, targetPort, *tg.Spec.HealthCheckConfig.Port)
+	tgbSpec := elbv2model.TargetGroupBindingSpec{
+		TargetGroupARN: tg.TargetGroupARN(),
+		TargetType:     &targetType,
+		ServiceRef: elbv2api.ServiceReference{
+			Name: svc.Name,
+			Port: port,
+		},
+		Networking:    tgbNetworking,
+		NodeSelector:  nodeSelector,
+		IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+		VpcID:         t.vpcID,
+	}
+	if t.assumeRole != nil {
+		tgbSpec.IAMRoleARNToAssume = t.assumeRole.RoleARN
+		tgbSpec.AssumeRoleExternalID = t.assumeRole.ExternalID
+	}
 	return elbv2model.TargetGroupBindingResourceSpec{
 		Template: elbv2model.TargetGroupBindingTemplate{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: svc.Namespace,
 				Name:      tg.Spec.Name,
 			},
-			Spec: elbv2model.TargetGroupBindingSpec{
-				TargetGroupARN: tg.TargetGroupARN(),
-				TargetType:     &targetType,
-				ServiceRef: elbv2api.ServiceReference{
-					Name: svc.Name,
-					Port: port,
-				},
-				Networking:    tgbNetworking,
-				NodeSelector:  nodeSelector,
-				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
-				VpcID:         t.vpcID,
-			},
+			Spec: tgbSpec,
 		},
 	}
 }
@@ -141,7 +146,12 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) (elbv2model.TargetGroupSpec, error) {
 	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
-	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations)
+	// settings from TargetGroupConfiguration of the Service take precedence over the ones from annotations.
+	tgProps, err := t.tgConfigLoader.Load(ctx, svc, svcPort)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations, tgProps)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -149,19 +159,19 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, svcAndIngAnnotations)
+	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, svcAndIngAnnotations, tgProps)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, targetType, tgProtocol, tgProtocolVersion)
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svc, svcAndIngAnnotations, tgProps, targetType, tgProtocol, tgProtocolVersion)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations)
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations, tgProps)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tags, err := t.buildTargetGroupTags(ctx, ing, svc)
+	tags, err := t.buildTargetGroupTags(ctx, ing, svc, tgProps)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -208,9 +218,12 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.TargetType, error) {
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndIngAnnotations map[string]string, tgProps *elbv2api.TargetGroupProps) (elbv2model.TargetType, error) {
 	rawTargetType := string(t.defaultTargetType)
 	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndIngAnnotations)
+	if tgProps != nil && tgProps.TargetType != nil {
+		rawTargetType = string(*tgProps.TargetType)
+	}
 	switch rawTargetType {
 	case string(elbv2model.TargetTypeInstance):
 		return elbv2model.TargetTypeInstance, nil
@@ -270,9 +283,12 @@ func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcA
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2model.ProtocolVersion, error) {
+func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, svcAndIngAnnotations map[string]string, tgProps *elbv2api.TargetGroupProps) (elbv2model.ProtocolVersion, error) {
 	rawBackendProtocolVersion := string(t.defaultBackendProtocolVersion)
 	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocolVersion, &rawBackendProtocolVersion, svcAndIngAnnotations)
+	if tgProps != nil && tgProps.ProtocolVersion != nil {
+		rawBackendProtocolVersion = string(*tgProps.ProtocolVersion)
+	}
 	switch rawBackendProtocolVersion {
 	case string(elbv2model.ProtocolVersionHTTP1):
 		return elbv2model.ProtocolVersionHTTP1, nil
@@ -285,30 +301,35 @@ func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Contex
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
-	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndIngAnnotations, targetType)
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, tgProps *elbv2api.TargetGroupProps,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
+	var hcProps *elbv2api.TargetGroupHealthCheckConfig
+	if tgProps != nil {
+		hcProps = tgProps.HealthCheckConfig
+	}
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, svc, svcAndIngAnnotations, hcProps, targetType)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, svcAndIngAnnotations, tgProtocol)
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, svcAndIngAnnotations, hcProps, tgProtocol)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckPath := t.buildTargetGroupHealthCheckPath(ctx, svcAndIngAnnotations, tgProtocolVersion)
-	healthCheckMatcher := t.buildTargetGroupHealthCheckMatcher(ctx, svcAndIngAnnotations, tgProtocolVersion)
-	healthCheckIntervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, svcAndIngAnnotations)
+	healthCheckPath := t.buildTargetGroupHealthCheckPath(ctx, svcAndIngAnnotations, hcProps, tgProtocolVersion)
+	healthCheckMatcher := t.buildTargetGroupHealthCheckMatcher(ctx, svcAndIngAnnotations, hcProps, tgProtocolVersion)
+	healthCheckIntervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, svcAndIngAnnotations, hcProps)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckTimeoutSeconds, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, svcAndIngAnnotations)
+	healthCheckTimeoutSeconds, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, svcAndIngAnnotations, hcProps)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckHealthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, svcAndIngAnnotations)
+	healthCheckHealthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, svcAndIngAnnotations, hcProps)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
-	healthCheckUnhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, svcAndIngAnnotations)
+	healthCheckUnhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, svcAndIngAnnotations, hcProps)
 	if err != nil {
 		return elbv2model.TargetGroupHealthCheckConfig{}, err
 	}
@@ -324,10 +345,12 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Co
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
-	rawHealthCheckPort := ""
-	if exist := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAndIngAnnotations); !exist {
-		return intstr.FromString(healthCheckPortTrafficPort), nil
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
+	rawHealthCheckPort := healthCheckPortTrafficPort
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPort, &rawHealthCheckPort, svcAndIngAnnotations)
+	if hcProps != nil && hcProps.Port != nil {
+		rawHealthCheckPort = hcProps.Port.String()
 	}
 	if rawHealthCheckPort == healthCheckPortTrafficPort {
 		return intstr.FromString(healthCheckPortTrafficPort), nil
@@ -350,9 +373,13 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Contex
 	return intstr.IntOrString{}, errors.New("cannot use named healthCheckPort for IP TargetType when service's targetPort is a named port")
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocol elbv2model.Protocol) (elbv2model.Protocol, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, svcAndIngAnnotations map[string]string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, tgProtocol elbv2model.Protocol) (elbv2model.Protocol, error) {
 	rawHealthCheckProtocol := string(tgProtocol)
 	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckProtocol, &rawHealthCheckProtocol, svcAndIngAnnotations)
+	if hcProps != nil && hcProps.Protocol != nil {
+		rawHealthCheckProtocol = string(*hcProps.Protocol)
+	}
 	switch rawHealthCheckProtocol {
 	case string(elbv2model.ProtocolHTTP):
 		return elbv2model.ProtocolHTTP, nil
@@ -363,7 +390,8 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Co
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocolVersion elbv2model.ProtocolVersion) string {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, svcAndIngAnnotations map[string]string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, tgProtocolVersion elbv2model.ProtocolVersion) string {
 	var rawHealthCheckPath string
 	switch tgProtocolVersion {
 	case elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2:
@@ -372,10 +400,14 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Contex
 		rawHealthCheckPath = t.defaultHealthCheckPathGRPC
 	}
 	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPath, &rawHealthCheckPath, svcAndIngAnnotations)
+	if hcProps != nil && hcProps.Path != nil {
+		rawHealthCheckPath = *hcProps.Path
+	}
 	return rawHealthCheckPath
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, svcAndIngAnnotations map[string]string, tgProtocolVersion elbv2model.ProtocolVersion) elbv2model.HealthCheckMatcher {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, svcAndIngAnnotations map[string]string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, tgProtocolVersion elbv2model.ProtocolVersion) elbv2model.HealthCheckMatcher {
 	var rawHealthCheckMatcherHTTPCode string
 	switch tgProtocolVersion {
 	case elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2:
@@ -385,6 +417,14 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Con
 	}
 
 	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSuccessCodes, &rawHealthCheckMatcherHTTPCode, svcAndIngAnnotations)
+	if hcProps != nil && hcProps.Matcher != nil {
+		if tgProtocolVersion == elbv2model.ProtocolVersionGRPC && hcProps.Matcher.GRPCCode != nil {
+			rawHealthCheckMatcherHTTPCode = *hcProps.Matcher.GRPCCode
+		}
+		if tgProtocolVersion != elbv2model.ProtocolVersionGRPC && hcProps.Matcher.HTTPCode != nil {
+			rawHealthCheckMatcherHTTPCode = *hcProps.Matcher.HTTPCode
+		}
+	}
 	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
 		return elbv2model.HealthCheckMatcher{
 			GRPCCode: &rawHealthCheckMatcherHTTPCode,
@@ -395,47 +435,67 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Con
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, svcAndIngAnnotations map[string]string, hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	rawHealthCheckIntervalSeconds := t.defaultHealthCheckIntervalSeconds
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckIntervalSeconds,
 		&rawHealthCheckIntervalSeconds, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.IntervalSeconds != nil {
+		rawHealthCheckIntervalSeconds = *hcProps.IntervalSeconds
+	}
 	return rawHealthCheckIntervalSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, svcAndIngAnnotations map[string]string, hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	rawHealthCheckTimeoutSeconds := t.defaultHealthCheckTimeoutSeconds
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckTimeoutSeconds,
 		&rawHealthCheckTimeoutSeconds, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.TimeoutSeconds != nil {
+		rawHealthCheckTimeoutSeconds = *hcProps.TimeoutSeconds
+	}
 	return rawHealthCheckTimeoutSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string, hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	rawHealthCheckHealthyThresholdCount := t.defaultHealthCheckHealthyThresholdCount
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthyThresholdCount,
 		&rawHealthCheckHealthyThresholdCount, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.HealthyThresholdCount != nil {
+		rawHealthCheckHealthyThresholdCount = *hcProps.HealthyThresholdCount
+	}
 	return rawHealthCheckHealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, svcAndIngAnnotations map[string]string, hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	rawHealthCheckUnhealthyThresholdCount := t.defaultHealthCheckUnhealthyThresholdCount
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixUnhealthyThresholdCount,
 		&rawHealthCheckUnhealthyThresholdCount, svcAndIngAnnotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.UnhealthyThresholdCount != nil {
+		rawHealthCheckUnhealthyThresholdCount = *hcProps.UnhealthyThresholdCount
+	}
 	return rawHealthCheckUnhealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string, tgProps *elbv2api.TargetGroupProps) ([]elbv2model.TargetGroupAttribute, error) {
 	var rawAttributes map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndIngAnnotations); err != nil {
 		return nil, err
 	}
+	if tgProps != nil && len(tgProps.TargetGroupAttributes) != 0 {
+		if rawAttributes == nil {
+			rawAttributes = make(map[string]string, len(tgProps.TargetGroupAttributes))
+		}
+		for _, attr := range tgProps.TargetGroupAttributes {
+			rawAttributes[attr.Key] = attr.Value
+		}
+	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
@@ -446,12 +506,22 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, sv
 	return attributes, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing ClassifiedIngress, svc *corev1.Service) (map[string]string, error) {
+func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing ClassifiedIngress, svc *corev1.Service, tgProps *elbv2api.TargetGroupProps) (map[string]string, error) {
 	ingSvcTags, err := t.buildIngressBackendResourceTags(ing, svc)
 	if err != nil {
 		return nil, err
 	}
-	return algorithm.MergeStringMap(t.defaultTags, ingSvcTags), nil
+	var tgConfigTags map[string]string
+	if tgProps != nil && len(tgProps.Tags) != 0 {
+		tgConfigTags = make(map[string]string, len(tgProps.Tags))
+		for _, tag := range tgProps.Tags {
+			tgConfigTags[tag.Key] = tag.Value
+		}
+		if err := t.validateTagCollisionWithExternalManagedTags(tgConfigTags); err != nil {
+			return nil, errors.Wrapf(err, "failed build tags from TargetGroupConfiguration for Service %v", k8s.NamespacedName(svc).String())
+		}
+	}
+	return algorithm.MergeStringMap(t.defaultTags, tgConfigTags, ingSvcTags), nil
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
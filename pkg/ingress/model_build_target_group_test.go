@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -201,8 +202,9 @@ func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 		externalManagedTags sets.String
 	}
 	type args struct {
-		ing ClassifiedIngress
-		svc *corev1.Service
+		ing     ClassifiedIngress
+		svc     *corev1.Service
+		tgProps *elbv2api.TargetGroupProps
 	}
 	tests := []struct {
 		name    string
@@ -355,6 +357,81 @@ func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 				"k4": "v4",
 			},
 		},
+		{
+			name: "non-empty default tags, non-empty tags from TargetGroupConfiguration and annotation",
+			fields: fields{
+				defaultTags: map[string]string{
+					"k1": "v1",
+				},
+			},
+			args: args{
+				ing: ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/tags": "k1=v1a,k2=v2a,k3=v3a",
+							},
+						},
+					},
+				},
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "svc-1",
+					},
+				},
+				tgProps: &elbv2api.TargetGroupProps{
+					Tags: []elbv2api.Tag{
+						{
+							Key:   "k1",
+							Value: "v1b",
+						},
+						{
+							Key:   "k2",
+							Value: "v2b",
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"k1": "v1",
+				"k2": "v2b",
+				"k3": "v3a",
+			},
+		},
+		{
+			name: "tags from TargetGroupConfiguration collide with external managed tags",
+			fields: fields{
+				externalManagedTags: sets.NewString("k1"),
+			},
+			args: args{
+				ing: ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+						},
+					},
+				},
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "svc-1",
+					},
+				},
+				tgProps: &elbv2api.TargetGroupProps{
+					Tags: []elbv2api.Tag{
+						{
+							Key:   "k1",
+							Value: "v1",
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed build tags from TargetGroupConfiguration for Service awesome-ns/svc-1: external managed tag key k1 cannot be specified"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -363,7 +440,7 @@ func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 				externalManagedTags: tt.fields.externalManagedTags,
 				annotationParser:    annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
 			}
-			got, err := task.buildTargetGroupTags(context.Background(), tt.args.ing, tt.args.svc)
+			got, err := task.buildTargetGroupTags(context.Background(), tt.args.ing, tt.args.svc, tt.args.tgProps)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -381,6 +458,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 	}
 	type args struct {
 		svcAndIngAnnotations map[string]string
+		hcProps              *elbv2api.TargetGroupHealthCheckConfig
 		tgProtocolVersion    elbv2model.ProtocolVersion
 	}
 	tests := []struct {
@@ -467,6 +545,23 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 			},
 			want: "/package.service/method",
 		},
+		{
+			name: "HTTP1, with both annotation and TargetGroupConfiguration configured",
+			fields: fields{
+				defaultHealthCheckPathHTTP: "/",
+				defaultHealthCheckPathGRPC: "/AWS.ALB/healthcheck",
+			},
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/healthcheck-path": "/ping",
+				},
+				hcProps: &elbv2api.TargetGroupHealthCheckConfig{
+					Path: awssdk.String("/healthz"),
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: "/healthz",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -475,7 +570,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 				defaultHealthCheckPathHTTP: tt.fields.defaultHealthCheckPathHTTP,
 				defaultHealthCheckPathGRPC: tt.fields.defaultHealthCheckPathGRPC,
 			}
-			got := task.buildTargetGroupHealthCheckPath(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupHealthCheckPath(context.Background(), tt.args.svcAndIngAnnotations, tt.args.hcProps, tt.args.tgProtocolVersion)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -488,6 +583,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 	}
 	type args struct {
 		svcAndIngAnnotations map[string]string
+		hcProps              *elbv2api.TargetGroupHealthCheckConfig
 		tgProtocolVersion    elbv2model.ProtocolVersion
 	}
 	tests := []struct {
@@ -586,6 +682,49 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 				GRPCCode: awssdk.String("0"),
 			},
 		},
+		{
+			name: "HTTP1, with both annotation and TargetGroupConfiguration configured",
+			fields: fields{
+				defaultHealthCheckMatcherHTTPCode: "200",
+				defaultHealthCheckMatcherGRPCCode: "12",
+			},
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/success-codes": "200-300",
+				},
+				hcProps: &elbv2api.TargetGroupHealthCheckConfig{
+					Matcher: &elbv2api.HealthCheckMatcher{
+						HTTPCode: awssdk.String("200-399"),
+						GRPCCode: awssdk.String("0-99"),
+					},
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+			},
+			want: elbv2model.HealthCheckMatcher{
+				HTTPCode: awssdk.String("200-399"),
+			},
+		},
+		{
+			name: "GRPC, with TargetGroupConfiguration configured for HTTP only",
+			fields: fields{
+				defaultHealthCheckMatcherHTTPCode: "200",
+				defaultHealthCheckMatcherGRPCCode: "12",
+			},
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/success-codes": "0",
+				},
+				hcProps: &elbv2api.TargetGroupHealthCheckConfig{
+					Matcher: &elbv2api.HealthCheckMatcher{
+						HTTPCode: awssdk.String("200-399"),
+					},
+				},
+				tgProtocolVersion: elbv2model.ProtocolVersionGRPC,
+			},
+			want: elbv2model.HealthCheckMatcher{
+				GRPCCode: awssdk.String("0"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -594,7 +733,7 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 				defaultHealthCheckMatcherHTTPCode: tt.fields.defaultHealthCheckMatcherHTTPCode,
 				defaultHealthCheckMatcherGRPCCode: tt.fields.defaultHealthCheckMatcherGRPCCode,
 			}
-			got := task.buildTargetGroupHealthCheckMatcher(context.Background(), tt.args.svcAndIngAnnotations, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupHealthCheckMatcher(context.Background(), tt.args.svcAndIngAnnotations, tt.args.hcProps, tt.args.tgProtocolVersion)
 			assert.Equal(t, tt.want, got)
 		})
 	}
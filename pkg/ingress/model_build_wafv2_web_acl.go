@@ -0,0 +1,212 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+const (
+	resourceIDWAFv2WebACL = "WebACL"
+)
+
+var invalidWAFv2WebACLNamePattern = regexp.MustCompile(`[^\w-]`)
+
+// buildWAFv2WebACLRef returns the name of WebACL referenced by IngressGroup, along with whether it's referenced by IngressClassParams.
+// the WebACL referenced by IngressClassParams takes higher priority than the one referenced by annotation on Ingresses.
+func (t *defaultModelBuildTask) buildWAFv2WebACLRef(_ context.Context) (string, bool, error) {
+	explicitNamesViaIngClassParams := sets.NewString()
+	explicitNamesViaAnnotation := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WebACL != "" {
+			explicitNamesViaIngClassParams.Insert(member.IngClassConfig.IngClassParams.Spec.WebACL)
+			continue
+		}
+		rawName := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2WebACL, &rawName, member.Ing.Annotations); exists {
+			explicitNamesViaAnnotation.Insert(rawName)
+		}
+	}
+	explicitNames, viaIngClassParams := explicitNamesViaAnnotation, false
+	if len(explicitNamesViaIngClassParams) != 0 {
+		explicitNames, viaIngClassParams = explicitNamesViaIngClassParams, true
+	}
+	if len(explicitNames) == 0 {
+		return "", false, nil
+	}
+	if len(explicitNames) > 1 {
+		return "", false, errors.Errorf("conflicting WAFv2 WebACLs: %v", explicitNames.List())
+	}
+	name, _ := explicitNames.PopAny()
+	return name, viaIngClassParams, nil
+}
+
+// buildWAFv2WebACL builds the webACL provisioned from WebACL with name for IngressGroup.
+// the webACL is shared by the LoadBalancers of all shards, so that it's only built once.
+func (t *defaultModelBuildTask) buildWAFv2WebACL(ctx context.Context, name string) (*wafv2model.WebACL, error) {
+	if t.webACL != nil {
+		return t.webACL, nil
+	}
+	webACL := &elbv2api.WebACL{}
+	if err := t.k8sClient.Get(ctx, types.NamespacedName{Name: name}, webACL); err != nil {
+		return nil, errors.Wrapf(err, "failed to get WebACL: %v", name)
+	}
+	webACLSpec, err := t.buildWAFv2WebACLSpec(ctx, webACL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid WebACL: %v", name)
+	}
+	t.webACL = wafv2model.NewWebACL(t.stack, resourceIDWAFv2WebACL, webACLSpec)
+	return t.webACL, nil
+}
+
+func (t *defaultModelBuildTask) buildWAFv2WebACLSpec(_ context.Context, webACL *elbv2api.WebACL) (wafv2model.WebACLSpec, error) {
+	if webACL.Spec.DefaultAction.Type == elbv2api.WebACLActionTypeCount {
+		return wafv2model.WebACLSpec{}, errors.Errorf("unsupported default action: %v", webACL.Spec.DefaultAction.Type)
+	}
+	defaultAction, err := buildWAFv2WebACLAction(webACL.Spec.DefaultAction, webACL.Spec.CustomResponseBodies)
+	if err != nil {
+		return wafv2model.WebACLSpec{}, err
+	}
+	ruleNames := sets.NewString()
+	rules := make([]wafv2model.Rule, 0, len(webACL.Spec.Rules))
+	for _, rule := range webACL.Spec.Rules {
+		if ruleNames.Has(rule.Name) {
+			return wafv2model.WebACLSpec{}, errors.Errorf("duplicate rule: %v", rule.Name)
+		}
+		ruleNames.Insert(rule.Name)
+		modelRule, err := buildWAFv2WebACLRule(rule, webACL.Spec.CustomResponseBodies)
+		if err != nil {
+			return wafv2model.WebACLSpec{}, errors.Wrapf(err, "invalid rule %v", rule.Name)
+		}
+		rules = append(rules, modelRule)
+	}
+	var customResponseBodies map[string]wafv2model.CustomResponseBody
+	if len(webACL.Spec.CustomResponseBodies) != 0 {
+		customResponseBodies = make(map[string]wafv2model.CustomResponseBody, len(webACL.Spec.CustomResponseBodies))
+		for key, body := range webACL.Spec.CustomResponseBodies {
+			customResponseBodies[key] = wafv2model.CustomResponseBody{
+				ContentType: string(body.ContentType),
+				Content:     body.Content,
+			}
+		}
+	}
+	metricsEnabled := true
+	if webACL.Spec.MetricsEnabled != nil {
+		metricsEnabled = *webACL.Spec.MetricsEnabled
+	}
+	webACLTags := make(map[string]string, len(webACL.Spec.Tags))
+	for _, tag := range webACL.Spec.Tags {
+		webACLTags[tag.Key] = tag.Value
+	}
+	return wafv2model.WebACLSpec{
+		Name:                 t.buildWAFv2WebACLName(webACL.Name),
+		Description:          webACL.Spec.Description,
+		DefaultAction:        defaultAction,
+		Rules:                rules,
+		CustomResponseBodies: customResponseBodies,
+		MetricsEnabled:       metricsEnabled,
+		Tags:                 algorithm.MergeStringMap(t.defaultTags, webACLTags),
+	}, nil
+}
+
+// buildWAFv2WebACLName builds the name of webACL provisioned for IngressGroup, which is unique per cluster and IngressGroup.
+func (t *defaultModelBuildTask) buildWAFv2WebACLName(webACLName string) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+	sanitizedName := invalidWAFv2WebACLNamePattern.ReplaceAllString(webACLName, "-")
+	// the name of webACL can only have up to 128 characters.
+	return fmt.Sprintf("k8s-%.10s-%.113s", uuid, sanitizedName)
+}
+
+func buildWAFv2WebACLRule(rule elbv2api.WebACLRule, customResponseBodies map[string]elbv2api.WebACLCustomResponseBody) (wafv2model.Rule, error) {
+	modelRule := wafv2model.Rule{
+		Name:     rule.Name,
+		Priority: rule.Priority,
+	}
+	statementCount := 0
+	if rule.ManagedRuleGroup != nil {
+		statementCount++
+		modelRule.ManagedRuleGroup = &wafv2model.ManagedRuleGroupStatement{
+			VendorName:    rule.ManagedRuleGroup.VendorName,
+			Name:          rule.ManagedRuleGroup.Name,
+			Version:       rule.ManagedRuleGroup.Version,
+			ExcludedRules: rule.ManagedRuleGroup.ExcludedRules,
+		}
+	}
+	if rule.RateBased != nil {
+		statementCount++
+		modelRule.RateBased = &wafv2model.RateBasedStatement{
+			Limit:             rule.RateBased.Limit,
+			ForwardedIPHeader: rule.RateBased.ForwardedIPHeader,
+		}
+	}
+	if rule.IPSet != nil {
+		statementCount++
+		if (len(rule.IPSet.Addresses) == 0) == (rule.IPSet.ARN == "") {
+			return wafv2model.Rule{}, errors.New("exactly one of addresses or arn must be specified for ipSet")
+		}
+		modelRule.IPSetReference = &wafv2model.IPSetReferenceStatement{
+			ARN:              rule.IPSet.ARN,
+			IPAddressVersion: string(rule.IPSet.IPAddressVersion),
+			Addresses:        rule.IPSet.Addresses,
+		}
+	}
+	if statementCount != 1 {
+		return wafv2model.Rule{}, errors.New("exactly one of managedRuleGroup, rateBased or ipSet must be specified")
+	}
+
+	if modelRule.ManagedRuleGroup != nil {
+		if rule.Action != nil {
+			return wafv2model.Rule{}, errors.New("action is not supported for managedRuleGroup, use overrideAction instead")
+		}
+		if rule.OverrideAction != nil {
+			overrideAction := wafv2model.OverrideActionType(*rule.OverrideAction)
+			modelRule.OverrideAction = &overrideAction
+		}
+		return modelRule, nil
+	}
+	if rule.OverrideAction != nil {
+		return wafv2model.Rule{}, errors.New("overrideAction is only supported for managedRuleGroup")
+	}
+	if rule.Action != nil {
+		action, err := buildWAFv2WebACLAction(*rule.Action, customResponseBodies)
+		if err != nil {
+			return wafv2model.Rule{}, err
+		}
+		modelRule.Action = &action
+	}
+	return modelRule, nil
+}
+
+func buildWAFv2WebACLAction(action elbv2api.WebACLAction, customResponseBodies map[string]elbv2api.WebACLCustomResponseBody) (wafv2model.Action, error) {
+	modelAction := wafv2model.Action{
+		Type: wafv2model.ActionType(action.Type),
+	}
+	if action.CustomResponse == nil {
+		return modelAction, nil
+	}
+	if action.Type != elbv2api.WebACLActionTypeBlock {
+		return wafv2model.Action{}, errors.Errorf("customResponse is only supported for %v action", elbv2api.WebACLActionTypeBlock)
+	}
+	bodyKey := action.CustomResponse.CustomResponseBodyKey
+	if _, exists := customResponseBodies[bodyKey]; bodyKey != "" && !exists {
+		return wafv2model.Action{}, errors.Errorf("unknown customResponseBodyKey: %v", bodyKey)
+	}
+	modelAction.CustomResponse = &wafv2model.CustomResponse{
+		ResponseCode:          action.CustomResponse.ResponseCode,
+		CustomResponseBodyKey: bodyKey,
+	}
+	return modelAction, nil
+}
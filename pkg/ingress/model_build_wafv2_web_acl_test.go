@@ -0,0 +1,399 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation_withWebACL(t *testing.T) {
+	webACL := &elbv2api.WebACL{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-web-acl",
+		},
+		Spec: elbv2api.WebACLSpec{
+			DefaultAction: elbv2api.WebACLAction{Type: elbv2api.WebACLActionTypeAllow},
+			Rules: []elbv2api.WebACLRule{
+				{
+					Name:     "common",
+					Priority: 0,
+					ManagedRuleGroup: &elbv2api.WebACLManagedRuleGroup{
+						VendorName: "AWS",
+						Name:       "AWSManagedRulesCommonRuleSet",
+					},
+				},
+			},
+			Tags: []elbv2api.Tag{{Key: "team", Value: "security"}},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			WebACL: "my-web-acl",
+		},
+	}
+	wantWebACLSpec := wafv2model.WebACLSpec{
+		Name:          "k8s-50383175f3-my-web-acl",
+		DefaultAction: wafv2model.Action{Type: wafv2model.ActionTypeAllow},
+		Rules: []wafv2model.Rule{
+			{
+				Name:     "common",
+				Priority: 0,
+				ManagedRuleGroup: &wafv2model.ManagedRuleGroupStatement{
+					VendorName: "AWS",
+					Name:       "AWSManagedRulesCommonRuleSet",
+				},
+			},
+		},
+		MetricsEnabled: true,
+		Tags: map[string]string{
+			"cluster-tag": "value",
+			"team":        "security",
+		},
+	}
+	tests := []struct {
+		name           string
+		members        []ClassifiedIngress
+		wantWebACLSpec *wafv2model.WebACLSpec
+		wantErr        error
+	}{
+		{
+			name: "when WebACL is referenced by annotation",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-0",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+							},
+						},
+					},
+				},
+			},
+			wantWebACLSpec: &wantWebACLSpec,
+		},
+		{
+			name: "when WebACL is referenced by IngressClassParams, it takes precedence over annotations",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-0",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "other-web-acl",
+								"alb.ingress.kubernetes.io/wafv2-acl-arn": "wafv2-arn-1",
+							},
+						},
+						Spec: networking.IngressSpec{
+							IngressClassName: awssdk.String("awesome-class"),
+						},
+					},
+					IngClassConfig: ClassConfiguration{
+						IngClassParams: ingClassParams,
+					},
+				},
+			},
+			wantWebACLSpec: &wantWebACLSpec,
+		},
+		{
+			name: "when WebACL is referenced by annotation along with wafv2-acl-arn",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-0",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+							},
+						},
+					},
+				},
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-acl-arn": "wafv2-arn-1",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting WAFv2 WebACL my-web-acl and WebACL ARNs: [wafv2-arn-1]"),
+		},
+		{
+			name: "when different WebACLs are referenced",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-0",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+							},
+						},
+					},
+				},
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "other-web-acl",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting WAFv2 WebACLs: [my-web-acl other-web-acl]"),
+		},
+		{
+			name: "when referenced WebACL doesn't exist",
+			members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing-0",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/wafv2-web-acl": "other-web-acl",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New(`failed to get WebACL: other-web-acl: webacls.elbv2.k8s.aws "other-web-acl" not found`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(webACL).Build()
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+			task := &defaultModelBuildTask{
+				k8sClient:        k8sClient,
+				clusterName:      "cluster-name",
+				ingGroup:         Group{ID: GroupID{Name: "awesome-group"}, Members: tt.members},
+				stack:            stack,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultTags:      map[string]string{"cluster-tag": "value"},
+			}
+			lbARN := core.LiteralStringToken("awesome-lb-arn")
+			got, err := task.buildWAFv2WebACLAssociation(context.Background(), lbARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			opts := cmpopts.IgnoreTypes(core.ResourceMeta{})
+			assert.True(t, cmp.Equal(*tt.wantWebACLSpec, task.webACL.Spec, opts), "diff", cmp.Diff(*tt.wantWebACLSpec, task.webACL.Spec, opts))
+			task.webACL.SetStatus(wafv2model.WebACLStatus{WebACLARN: "web-acl-arn"})
+			webACLARN, err := got.Spec.WebACLARN.Resolve(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "web-acl-arn", webACLARN)
+			assert.Equal(t, lbARN, got.Spec.ResourceARN)
+
+			// the webACL is shared by LoadBalancers of all shards.
+			task.shardIndex = 1
+			_, err = task.buildWAFv2WebACLAssociation(context.Background(), lbARN)
+			assert.NoError(t, err)
+			var resWebACLs []*wafv2model.WebACL
+			assert.NoError(t, stack.ListResources(&resWebACLs))
+			assert.Len(t, resWebACLs, 1)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildWAFv2WebACLSpec(t *testing.T) {
+	blockAction := elbv2api.WebACLAction{Type: elbv2api.WebACLActionTypeBlock}
+	rateBased := &elbv2api.WebACLRateBasedRule{Limit: 1000}
+	tests := []struct {
+		name    string
+		spec    elbv2api.WebACLSpec
+		want    wafv2model.WebACLSpec
+		wantErr error
+	}{
+		{
+			name: "rules with custom responses and metrics disabled",
+			spec: elbv2api.WebACLSpec{
+				Description:   "my web acl",
+				DefaultAction: elbv2api.WebACLAction{Type: elbv2api.WebACLActionTypeAllow},
+				Rules: []elbv2api.WebACLRule{
+					{
+						Name:     "rate-limit",
+						Priority: 0,
+						Action: &elbv2api.WebACLAction{
+							Type: elbv2api.WebACLActionTypeBlock,
+							CustomResponse: &elbv2api.WebACLCustomResponse{
+								ResponseCode:          429,
+								CustomResponseBodyKey: "too-many-requests",
+							},
+						},
+						RateBased: &elbv2api.WebACLRateBasedRule{Limit: 1000, ForwardedIPHeader: "X-Forwarded-For"},
+					},
+					{
+						Name:     "blocked-ips",
+						Priority: 1,
+						IPSet: &elbv2api.WebACLIPSetRule{
+							IPAddressVersion: elbv2api.WebACLIPAddressVersionIPV6,
+							Addresses:        []string{"2001:db8::/32"},
+						},
+					},
+				},
+				CustomResponseBodies: map[string]elbv2api.WebACLCustomResponseBody{
+					"too-many-requests": {ContentType: elbv2api.WebACLResponseContentTypeTextPlain, Content: "slow down"},
+				},
+				MetricsEnabled: awssdk.Bool(false),
+			},
+			want: wafv2model.WebACLSpec{
+				Name:          "k8s-50383175f3-my-web-acl",
+				Description:   "my web acl",
+				DefaultAction: wafv2model.Action{Type: wafv2model.ActionTypeAllow},
+				Rules: []wafv2model.Rule{
+					{
+						Name:     "rate-limit",
+						Priority: 0,
+						Action: &wafv2model.Action{
+							Type: wafv2model.ActionTypeBlock,
+							CustomResponse: &wafv2model.CustomResponse{
+								ResponseCode:          429,
+								CustomResponseBodyKey: "too-many-requests",
+							},
+						},
+						RateBased: &wafv2model.RateBasedStatement{Limit: 1000, ForwardedIPHeader: "X-Forwarded-For"},
+					},
+					{
+						Name:     "blocked-ips",
+						Priority: 1,
+						IPSetReference: &wafv2model.IPSetReferenceStatement{
+							IPAddressVersion: "IPV6",
+							Addresses:        []string{"2001:db8::/32"},
+						},
+					},
+				},
+				CustomResponseBodies: map[string]wafv2model.CustomResponseBody{
+					"too-many-requests": {ContentType: "TEXT_PLAIN", Content: "slow down"},
+				},
+				MetricsEnabled: false,
+				Tags:           map[string]string{},
+			},
+		},
+		{
+			name: "default action cannot be Count",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: elbv2api.WebACLAction{Type: elbv2api.WebACLActionTypeCount},
+			},
+			wantErr: errors.New("unsupported default action: Count"),
+		},
+		{
+			name: "rule names must be unique",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: blockAction,
+				Rules: []elbv2api.WebACLRule{
+					{Name: "rule", Priority: 0, RateBased: rateBased},
+					{Name: "rule", Priority: 1, RateBased: rateBased},
+				},
+			},
+			wantErr: errors.New("duplicate rule: rule"),
+		},
+		{
+			name: "rule must have exactly one statement",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: blockAction,
+				Rules: []elbv2api.WebACLRule{
+					{Name: "rule", Priority: 0, RateBased: rateBased, IPSet: &elbv2api.WebACLIPSetRule{ARN: "ip-set-arn"}},
+				},
+			},
+			wantErr: errors.New("invalid rule rule: exactly one of managedRuleGroup, rateBased or ipSet must be specified"),
+		},
+		{
+			name: "ipSet must have exactly one of addresses or arn",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: blockAction,
+				Rules: []elbv2api.WebACLRule{
+					{Name: "rule", Priority: 0, IPSet: &elbv2api.WebACLIPSetRule{}},
+				},
+			},
+			wantErr: errors.New("invalid rule rule: exactly one of addresses or arn must be specified for ipSet"),
+		},
+		{
+			name: "custom response is only allowed for Block action",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: elbv2api.WebACLAction{
+					Type:           elbv2api.WebACLActionTypeAllow,
+					CustomResponse: &elbv2api.WebACLCustomResponse{ResponseCode: 403},
+				},
+			},
+			wantErr: errors.New("customResponse is only supported for Block action"),
+		},
+		{
+			name: "custom response body must exist",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: elbv2api.WebACLAction{
+					Type:           elbv2api.WebACLActionTypeBlock,
+					CustomResponse: &elbv2api.WebACLCustomResponse{ResponseCode: 403, CustomResponseBodyKey: "forbidden"},
+				},
+			},
+			wantErr: errors.New("unknown customResponseBodyKey: forbidden"),
+		},
+		{
+			name: "action isn't allowed for managedRuleGroup",
+			spec: elbv2api.WebACLSpec{
+				DefaultAction: blockAction,
+				Rules: []elbv2api.WebACLRule{
+					{
+						Name:             "rule",
+						Priority:         0,
+						Action:           &blockAction,
+						ManagedRuleGroup: &elbv2api.WebACLManagedRuleGroup{VendorName: "AWS", Name: "AWSManagedRulesCommonRuleSet"},
+					},
+				},
+			},
+			wantErr: errors.New("invalid rule rule: action is not supported for managedRuleGroup, use overrideAction instead"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				clusterName: "cluster-name",
+				ingGroup:    Group{ID: GroupID{Name: "awesome-group"}},
+			}
+			webACL := &elbv2api.WebACL{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-web-acl"},
+				Spec:       tt.spec,
+			}
+			got, err := task.buildWAFv2WebACLSpec(context.Background(), webACL)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
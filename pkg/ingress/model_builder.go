@@ -14,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
@@ -22,6 +24,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -33,7 +36,28 @@ const (
 // ModelBuilder is responsible for build mode stack for a IngressGroup.
 type ModelBuilder interface {
 	// build mode stack for a IngressGroup.
-	Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error)
+	// the LoadBalancer of each member Ingress is returned along with the stack, members may spill into overflow LoadBalancers.
+	Build(ctx context.Context, ingGroup Group, opts ...ModelBuildOption) (core.Stack, map[types.NamespacedName]*elbv2model.LoadBalancer, []types.NamespacedName, bool, error)
+}
+
+type ModelBuildOptions struct {
+	// whether the model is built only to be planned, side effects such as importing TLS secrets into ACM are skipped if so.
+	PlanOnly bool
+}
+
+type ModelBuildOption func(opts *ModelBuildOptions)
+
+func (opts *ModelBuildOptions) ApplyOptions(options ...ModelBuildOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+// WithPlanOnly is a option that sets the PlanOnly.
+func WithPlanOnly(planOnly bool) ModelBuildOption {
+	return func(opts *ModelBuildOptions) {
+		opts.PlanOnly = planOnly
+	}
 }
 
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
@@ -44,9 +68,11 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
 	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string,
 	backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver,
-	enableBackendSG bool, disableRestrictedSGRules bool, allowedCAARNs []string, enableIPTargetType bool, logger logr.Logger) *defaultModelBuilder {
-	certDiscovery := NewACMCertDiscovery(acmClient, allowedCAARNs, logger)
+	enableBackendSG bool, disableRestrictedSGRules bool, certDiscoveryFilters CertDiscoveryFilters, enableIPTargetType bool, listenerRulesLimit int,
+	tlsSecretCertImporter TLSSecretCertImporter, assumeRole *aws.AssumeRoleConfig, logger logr.Logger) *defaultModelBuilder {
+	certDiscovery := NewACMCertDiscovery(acmClient, certDiscoveryFilters, logger)
 	ruleOptimizer := NewDefaultRuleOptimizer(logger)
+	tgConfigLoader := config.NewDefaultTargetGroupConfigurationLoader(k8sClient)
 	return &defaultModelBuilder{
 		k8sClient:                k8sClient,
 		eventRecorder:            eventRecorder,
@@ -54,14 +80,17 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		elbv2Client:              elbv2Client,
 		vpcID:                    vpcID,
 		clusterName:              clusterName,
+		assumeRole:               assumeRole,
 		annotationParser:         annotationParser,
 		subnetsResolver:          subnetsResolver,
 		backendSGProvider:        backendSGProvider,
 		sgResolver:               sgResolver,
 		certDiscovery:            certDiscovery,
+		tlsSecretCertImporter:    tlsSecretCertImporter,
 		authConfigBuilder:        authConfigBuilder,
 		enhancedBackendBuilder:   enhancedBackendBuilder,
 		ruleOptimizer:            ruleOptimizer,
+		tgConfigLoader:           tgConfigLoader,
 		trackingProvider:         trackingProvider,
 		elbv2TaggingManager:      elbv2TaggingManager,
 		featureGates:             featureGates,
@@ -72,6 +101,7 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
 		enableIPTargetType:       enableIPTargetType,
+		listenerRulesLimit:       listenerRulesLimit,
 		logger:                   logger,
 	}
 }
@@ -87,15 +117,20 @@ type defaultModelBuilder struct {
 
 	vpcID       string
 	clusterName string
-
-	annotationParser         annotations.Parser
-	subnetsResolver          networkingpkg.SubnetsResolver
-	backendSGProvider        networkingpkg.BackendSGProvider
-	sgResolver               networkingpkg.SecurityGroupResolver
-	certDiscovery            CertDiscovery
+	// assumeRole is the IAM role assumed to provision resources in another AWS account, nil for the controller's own account.
+	assumeRole *aws.AssumeRoleConfig
+
+	annotationParser  annotations.Parser
+	subnetsResolver   networkingpkg.SubnetsResolver
+	backendSGProvider networkingpkg.BackendSGProvider
+	sgResolver        networkingpkg.SecurityGroupResolver
+	certDiscovery     CertDiscovery
+	// tlsSecretCertImporter imports the TLS secrets of Ingress TLS blocks into ACM, nil if TLS secrets import is disabled.
+	tlsSecretCertImporter    TLSSecretCertImporter
 	authConfigBuilder        AuthConfigBuilder
 	enhancedBackendBuilder   EnhancedBackendBuilder
 	ruleOptimizer            RuleOptimizer
+	tgConfigLoader           config.TargetGroupConfigurationLoader
 	trackingProvider         tracking.Provider
 	elbv2TaggingManager      elbv2deploy.TaggingManager
 	featureGates             config.FeatureGates
@@ -106,12 +141,15 @@ type defaultModelBuilder struct {
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	enableIPTargetType       bool
+	listenerRulesLimit       int
 
 	logger logr.Logger
 }
 
 // build mode stack for a IngressGroup.
-func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error) {
+func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group, opts ...ModelBuildOption) (core.Stack, map[types.NamespacedName]*elbv2model.LoadBalancer, []types.NamespacedName, bool, error) {
+	buildOpts := ModelBuildOptions{}
+	buildOpts.ApplyOptions(opts...)
 	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
 	task := &defaultModelBuildTask{
 		k8sClient:                b.k8sClient,
@@ -120,12 +158,15 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		elbv2Client:              b.elbv2Client,
 		vpcID:                    b.vpcID,
 		clusterName:              b.clusterName,
+		assumeRole:               b.assumeRole,
 		annotationParser:         b.annotationParser,
 		subnetsResolver:          b.subnetsResolver,
 		certDiscovery:            b.certDiscovery,
+		tlsSecretCertImporter:    b.tlsSecretCertImporter,
 		authConfigBuilder:        b.authConfigBuilder,
 		enhancedBackendBuilder:   b.enhancedBackendBuilder,
 		ruleOptimizer:            b.ruleOptimizer,
+		tgConfigLoader:           b.tgConfigLoader,
 		trackingProvider:         b.trackingProvider,
 		elbv2TaggingManager:      b.elbv2TaggingManager,
 		featureGates:             b.featureGates,
@@ -135,9 +176,11 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		enableBackendSG:          b.enableBackendSG,
 		disableRestrictedSGRules: b.disableRestrictedSGRules,
 		enableIPTargetType:       b.enableIPTargetType,
+		listenerRulesLimit:       b.listenerRulesLimit,
 
 		ingGroup: ingGroup,
 		stack:    stack,
+		planOnly: buildOpts.PlanOnly,
 
 		defaultTags:                               b.defaultTags,
 		externalManagedTags:                       b.externalManagedTags,
@@ -156,14 +199,15 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		defaultHealthCheckMatcherHTTPCode:         "200",
 		defaultHealthCheckMatcherGRPCCode:         "12",
 
-		loadBalancer:    nil,
-		tgByResID:       make(map[string]*elbv2model.TargetGroup),
-		backendServices: make(map[types.NamespacedName]*corev1.Service),
+		loadBalancer:          nil,
+		loadBalancerByIngress: make(map[types.NamespacedName]*elbv2model.LoadBalancer),
+		tgByResID:             make(map[string]*elbv2model.TargetGroup),
+		backendServices:       make(map[types.NamespacedName]*corev1.Service),
 	}
 	if err := task.run(ctx); err != nil {
 		return nil, nil, nil, false, err
 	}
-	return task.stack, task.loadBalancer, task.secretKeys, task.backendSGAllocated, nil
+	return task.stack, task.loadBalancerByIngress, task.secretKeys, task.backendSGAllocated, nil
 }
 
 // the default model build task
@@ -174,14 +218,17 @@ type defaultModelBuildTask struct {
 	elbv2Client            services.ELBV2
 	vpcID                  string
 	clusterName            string
+	assumeRole             *aws.AssumeRoleConfig
 	annotationParser       annotations.Parser
 	subnetsResolver        networkingpkg.SubnetsResolver
 	backendSGProvider      networkingpkg.BackendSGProvider
 	sgResolver             networkingpkg.SecurityGroupResolver
 	certDiscovery          CertDiscovery
+	tlsSecretCertImporter  TLSSecretCertImporter
 	authConfigBuilder      AuthConfigBuilder
 	enhancedBackendBuilder EnhancedBackendBuilder
 	ruleOptimizer          RuleOptimizer
+	tgConfigLoader         config.TargetGroupConfigurationLoader
 	trackingProvider       tracking.Provider
 	elbv2TaggingManager    elbv2deploy.TaggingManager
 	featureGates           config.FeatureGates
@@ -190,11 +237,16 @@ type defaultModelBuildTask struct {
 	ingGroup                 Group
 	sslRedirectConfig        *SSLRedirectConfig
 	stack                    core.Stack
+	planOnly                 bool
 	backendSGIDToken         core.StringToken
 	backendSGAllocated       bool
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	enableIPTargetType       bool
+	// listenerRulesLimit is the maximum count of listener rules per LoadBalancer, zero means unlimited.
+	listenerRulesLimit int
+	// shardIndex is the index of LoadBalancer being built, non-zero for overflow LoadBalancers.
+	shardIndex int
 
 	defaultTags                               map[string]string
 	externalManagedTags                       sets.String
@@ -213,10 +265,15 @@ type defaultModelBuildTask struct {
 	defaultHealthCheckMatcherHTTPCode         string
 	defaultHealthCheckMatcherGRPCCode         string
 
-	loadBalancer    *elbv2model.LoadBalancer
-	tgByResID       map[string]*elbv2model.TargetGroup
-	backendServices map[types.NamespacedName]*corev1.Service
-	secretKeys      []types.NamespacedName
+	loadBalancer          *elbv2model.LoadBalancer
+	loadBalancerByIngress map[types.NamespacedName]*elbv2model.LoadBalancer
+	tgByResID             map[string]*elbv2model.TargetGroup
+	backendServices       map[types.NamespacedName]*corev1.Service
+	secretKeys            []types.NamespacedName
+	// webACL is the webACL provisioned for IngressGroup, which is shared by LoadBalancers of all shards.
+	webACL *wafv2model.WebACL
+	// lbConfigByIngress is the LoadBalancerConfiguration settings for member Ingresses that reference one.
+	lbConfigByIngress map[types.NamespacedName]*elbv2api.LoadBalancerConfigurationSpec
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
@@ -234,60 +291,110 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	if len(t.ingGroup.Members) == 0 {
 		return nil
 	}
+	if err := t.loadLoadBalancerConfigurations(ctx); err != nil {
+		return err
+	}
 
-	ingListByPort := make(map[int64][]ClassifiedIngress)
-	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
+	listenPortConfigByPortByIngress := make(map[types.NamespacedName]map[int64]listenPortConfig, len(t.ingGroup.Members))
 	for _, member := range t.ingGroup.Members {
 		ingKey := k8s.NamespacedName(member.Ing)
 		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, &member)
 		if err != nil {
 			return errors.Wrapf(err, "ingress: %v", ingKey.String())
 		}
-		for port, cfg := range listenPortConfigByPortForIngress {
-			ingListByPort[port] = append(ingListByPort[port], member)
-			listenPortConfigsByPort[port] = append(listenPortConfigsByPort[port], listenPortConfigWithIngress{
-				ingKey:           ingKey,
-				listenPortConfig: cfg,
-			})
-		}
-	}
-
-	listenPortConfigByPort := make(map[int64]listenPortConfig)
-	for port, cfgs := range listenPortConfigsByPort {
-		mergedCfg, err := t.mergeListenPortConfigs(ctx, cfgs)
-		if err != nil {
-			return errors.Wrapf(err, "failed to merge listenPort config for port: %v", port)
-		}
-		listenPortConfigByPort[port] = mergedCfg
+		listenPortConfigByPortByIngress[ingKey] = listenPortConfigByPortForIngress
 	}
 
-	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	_, listenPortConfigByPort, err := t.mergeIngressListenPortConfigs(ctx, t.ingGroup.Members, listenPortConfigByPortByIngress)
 	if err != nil {
 		return err
 	}
-
 	t.sslRedirectConfig, err = t.buildSSLRedirectConfig(ctx, listenPortConfigByPort)
 	if err != nil {
 		return err
 	}
+
+	shards, err := t.buildLoadBalancerShards(ctx, listenPortConfigByPortByIngress)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		t.shardIndex = 0
+	}()
+	for shardIndex, shardMembers := range shards {
+		// the LoadBalancer of an empty shard is deleted, while other shards keep their index.
+		if len(shardMembers) == 0 {
+			continue
+		}
+		t.shardIndex = shardIndex
+		lb, err := t.buildLoadBalancerShard(ctx, shardMembers, listenPortConfigByPortByIngress)
+		if err != nil {
+			return err
+		}
+		for _, member := range shardMembers {
+			t.loadBalancerByIngress[k8s.NamespacedName(member.Ing)] = lb
+		}
+	}
+	return nil
+}
+
+// buildLoadBalancerShard builds the LoadBalancer of current shard along with listeners and rules for members of the shard.
+// settings of LoadBalancer are shared by all shards and come from all members of IngressGroup.
+func (t *defaultModelBuildTask) buildLoadBalancerShard(ctx context.Context, shardMembers []ClassifiedIngress,
+	listenPortConfigByPortByIngress map[types.NamespacedName]map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	ingListByPort, listenPortConfigByPort, err := t.mergeIngressListenPortConfigs(ctx, shardMembers, listenPortConfigByPortByIngress)
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	if err != nil {
+		return nil, err
+	}
+
 	for port, cfg := range listenPortConfigByPort {
 		ingList := ingListByPort[port]
 		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg, ingList)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, cfg.protocol, ingList); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := t.buildLoadBalancerAddOns(ctx, lb.LoadBalancerARN()); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return lb, nil
+}
+
+// mergeIngressListenPortConfigs merges the listen port configs of members by port,
+// it returns the Ingresses listening on each port along with the merged listen port config of each port.
+func (t *defaultModelBuildTask) mergeIngressListenPortConfigs(ctx context.Context, members []ClassifiedIngress,
+	listenPortConfigByPortByIngress map[types.NamespacedName]map[int64]listenPortConfig) (map[int64][]ClassifiedIngress, map[int64]listenPortConfig, error) {
+	ingListByPort := make(map[int64][]ClassifiedIngress)
+	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
+	for _, member := range members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		for port, cfg := range listenPortConfigByPortByIngress[ingKey] {
+			ingListByPort[port] = append(ingListByPort[port], member)
+			listenPortConfigsByPort[port] = append(listenPortConfigsByPort[port], listenPortConfigWithIngress{
+				ingKey:           ingKey,
+				listenPortConfig: cfg,
+			})
+		}
+	}
+
+	listenPortConfigByPort := make(map[int64]listenPortConfig)
+	for port, cfgs := range listenPortConfigsByPort {
+		mergedCfg, err := t.mergeListenPortConfigs(ctx, cfgs)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to merge listenPort config for port: %v", port)
+		}
+		listenPortConfigByPort[port] = mergedCfg
+	}
+	return ingListByPort, listenPortConfigByPort, nil
 }
 
 func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listenPortConfigs []listenPortConfigWithIngress) (listenPortConfig, error) {
@@ -81,7 +81,7 @@ const baseStackJSON = `
             }
         },
         "AWS::ElasticLoadBalancingV2::ListenerRule":{
-            "80:1":{
+            "80:0c0332b8728af1cc":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -121,7 +121,7 @@ const baseStackJSON = `
                     ]
                 }
             },
-            "80:2":{
+            "80:ed52a96b8b6a56cd":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -161,7 +161,7 @@ const baseStackJSON = `
                     ]
                 }
             },
-            "80:3":{
+            "80:0ea85809ce756352":{
                 "spec":{
                     "listenerARN":{
                         "$ref":"#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
@@ -446,7 +446,8 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 		err     error
 	}
 	type env struct {
-		svcs []*corev1.Service
+		svcs      []*corev1.Service
+		tgConfigs []*v1beta1.TargetGroupConfiguration
 	}
 	type listLoadBalancersCall struct {
 		matchedLBs []elbv2.LoadBalancerWithTags
@@ -1144,7 +1145,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			"80": null
 		},
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"443:1": {
+			"443:0c0332b8728af1cc": {
 				"spec": {
 					"actions": [
 						{
@@ -1184,7 +1185,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"priority": 1
 				}
 			},
-			"443:2": {
+			"443:0ea85809ce756352": {
 				"spec": {
 					"actions": [
 						{
@@ -1192,7 +1193,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
 										}
 									}
 								]
@@ -1205,7 +1206,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-1.example.com"
+									"app-2.example.com"
 								]
 							}
 						},
@@ -1213,7 +1214,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-2"
+									"/svc-3"
 								]
 							}
 						}
@@ -1221,10 +1222,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 2
+					"priority": 3
 				}
 			},
-			"443:3": {
+			"443:ed52a96b8b6a56cd": {
 				"spec": {
 					"actions": [
 						{
@@ -1232,7 +1233,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
 										}
 									}
 								]
@@ -1245,7 +1246,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-2.example.com"
+									"app-1.example.com"
 								]
 							}
 						},
@@ -1253,7 +1254,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-3"
+									"/svc-2"
 								]
 							}
 						}
@@ -1261,12 +1262,12 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 3
+					"priority": 2
 				}
 			},
-			"80:1": null,
-			"80:2": null,
-			"80:3": null
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::LoadBalancer": {
 			"LoadBalancer": {
@@ -1350,8 +1351,24 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 {
 	"resources": {
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"80:1": {
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:1e91fc2b1dadd0e0": {
 				"spec": {
+					"actions": [
+						{
+							"forwardConfig": {
+								"targetGroups": [
+									{
+										"targetGroupARN": {
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-1:80/status/targetGroupARN"
+										}
+									}
+								]
+							},
+							"type": "forward"
+						}
+					],
 					"conditions": [
 						{
 							"field": "host-header",
@@ -1365,14 +1382,18 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-1-name"
+									"/svc-1-port"
 								]
 							}
 						}
-					]
+					],
+					"listenerARN": {
+						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+					},
+					"priority": 2
 				}
 			},
-			"80:2": {
+			"80:aaf0b4b40e573b31": {
 				"spec": {
 					"actions": [
 						{
@@ -1380,7 +1401,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-1:80/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-1:http/status/targetGroupARN"
 										}
 									}
 								]
@@ -1401,14 +1422,18 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-1-port"
+									"/svc-1-name"
 								]
 							}
 						}
-					]
+					],
+					"listenerARN": {
+						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+					},
+					"priority": 1
 				}
 			},
-			"80:3": null
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::TargetGroup": {
 			"ns-1/ing-1-svc-1:80": {
@@ -1750,7 +1775,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			"80": null
 		},
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"443:1": {
+			"443:0c0332b8728af1cc": {
 				"spec": {
 					"actions": [
 						{
@@ -1790,7 +1815,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"priority": 1
 				}
 			},
-			"443:2": {
+			"443:0ea85809ce756352": {
 				"spec": {
 					"actions": [
 						{
@@ -1798,7 +1823,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
 										}
 									}
 								]
@@ -1811,7 +1836,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-1.example.com"
+									"app-2.example.com"
 								]
 							}
 						},
@@ -1819,7 +1844,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-2"
+									"/svc-3"
 								]
 							}
 						}
@@ -1827,10 +1852,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 2
+					"priority": 3
 				}
 			},
-			"443:3": {
+			"443:ed52a96b8b6a56cd": {
 				"spec": {
 					"actions": [
 						{
@@ -1838,7 +1863,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
 										}
 									}
 								]
@@ -1851,7 +1876,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-2.example.com"
+									"app-1.example.com"
 								]
 							}
 						},
@@ -1859,7 +1884,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-3"
+									"/svc-2"
 								]
 							}
 						}
@@ -1867,12 +1892,12 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 3
+					"priority": 2
 				}
 			},
-			"80:1": null,
-			"80:2": null,
-			"80:3": null
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:ed52a96b8b6a56cd": null
 		}
 	}
 }`,
@@ -2015,7 +2040,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			"80": null
 		},
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"443:1": {
+			"443:0c0332b8728af1cc": {
 				"spec": {
 					"actions": [
 						{
@@ -2055,7 +2080,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"priority": 1
 				}
 			},
-			"443:2": {
+			"443:0ea85809ce756352": {
 				"spec": {
 					"actions": [
 						{
@@ -2063,7 +2088,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
 										}
 									}
 								]
@@ -2076,7 +2101,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-1.example.com"
+									"app-2.example.com"
 								]
 							}
 						},
@@ -2084,7 +2109,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-2"
+									"/svc-3"
 								]
 							}
 						}
@@ -2092,10 +2117,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 2
+					"priority": 3
 				}
 			},
-			"443:3": {
+			"443:ed52a96b8b6a56cd": {
 				"spec": {
 					"actions": [
 						{
@@ -2103,7 +2128,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								"targetGroups": [
 									{
 										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
+											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-2:http/status/targetGroupARN"
 										}
 									}
 								]
@@ -2116,7 +2141,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "host-header",
 							"hostHeaderConfig": {
 								"values": [
-									"app-2.example.com"
+									"app-1.example.com"
 								]
 							}
 						},
@@ -2124,7 +2149,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 							"field": "path-pattern",
 							"pathPatternConfig": {
 								"values": [
-									"/svc-3"
+									"/svc-2"
 								]
 							}
 						}
@@ -2132,12 +2157,12 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					"listenerARN": {
 						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/443/status/listenerARN"
 					},
-					"priority": 3
+					"priority": 2
 				}
 			},
-			"80:1": null,
-			"80:2": null,
-			"80:3": null
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:ed52a96b8b6a56cd": null
 		}
 	}
 }`,
@@ -2398,44 +2423,13 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 	"resources": {
 		"AWS::EC2::SecurityGroup": null,
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"80:1": {
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": {
 				"spec": {
-					"actions": [
-						{
-							"forwardConfig": {
-								"targetGroups": [
-									{
-										"targetGroupARN": {
-											"$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/ns-1/ing-1-svc-3:https/status/targetGroupARN"
-										}
-									}
-								]
-							},
-							"type": "forward"
-						}
-					],
-					"conditions": [
-						{
-							"field": "host-header",
-							"hostHeaderConfig": {
-								"values": [
-									"app-2.example.com"
-								]
-							}
-						},
-						{
-							"field": "path-pattern",
-							"pathPatternConfig": {
-								"values": [
-									"/svc-3"
-								]
-							}
-						}
-					]
+					"priority": 1
 				}
 			},
-			"80:2": null,
-			"80:3": null
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::LoadBalancer": {
 			"LoadBalancer": {
@@ -2618,7 +2612,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			}
 		},
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"80:1": {
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:7862871e7f54d1f8": {
 				"spec": {
 					"actions": [
 						{
@@ -2643,11 +2639,14 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								]
 							}
 						}
-					]
+					],
+					"listenerARN": {
+						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+					},
+					"priority": 1
 				}
 			},
-			"80:2": null,
-			"80:3": null
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::LoadBalancer": {
 			"LoadBalancer": {
@@ -2838,6 +2837,80 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			},
 			wantErr: "ingress: ns-1/ing-1: unsupported targetType: ip when EnableIPTargetType is false",
 		},
+		{
+			name: "target type IP from TargetGroupConfiguration with enableIPTargetType set to false",
+			env: env{
+				svcs: []*corev1.Service{svcWithNamedTargetPort},
+				tgConfigs: []*v1beta1.TargetGroupConfiguration{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "ns-1",
+							Name:      "tg-config",
+						},
+						Spec: v1beta1.TargetGroupConfigurationSpec{
+							ServiceRef: v1beta1.TargetGroupConfigurationServiceReference{
+								Name: svcWithNamedTargetPort.Name,
+							},
+							PortConfigurations: []v1beta1.TargetGroupPortConfiguration{
+								{
+									Port: intstr.FromString("https"),
+									TargetGroupProps: v1beta1.TargetGroupProps{
+										TargetType: (*v1beta1.TargetType)(awssdk.String("ip")),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			enableIPTargetType: awssdk.Bool(false),
+			fields: fields{
+				resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForInternalLB},
+				listLoadBalancersCalls:   []listLoadBalancersCall{listLoadBalancerCallForEmptyLB},
+				enableBackendSG:          true,
+			},
+			args: args{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/target-type": "instance",
+								},
+							},
+								Spec: networking.IngressSpec{
+									Rules: []networking.IngressRule{
+										{
+											IngressRuleValue: networking.IngressRuleValue{
+												HTTP: &networking.HTTPIngressRuleValue{
+													Paths: []networking.HTTPIngressPath{
+														{
+															Path: "/",
+															Backend: networking.IngressBackend{
+																Service: &networking.IngressServiceBackend{
+																	Name: svcWithNamedTargetPort.Name,
+																	Port: networking.ServiceBackendPort{
+																		Name: "https",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: "ingress: ns-1/ing-1: unsupported targetType: ip when EnableIPTargetType is false",
+		},
 		{
 			name: "target type IP with named target port",
 			env: env{
@@ -2892,7 +2965,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 {
 	"resources": {
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"80:1": {
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:7862871e7f54d1f8": {
 				"spec": {
 					"actions": [
 						{
@@ -2917,11 +2992,14 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								]
 							}
 						}
-					]
+					],
+					"listenerARN": {
+						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+					},
+					"priority": 1
 				}
 			},
-			"80:2": null,
-			"80:3": null
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::TargetGroup": {
 			"ns-1/ing-1-svc-1:http": null,
@@ -3052,7 +3130,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 {
 	"resources": {
 		"AWS::ElasticLoadBalancingV2::ListenerRule": {
-			"80:1": {
+			"80:0c0332b8728af1cc": null,
+			"80:0ea85809ce756352": null,
+			"80:7862871e7f54d1f8": {
 				"spec": {
 					"actions": [
 						{
@@ -3077,11 +3157,14 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								]
 							}
 						}
-					]
+					],
+					"listenerARN": {
+						"$ref": "#/resources/AWS::ElasticLoadBalancingV2::Listener/80/status/listenerARN"
+					},
+					"priority": 1
 				}
 			},
-			"80:2": null,
-			"80:3": null
+			"80:ed52a96b8b6a56cd": null
 		},
 		"AWS::ElasticLoadBalancingV2::TargetGroup": {
 			"ns-1/ing-1-svc-1:http": null,
@@ -3643,10 +3726,14 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			ctx := context.Background()
 			k8sSchema := runtime.NewScheme()
 			clientgoscheme.AddToScheme(k8sSchema)
+			v1beta1.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
 			for _, svc := range tt.env.svcs {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
+			for _, tgConfig := range tt.env.tgConfigs {
+				assert.NoError(t, k8sClient.Create(ctx, tgConfig.DeepCopy()))
+			}
 			eventRecorder := record.NewFakeRecorder(10)
 			vpcID := "vpc-dummy"
 			clusterName := "cluster-dummy"
@@ -3697,6 +3784,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				authConfigBuilder:      authConfigBuilder,
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				ruleOptimizer:          ruleOptimizer,
+				tgConfigLoader:         config.NewDefaultTargetGroupConfigurationLoader(k8sClient),
 				trackingProvider:       trackingProvider,
 				elbv2TaggingManager:    elbv2TaggingManager,
 				enableBackendSG:        tt.fields.enableBackendSG,
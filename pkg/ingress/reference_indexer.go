@@ -6,8 +6,10 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -20,6 +22,10 @@ const (
 	IndexKeyIngressClassRefName = "ingress.ingressClassRef.name"
 	// IndexKeyIngressClassParamsRefName is index key for ingressClassParams referenced by IngressClass.
 	IndexKeyIngressClassParamsRefName = "ingressClass.ingressClassParamsRef.name"
+	// IndexKeyWebACLRefName is index key for WebACL referenced by Ingress or IngressClassParams.
+	IndexKeyWebACLRefName = "ingress.webACLRef.name"
+	// IndexKeyLoadBalancerConfigurationRefName is index key for LoadBalancerConfiguration referenced by Ingress or IngressClassParams.
+	IndexKeyLoadBalancerConfigurationRefName = "ingress.loadBalancerConfigurationRef.name"
 )
 
 // ReferenceIndexer has the ability to index Ingresses with referenced objects.
@@ -32,13 +38,19 @@ type ReferenceIndexer interface {
 	BuildIngressClassRefIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildIngressClassParamsRefIndexes returns the name of related IngressClassParams objects.
 	BuildIngressClassParamsRefIndexes(ctx context.Context, ingClass *networking.IngressClass) []string
+	// BuildWebACLRefIndexes returns the name of related WebACL objects.
+	BuildWebACLRefIndexes(ctx context.Context, ingOrIngClassParams client.Object) []string
+	// BuildLoadBalancerConfigurationRefIndexes returns the namespaced name of related LoadBalancerConfiguration objects.
+	BuildLoadBalancerConfigurationRefIndexes(ctx context.Context, ingOrIngClassParams client.Object) []string
 }
 
 // NewDefaultReferenceIndexer constructs new defaultReferenceIndexer.
-func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder, logger logr.Logger) *defaultReferenceIndexer {
+func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder,
+	annotationParser annotations.Parser, logger logr.Logger) *defaultReferenceIndexer {
 	return &defaultReferenceIndexer{
 		enhancedBackendBuilder: enhancedBackendBuilder,
 		authConfigBuilder:      authConfigBuilder,
+		annotationParser:       annotationParser,
 		logger:                 logger,
 	}
 }
@@ -49,6 +61,7 @@ var _ ReferenceIndexer = &defaultReferenceIndexer{}
 type defaultReferenceIndexer struct {
 	enhancedBackendBuilder EnhancedBackendBuilder
 	authConfigBuilder      AuthConfigBuilder
+	annotationParser       annotations.Parser
 	logger                 logr.Logger
 }
 
@@ -90,7 +103,11 @@ func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ing
 			"indexKey", IndexKeySecretRefName)
 		return nil
 	}
-	return extractSecretNamesFromAuthConfig(authCfg)
+	secretNames := extractSecretNamesFromAuthConfig(authCfg)
+	if ing, ok := ingOrSvc.(*networking.Ingress); ok {
+		secretNames = append(secretNames, extractSecretNamesFromIngressTLS(ing)...)
+	}
+	return secretNames
 }
 
 func (i *defaultReferenceIndexer) BuildIngressClassRefIndexes(_ context.Context, ing *networking.Ingress) []string {
@@ -115,6 +132,35 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
+func (i *defaultReferenceIndexer) BuildWebACLRefIndexes(_ context.Context, ingOrIngClassParams client.Object) []string {
+	if ingClassParams, ok := ingOrIngClassParams.(*elbv2api.IngressClassParams); ok {
+		if ingClassParams.Spec.WebACL == "" {
+			return nil
+		}
+		return []string{ingClassParams.Spec.WebACL}
+	}
+	webACLName := ""
+	if exists := i.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2WebACL, &webACLName, ingOrIngClassParams.GetAnnotations()); !exists || webACLName == "" {
+		return nil
+	}
+	return []string{webACLName}
+}
+
+func (i *defaultReferenceIndexer) BuildLoadBalancerConfigurationRefIndexes(_ context.Context, ingOrIngClassParams client.Object) []string {
+	if ingClassParams, ok := ingOrIngClassParams.(*elbv2api.IngressClassParams); ok {
+		if ingClassParams.Spec.LoadBalancerConfiguration == nil {
+			return nil
+		}
+		lbConfigRef := ingClassParams.Spec.LoadBalancerConfiguration
+		return []string{types.NamespacedName{Namespace: lbConfigRef.Namespace, Name: lbConfigRef.Name}.String()}
+	}
+	lbConfigName := ""
+	if exists := i.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerConfiguration, &lbConfigName, ingOrIngClassParams.GetAnnotations()); !exists || lbConfigName == "" {
+		return nil
+	}
+	return []string{types.NamespacedName{Namespace: ingOrIngClassParams.GetNamespace(), Name: lbConfigName}.String()}
+}
+
 func extractServiceNamesFromAction(action Action) []string {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
@@ -140,3 +186,14 @@ func extractSecretNamesFromAuthConfig(authCfg AuthConfig) []string {
 	}
 	return []string{authCfg.IDPConfigOIDC.SecretName}
 }
+
+// extractSecretNamesFromIngressTLS returns the name of TLS secrets of Ingress TLS blocks, which can be imported into ACM.
+func extractSecretNamesFromIngressTLS(ing *networking.Ingress) []string {
+	var secretNames []string
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.SecretName) != 0 {
+			secretNames = append(secretNames, tls.SecretName)
+		}
+	}
+	return secretNames
+}
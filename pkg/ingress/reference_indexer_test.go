@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -349,6 +350,31 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 			},
 			want: []string{"my-k8s-secret"},
 		},
+		{
+			name: "ingress with AuthOIDC annotation and TLS secrets",
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret"}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						TLS: []networking.IngressTLS{
+							{
+								Hosts:      []string{"www.example.com"},
+								SecretName: "my-tls-secret",
+							},
+							{
+								Hosts: []string{"api.example.com"},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"my-k8s-secret", "my-tls-secret"},
+		},
 		{
 			name: "ingress with no annotation",
 			args: args{
@@ -516,3 +542,152 @@ func Test_defaultReferenceIndexer_BuildIngressClassParamsRefIndexes(t *testing.T
 		})
 	}
 }
+
+func Test_defaultReferenceIndexer_BuildWebACLRefIndexes(t *testing.T) {
+	type args struct {
+		ingOrIngClassParams client.Object
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Ingress refers no WebACL",
+			args: args{
+				ingOrIngClassParams: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-ing",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "Ingress refers one WebACL",
+			args: args{
+				ingOrIngClassParams: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+						},
+					},
+				},
+			},
+			want: []string{"my-web-acl"},
+		},
+		{
+			name: "IngressClassParams refers no WebACL",
+			args: args{
+				ingOrIngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-class-params",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "IngressClassParams refers one WebACL",
+			args: args{
+				ingOrIngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-class-params",
+					},
+					Spec: elbv2api.IngressClassParamsSpec{
+						WebACL: "my-web-acl",
+					},
+				},
+			},
+			want: []string{"my-web-acl"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got := i.BuildWebACLRefIndexes(context.Background(), tt.args.ingOrIngClassParams)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildLoadBalancerConfigurationRefIndexes(t *testing.T) {
+	type args struct {
+		ingOrIngClassParams client.Object
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Ingress refers no LoadBalancerConfiguration",
+			args: args{
+				ingOrIngClassParams: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-ing",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "Ingress refers one LoadBalancerConfiguration",
+			args: args{
+				ingOrIngClassParams: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-configuration": "my-lb-config",
+						},
+					},
+				},
+			},
+			want: []string{"default/my-lb-config"},
+		},
+		{
+			name: "IngressClassParams refers no LoadBalancerConfiguration",
+			args: args{
+				ingOrIngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-class-params",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "IngressClassParams refers one LoadBalancerConfiguration",
+			args: args{
+				ingOrIngClassParams: &elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-class-params",
+					},
+					Spec: elbv2api.IngressClassParamsSpec{
+						LoadBalancerConfiguration: &elbv2api.LoadBalancerConfigurationReference{
+							Namespace: "awesome-ns",
+							Name:      "my-lb-config",
+						},
+					},
+				},
+			},
+			want: []string{"awesome-ns/my-lb-config"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &defaultReferenceIndexer{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got := i.BuildLoadBalancerConfigurationRefIndexes(context.Background(), tt.args.ingOrIngClassParams)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
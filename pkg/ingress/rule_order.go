@@ -0,0 +1,127 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+const (
+	// ruleOrderFirst denotes rules that are evaluated before all other rules on listener.
+	ruleOrderFirst = "first"
+	// ruleOrderLast denotes rules that are evaluated after all other rules on listener.
+	ruleOrderLast = "last"
+)
+
+// ruleOrderBucket is the bucket of listener rules, rules are ordered by their bucket first.
+type ruleOrderBucket int
+
+const (
+	ruleOrderBucketFirst ruleOrderBucket = iota
+	ruleOrderBucketPriority
+	ruleOrderBucketDefault
+	ruleOrderBucketLast
+)
+
+// ruleOrder is the ordering of a listener rule across Ingresses within IngressGroup.
+type ruleOrder struct {
+	bucket ruleOrderBucket
+	// priority of rule within ruleOrderBucketPriority bucket.
+	priority int64
+	// source is the Ingress and host/path key that claims this order, used for conflict detection.
+	source string
+}
+
+// orderedRule is a listener rule along with its ordering.
+type orderedRule struct {
+	rule  Rule
+	order ruleOrder
+}
+
+// buildRuleOrders builds the ordering of rules defined by ing via the "rule-order" annotation, keyed by host/path.
+// the annotation is a stringMap with following key formats:
+//   - `host/path`: the path of specific host.
+//   - `host`: all paths of specific host.
+//   - `/path`: the path of any host.
+//
+// and following value formats:
+//   - `first`: the rule is evaluated before all other rules.
+//   - `last`: the rule is evaluated after all other rules.
+//   - a positive integer: the rule is evaluated after `first` rules and before rules without explicit order, by ascending priority.
+func (t *defaultModelBuildTask) buildRuleOrders(_ context.Context, ing *networking.Ingress) (map[string]ruleOrder, error) {
+	var rawRuleOrders map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixRuleOrder, &rawRuleOrders, ing.Annotations); err != nil {
+		return nil, err
+	}
+	ruleOrders := make(map[string]ruleOrder, len(rawRuleOrders))
+	for key, rawOrder := range rawRuleOrders {
+		order := ruleOrder{
+			source: fmt.Sprintf("%v(%v)", k8s.NamespacedName(ing), key),
+		}
+		switch rawOrder {
+		case ruleOrderFirst:
+			order.bucket = ruleOrderBucketFirst
+		case ruleOrderLast:
+			order.bucket = ruleOrderBucketLast
+		default:
+			priority, err := strconv.ParseInt(rawOrder, 10, 64)
+			if err != nil || priority < 1 {
+				return nil, errors.Errorf("invalid rule order %v for %v, must be first, last or a positive integer", rawOrder, key)
+			}
+			order.bucket = ruleOrderBucketPriority
+			order.priority = priority
+		}
+		ruleOrders[key] = order
+	}
+	return ruleOrders, nil
+}
+
+// lookupRuleOrder finds the ordering of rule for path of host, the most specific key takes precedence.
+func lookupRuleOrder(ruleOrders map[string]ruleOrder, host string, path string) ruleOrder {
+	for _, key := range []string{host + path, host, path} {
+		if key == "" {
+			continue
+		}
+		if order, ok := ruleOrders[key]; ok {
+			return order
+		}
+	}
+	return ruleOrder{bucket: ruleOrderBucketDefault}
+}
+
+// sortRulesByOrder sorts rules by their ordering, rules of same ordering keep their relative order.
+// it's an error when rules from different sources claim the same priority.
+func sortRulesByOrder(rules []orderedRule) ([]Rule, error) {
+	sourceByPriority := make(map[int64]string)
+	for _, rule := range rules {
+		if rule.order.bucket != ruleOrderBucketPriority {
+			continue
+		}
+		if source, exists := sourceByPriority[rule.order.priority]; exists && source != rule.order.source {
+			sources := []string{source, rule.order.source}
+			sort.Strings(sources)
+			return nil, errors.Errorf("conflicting rule order %v claimed by %v", rule.order.priority, strings.Join(sources, " and "))
+		}
+		sourceByPriority[rule.order.priority] = rule.order.source
+	}
+
+	sortedRules := append([]orderedRule(nil), rules...)
+	sort.SliceStable(sortedRules, func(i, j int) bool {
+		if sortedRules[i].order.bucket != sortedRules[j].order.bucket {
+			return sortedRules[i].order.bucket < sortedRules[j].order.bucket
+		}
+		return sortedRules[i].order.priority < sortedRules[j].order.priority
+	})
+	result := make([]Rule, 0, len(sortedRules))
+	for _, rule := range sortedRules {
+		result = append(result, rule.rule)
+	}
+	return result, nil
+}
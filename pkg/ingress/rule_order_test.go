@@ -0,0 +1,191 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildRuleOrders(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        map[string]ruleOrder
+		wantErr     string
+	}{
+		{
+			name: "no annotation",
+			want: map[string]ruleOrder{},
+		},
+		{
+			name: "first, last and priority",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": "app.example.com/api=first, app.example.com=10, /health=last",
+			},
+			want: map[string]ruleOrder{
+				"app.example.com/api": {bucket: ruleOrderBucketFirst, source: "awesome-ns/ing-1(app.example.com/api)"},
+				"app.example.com":     {bucket: ruleOrderBucketPriority, priority: 10, source: "awesome-ns/ing-1(app.example.com)"},
+				"/health":             {bucket: ruleOrderBucketLast, source: "awesome-ns/ing-1(/health)"},
+			},
+		},
+		{
+			name: "invalid order",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": "/health=middle",
+			},
+			wantErr: "invalid rule order middle for /health, must be first, last or a positive integer",
+		},
+		{
+			name: "non-positive priority",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": "/health=0",
+			},
+			wantErr: "invalid rule order 0 for /health, must be first, last or a positive integer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "ing-1",
+					Annotations: tt.annotations,
+				},
+			}
+			got, err := task.buildRuleOrders(context.Background(), ing)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_lookupRuleOrder(t *testing.T) {
+	ruleOrders := map[string]ruleOrder{
+		"app.example.com/api": {bucket: ruleOrderBucketFirst},
+		"app.example.com":     {bucket: ruleOrderBucketPriority, priority: 10},
+		"/health":             {bucket: ruleOrderBucketLast},
+	}
+	tests := []struct {
+		name string
+		host string
+		path string
+		want ruleOrder
+	}{
+		{
+			name: "host and path",
+			host: "app.example.com",
+			path: "/api",
+			want: ruleOrder{bucket: ruleOrderBucketFirst},
+		},
+		{
+			name: "host only",
+			host: "app.example.com",
+			path: "/users",
+			want: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10},
+		},
+		{
+			name: "path only",
+			host: "other.example.com",
+			path: "/health",
+			want: ruleOrder{bucket: ruleOrderBucketLast},
+		},
+		{
+			name: "path without host",
+			path: "/health",
+			want: ruleOrder{bucket: ruleOrderBucketLast},
+		},
+		{
+			name: "no match",
+			host: "other.example.com",
+			path: "/users",
+			want: ruleOrder{bucket: ruleOrderBucketDefault},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lookupRuleOrder(ruleOrders, tt.host, tt.path)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sortRulesByOrder(t *testing.T) {
+	newRule := func(path string) Rule {
+		return Rule{
+			Conditions: []elbv2model.RuleCondition{
+				{
+					Field:             elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{path}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		rules   []orderedRule
+		want    []Rule
+		wantErr string
+	}{
+		{
+			name: "rules are ordered by bucket and priority",
+			rules: []orderedRule{
+				{rule: newRule("/default-1"), order: ruleOrder{bucket: ruleOrderBucketDefault}},
+				{rule: newRule("/last"), order: ruleOrder{bucket: ruleOrderBucketLast, source: "ns/ing-1(/last)"}},
+				{rule: newRule("/priority-20"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 20, source: "ns/ing-1(/priority-20)"}},
+				{rule: newRule("/default-2"), order: ruleOrder{bucket: ruleOrderBucketDefault}},
+				{rule: newRule("/priority-10"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10, source: "ns/ing-2(/priority-10)"}},
+				{rule: newRule("/first"), order: ruleOrder{bucket: ruleOrderBucketFirst, source: "ns/ing-2(/first)"}},
+			},
+			want: []Rule{
+				newRule("/first"),
+				newRule("/priority-10"),
+				newRule("/priority-20"),
+				newRule("/default-1"),
+				newRule("/default-2"),
+				newRule("/last"),
+			},
+		},
+		{
+			name: "rules from same source share priority",
+			rules: []orderedRule{
+				{rule: newRule("/b"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10, source: "ns/ing-1(app.example.com)"}},
+				{rule: newRule("/a"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10, source: "ns/ing-1(app.example.com)"}},
+			},
+			want: []Rule{
+				newRule("/b"),
+				newRule("/a"),
+			},
+		},
+		{
+			name: "rules from different sources claim same priority",
+			rules: []orderedRule{
+				{rule: newRule("/a"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10, source: "ns/ing-2(/a)"}},
+				{rule: newRule("/b"), order: ruleOrder{bucket: ruleOrderBucketPriority, priority: 10, source: "ns/ing-1(/b)"}},
+			},
+			wantErr: "conflicting rule order 10 claimed by ns/ing-1(/b) and ns/ing-2(/a)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sortRulesByOrder(tt.rules)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// tlsSecretHashTagKey is the AWS TagKey for the hash of TLS secret content an imported certificate is imported from.
+	tlsSecretHashTagKey = "ingress.k8s.aws/tls-secret-hash"
+)
+
+// TLSSecretCertImporter is responsible for importing kubernetes.io/tls secrets referenced by Ingress TLS blocks into ACM.
+type TLSSecretCertImporter interface {
+	// Import imports the TLS secret into ACM for the IngressGroup and returns the certificate ARN.
+	// the certificate is re-imported once the secret content is rotated.
+	Import(ctx context.Context, groupID GroupID, secretKey types.NamespacedName) (string, error)
+
+	// Lookup returns the certificate ARN that the TLS secret is imported as for the IngressGroup, without importing it.
+	// TLS secrets that aren't imported yet are represented by a placeholder built by buildPlannedImportedCertARN.
+	Lookup(ctx context.Context, groupID GroupID, secretKey types.NamespacedName) (string, error)
+
+	// Release deletes the certificates imported for the IngressGroup whose TLS secrets are no longer active.
+	Release(ctx context.Context, groupID GroupID, activeSecretKeys []types.NamespacedName) error
+}
+
+// NewDefaultTLSSecretCertImporter constructs new defaultTLSSecretCertImporter.
+func NewDefaultTLSSecretCertImporter(k8sClient client.Client, acmClient services.ACM, trackingProvider tracking.Provider,
+	defaultTags map[string]string, logger logr.Logger) *defaultTLSSecretCertImporter {
+	return &defaultTLSSecretCertImporter{
+		k8sClient:        k8sClient,
+		acmClient:        acmClient,
+		trackingProvider: trackingProvider,
+		defaultTags:      defaultTags,
+		logger:           logger,
+	}
+}
+
+var _ TLSSecretCertImporter = &defaultTLSSecretCertImporter{}
+
+// default implementation for TLSSecretCertImporter.
+type defaultTLSSecretCertImporter struct {
+	k8sClient        client.Client
+	acmClient        services.ACM
+	trackingProvider tracking.Provider
+	defaultTags      map[string]string
+	logger           logr.Logger
+
+	// mutex protects the importedCerts, and serializes the calls to ACM.
+	mutex sync.Mutex
+	// importedCerts are the certificates imported from TLS secrets by this controller.
+	// they're loaded from ACM on first use, and kept up to date on import and deletion afterwards.
+	importedCerts []*importedCert
+}
+
+// importedCert is a certificate imported into ACM from TLS secret.
+type importedCert struct {
+	certARN string
+	tags    map[string]string
+}
+
+func (i *defaultTLSSecretCertImporter) Import(ctx context.Context, groupID GroupID, secretKey types.NamespacedName) (string, error) {
+	certificate, certificateChain, privateKey, contentHash, err := i.loadTLSSecret(ctx, secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if err := i.loadImportedCertsIfNeeded(ctx); err != nil {
+		return "", err
+	}
+	cert := i.findImportedCert(groupID, secretKey)
+	if cert != nil && cert.tags[tlsSecretHashTagKey] == contentHash {
+		return cert.certARN, nil
+	}
+
+	req := &acm.ImportCertificateInput{
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+	}
+	if len(certificateChain) != 0 {
+		req.CertificateChain = certificateChain
+	}
+	if cert == nil {
+		tags := i.buildImportedCertTags(groupID, secretKey, contentHash)
+		req.Tags = buildSDKACMTags(tags)
+		i.logger.Info("importing TLS secret", "groupID", groupID, "secret", secretKey)
+		resp, err := i.acmClient.ImportCertificateWithContext(ctx, req)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to import TLS secret %v", secretKey)
+		}
+		cert = &importedCert{
+			certARN: awssdk.StringValue(resp.CertificateArn),
+			tags:    tags,
+		}
+		i.importedCerts = append(i.importedCerts, cert)
+		i.logger.Info("imported TLS secret", "groupID", groupID, "secret", secretKey, "certificateARN", cert.certARN)
+		return cert.certARN, nil
+	}
+
+	// tags cannot be specified when re-importing a certificate, the content hash is tagged afterwards.
+	req.CertificateArn = awssdk.String(cert.certARN)
+	i.logger.Info("re-importing rotated TLS secret", "groupID", groupID, "secret", secretKey, "certificateARN", cert.certARN)
+	if _, err := i.acmClient.ImportCertificateWithContext(ctx, req); err != nil {
+		return "", errors.Wrapf(err, "failed to re-import TLS secret %v", secretKey)
+	}
+	if _, err := i.acmClient.AddTagsToCertificateWithContext(ctx, &acm.AddTagsToCertificateInput{
+		CertificateArn: awssdk.String(cert.certARN),
+		Tags:           buildSDKACMTags(map[string]string{tlsSecretHashTagKey: contentHash}),
+	}); err != nil {
+		return "", err
+	}
+	cert.tags[tlsSecretHashTagKey] = contentHash
+	i.logger.Info("re-imported rotated TLS secret", "groupID", groupID, "secret", secretKey, "certificateARN", cert.certARN)
+	return cert.certARN, nil
+}
+
+func (i *defaultTLSSecretCertImporter) Lookup(ctx context.Context, groupID GroupID, secretKey types.NamespacedName) (string, error) {
+	if _, _, _, _, err := i.loadTLSSecret(ctx, secretKey); err != nil {
+		return "", err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if err := i.loadImportedCertsIfNeeded(ctx); err != nil {
+		return "", err
+	}
+	// rotated TLS secrets are re-imported into the same certificate.
+	if cert := i.findImportedCert(groupID, secretKey); cert != nil {
+		return cert.certARN, nil
+	}
+	return buildPlannedImportedCertARN(secretKey), nil
+}
+
+func (i *defaultTLSSecretCertImporter) Release(ctx context.Context, groupID GroupID, activeSecretKeys []types.NamespacedName) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	if err := i.loadImportedCertsIfNeeded(ctx); err != nil {
+		return err
+	}
+	stackTags := i.trackingProvider.StackTags(core.NewDefaultStack(core.StackID(groupID)))
+	activeSecretKeySet := sets.NewString()
+	for _, secretKey := range activeSecretKeys {
+		activeSecretKeySet.Insert(secretKey.String())
+	}
+	remainingCerts := make([]*importedCert, 0, len(i.importedCerts))
+	for idx, cert := range i.importedCerts {
+		secretKey := cert.tags[i.trackingProvider.ResourceIDTagKey()]
+		if !matchesTags(cert.tags, stackTags) || activeSecretKeySet.Has(secretKey) {
+			remainingCerts = append(remainingCerts, cert)
+			continue
+		}
+		i.logger.Info("deleting imported certificate", "groupID", groupID, "secret", secretKey, "certificateARN", cert.certARN)
+		if _, err := i.acmClient.DeleteCertificateWithContext(ctx, &acm.DeleteCertificateInput{
+			CertificateArn: awssdk.String(cert.certARN),
+		}); err != nil {
+			i.importedCerts = append(remainingCerts, i.importedCerts[idx:]...)
+			return errors.Wrapf(err, "failed to delete certificate imported from TLS secret %v", secretKey)
+		}
+		i.logger.Info("deleted imported certificate", "groupID", groupID, "secret", secretKey, "certificateARN", cert.certARN)
+	}
+	i.importedCerts = remainingCerts
+	return nil
+}
+
+// loadTLSSecret loads the TLS secret, and returns its certificate, certificate chain and private key along with the hash of its content.
+func (i *defaultTLSSecretCertImporter) loadTLSSecret(ctx context.Context, secretKey types.NamespacedName) ([]byte, []byte, []byte, string, error) {
+	secret := &corev1.Secret{}
+	if err := i.k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return nil, nil, nil, "", err
+	}
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil, nil, nil, "", errors.Errorf("secret %v is of type %v, expect %v", secretKey, secret.Type, corev1.SecretTypeTLS)
+	}
+	certificate, certificateChain, err := splitCertificateChain(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, nil, nil, "", errors.Wrapf(err, "invalid %v in secret %v", corev1.TLSCertKey, secretKey)
+	}
+	privateKey := secret.Data[corev1.TLSPrivateKeyKey]
+	if len(privateKey) == 0 {
+		return nil, nil, nil, "", errors.Errorf("missing %v in secret %v", corev1.TLSPrivateKeyKey, secretKey)
+	}
+	return certificate, certificateChain, privateKey, computeTLSSecretContentHash(secret.Data[corev1.TLSCertKey], privateKey), nil
+}
+
+// findImportedCert finds the certificate imported from TLS secret for the IngressGroup, returns nil if not found.
+func (i *defaultTLSSecretCertImporter) findImportedCert(groupID GroupID, secretKey types.NamespacedName) *importedCert {
+	stackTags := i.trackingProvider.StackTags(core.NewDefaultStack(core.StackID(groupID)))
+	for _, cert := range i.importedCerts {
+		if matchesTags(cert.tags, stackTags) && cert.tags[i.trackingProvider.ResourceIDTagKey()] == secretKey.String() {
+			return cert
+		}
+	}
+	return nil
+}
+
+// loadImportedCertsIfNeeded loads the certificates imported from TLS secrets from ACM, which are identified by the content hash tag.
+func (i *defaultTLSSecretCertImporter) loadImportedCertsIfNeeded(ctx context.Context) error {
+	if i.importedCerts != nil {
+		return nil
+	}
+	certSummaries, err := i.acmClient.ListCertificatesAsList(ctx, &acm.ListCertificatesInput{
+		Includes: &acm.Filters{
+			KeyTypes: awssdk.StringSlice(acm.KeyAlgorithm_Values()),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	importedCerts := make([]*importedCert, 0)
+	for _, certSummary := range certSummaries {
+		if awssdk.StringValue(certSummary.Type) != acm.CertificateTypeImported {
+			continue
+		}
+		resp, err := i.acmClient.ListTagsForCertificateWithContext(ctx, &acm.ListTagsForCertificateInput{
+			CertificateArn: certSummary.CertificateArn,
+		})
+		if err != nil {
+			return err
+		}
+		tags := make(map[string]string, len(resp.Tags))
+		for _, tag := range resp.Tags {
+			tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+		if _, ok := tags[tlsSecretHashTagKey]; !ok {
+			continue
+		}
+		importedCerts = append(importedCerts, &importedCert{
+			certARN: awssdk.StringValue(certSummary.CertificateArn),
+			tags:    tags,
+		})
+	}
+	i.importedCerts = importedCerts
+	return nil
+}
+
+func (i *defaultTLSSecretCertImporter) buildImportedCertTags(groupID GroupID, secretKey types.NamespacedName, contentHash string) map[string]string {
+	stack := core.NewDefaultStack(core.StackID(groupID))
+	return algorithm.MergeStringMap(i.trackingProvider.StackTags(stack), map[string]string{
+		i.trackingProvider.ResourceIDTagKey(): secretKey.String(),
+		tlsSecretHashTagKey:                   contentHash,
+	}, i.defaultTags)
+}
+
+// buildPlannedImportedCertARN builds the placeholder of the certificate that the TLS secret would be imported as, which is used in dry run.
+func buildPlannedImportedCertARN(secretKey types.NamespacedName) string {
+	return fmt.Sprintf("(imported from TLS secret %v)", secretKey)
+}
+
+// matchesTags checks whether tags contains all of the expectedTags.
+func matchesTags(tags map[string]string, expectedTags map[string]string) bool {
+	for tagKey, tagValue := range expectedTags {
+		if tags[tagKey] != tagValue {
+			return false
+		}
+	}
+	return true
+}
+
+func buildSDKACMTags(tags map[string]string) []*acm.Tag {
+	sdkTags := make([]*acm.Tag, 0, len(tags))
+	for _, tagKey := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, &acm.Tag{
+			Key:   awssdk.String(tagKey),
+			Value: awssdk.String(tags[tagKey]),
+		})
+	}
+	return sdkTags
+}
+
+// splitCertificateChain splits the PEM encoded certificates of TLS secret into the leaf certificate and its chain.
+func splitCertificateChain(rawCerts []byte) ([]byte, []byte, error) {
+	var certs [][]byte
+	rest := rawCerts
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certs = append(certs, pem.EncodeToMemory(block))
+	}
+	if len(certs) == 0 {
+		return nil, nil, errors.New("no PEM encoded certificate found")
+	}
+	return certs[0], bytes.Join(certs[1:], nil), nil
+}
+
+// computeTLSSecretContentHash computes the hash of TLS secret content, which identifies rotations of the secret.
+func computeTLSSecretContentHash(rawCerts []byte, privateKey []byte) string {
+	hasher := sha256.New()
+	hasher.Write(rawCerts)
+	hasher.Write([]byte{0})
+	hasher.Write(privateKey)
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -0,0 +1,253 @@
+package ingress
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeACM is an in-memory ACM with the APIs used by TLS secrets import.
+type fakeACM struct {
+	services.ACM
+	certs     map[string]*fakeACMCert
+	nextCert  int
+	importLog []string
+}
+
+type fakeACMCert struct {
+	certType    string
+	certificate []byte
+	chain       []byte
+	tags        map[string]string
+}
+
+func (c *fakeACM) ListCertificatesAsList(_ context.Context, _ *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error) {
+	var result []*acm.CertificateSummary
+	for certARN, cert := range c.certs {
+		result = append(result, &acm.CertificateSummary{
+			CertificateArn: awssdk.String(certARN),
+			Type:           awssdk.String(cert.certType),
+		})
+	}
+	return result, nil
+}
+
+func (c *fakeACM) ListTagsForCertificateWithContext(_ context.Context, input *acm.ListTagsForCertificateInput, _ ...request.Option) (*acm.ListTagsForCertificateOutput, error) {
+	var tags []*acm.Tag
+	for k, v := range c.certs[awssdk.StringValue(input.CertificateArn)].tags {
+		tags = append(tags, &acm.Tag{Key: awssdk.String(k), Value: awssdk.String(v)})
+	}
+	return &acm.ListTagsForCertificateOutput{Tags: tags}, nil
+}
+
+func (c *fakeACM) ImportCertificateWithContext(_ context.Context, input *acm.ImportCertificateInput, _ ...request.Option) (*acm.ImportCertificateOutput, error) {
+	certARN := awssdk.StringValue(input.CertificateArn)
+	if certARN == "" {
+		c.nextCert++
+		certARN = fmt.Sprintf("arn:aws:acm:us-west-2:123456789012:certificate/cert-%d", c.nextCert)
+		tags := make(map[string]string, len(input.Tags))
+		for _, tag := range input.Tags {
+			tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		}
+		c.certs[certARN] = &fakeACMCert{certType: acm.CertificateTypeImported, tags: tags}
+	}
+	c.certs[certARN].certificate = input.Certificate
+	c.certs[certARN].chain = input.CertificateChain
+	c.importLog = append(c.importLog, certARN)
+	return &acm.ImportCertificateOutput{CertificateArn: awssdk.String(certARN)}, nil
+}
+
+func (c *fakeACM) AddTagsToCertificateWithContext(_ context.Context, input *acm.AddTagsToCertificateInput, _ ...request.Option) (*acm.AddTagsToCertificateOutput, error) {
+	for _, tag := range input.Tags {
+		c.certs[awssdk.StringValue(input.CertificateArn)].tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return &acm.AddTagsToCertificateOutput{}, nil
+}
+
+func (c *fakeACM) DeleteCertificateWithContext(_ context.Context, input *acm.DeleteCertificateInput, _ ...request.Option) (*acm.DeleteCertificateOutput, error) {
+	delete(c.certs, awssdk.StringValue(input.CertificateArn))
+	return &acm.DeleteCertificateOutput{}, nil
+}
+
+func encodeTestPEMCert(content string) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(content)})
+}
+
+func newTestTLSSecret(name string, certs []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      name,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certs,
+			corev1.TLSPrivateKeyKey: []byte("private-key"),
+		},
+	}
+}
+
+func Test_defaultTLSSecretCertImporter_Import(t *testing.T) {
+	groupID := GroupID{Name: "awesome-group"}
+	secretKey := types.NamespacedName{Namespace: "ns-1", Name: "secret-1"}
+	leafCert := encodeTestPEMCert("leaf")
+	chainCert := encodeTestPEMCert("intermediate")
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(newTestTLSSecret("secret-1", append(append([]byte{}, leafCert...), chainCert...))).Build()
+	acmClient := &fakeACM{
+		certs: map[string]*fakeACMCert{
+			"arn:aws:acm:us-west-2:123456789012:certificate/amazon-issued": {
+				certType: acm.CertificateTypeAmazonIssued,
+			},
+		},
+	}
+	trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	importer := NewDefaultTLSSecretCertImporter(k8sClient, acmClient, trackingProvider, map[string]string{"team": "awesome"}, logr.New(&log.NullLogSink{}))
+
+	// first import creates the certificate with the leaf certificate and its chain.
+	certARN, err := importer.Import(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-1", certARN)
+	cert := acmClient.certs[certARN]
+	assert.Equal(t, leafCert, cert.certificate)
+	assert.Equal(t, chainCert, cert.chain)
+	assert.Equal(t, "awesome-group", cert.tags["ingress.k8s.aws/stack"])
+	assert.Equal(t, "cluster-name", cert.tags["elbv2.k8s.aws/cluster"])
+	assert.Equal(t, "ns-1/secret-1", cert.tags["ingress.k8s.aws/resource"])
+	assert.Equal(t, "awesome", cert.tags["team"])
+	originalHash := cert.tags[tlsSecretHashTagKey]
+
+	// unchanged secret is not re-imported.
+	certARN, err = importer.Import(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-1", certARN)
+	assert.Len(t, acmClient.importLog, 1)
+
+	// rotated secret is re-imported into the same certificate.
+	rotatedCert := encodeTestPEMCert("rotated-leaf")
+	assert.NoError(t, k8sClient.Update(context.Background(), newTestTLSSecret("secret-1", rotatedCert)))
+	certARN, err = importer.Import(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-1", certARN)
+	assert.Len(t, acmClient.importLog, 2)
+	assert.Equal(t, rotatedCert, cert.certificate)
+	assert.Empty(t, cert.chain)
+	assert.NotEqual(t, originalHash, cert.tags[tlsSecretHashTagKey])
+
+	// imported certificates are recovered from tags by a new importer.
+	recoveredImporter := NewDefaultTLSSecretCertImporter(k8sClient, acmClient, trackingProvider, nil, logr.New(&log.NullLogSink{}))
+	certARN, err = recoveredImporter.Import(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-1", certARN)
+	assert.Len(t, acmClient.importLog, 2)
+
+	// the same secret is imported separately for another IngressGroup.
+	certARN, err = recoveredImporter.Import(context.Background(), GroupID{Name: "another-group"}, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-2", certARN)
+}
+
+func Test_defaultTLSSecretCertImporter_Lookup(t *testing.T) {
+	groupID := GroupID{Name: "awesome-group"}
+	secretKey := types.NamespacedName{Namespace: "ns-1", Name: "secret-1"}
+	k8sClient := testclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(newTestTLSSecret("secret-1", encodeTestPEMCert("leaf")), newTestTLSSecret("no-cert", []byte("garbage"))).Build()
+	acmClient := &fakeACM{certs: map[string]*fakeACMCert{}}
+	importer := NewDefaultTLSSecretCertImporter(k8sClient, acmClient, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+		nil, logr.New(&log.NullLogSink{}))
+
+	// secret not imported yet is represented by a placeholder, without being imported.
+	certARN, err := importer.Lookup(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "(imported from TLS secret ns-1/secret-1)", certARN)
+	assert.Empty(t, acmClient.importLog)
+
+	// imported secret is represented by its certificate, even if it'd be re-imported.
+	_, err = importer.Import(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.NoError(t, k8sClient.Update(context.Background(), newTestTLSSecret("secret-1", encodeTestPEMCert("rotated-leaf"))))
+	certARN, err = importer.Lookup(context.Background(), groupID, secretKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:acm:us-west-2:123456789012:certificate/cert-1", certARN)
+	assert.Len(t, acmClient.importLog, 1)
+
+	// invalid secret is rejected as if it were imported.
+	_, err = importer.Lookup(context.Background(), groupID, types.NamespacedName{Namespace: "ns-1", Name: "no-cert"})
+	assert.EqualError(t, err, "invalid tls.crt in secret ns-1/no-cert: no PEM encoded certificate found")
+}
+
+func Test_defaultTLSSecretCertImporter_Import_invalidSecret(t *testing.T) {
+	opaqueSecret := newTestTLSSecret("opaque", encodeTestPEMCert("leaf"))
+	opaqueSecret.Type = corev1.SecretTypeOpaque
+	tests := []struct {
+		name      string
+		secretKey types.NamespacedName
+		wantErr   string
+	}{
+		{
+			name:      "secret not of TLS type",
+			secretKey: types.NamespacedName{Namespace: "ns-1", Name: "opaque"},
+			wantErr:   "secret ns-1/opaque is of type Opaque, expect kubernetes.io/tls",
+		},
+		{
+			name:      "secret without PEM encoded certificate",
+			secretKey: types.NamespacedName{Namespace: "ns-1", Name: "no-cert"},
+			wantErr:   "invalid tls.crt in secret ns-1/no-cert: no PEM encoded certificate found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+				WithObjects(opaqueSecret, newTestTLSSecret("no-cert", []byte("garbage"))).Build()
+			acmClient := &fakeACM{certs: map[string]*fakeACMCert{}}
+			importer := NewDefaultTLSSecretCertImporter(k8sClient, acmClient, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				nil, logr.New(&log.NullLogSink{}))
+			_, err := importer.Import(context.Background(), GroupID{Name: "awesome-group"}, tt.secretKey)
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Empty(t, acmClient.certs)
+		})
+	}
+}
+
+func Test_defaultTLSSecretCertImporter_Release(t *testing.T) {
+	groupID := GroupID{Name: "awesome-group"}
+	k8sClient := testclient.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(newTestTLSSecret("secret-1", encodeTestPEMCert("leaf-1")), newTestTLSSecret("secret-2", encodeTestPEMCert("leaf-2"))).Build()
+	acmClient := &fakeACM{certs: map[string]*fakeACMCert{}}
+	importer := NewDefaultTLSSecretCertImporter(k8sClient, acmClient, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+		nil, logr.New(&log.NullLogSink{}))
+
+	secret1 := types.NamespacedName{Namespace: "ns-1", Name: "secret-1"}
+	secret2 := types.NamespacedName{Namespace: "ns-1", Name: "secret-2"}
+	cert1ARN, err := importer.Import(context.Background(), groupID, secret1)
+	assert.NoError(t, err)
+	cert2ARN, err := importer.Import(context.Background(), groupID, secret2)
+	assert.NoError(t, err)
+	otherGroupCertARN, err := importer.Import(context.Background(), GroupID{Name: "another-group"}, secret1)
+	assert.NoError(t, err)
+
+	assert.NoError(t, importer.Release(context.Background(), groupID, []types.NamespacedName{secret1}))
+	assert.Contains(t, acmClient.certs, cert1ARN)
+	assert.NotContains(t, acmClient.certs, cert2ARN)
+	assert.Contains(t, acmClient.certs, otherGroupCertARN)
+
+	assert.NoError(t, importer.Release(context.Background(), groupID, nil))
+	assert.NotContains(t, acmClient.certs, cert1ARN)
+	assert.Contains(t, acmClient.certs, otherGroupCertARN)
+}
@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchAnnotation sets the annotation of key to value on k8s object, or removes it if value is nil.
+// obj will be in-place updated.
+func PatchAnnotation(ctx context.Context, k8sClient client.Client, obj client.Object, key string, value *string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := k8sClient.Get(ctx, NamespacedName(obj), obj); err != nil {
+			return err
+		}
+		currentValue, exists := obj.GetAnnotations()[key]
+		if value == nil && !exists || value != nil && exists && currentValue == *value {
+			return nil
+		}
+
+		oldObj := obj.DeepCopyObject().(client.Object)
+		annotations := make(map[string]string, len(obj.GetAnnotations())+1)
+		for k, v := range obj.GetAnnotations() {
+			annotations[k] = v
+		}
+		if value == nil {
+			delete(annotations, key)
+		} else {
+			annotations[key] = *value
+		}
+		obj.SetAnnotations(annotations)
+		return k8sClient.Patch(ctx, obj, client.MergeFromWithOptions(oldObj, client.MergeFromWithOptimisticLock{}))
+	})
+}
@@ -0,0 +1,112 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_PatchAnnotation(t *testing.T) {
+	type args struct {
+		obj   *networking.Ingress
+		key   string
+		value *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantObj *networking.Ingress
+	}{
+		{
+			name: "set annotation",
+			args: args{
+				obj: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "my-ns",
+						Name:        "my-ing",
+						Annotations: map[string]string{"other": "value"},
+					},
+				},
+				key:   "my-key",
+				value: awssdk.String("my-value"),
+			},
+			wantObj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "my-ns",
+					Name:        "my-ing",
+					Annotations: map[string]string{"other": "value", "my-key": "my-value"},
+				},
+			},
+		},
+		{
+			name: "overwrite annotation",
+			args: args{
+				obj: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "my-ns",
+						Name:        "my-ing",
+						Annotations: map[string]string{"my-key": "old-value"},
+					},
+				},
+				key:   "my-key",
+				value: awssdk.String("my-value"),
+			},
+			wantObj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "my-ns",
+					Name:        "my-ing",
+					Annotations: map[string]string{"my-key": "my-value"},
+				},
+			},
+		},
+		{
+			name: "remove annotation",
+			args: args{
+				obj: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "my-ns",
+						Name:        "my-ing",
+						Annotations: map[string]string{"other": "value", "my-key": "my-value"},
+					},
+				},
+				key: "my-key",
+			},
+			wantObj: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "my-ns",
+					Name:        "my-ing",
+					Annotations: map[string]string{"other": "value"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				Build()
+
+			err := k8sClient.Create(ctx, tt.args.obj.DeepCopy())
+			assert.NoError(t, err)
+
+			err = PatchAnnotation(ctx, k8sClient, tt.args.obj, tt.args.key, tt.args.value)
+			assert.NoError(t, err)
+			gotObj := &networking.Ingress{}
+			err = k8sClient.Get(ctx, NamespacedName(tt.args.obj), gotObj)
+			assert.NoError(t, err)
+			opts := IgnoreFakeClientPopulatedFields()
+			assert.True(t, cmp.Equal(tt.wantObj, gotObj, opts), "diff", cmp.Diff(tt.wantObj, gotObj, opts))
+		})
+	}
+}
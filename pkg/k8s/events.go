@@ -2,14 +2,23 @@ package k8s
 
 const (
 	// Ingress events
-	IngressEventReasonConflictingIngressClass = "ConflictingIngressClass"
-	IngressEventReasonFailedLoadGroupID       = "FailedLoadGroupID"
-	IngressEventReasonFailedAddFinalizer      = "FailedAddFinalizer"
-	IngressEventReasonFailedRemoveFinalizer   = "FailedRemoveFinalizer"
-	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
-	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
-	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
-	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
+	IngressEventReasonConflictingIngressClass    = "ConflictingIngressClass"
+	IngressEventReasonFailedLoadGroupID          = "FailedLoadGroupID"
+	IngressEventReasonFailedAddFinalizer         = "FailedAddFinalizer"
+	IngressEventReasonFailedRemoveFinalizer      = "FailedRemoveFinalizer"
+	IngressEventReasonFailedUpdateStatus         = "FailedUpdateStatus"
+	IngressEventReasonFailedBuildModel           = "FailedBuildModel"
+	IngressEventReasonFailedDeployModel          = "FailedDeployModel"
+	IngressEventReasonSuccessfullyReconciled     = "SuccessfullyReconciled"
+	IngressEventReasonFailedPlanModel            = "FailedPlanModel"
+	IngressEventReasonSuccessfullyPlanned        = "SuccessfullyPlanned"
+	IngressEventReasonDriftDetected              = "DriftDetected"
+	IngressEventReasonFailedReleaseModel         = "FailedReleaseModel"
+	IngressEventReasonSuccessfullyReleased       = "SuccessfullyReleased"
+	IngressEventReasonListenerRulesLimitExceeded = "ListenerRulesLimitExceeded"
+	IngressEventReasonCertificateExpiring        = "CertificateExpiring"
+	IngressEventReasonCertificateInvalid         = "CertificateInvalid"
+	IngressEventReasonFailedRecordIAMRole        = "FailedRecordIAMRole"
 
 	// Service events
 	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -19,6 +28,23 @@ const (
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	ServiceEventReasonFailedPlanModel        = "FailedPlanModel"
+	ServiceEventReasonSuccessfullyPlanned    = "SuccessfullyPlanned"
+	ServiceEventReasonDriftDetected          = "DriftDetected"
+	ServiceEventReasonFailedReleaseModel     = "FailedReleaseModel"
+	ServiceEventReasonSuccessfullyReleased   = "SuccessfullyReleased"
+	ServiceEventReasonCertificateExpiring    = "CertificateExpiring"
+	ServiceEventReasonCertificateInvalid     = "CertificateInvalid"
+	ServiceEventReasonFailedRecordIAMRole    = "FailedRecordIAMRole"
+
+	// Gateway events
+	GatewayEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	GatewayEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	GatewayEventReasonFailedLoadRoutes       = "FailedLoadRoutes"
+	GatewayEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	GatewayEventReasonFailedBuildModel       = "FailedBuildModel"
+	GatewayEventReasonFailedDeployModel      = "FailedDeployModel"
+	GatewayEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 
 	// TargetGroupBinding events
 	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -28,4 +54,9 @@ const (
 	TargetGroupBindingEventReasonFailedNetworkReconcile = "FailedNetworkReconcile"
 	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
 	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// Controller config events
+	ControllerConfigEventReasonFailedReload         = "FailedReload"
+	ControllerConfigEventReasonRestartRequired      = "RestartRequired"
+	ControllerConfigEventReasonSuccessfullyReloaded = "SuccessfullyReloaded"
 )
@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LoadFromCluster loads the objects relevant to migration from cluster.
+// namespaced objects are loaded from namespace, or from all namespaces if namespace is empty.
+func LoadFromCluster(ctx context.Context, k8sClient client.Reader, namespace string) ([]client.Object, error) {
+	var objs []client.Object
+
+	ingClassList := &networking.IngressClassList{}
+	if err := k8sClient.List(ctx, ingClassList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingressClasses")
+	}
+	for i := range ingClassList.Items {
+		objs = append(objs, &ingClassList.Items[i])
+	}
+	ingClassParamsList := &elbv2api.IngressClassParamsList{}
+	if err := k8sClient.List(ctx, ingClassParamsList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingressClassParams")
+	}
+	for i := range ingClassParamsList.Items {
+		objs = append(objs, &ingClassParamsList.Items[i])
+	}
+	// Namespaces are needed to evaluate the namespaceSelector of IngressClassParams.
+	nsList := &corev1.NamespaceList{}
+	if err := k8sClient.List(ctx, nsList); err != nil {
+		return nil, errors.Wrap(err, "failed to list namespaces")
+	}
+	for i := range nsList.Items {
+		if namespace == "" || nsList.Items[i].Name == namespace {
+			objs = append(objs, &nsList.Items[i])
+		}
+	}
+
+	ingList := &networking.IngressList{}
+	if err := k8sClient.List(ctx, ingList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list ingresses")
+	}
+	for i := range ingList.Items {
+		objs = append(objs, &ingList.Items[i])
+	}
+	svcList := &corev1.ServiceList{}
+	if err := k8sClient.List(ctx, svcList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list services")
+	}
+	for i := range svcList.Items {
+		objs = append(objs, &svcList.Items[i])
+	}
+	lbConfigList := &elbv2api.LoadBalancerConfigurationList{}
+	if err := k8sClient.List(ctx, lbConfigList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list loadBalancerConfigurations")
+	}
+	for i := range lbConfigList.Items {
+		objs = append(objs, &lbConfigList.Items[i])
+	}
+	tgConfigList := &elbv2api.TargetGroupConfigurationList{}
+	if err := k8sClient.List(ctx, tgConfigList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list targetGroupConfigurations")
+	}
+	for i := range tgConfigList.Items {
+		objs = append(objs, &tgConfigList.Items[i])
+	}
+	return objs, nil
+}
@@ -0,0 +1,68 @@
+package migrate
+
+import (
+	"sort"
+	"strings"
+
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+// buildLoadBalancerScheme builds the LoadBalancerScheme from raw annotation value, returns false if it's invalid.
+func buildLoadBalancerScheme(rawScheme string) (elbv2api.LoadBalancerScheme, bool) {
+	switch scheme := elbv2api.LoadBalancerScheme(rawScheme); scheme {
+	case elbv2api.LoadBalancerSchemeInternal, elbv2api.LoadBalancerSchemeInternetFacing:
+		return scheme, true
+	default:
+		return "", false
+	}
+}
+
+// buildIPAddressType builds the IPAddressType from raw annotation value, returns false if it's invalid.
+func buildIPAddressType(rawIPAddressType string) (elbv2api.IPAddressType, bool) {
+	switch ipAddressType := elbv2api.IPAddressType(rawIPAddressType); ipAddressType {
+	case elbv2api.IPAddressTypeIPV4, elbv2api.IPAddressTypeDualStack, elbv2api.IPAddressTypeDualStackWithoutPublicIPV4:
+		return ipAddressType, true
+	default:
+		return "", false
+	}
+}
+
+// buildSubnetSelector builds the SubnetSelector from raw annotation values, returns false unless all of them are subnet IDs.
+// subnets specified by name aren't migrated, since the selection of subnets by the Name tag differs from the resolution of names.
+func buildSubnetSelector(rawSubnetNameOrIDs []string) (*elbv2api.SubnetSelector, bool) {
+	if len(rawSubnetNameOrIDs) == 0 {
+		return nil, false
+	}
+	subnetIDs := make([]elbv2api.SubnetID, 0, len(rawSubnetNameOrIDs))
+	for _, nameOrID := range rawSubnetNameOrIDs {
+		if !strings.HasPrefix(nameOrID, "subnet-") {
+			return nil, false
+		}
+		subnetIDs = append(subnetIDs, elbv2api.SubnetID(nameOrID))
+	}
+	return &elbv2api.SubnetSelector{IDs: subnetIDs}, true
+}
+
+// buildAttributes builds the Attributes from raw annotation key-value pairs, ordered by key.
+func buildAttributes(rawAttributes map[string]string) []elbv2api.Attribute {
+	attributes := make([]elbv2api.Attribute, 0, len(rawAttributes))
+	for key, value := range rawAttributes {
+		attributes = append(attributes, elbv2api.Attribute{Key: key, Value: value})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes
+}
+
+// buildTags builds the Tags from raw annotation key-value pairs, ordered by key.
+func buildTags(rawTags map[string]string) []elbv2api.Tag {
+	tags := make([]elbv2api.Tag, 0, len(rawTags))
+	for key, value := range rawTags {
+		tags = append(tags, elbv2api.Tag{Key: key, Value: value})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	return tags
+}
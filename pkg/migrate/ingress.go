@@ -0,0 +1,216 @@
+package migrate
+
+import (
+	"encoding/json"
+	"sort"
+
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// ingressMigrationOptions are the settings that depend on other objects than the Ingress itself.
+type ingressMigrationOptions struct {
+	// migrateSubnets specifies whether subnets can be migrated, subnets in LoadBalancerConfiguration conflict with
+	// the subnets annotation on other Ingresses within the same IngressGroup.
+	migrateSubnets bool
+
+	// migrateTags specifies whether tags can be migrated, tags in LoadBalancerConfiguration take precedence over
+	// the tags annotation on backend Services while tags annotation on Ingress doesn't.
+	migrateTags bool
+}
+
+// migrateIngress migrates the annotations of ing into a LoadBalancerConfiguration.
+// it returns nil if there is no annotation to migrate.
+func (m *defaultMigrator) migrateIngress(ing *networking.Ingress, opts ingressMigrationOptions) (*networking.Ingress, *elbv2api.LoadBalancerConfiguration) {
+	if _, exists := ing.Annotations[annotations.AnnotationPrefixIngress+"/"+annotations.IngressSuffixLoadBalancerConfiguration]; exists {
+		m.logger.Info("skipping ingress that already references LoadBalancerConfiguration", "ingress", k8s.NamespacedName(ing))
+		return nil, nil
+	}
+	migration := &annotationMigration{prefix: annotations.AnnotationPrefixIngress}
+	spec := elbv2api.LoadBalancerConfigurationSpec{}
+
+	rawScheme := ""
+	if exists := m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixScheme, &rawScheme, ing.Annotations); exists {
+		if scheme, ok := buildLoadBalancerScheme(rawScheme); ok {
+			spec.Scheme = &scheme
+			migration.markMigrated(annotations.IngressSuffixScheme)
+		}
+	}
+	rawIPAddressType := ""
+	if exists := m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAddressType, &rawIPAddressType, ing.Annotations); exists {
+		if ipAddressType, ok := buildIPAddressType(rawIPAddressType); ok {
+			spec.IPAddressType = &ipAddressType
+			migration.markMigrated(annotations.IngressSuffixIPAddressType)
+		}
+	}
+	var rawSubnetNameOrIDs []string
+	if exists := m.ingAnnotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, ing.Annotations); exists && opts.migrateSubnets {
+		if subnetSelector, ok := buildSubnetSelector(rawSubnetNameOrIDs); ok {
+			spec.Subnets = subnetSelector
+			migration.markMigrated(annotations.IngressSuffixSubnets)
+		}
+	}
+	var rawSGNameOrIDs []string
+	if exists := m.ingAnnotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroups, &rawSGNameOrIDs, ing.Annotations); exists && len(rawSGNameOrIDs) != 0 {
+		spec.SecurityGroups = rawSGNameOrIDs
+		migration.markMigrated(annotations.IngressSuffixSecurityGroups)
+	}
+	var rawAttributes map[string]string
+	if exists, err := m.ingAnnotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, ing.Annotations); exists && err == nil && len(rawAttributes) != 0 {
+		// deletion protection is checked via annotation upon the deletion of Ingress.
+		if _, exists := rawAttributes[lbAttrsDeletionProtectionEnabled]; !exists {
+			spec.LoadBalancerAttributes = buildAttributes(rawAttributes)
+			migration.markMigrated(annotations.IngressSuffixLoadBalancerAttributes)
+		}
+	}
+	var rawTags map[string]string
+	if exists, err := m.ingAnnotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &rawTags, ing.Annotations); exists && err == nil && len(rawTags) != 0 && opts.migrateTags {
+		spec.Tags = buildTags(rawTags)
+		migration.markMigrated(annotations.IngressSuffixTags)
+	}
+	spec.Listeners = m.migrateIngressListeners(ing, migration)
+	m.migrateIngressAddons(ing, &spec, migration)
+
+	if len(migration.migrated) == 0 {
+		return nil, nil
+	}
+	lbConfigName := buildConfigName(ingressConfigNamePrefix, ing.Name)
+	migratedIng := ing.DeepCopy()
+	migration.apply(migratedIng)
+	migration.reference(migratedIng, annotations.IngressSuffixLoadBalancerConfiguration, lbConfigName)
+	m.logger.Info("migrated ingress annotations", "ingress", k8s.NamespacedName(ing), "annotations", migration.migrated)
+	return migratedIng, &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ing.Namespace, Name: lbConfigName},
+		Spec:       spec,
+	}
+}
+
+// migrateIngressListeners migrates the listen-ports annotation along with certificate-arn and ssl-policy annotations into listeners.
+func (m *defaultMigrator) migrateIngressListeners(ing *networking.Ingress, migration *annotationMigration) []elbv2api.ListenerConfiguration {
+	var rawCertARNs []string
+	_ = m.ingAnnotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawCertARNs, ing.Annotations)
+	// the certificates of TLS secrets are imported only if there are no certificates for Ingress, they must stay on Ingress.
+	migrateCertARNs := len(rawCertARNs) != 0 && !hasIngressTLSSecrets(ing)
+
+	listeners, listenPortsMigrated := m.buildIngressListenersViaListenPorts(ing)
+	if !listenPortsMigrated {
+		// the listen port defaults to 443 for HTTPS if there are certificates, and 80 for HTTP otherwise.
+		if _, exists := ing.Annotations[annotations.AnnotationPrefixIngress+"/"+annotations.IngressSuffixListenPorts]; exists || !migrateCertARNs {
+			return nil
+		}
+		listeners = []elbv2api.ListenerConfiguration{{Port: 443, Protocol: listenerProtocolPtr(elbv2api.ListenerProtocolHTTPS)}}
+	}
+
+	hasHTTPSListener := false
+	for _, listener := range listeners {
+		if *listener.Protocol == elbv2api.ListenerProtocolHTTPS {
+			hasHTTPSListener = true
+		}
+	}
+	rawSSLPolicy := ""
+	_ = m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixSSLPolicy, &rawSSLPolicy, ing.Annotations)
+	migrateSSLPolicy := hasHTTPSListener && rawSSLPolicy != ""
+	migrateCertARNs = hasHTTPSListener && migrateCertARNs
+	for i := range listeners {
+		if *listeners[i].Protocol != elbv2api.ListenerProtocolHTTPS {
+			continue
+		}
+		if migrateCertARNs {
+			listeners[i].CertificateARNs = rawCertARNs
+		}
+		if migrateSSLPolicy {
+			sslPolicy := rawSSLPolicy
+			listeners[i].SSLPolicy = &sslPolicy
+		}
+	}
+
+	if listenPortsMigrated {
+		migration.markMigrated(annotations.IngressSuffixListenPorts)
+	}
+	if migrateCertARNs {
+		migration.markMigrated(annotations.IngressSuffixCertificateARN)
+	}
+	if migrateSSLPolicy {
+		migration.markMigrated(annotations.IngressSuffixSSLPolicy)
+	}
+	return listeners
+}
+
+// buildIngressListenersViaListenPorts builds the listeners from listen-ports annotation, ordered by port.
+// it returns false if the annotation doesn't exist or is invalid.
+func (m *defaultMigrator) buildIngressListenersViaListenPorts(ing *networking.Ingress) ([]elbv2api.ListenerConfiguration, bool) {
+	rawListenPorts := ""
+	if exists := m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
+		return nil, false
+	}
+	var entries []map[string]int64
+	if err := json.Unmarshal([]byte(rawListenPorts), &entries); err != nil || len(entries) == 0 {
+		return nil, false
+	}
+	// ports that are listed more than once are left on Ingress, since the protocol chosen for them depends on the order of entries.
+	ports := sets.NewInt64()
+	var listeners []elbv2api.ListenerConfiguration
+	for _, entry := range entries {
+		for protocol, port := range entry {
+			if port < 1 || port > 65535 || ports.Has(port) {
+				return nil, false
+			}
+			switch protocol {
+			case string(elbv2api.ListenerProtocolHTTP), string(elbv2api.ListenerProtocolHTTPS):
+			default:
+				return nil, false
+			}
+			ports.Insert(port)
+			listeners = append(listeners, elbv2api.ListenerConfiguration{
+				Port:     int32(port),
+				Protocol: listenerProtocolPtr(elbv2api.ListenerProtocol(protocol)),
+			})
+		}
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].Port < listeners[j].Port
+	})
+	return listeners, true
+}
+
+// migrateIngressAddons migrates the WAF and Shield annotations.
+func (m *defaultMigrator) migrateIngressAddons(ing *networking.Ingress, spec *elbv2api.LoadBalancerConfigurationSpec, migration *annotationMigration) {
+	rawWebACLARN := ""
+	_ = m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, ing.Annotations)
+	if rawWebACLARN != "" {
+		spec.WAFv2ACLArn = rawWebACLARN
+		migration.markMigrated(annotations.IngressSuffixWAFv2ACLARN)
+	}
+	// the deprecated web-acl-id annotation is only used if there is no waf-acl-id annotation.
+	rawWebACLID := ""
+	if exists := m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFACLID, &rawWebACLID, ing.Annotations); !exists {
+		_ = m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixWebACLID, &rawWebACLID, ing.Annotations)
+	}
+	if rawWebACLID != "" {
+		spec.WAFACLID = rawWebACLID
+		migration.markMigrated(annotations.IngressSuffixWAFACLID, annotations.IngressSuffixWebACLID)
+	}
+	rawEnableProtection := false
+	if exists, err := m.ingAnnotationParser.ParseBoolAnnotation(annotations.IngressSuffixShieldAdvancedProtection, &rawEnableProtection, ing.Annotations); exists && err == nil {
+		spec.ShieldAdvancedProtection = &rawEnableProtection
+		migration.markMigrated(annotations.IngressSuffixShieldAdvancedProtection)
+	}
+}
+
+// hasIngressTLSSecrets checks whether ing has TLS blocks with secrets.
+func hasIngressTLSSecrets(ing *networking.Ingress) bool {
+	for _, tls := range ing.Spec.TLS {
+		if tls.SecretName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func listenerProtocolPtr(protocol elbv2api.ListenerProtocol) *elbv2api.ListenerProtocol {
+	return &protocol
+}
@@ -0,0 +1,59 @@
+package migrate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	annotationLastAppliedConfiguration = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// serverPopulatedMetadataFields are the metadata fields populated by API server, which must not be applied.
+var serverPopulatedMetadataFields = []string{"resourceVersion", "uid", "creationTimestamp", "generation", "managedFields", "selfLink"}
+
+// WriteManifests writes objs as a multi-document YAML manifest that can be applied, without status and the metadata populated by API server.
+func WriteManifests(w io.Writer, scheme *k8sruntime.Scheme, objs []client.Object) error {
+	for _, obj := range objs {
+		manifest, err := buildManifest(scheme, obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildManifest(scheme *k8sruntime.Scheme, obj client.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+	rawObj, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert %v: %v/%v", gvk.Kind, obj.GetNamespace(), obj.GetName())
+	}
+	rawObj["apiVersion"] = gvk.GroupVersion().String()
+	rawObj["kind"] = gvk.Kind
+	delete(rawObj, "status")
+	if metadata, ok := rawObj["metadata"].(map[string]interface{}); ok {
+		for _, field := range serverPopulatedMetadataFields {
+			delete(metadata, field)
+		}
+		if objAnnotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(objAnnotations, annotationLastAppliedConfiguration)
+			if len(objAnnotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	return yaml.Marshal(rawObj)
+}
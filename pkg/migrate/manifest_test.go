@@ -0,0 +1,108 @@
+package migrate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_WriteManifests(t *testing.T) {
+	tests := []struct {
+		name string
+		objs []client.Object
+		want string
+	}{
+		{
+			name: "no objects",
+			want: "",
+		},
+		{
+			name: "objects with server populated fields",
+			objs: []client.Object{
+				&elbv2api.LoadBalancerConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing"},
+					Spec: elbv2api.LoadBalancerConfigurationSpec{
+						Scheme: ptr.To(elbv2api.LoadBalancerSchemeInternal),
+					},
+				},
+				func() client.Object {
+					ing := newIngress("ing", map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "ingress-ing",
+						"kubectl.kubernetes.io/last-applied-configuration":      "{}",
+					})
+					ing.ResourceVersion = "42"
+					ing.UID = "awesome-uid"
+					ing.Generation = 2
+					ing.CreationTimestamp = metav1.Now()
+					return ing
+				}(),
+				newService("svc", map[string]string{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				}),
+			},
+			want: `---
+apiVersion: elbv2.k8s.aws/v1beta1
+kind: LoadBalancerConfiguration
+metadata:
+  name: ingress-ing
+  namespace: awesome-ns
+spec:
+  scheme: internal
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  annotations:
+    alb.ingress.kubernetes.io/load-balancer-configuration: ingress-ing
+  name: ing
+  namespace: awesome-ns
+spec:
+  defaultBackend:
+    service:
+      name: backend
+      port:
+        number: 80
+  ingressClassName: alb
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  namespace: awesome-ns
+spec:
+  ports:
+  - name: http
+    port: 80
+    protocol: TCP
+    targetPort: 8080
+  - name: https
+    port: 443
+    protocol: TCP
+    targetPort: 8080
+  - name: dns
+    port: 53
+    protocol: UDP
+    targetPort: 53
+  type: LoadBalancer
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := k8sruntime.NewScheme()
+			clientgoscheme.AddToScheme(scheme)
+			elbv2api.AddToScheme(scheme)
+			var buf bytes.Buffer
+			err := WriteManifests(&buf, scheme, tt.objs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package migrate
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+
+	// the generated configurations are named after the migrated objects along with these prefixes,
+	// so that configurations of an Ingress and a Service with same name don't collide.
+	ingressConfigNamePrefix = "ingress-"
+	serviceConfigNamePrefix = "service-"
+	maxConfigNameLength     = 253
+
+	lbAttrsDeletionProtectionEnabled = "deletion_protection.enabled"
+)
+
+// Result is the result of migrating annotations of Ingresses and Services into configuration CRDs.
+type Result struct {
+	// Configurations are the LoadBalancerConfigurations and TargetGroupConfigurations built from annotations.
+	Configurations []client.Object
+
+	// Objects are the migrated Ingresses and Services, whose migrated annotations are replaced by the references to Configurations.
+	Objects []client.Object
+}
+
+// Migrator migrates annotations of Ingresses and Services into configuration CRDs.
+type Migrator interface {
+	// Migrate migrates the annotations of Ingresses and Services among objs.
+	// objs are left unchanged, Ingresses and Services without annotations to migrate are omitted from result.
+	Migrate(ctx context.Context, objs []client.Object) (Result, error)
+}
+
+// NewDefaultMigrator constructs new defaultMigrator.
+func NewDefaultMigrator(serviceUtils service.ServiceUtils, logger logr.Logger) *defaultMigrator {
+	return &defaultMigrator{
+		ingAnnotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+		svcAnnotationParser: annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+		serviceUtils:        serviceUtils,
+		logger:              logger,
+	}
+}
+
+var _ Migrator = &defaultMigrator{}
+
+// default implementation for Migrator.
+// annotations are only migrated if the settings in configuration CRDs are equivalent to them,
+// other annotations are left on the objects.
+type defaultMigrator struct {
+	ingAnnotationParser annotations.Parser
+	svcAnnotationParser annotations.Parser
+	serviceUtils        service.ServiceUtils
+	logger              logr.Logger
+}
+
+func (m *defaultMigrator) Migrate(_ context.Context, objs []client.Object) (Result, error) {
+	lbConfigRefByIngClass := buildLoadBalancerConfigurationRefByIngressClass(objs)
+	ingBackendServices := buildIngressBackendServices(objs)
+	tgConfigServices := buildTargetGroupConfigurationServices(objs)
+	taggedServices := m.buildTaggedServices(objs)
+	legacySubnetsIngGroups := m.buildLegacySubnetsIngressGroups(objs)
+
+	var result Result
+	for _, obj := range objs {
+		switch obj := obj.(type) {
+		case *networking.Ingress:
+			if ingClassName := obj.Spec.IngressClassName; ingClassName != nil && lbConfigRefByIngClass[*ingClassName] {
+				m.logger.Info("skipping ingress whose IngressClassParams references LoadBalancerConfiguration", "ingress", k8s.NamespacedName(obj))
+				continue
+			}
+			opts := ingressMigrationOptions{
+				migrateSubnets: !legacySubnetsIngGroups.Has(m.buildIngressGroupName(obj)),
+				migrateTags:    !taggedServices.HasAny(buildBackendServiceKeys(obj)...),
+			}
+			migratedIng, lbConfig := m.migrateIngress(obj, opts)
+			if migratedIng == nil {
+				continue
+			}
+			result.Objects = append(result.Objects, migratedIng)
+			result.Configurations = append(result.Configurations, lbConfig)
+		case *corev1.Service:
+			if !m.serviceUtils.IsServiceSupported(obj) {
+				continue
+			}
+			// TargetGroupConfiguration applies to the TargetGroups of Ingresses for the Service as well, which mustn't change.
+			svcKey := k8s.NamespacedName(obj)
+			migrateTGConfig := !ingBackendServices.Has(svcKey.String()) && !tgConfigServices.Has(svcKey.String())
+			migratedSvc, configs := m.migrateService(obj, migrateTGConfig)
+			if migratedSvc == nil {
+				continue
+			}
+			result.Objects = append(result.Objects, migratedSvc)
+			result.Configurations = append(result.Configurations, configs...)
+		}
+	}
+	existingConfigs := sets.NewString()
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *elbv2api.LoadBalancerConfiguration, *elbv2api.TargetGroupConfiguration:
+			existingConfigs.Insert(buildObjectKey(obj))
+		}
+	}
+	for _, config := range result.Configurations {
+		if existingConfigs.Has(buildObjectKey(config)) {
+			return Result{}, errors.Errorf("configuration to migrate into already exists: %v", k8s.NamespacedName(config))
+		}
+	}
+	sortConfigurations(result.Configurations)
+	return result, nil
+}
+
+// annotationMigration tracks the annotations migrated from an object.
+type annotationMigration struct {
+	prefix   string
+	migrated []string
+}
+
+// markMigrated marks the annotations with suffixes as migrated.
+func (a *annotationMigration) markMigrated(suffixes ...string) {
+	for _, suffix := range suffixes {
+		a.migrated = append(a.migrated, a.prefix+"/"+suffix)
+	}
+}
+
+// apply removes the migrated annotations from obj.
+func (a *annotationMigration) apply(obj client.Object) {
+	objAnnotations := make(map[string]string, len(obj.GetAnnotations()))
+	for key, value := range obj.GetAnnotations() {
+		objAnnotations[key] = value
+	}
+	for _, key := range a.migrated {
+		delete(objAnnotations, key)
+	}
+	obj.SetAnnotations(objAnnotations)
+}
+
+// reference references the configuration with configName from obj by the annotation with refSuffix.
+func (a *annotationMigration) reference(obj client.Object, refSuffix string, configName string) {
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = make(map[string]string)
+	}
+	objAnnotations[a.prefix+"/"+refSuffix] = configName
+	obj.SetAnnotations(objAnnotations)
+}
+
+// buildConfigName builds the name of configuration for the object with name.
+func buildConfigName(prefix string, name string) string {
+	configName := prefix + name
+	if len(configName) > maxConfigNameLength {
+		configName = strings.TrimRight(configName[:maxConfigNameLength], "-.")
+	}
+	return configName
+}
+
+// buildLoadBalancerConfigurationRefByIngressClass builds whether the IngressClassParams of IngressClasses reference a LoadBalancerConfiguration,
+// which takes precedence over the annotation on Ingresses.
+func buildLoadBalancerConfigurationRefByIngressClass(objs []client.Object) map[string]bool {
+	lbConfigRefByIngClassParams := make(map[string]bool)
+	for _, obj := range objs {
+		if ingClassParams, ok := obj.(*elbv2api.IngressClassParams); ok {
+			lbConfigRefByIngClassParams[ingClassParams.Name] = ingClassParams.Spec.LoadBalancerConfiguration != nil
+		}
+	}
+	lbConfigRefByIngClass := make(map[string]bool)
+	for _, obj := range objs {
+		ingClass, ok := obj.(*networking.IngressClass)
+		if !ok || ingClass.Spec.Parameters == nil {
+			continue
+		}
+		params := ingClass.Spec.Parameters
+		if params.APIGroup == nil || *params.APIGroup != elbv2api.GroupVersion.Group || params.Kind != "IngressClassParams" {
+			continue
+		}
+		lbConfigRefByIngClass[ingClass.Name] = lbConfigRefByIngClassParams[params.Name]
+	}
+	return lbConfigRefByIngClass
+}
+
+// buildIngressBackendServices builds the Services that Ingresses route traffic to, keyed by namespace/name.
+func buildIngressBackendServices(objs []client.Object) sets.String {
+	backendServices := sets.NewString()
+	for _, obj := range objs {
+		if ing, ok := obj.(*networking.Ingress); ok {
+			backendServices.Insert(buildBackendServiceKeys(ing)...)
+		}
+	}
+	return backendServices
+}
+
+// buildBackendServiceKeys builds the Services that ing routes traffic to, keyed by namespace/name.
+func buildBackendServiceKeys(ing *networking.Ingress) []string {
+	var backendServiceKeys []string
+	if ing.Spec.DefaultBackend != nil && ing.Spec.DefaultBackend.Service != nil {
+		backendServiceKeys = append(backendServiceKeys, ing.Namespace+"/"+ing.Spec.DefaultBackend.Service.Name)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil {
+				backendServiceKeys = append(backendServiceKeys, ing.Namespace+"/"+path.Backend.Service.Name)
+			}
+		}
+	}
+	return backendServiceKeys
+}
+
+// buildTaggedServices builds the Services with tags annotation for the TargetGroups of Ingresses, keyed by namespace/name.
+func (m *defaultMigrator) buildTaggedServices(objs []client.Object) sets.String {
+	taggedServices := sets.NewString()
+	for _, obj := range objs {
+		svc, ok := obj.(*corev1.Service)
+		if !ok {
+			continue
+		}
+		if _, exists := svc.Annotations[annotations.AnnotationPrefixIngress+"/"+annotations.IngressSuffixTags]; exists {
+			taggedServices.Insert(k8s.NamespacedName(svc).String())
+		}
+	}
+	return taggedServices
+}
+
+// buildLegacySubnetsIngressGroups builds the IngressGroups with subnets annotation that cannot be migrated,
+// subnets of the other Ingresses within them must stay on annotation as well.
+func (m *defaultMigrator) buildLegacySubnetsIngressGroups(objs []client.Object) sets.String {
+	legacySubnetsIngGroups := sets.NewString()
+	for _, obj := range objs {
+		ing, ok := obj.(*networking.Ingress)
+		if !ok {
+			continue
+		}
+		var rawSubnetNameOrIDs []string
+		if exists := m.ingAnnotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, ing.Annotations); !exists {
+			continue
+		}
+		if _, ok := buildSubnetSelector(rawSubnetNameOrIDs); !ok {
+			legacySubnetsIngGroups.Insert(m.buildIngressGroupName(ing))
+		}
+	}
+	return legacySubnetsIngGroups
+}
+
+// buildIngressGroupName builds the name of IngressGroup per the group.name annotation, Ingresses without it form implicit groups on their own.
+func (m *defaultMigrator) buildIngressGroupName(ing *networking.Ingress) string {
+	groupName := ""
+	if exists := m.ingAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); exists && groupName != "" {
+		return groupName
+	}
+	return k8s.NamespacedName(ing).String()
+}
+
+// buildTargetGroupConfigurationServices builds the Services that already have a TargetGroupConfiguration, keyed by namespace/name.
+func buildTargetGroupConfigurationServices(objs []client.Object) sets.String {
+	tgConfigServices := sets.NewString()
+	for _, obj := range objs {
+		if tgConfig, ok := obj.(*elbv2api.TargetGroupConfiguration); ok {
+			tgConfigServices.Insert(tgConfig.Namespace + "/" + tgConfig.Spec.ServiceRef.Name)
+		}
+	}
+	return tgConfigServices
+}
+
+// sortConfigurations sorts configurations by kind, namespace and name.
+func sortConfigurations(configs []client.Object) {
+	kindOrder := func(obj client.Object) int {
+		if _, ok := obj.(*elbv2api.LoadBalancerConfiguration); ok {
+			return 0
+		}
+		return 1
+	}
+	sort.SliceStable(configs, func(i, j int) bool {
+		if kindOrder(configs[i]) != kindOrder(configs[j]) {
+			return kindOrder(configs[i]) < kindOrder(configs[j])
+		}
+		if configs[i].GetNamespace() != configs[j].GetNamespace() {
+			return configs[i].GetNamespace() < configs[j].GetNamespace()
+		}
+		return configs[i].GetName() < configs[j].GetName()
+	})
+}
@@ -0,0 +1,421 @@
+package migrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newIngress(name string, objAnnotations map[string]string) *networking.Ingress {
+	return &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name, Annotations: objAnnotations},
+		Spec: networking.IngressSpec{
+			IngressClassName: ptr.To("alb"),
+			DefaultBackend: &networking.IngressBackend{
+				Service: &networking.IngressServiceBackend{Name: "backend", Port: networking.ServiceBackendPort{Number: 80}},
+			},
+		},
+	}
+}
+
+func newService(name string, objAnnotations map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name, Annotations: objAnnotations},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(8080)},
+				{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt(8080)},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromInt(53)},
+			},
+		},
+	}
+}
+
+func Test_defaultMigrator_Migrate(t *testing.T) {
+	nlbAnnotations := map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+		"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+	}
+	withNLBAnnotations := func(objAnnotations map[string]string) map[string]string {
+		merged := make(map[string]string)
+		for key, value := range nlbAnnotations {
+			merged[key] = value
+		}
+		for key, value := range objAnnotations {
+			merged[key] = value
+		}
+		return merged
+	}
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		wantResult Result
+		wantErr    error
+	}{
+		{
+			name: "ingress with annotations to migrate",
+			objs: []client.Object{
+				newIngress("ing", map[string]string{
+					"alb.ingress.kubernetes.io/scheme":                     "internet-facing",
+					"alb.ingress.kubernetes.io/ip-address-type":            "dualstack",
+					"alb.ingress.kubernetes.io/subnets":                    "subnet-a, subnet-b",
+					"alb.ingress.kubernetes.io/security-groups":            "sg-a",
+					"alb.ingress.kubernetes.io/listen-ports":               `[{"HTTPS": 443}, {"HTTP": 80}]`,
+					"alb.ingress.kubernetes.io/certificate-arn":            "cert-a,cert-b",
+					"alb.ingress.kubernetes.io/ssl-policy":                 "awesome-policy",
+					"alb.ingress.kubernetes.io/load-balancer-attributes":   "idle_timeout.timeout_seconds=120,routing.http2.enabled=false",
+					"alb.ingress.kubernetes.io/tags":                       "team=awesome,env=prod",
+					"alb.ingress.kubernetes.io/web-acl-id":                 "web-acl",
+					"alb.ingress.kubernetes.io/shield-advanced-protection": "true",
+					"alb.ingress.kubernetes.io/target-type":                "ip",
+				}),
+			},
+			wantResult: Result{
+				Configurations: []client.Object{
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Scheme:         ptr.To(elbv2api.LoadBalancerSchemeInternetFacing),
+							IPAddressType:  ptr.To(elbv2api.IPAddressTypeDualStack),
+							Subnets:        &elbv2api.SubnetSelector{IDs: []elbv2api.SubnetID{"subnet-a", "subnet-b"}},
+							SecurityGroups: []string{"sg-a"},
+							Listeners: []elbv2api.ListenerConfiguration{
+								{Port: 80, Protocol: ptr.To(elbv2api.ListenerProtocolHTTP)},
+								{Port: 443, Protocol: ptr.To(elbv2api.ListenerProtocolHTTPS), CertificateARNs: []string{"cert-a", "cert-b"}, SSLPolicy: ptr.To("awesome-policy")},
+							},
+							LoadBalancerAttributes: []elbv2api.Attribute{
+								{Key: "idle_timeout.timeout_seconds", Value: "120"},
+								{Key: "routing.http2.enabled", Value: "false"},
+							},
+							Tags: []elbv2api.Tag{
+								{Key: "env", Value: "prod"},
+								{Key: "team", Value: "awesome"},
+							},
+							WAFACLID:                 "web-acl",
+							ShieldAdvancedProtection: ptr.To(true),
+						},
+					},
+				},
+				Objects: []client.Object{
+					newIngress("ing", map[string]string{
+						"alb.ingress.kubernetes.io/target-type":                 "ip",
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "ingress-ing",
+					}),
+				},
+			},
+		},
+		{
+			name: "ingress with invalid annotations isn't migrated",
+			objs: []client.Object{
+				newIngress("ing", map[string]string{
+					"alb.ingress.kubernetes.io/scheme":                   "public",
+					"alb.ingress.kubernetes.io/subnets":                  "subnet-a,awesome-subnet",
+					"alb.ingress.kubernetes.io/listen-ports":             `[{"HTTPS": 443}, {"HTTP": 443}]`,
+					"alb.ingress.kubernetes.io/certificate-arn":          "cert-a",
+					"alb.ingress.kubernetes.io/load-balancer-attributes": "deletion_protection.enabled=true",
+				}),
+			},
+			wantResult: Result{},
+		},
+		{
+			name: "ingress certificates default to HTTPS listener, unless there are TLS secrets",
+			objs: []client.Object{
+				newIngress("ing", map[string]string{
+					"alb.ingress.kubernetes.io/certificate-arn": "cert-a",
+				}),
+				func() client.Object {
+					ing := newIngress("ing-tls", map[string]string{
+						"alb.ingress.kubernetes.io/scheme":          "internal",
+						"alb.ingress.kubernetes.io/certificate-arn": "cert-a",
+					})
+					ing.Spec.TLS = []networking.IngressTLS{{Hosts: []string{"www.example.com"}, SecretName: "awesome-secret"}}
+					return ing
+				}(),
+			},
+			wantResult: Result{
+				Configurations: []client.Object{
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Listeners: []elbv2api.ListenerConfiguration{
+								{Port: 443, Protocol: ptr.To(elbv2api.ListenerProtocolHTTPS), CertificateARNs: []string{"cert-a"}},
+							},
+						},
+					},
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing-tls"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Scheme: ptr.To(elbv2api.LoadBalancerSchemeInternal),
+						},
+					},
+				},
+				Objects: []client.Object{
+					newIngress("ing", map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "ingress-ing",
+					}),
+					func() client.Object {
+						ing := newIngress("ing-tls", map[string]string{
+							"alb.ingress.kubernetes.io/certificate-arn":             "cert-a",
+							"alb.ingress.kubernetes.io/load-balancer-configuration": "ingress-ing-tls",
+						})
+						ing.Spec.TLS = []networking.IngressTLS{{Hosts: []string{"www.example.com"}, SecretName: "awesome-secret"}}
+						return ing
+					}(),
+				},
+			},
+		},
+		{
+			name: "ingress subnets and tags are kept if they'd conflict with other objects",
+			objs: []client.Object{
+				newIngress("ing-a", map[string]string{
+					"alb.ingress.kubernetes.io/group.name": "awesome-group",
+					"alb.ingress.kubernetes.io/subnets":    "awesome-subnet",
+				}),
+				newIngress("ing-b", map[string]string{
+					"alb.ingress.kubernetes.io/group.name": "awesome-group",
+					"alb.ingress.kubernetes.io/subnets":    "subnet-a",
+					"alb.ingress.kubernetes.io/tags":       "team=awesome",
+					"alb.ingress.kubernetes.io/scheme":     "internal",
+				}),
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "backend",
+					Annotations: map[string]string{"alb.ingress.kubernetes.io/tags": "team=backend"},
+				}},
+			},
+			wantResult: Result{
+				Configurations: []client.Object{
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing-b"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Scheme: ptr.To(elbv2api.LoadBalancerSchemeInternal),
+						},
+					},
+				},
+				Objects: []client.Object{
+					newIngress("ing-b", map[string]string{
+						"alb.ingress.kubernetes.io/group.name":                  "awesome-group",
+						"alb.ingress.kubernetes.io/subnets":                     "subnet-a",
+						"alb.ingress.kubernetes.io/tags":                        "team=awesome",
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "ingress-ing-b",
+					}),
+				},
+			},
+		},
+		{
+			name: "ingress that already uses LoadBalancerConfiguration is skipped",
+			objs: []client.Object{
+				&networking.IngressClass{
+					ObjectMeta: metav1.ObjectMeta{Name: "alb"},
+					Spec: networking.IngressClassSpec{
+						Controller: "ingress.k8s.aws/alb",
+						Parameters: &networking.IngressClassParametersReference{
+							APIGroup: ptr.To(elbv2api.GroupVersion.Group),
+							Kind:     "IngressClassParams",
+							Name:     "awesome-params",
+						},
+					},
+				},
+				&elbv2api.IngressClassParams{
+					ObjectMeta: metav1.ObjectMeta{Name: "awesome-params"},
+					Spec: elbv2api.IngressClassParamsSpec{
+						LoadBalancerConfiguration: &elbv2api.LoadBalancerConfigurationReference{Namespace: "awesome-ns", Name: "awesome-config"},
+					},
+				},
+				newIngress("ing-a", map[string]string{
+					"alb.ingress.kubernetes.io/scheme": "internal",
+				}),
+				func() client.Object {
+					ing := newIngress("ing-b", map[string]string{
+						"alb.ingress.kubernetes.io/scheme":                      "internal",
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config",
+					})
+					ing.Spec.IngressClassName = ptr.To("other-class")
+					return ing
+				}(),
+			},
+			wantResult: Result{},
+		},
+		{
+			name: "service with annotations to migrate",
+			objs: []client.Object{
+				newService("svc", withNLBAnnotations(map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme":                        "internet-facing",
+					"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":               "dualstack",
+					"service.beta.kubernetes.io/aws-load-balancer-subnets":                       "subnet-a",
+					"service.beta.kubernetes.io/aws-load-balancer-security-groups":               "sg-a,sg-b",
+					"service.beta.kubernetes.io/aws-load-balancer-attributes":                    "load_balancing.cross_zone.enabled=true",
+					"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":                      "cert-a",
+					"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy":        "awesome-policy",
+					"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":      "team=awesome",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol":          "http",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-port":              "8080",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-path":              "/healthz",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-success-codes":     "200-399",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval":          "10",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-timeout":           "1",
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-healthy-threshold": "3",
+					"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes":       "deregistration_delay.timeout_seconds=30",
+				})),
+			},
+			wantResult: Result{
+				Configurations: []client.Object{
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "service-svc"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Scheme:         ptr.To(elbv2api.LoadBalancerSchemeInternetFacing),
+							IPAddressType:  ptr.To(elbv2api.IPAddressTypeDualStack),
+							Subnets:        &elbv2api.SubnetSelector{IDs: []elbv2api.SubnetID{"subnet-a"}},
+							SecurityGroups: []string{"sg-a", "sg-b"},
+							Listeners: []elbv2api.ListenerConfiguration{
+								{Port: 80, Protocol: ptr.To(elbv2api.ListenerProtocolTLS), CertificateARNs: []string{"cert-a"}, SSLPolicy: ptr.To("awesome-policy")},
+								{Port: 443, Protocol: ptr.To(elbv2api.ListenerProtocolTLS), CertificateARNs: []string{"cert-a"}, SSLPolicy: ptr.To("awesome-policy")},
+							},
+							LoadBalancerAttributes: []elbv2api.Attribute{
+								{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+							},
+						},
+					},
+					&elbv2api.TargetGroupConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "service-svc"},
+						Spec: elbv2api.TargetGroupConfigurationSpec{
+							ServiceRef: elbv2api.TargetGroupConfigurationServiceReference{Name: "svc"},
+							DefaultConfiguration: elbv2api.TargetGroupProps{
+								HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+									Port:                  ptr.To(intstr.FromInt(8080)),
+									Protocol:              ptr.To(elbv2api.TargetGroupHealthCheckProtocolHTTP),
+									Path:                  ptr.To("/healthz"),
+									Matcher:               &elbv2api.HealthCheckMatcher{HTTPCode: ptr.To("200-399")},
+									IntervalSeconds:       ptr.To(int64(10)),
+									HealthyThresholdCount: ptr.To(int64(3)),
+								},
+								TargetGroupAttributes: []elbv2api.Attribute{
+									{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+								},
+							},
+						},
+					},
+				},
+				Objects: []client.Object{
+					newService("svc", withNLBAnnotations(map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "team=awesome",
+						"service.beta.kubernetes.io/aws-load-balancer-healthcheck-timeout":      "1",
+						"service.beta.kubernetes.io/aws-load-balancer-configuration":            "service-svc",
+					})),
+				},
+			},
+		},
+		{
+			name: "service ssl-ports select TLS listeners, and legacy attributes annotations are kept",
+			objs: []client.Object{
+				newService("svc", withNLBAnnotations(map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":                          "cert-a",
+					"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":                         "https",
+					"service.beta.kubernetes.io/aws-load-balancer-attributes":                        "load_balancing.cross_zone.enabled=true",
+					"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "false",
+				})),
+			},
+			wantResult: Result{
+				Configurations: []client.Object{
+					&elbv2api.LoadBalancerConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "service-svc"},
+						Spec: elbv2api.LoadBalancerConfigurationSpec{
+							Listeners: []elbv2api.ListenerConfiguration{
+								{Port: 443, Protocol: ptr.To(elbv2api.ListenerProtocolTLS), CertificateARNs: []string{"cert-a"}},
+							},
+						},
+					},
+				},
+				Objects: []client.Object{
+					newService("svc", withNLBAnnotations(map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-attributes":                        "load_balancing.cross_zone.enabled=true",
+						"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "false",
+						"service.beta.kubernetes.io/aws-load-balancer-configuration":                     "service-svc",
+					})),
+				},
+			},
+		},
+		{
+			name: "service TargetGroupConfiguration isn't migrated for ingress backends, and unsupported services are skipped",
+			objs: []client.Object{
+				newIngress("ing", nil),
+				newService("backend", withNLBAnnotations(map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-healthcheck-path": "/healthz",
+				})),
+				newService("unsupported", map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+				}),
+			},
+			wantResult: Result{},
+		},
+		{
+			name: "configuration to migrate into already exists",
+			objs: []client.Object{
+				newIngress("ing", map[string]string{
+					"alb.ingress.kubernetes.io/scheme": "internal",
+				}),
+				&elbv2api.LoadBalancerConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ingress-ing"},
+				},
+			},
+			wantErr: errors.New("configuration to migrate into already exists: awesome-ns/ingress-ing"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceUtils := service.NewServiceUtils(annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix), "service.k8s.aws/resources",
+				"service.k8s.aws/nlb", config.NewFeatureGates())
+			m := NewDefaultMigrator(serviceUtils, logr.Discard())
+			got, err := m.Migrate(context.Background(), tt.objs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantResult, got)
+			}
+		})
+	}
+}
+
+func Test_buildConfigName(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		objName string
+		want    string
+	}{
+		{
+			name:    "short name",
+			prefix:  ingressConfigNamePrefix,
+			objName: "awesome-ing",
+			want:    "ingress-awesome-ing",
+		},
+		{
+			name:    "long name is truncated",
+			prefix:  serviceConfigNamePrefix,
+			objName: strings.Repeat("a", 244) + "-b",
+			want:    "service-" + strings.Repeat("a", 244),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildConfigName(tt.prefix, tt.objName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
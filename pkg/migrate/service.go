@@ -0,0 +1,227 @@
+package migrate
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// migrateService migrates the annotations of svc into a LoadBalancerConfiguration, as well as a TargetGroupConfiguration if migrateTGConfig is true.
+// it returns nil if there is no annotation to migrate.
+func (m *defaultMigrator) migrateService(svc *corev1.Service, migrateTGConfig bool) (*corev1.Service, []client.Object) {
+	migratedSvc := svc.DeepCopy()
+	var configs []client.Object
+	var migrated []string
+
+	if _, exists := svc.Annotations[serviceAnnotationPrefix+"/"+annotations.SvcLBSuffixLoadBalancerConfiguration]; exists {
+		m.logger.Info("skipping service load balancer that already references LoadBalancerConfiguration", "service", k8s.NamespacedName(svc))
+	} else if lbConfigSpec, migration := m.buildServiceLoadBalancerConfigurationSpec(svc); len(migration.migrated) != 0 {
+		lbConfigName := buildConfigName(serviceConfigNamePrefix, svc.Name)
+		migration.apply(migratedSvc)
+		migration.reference(migratedSvc, annotations.SvcLBSuffixLoadBalancerConfiguration, lbConfigName)
+		migrated = append(migrated, migration.migrated...)
+		configs = append(configs, &elbv2api.LoadBalancerConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: svc.Namespace, Name: lbConfigName},
+			Spec:       lbConfigSpec,
+		})
+	}
+
+	if migrateTGConfig {
+		if tgProps, migration := m.buildServiceTargetGroupProps(svc); len(migration.migrated) != 0 {
+			migration.apply(migratedSvc)
+			migrated = append(migrated, migration.migrated...)
+			configs = append(configs, &elbv2api.TargetGroupConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Namespace: svc.Namespace, Name: buildConfigName(serviceConfigNamePrefix, svc.Name)},
+				Spec: elbv2api.TargetGroupConfigurationSpec{
+					ServiceRef:           elbv2api.TargetGroupConfigurationServiceReference{Name: svc.Name},
+					DefaultConfiguration: tgProps,
+				},
+			})
+		}
+	}
+
+	if len(migrated) == 0 {
+		return nil, nil
+	}
+	m.logger.Info("migrated service annotations", "service", k8s.NamespacedName(svc), "annotations", migrated)
+	return migratedSvc, configs
+}
+
+// buildServiceLoadBalancerConfigurationSpec builds the LoadBalancerConfiguration settings from the annotations of svc.
+// the additional-resource-tags annotation isn't migrated, since it applies to TargetGroups as well.
+func (m *defaultMigrator) buildServiceLoadBalancerConfigurationSpec(svc *corev1.Service) (elbv2api.LoadBalancerConfigurationSpec, *annotationMigration) {
+	migration := &annotationMigration{prefix: serviceAnnotationPrefix}
+	spec := elbv2api.LoadBalancerConfigurationSpec{}
+
+	rawScheme := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, svc.Annotations); exists {
+		if scheme, ok := buildLoadBalancerScheme(rawScheme); ok {
+			spec.Scheme = &scheme
+			migration.markMigrated(annotations.SvcLBSuffixScheme)
+		}
+	}
+	rawIPAddressType := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, svc.Annotations); exists {
+		// NLBs don't support dualstack-without-public-ipv4.
+		if ipAddressType, ok := buildIPAddressType(rawIPAddressType); ok && ipAddressType != elbv2api.IPAddressTypeDualStackWithoutPublicIPV4 {
+			spec.IPAddressType = &ipAddressType
+			migration.markMigrated(annotations.SvcLBSuffixIPAddressType)
+		}
+	}
+	var rawSubnetNameOrIDs []string
+	if exists := m.svcAnnotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, svc.Annotations); exists {
+		if subnetSelector, ok := buildSubnetSelector(rawSubnetNameOrIDs); ok {
+			spec.Subnets = subnetSelector
+			migration.markMigrated(annotations.SvcLBSuffixSubnets)
+		}
+	}
+	var rawSGNameOrIDs []string
+	if exists := m.svcAnnotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &rawSGNameOrIDs, svc.Annotations); exists && len(rawSGNameOrIDs) != 0 {
+		spec.SecurityGroups = rawSGNameOrIDs
+		migration.markMigrated(annotations.SvcLBSuffixLoadBalancerSecurityGroups)
+	}
+	var rawAttributes map[string]string
+	if exists, err := m.svcAnnotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixLoadBalancerAttributes, &rawAttributes, svc.Annotations); exists && err == nil && len(rawAttributes) != 0 &&
+		m.canMigrateServiceLoadBalancerAttributes(svc, rawAttributes) {
+		spec.LoadBalancerAttributes = buildAttributes(rawAttributes)
+		migration.markMigrated(annotations.SvcLBSuffixLoadBalancerAttributes)
+	}
+	spec.Listeners = m.migrateServiceListeners(svc, migration)
+	return spec, migration
+}
+
+// canMigrateServiceLoadBalancerAttributes checks whether the load-balancer-attributes annotation can be migrated.
+// the legacy access logs and cross zone annotations take precedence over the annotation but not over LoadBalancerConfiguration,
+// and deletion protection is checked via annotation upon the deletion of Service.
+func (m *defaultMigrator) canMigrateServiceLoadBalancerAttributes(svc *corev1.Service, rawAttributes map[string]string) bool {
+	for _, suffix := range []string{annotations.SvcLBSuffixAccessLogEnabled, annotations.SvcLBSuffixCrossZoneLoadBalancingEnabled} {
+		if _, exists := svc.Annotations[serviceAnnotationPrefix+"/"+suffix]; exists {
+			return false
+		}
+	}
+	_, deletionProtectionSpecified := rawAttributes[lbAttrsDeletionProtectionEnabled]
+	return !deletionProtectionSpecified
+}
+
+// migrateServiceListeners migrates the ssl-cert annotation along with ssl-ports and ssl-negotiation-policy annotations into TLS listeners.
+func (m *defaultMigrator) migrateServiceListeners(svc *corev1.Service, migration *annotationMigration) []elbv2api.ListenerConfiguration {
+	var rawCertARNs []string
+	_ = m.svcAnnotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertificate, &rawCertARNs, svc.Annotations)
+	if len(rawCertARNs) == 0 {
+		return nil
+	}
+	var rawTLSPorts []string
+	tlsPortsExists := m.svcAnnotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLPorts, &rawTLSPorts, svc.Annotations)
+	tlsPorts := sets.NewString(rawTLSPorts...)
+	// listener settings are matched by port number, which must identify a single Service port.
+	portNumbers := sets.NewInt32()
+	usedTLSPorts := sets.NewString()
+	for _, port := range svc.Spec.Ports {
+		if portNumbers.Has(port.Port) {
+			return nil
+		}
+		portNumbers.Insert(port.Port)
+		usedTLSPorts.Insert(port.Name, strconv.Itoa(int(port.Port)))
+	}
+	// ssl-ports that don't match any Service port are invalid.
+	if !usedTLSPorts.IsSuperset(tlsPorts) {
+		return nil
+	}
+
+	rawSSLPolicy := ""
+	_ = m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixSSLNegotiationPolicy, &rawSSLPolicy, svc.Annotations)
+	var listeners []elbv2api.ListenerConfiguration
+	for _, port := range svc.Spec.Ports {
+		useTLS := port.Protocol != corev1.ProtocolUDP && (tlsPorts.Len() == 0 || tlsPorts.Has(port.Name) || tlsPorts.Has(strconv.Itoa(int(port.Port))))
+		if !useTLS {
+			continue
+		}
+		listener := elbv2api.ListenerConfiguration{
+			Port:            port.Port,
+			Protocol:        listenerProtocolPtr(elbv2api.ListenerProtocolTLS),
+			CertificateARNs: rawCertARNs,
+		}
+		if rawSSLPolicy != "" {
+			sslPolicy := rawSSLPolicy
+			listener.SSLPolicy = &sslPolicy
+		}
+		listeners = append(listeners, listener)
+	}
+	if len(listeners) == 0 {
+		return nil
+	}
+	migration.markMigrated(annotations.SvcLBSuffixSSLCertificate)
+	if tlsPortsExists {
+		migration.markMigrated(annotations.SvcLBSuffixSSLPorts)
+	}
+	if rawSSLPolicy != "" {
+		migration.markMigrated(annotations.SvcLBSuffixSSLNegotiationPolicy)
+	}
+	return listeners
+}
+
+// buildServiceTargetGroupProps builds the TargetGroupConfiguration settings from the annotations of svc.
+// the nlb-target-type annotation isn't migrated, since it determines whether the Service is supported by the controller.
+func (m *defaultMigrator) buildServiceTargetGroupProps(svc *corev1.Service) (elbv2api.TargetGroupProps, *annotationMigration) {
+	migration := &annotationMigration{prefix: serviceAnnotationPrefix}
+	hcConfig := elbv2api.TargetGroupHealthCheckConfig{}
+
+	rawHealthCheckPort := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCPort, &rawHealthCheckPort, svc.Annotations); exists && rawHealthCheckPort != "" {
+		healthCheckPort := intstr.Parse(rawHealthCheckPort)
+		hcConfig.Port = &healthCheckPort
+		migration.markMigrated(annotations.SvcLBSuffixHCPort)
+	}
+	rawHealthCheckProtocol := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCProtocol, &rawHealthCheckProtocol, svc.Annotations); exists {
+		switch healthCheckProtocol := elbv2api.TargetGroupHealthCheckProtocol(strings.ToUpper(rawHealthCheckProtocol)); healthCheckProtocol {
+		case elbv2api.TargetGroupHealthCheckProtocolTCP, elbv2api.TargetGroupHealthCheckProtocolHTTP, elbv2api.TargetGroupHealthCheckProtocolHTTPS:
+			hcConfig.Protocol = &healthCheckProtocol
+			migration.markMigrated(annotations.SvcLBSuffixHCProtocol)
+		}
+	}
+	rawHealthCheckPath := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCPath, &rawHealthCheckPath, svc.Annotations); exists {
+		hcConfig.Path = &rawHealthCheckPath
+		migration.markMigrated(annotations.SvcLBSuffixHCPath)
+	}
+	rawSuccessCodes := ""
+	if exists := m.svcAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCSuccessCodes, &rawSuccessCodes, svc.Annotations); exists {
+		hcConfig.Matcher = &elbv2api.HealthCheckMatcher{HTTPCode: &rawSuccessCodes}
+		migration.markMigrated(annotations.SvcLBSuffixHCSuccessCodes)
+	}
+	hcConfig.IntervalSeconds = m.migrateServiceInt64Annotation(svc, annotations.SvcLBSuffixHCInterval, 5, 300, migration)
+	hcConfig.TimeoutSeconds = m.migrateServiceInt64Annotation(svc, annotations.SvcLBSuffixHCTimeout, 2, 120, migration)
+	hcConfig.HealthyThresholdCount = m.migrateServiceInt64Annotation(svc, annotations.SvcLBSuffixHCHealthyThreshold, 2, 10, migration)
+	hcConfig.UnhealthyThresholdCount = m.migrateServiceInt64Annotation(svc, annotations.SvcLBSuffixHCUnhealthyThreshold, 2, 10, migration)
+
+	props := elbv2api.TargetGroupProps{}
+	if len(migration.migrated) != 0 {
+		props.HealthCheckConfig = &hcConfig
+	}
+	var rawAttributes map[string]string
+	if exists, err := m.svcAnnotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawAttributes, svc.Annotations); exists && err == nil && len(rawAttributes) != 0 {
+		props.TargetGroupAttributes = buildAttributes(rawAttributes)
+		migration.markMigrated(annotations.SvcLBSuffixTargetGroupAttributes)
+	}
+	return props, migration
+}
+
+// migrateServiceInt64Annotation migrates the int64 annotation with suffix if it's within the range allowed by TargetGroupConfiguration.
+func (m *defaultMigrator) migrateServiceInt64Annotation(svc *corev1.Service, suffix string, minValue int64, maxValue int64, migration *annotationMigration) *int64 {
+	var value int64
+	exists, err := m.svcAnnotationParser.ParseInt64Annotation(suffix, &value, svc.Annotations)
+	if !exists || err != nil || value < minValue || value > maxValue {
+		return nil
+	}
+	migration.markMigrated(suffix)
+	return &value
+}
@@ -0,0 +1,104 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Verifier verifies that migration doesn't change the model stacks built for Ingresses and Services.
+type Verifier interface {
+	// Verify verifies that the model stacks built for objs are identical before and after applying result.
+	Verify(ctx context.Context, objs []client.Object, result Result) error
+}
+
+// NewDefaultVerifier constructs new defaultVerifier.
+func NewDefaultVerifier(renderer render.Renderer) *defaultVerifier {
+	return &defaultVerifier{
+		renderer: renderer,
+	}
+}
+
+var _ Verifier = &defaultVerifier{}
+
+// default implementation for Verifier, it compares the model stacks rendered before and after migration.
+type defaultVerifier struct {
+	renderer render.Renderer
+}
+
+func (v *defaultVerifier) Verify(ctx context.Context, objs []client.Object, result Result) error {
+	stacksBefore, err := v.renderer.Render(ctx, objs)
+	if err != nil {
+		return errors.Wrap(err, "failed to render stacks before migration")
+	}
+	stacksAfter, err := v.renderer.Render(ctx, ApplyResult(objs, result))
+	if err != nil {
+		return errors.Wrap(err, "failed to render stacks after migration")
+	}
+	modelByStackBefore, err := buildModelByStack(stacksBefore)
+	if err != nil {
+		return err
+	}
+	modelByStackAfter, err := buildModelByStack(stacksAfter)
+	if err != nil {
+		return err
+	}
+
+	changedStacks := sets.NewString()
+	for stackKey, modelBefore := range modelByStackBefore {
+		modelAfter, exists := modelByStackAfter[stackKey]
+		if !exists || !reflect.DeepEqual(modelBefore, modelAfter) {
+			changedStacks.Insert(stackKey)
+		}
+	}
+	for stackKey := range modelByStackAfter {
+		if _, exists := modelByStackBefore[stackKey]; !exists {
+			changedStacks.Insert(stackKey)
+		}
+	}
+	if changedStacks.Len() != 0 {
+		return errors.Errorf("migration changes stacks: %v", changedStacks.List())
+	}
+	return nil
+}
+
+// ApplyResult applies result to objs, the migrated Ingresses and Services replace the original ones and configurations are added.
+func ApplyResult(objs []client.Object, result Result) []client.Object {
+	migratedObjByKey := make(map[string]client.Object, len(result.Objects))
+	for _, obj := range result.Objects {
+		migratedObjByKey[buildObjectKey(obj)] = obj
+	}
+	appliedObjs := make([]client.Object, 0, len(objs)+len(result.Configurations))
+	for _, obj := range objs {
+		if migratedObj, exists := migratedObjByKey[buildObjectKey(obj)]; exists {
+			appliedObjs = append(appliedObjs, migratedObj)
+			continue
+		}
+		appliedObjs = append(appliedObjs, obj)
+	}
+	return append(appliedObjs, result.Configurations...)
+}
+
+// buildModelByStack builds the unmarshalled models keyed by the kind and ID of stacks.
+// models are compared after unmarshalling, so that the formatting of marshalled models doesn't matter.
+func buildModelByStack(stacks []render.RenderedStack) (map[string]interface{}, error) {
+	modelByStack := make(map[string]interface{}, len(stacks))
+	for _, stack := range stacks {
+		var model interface{}
+		if err := json.Unmarshal(stack.Model, &model); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal stack: %v", stack.StackID)
+		}
+		modelByStack[fmt.Sprintf("%v/%v", stack.Kind, stack.StackID)] = model
+	}
+	return modelByStack, nil
+}
+
+func buildObjectKey(obj client.Object) string {
+	return fmt.Sprintf("%T/%v/%v", obj, obj.GetNamespace(), obj.GetName())
+}
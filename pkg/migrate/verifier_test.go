@@ -0,0 +1,157 @@
+package migrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_defaultVerifier_Verify(t *testing.T) {
+	fixture := `
+vpcID: vpc-xxx
+vpcCIDRs: [10.0.0.0/16]
+backendSecurityGroupID: sg-backend
+subnets:
+- id: subnet-a
+  availabilityZone: us-west-2a
+  availabilityZoneID: usw2-az1
+  tags: {kubernetes.io/role/elb: "1", kubernetes.io/role/internal-elb: "1"}
+- id: subnet-b
+  availabilityZone: us-west-2b
+  availabilityZoneID: usw2-az2
+  tags: {kubernetes.io/role/elb: "1", kubernetes.io/role/internal-elb: "1"}
+`
+	manifests := `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: NodePort
+  ports:
+  - port: 80
+    nodePort: 30080
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  annotations:
+    alb.ingress.kubernetes.io/scheme: internet-facing
+    alb.ingress.kubernetes.io/subnets: subnet-b,subnet-a
+    alb.ingress.kubernetes.io/listen-ports: '[{"HTTP": 80}, {"HTTPS": 443}]'
+    alb.ingress.kubernetes.io/certificate-arn: arn:aws:acm:us-west-2:123456789012:certificate/abc
+    alb.ingress.kubernetes.io/tags: team=web
+spec:
+  ingressClassName: alb
+  defaultBackend:
+    service:
+      name: web
+      port:
+        number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nlb
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internal
+    service.beta.kubernetes.io/aws-load-balancer-healthcheck-path: /healthz
+    service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol: http
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 80
+    targetPort: 8080
+`
+	dir := t.TempDir()
+	fixturePath := filepath.Join(dir, "fixture.yaml")
+	manifestsPath := filepath.Join(dir, "manifests.yaml")
+	assert.NoError(t, os.WriteFile(fixturePath, []byte(fixture), 0o644))
+	assert.NoError(t, os.WriteFile(manifestsPath, []byte(manifests), 0o644))
+
+	scheme := k8sruntime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+	objs, err := render.LoadManifests(scheme, []string{manifestsPath})
+	assert.NoError(t, err)
+	render.DefaultNamespace(objs, "awesome-ns")
+	loadedFixture, err := render.LoadFixture(fixturePath)
+	assert.NoError(t, err)
+
+	serviceUtils := service.NewServiceUtils(annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix), "service.k8s.aws/resources",
+		"service.k8s.aws/nlb", config.NewFeatureGates())
+	result, err := NewDefaultMigrator(serviceUtils, logr.Discard()).Migrate(context.Background(), objs)
+	assert.NoError(t, err)
+	assert.Len(t, result.Configurations, 3)
+
+	tests := []struct {
+		name       string
+		modifyFunc func(result Result) Result
+		wantErr    error
+	}{
+		{
+			name: "migration doesn't change stacks",
+		},
+		{
+			name: "migration changes stacks",
+			modifyFunc: func(result Result) Result {
+				modifiedConfigs := make([]client.Object, 0, len(result.Configurations))
+				for _, cfg := range result.Configurations {
+					if lbConfig, ok := cfg.(*elbv2api.LoadBalancerConfiguration); ok {
+						modifiedConfig := lbConfig.DeepCopy()
+						modifiedConfig.Spec.Scheme = ptr.To(elbv2api.LoadBalancerSchemeInternal)
+						cfg = modifiedConfig
+					}
+					modifiedConfigs = append(modifiedConfigs, cfg)
+				}
+				return Result{Configurations: modifiedConfigs, Objects: result.Objects}
+			},
+			wantErr: errors.New("migration changes stacks: [IngressGroup/awesome-ns/web]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifyResult := result
+			if tt.modifyFunc != nil {
+				verifyResult = tt.modifyFunc(result)
+			}
+			controllerConfig := config.ControllerConfig{
+				ClusterName:       "awesome-cluster",
+				DefaultTargetType: "instance",
+				DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+				FeatureGates:      config.NewFeatureGates(),
+			}
+			v := NewDefaultVerifier(render.NewDefaultRenderer(scheme, controllerConfig, loadedFixture, logr.Discard()))
+			err := v.Verify(context.Background(), objs, verifyResult)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
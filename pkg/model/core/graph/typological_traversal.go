@@ -5,7 +5,6 @@ import (
 )
 
 // TopologicalTraversal will traversal nodes in typological order.
-// @TODO: change this traversal to be parallel.
 func TopologicalTraversal(graph ResourceGraph, visitFunc func(uid ResourceUID) error) error {
 	nodes := graph.Nodes()
 	indegreeByNode := make(map[ResourceUID]int, len(nodes))
@@ -48,3 +47,74 @@ func TopologicalTraversal(graph ResourceGraph, visitFunc func(uid ResourceUID) e
 	}
 	return nil
 }
+
+// ParallelTopologicalTraversal will traversal nodes in typological order, where independent nodes are visited concurrently.
+// a node is visited once all nodes it depends on are visited, and at most maxConcurrency nodes are visited at the same time.
+// once a visit fails, no more nodes will be visited and the first error is returned after ongoing visits finished.
+func ParallelTopologicalTraversal(graph ResourceGraph, maxConcurrency int, visitFunc func(uid ResourceUID) error) error {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	nodes := graph.Nodes()
+	indegreeByNode := make(map[ResourceUID]int, len(nodes))
+	for _, node := range nodes {
+		if _, ok := indegreeByNode[node]; !ok {
+			indegreeByNode[node] = 0
+		}
+		for _, outEdgeNode := range graph.OutEdgeNodes(node) {
+			indegreeByNode[outEdgeNode]++
+		}
+	}
+
+	var queue []ResourceUID
+	for _, node := range nodes {
+		if indegreeByNode[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	type visitResult struct {
+		node ResourceUID
+		err  error
+	}
+	results := make(chan visitResult, len(indegreeByNode))
+	running := 0
+	var firstErr error
+	for len(queue) > 0 || running > 0 {
+		for firstErr == nil && len(queue) > 0 && running < maxConcurrency {
+			node := queue[0]
+			queue = queue[1:]
+			running++
+			go func() {
+				results <- visitResult{node: node, err: visitFunc(node)}
+			}()
+		}
+		if running == 0 {
+			break
+		}
+		result := <-results
+		running--
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			continue
+		}
+		for _, outEdgeNode := range graph.OutEdgeNodes(result.node) {
+			indegreeByNode[outEdgeNode]--
+			if indegreeByNode[outEdgeNode] == 0 {
+				queue = append(queue, outEdgeNode)
+			}
+		}
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+
+	for _, indegree := range indegreeByNode {
+		if indegree > 0 {
+			return errors.New("ResourceGraph is not a DAG")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelTopologicalTraversal(t *testing.T) {
+	type edge struct {
+		src string
+		dst string
+	}
+	tests := []struct {
+		name           string
+		nodes          []string
+		edges          []edge
+		maxConcurrency int
+		failedNodes    []string
+		wantVisited    []string
+		wantErr        error
+	}{
+		{
+			name:           "independent nodes",
+			nodes:          []string{"node-A", "node-B", "node-C"},
+			maxConcurrency: 2,
+			wantVisited:    []string{"node-A", "node-B", "node-C"},
+		},
+		{
+			name:  "dependent nodes",
+			nodes: []string{"node-A", "node-B", "node-C", "node-D"},
+			edges: []edge{
+				{src: "node-A", dst: "node-C"},
+				{src: "node-B", dst: "node-C"},
+				{src: "node-C", dst: "node-D"},
+			},
+			maxConcurrency: 3,
+			wantVisited:    []string{"node-A", "node-B", "node-C", "node-D"},
+		},
+		{
+			name:  "nodes depend on failed node are not visited",
+			nodes: []string{"node-A", "node-B", "node-C"},
+			edges: []edge{
+				{src: "node-A", dst: "node-C"},
+			},
+			maxConcurrency: 3,
+			failedNodes:    []string{"node-A"},
+			wantVisited:    []string{"node-A", "node-B"},
+			wantErr:        errors.New("failed to visit node-A"),
+		},
+		{
+			name:  "graph with cycle",
+			nodes: []string{"node-A", "node-B", "node-C"},
+			edges: []edge{
+				{src: "node-B", dst: "node-C"},
+				{src: "node-C", dst: "node-B"},
+			},
+			maxConcurrency: 3,
+			wantVisited:    []string{"node-A"},
+			wantErr:        errors.New("ResourceGraph is not a DAG"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := NewDefaultResourceGraph()
+			for _, node := range tt.nodes {
+				graph.AddNode(fakeResourceUID(node))
+			}
+			for _, e := range tt.edges {
+				graph.AddEdge(fakeResourceUID(e.src), fakeResourceUID(e.dst))
+			}
+
+			var mutex sync.Mutex
+			visitedNodes := make(map[string]bool)
+			running := 0
+			maxRunning := 0
+			err := ParallelTopologicalTraversal(graph, tt.maxConcurrency, func(uid ResourceUID) error {
+				mutex.Lock()
+				for _, e := range tt.edges {
+					if e.dst == uid.ResID {
+						assert.True(t, visitedNodes[e.src], "%v visited before %v", uid.ResID, e.src)
+					}
+				}
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				mutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				running--
+				visitedNodes[uid.ResID] = true
+				for _, failedNode := range tt.failedNodes {
+					if failedNode == uid.ResID {
+						return errors.New("failed to visit " + uid.ResID)
+					}
+				}
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			var gotVisited []string
+			for _, node := range tt.nodes {
+				if visitedNodes[node] {
+					gotVisited = append(gotVisited, node)
+				}
+			}
+			assert.Equal(t, tt.wantVisited, gotVisited)
+			assert.LessOrEqual(t, maxRunning, tt.maxConcurrency)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package core
+
+// StackKind is the kind of Kubernetes resource a model stack is built for.
+type StackKind string
+
+const (
+	StackKindIngressGroup StackKind = "IngressGroup"
+	StackKindService      StackKind = "Service"
+)
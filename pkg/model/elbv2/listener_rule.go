@@ -129,7 +129,8 @@ type RuleCondition struct {
 type ListenerRuleSpec struct {
 	// The Amazon Resource Name (ARN) of the listener.
 	ListenerARN core.StringToken `json:"listenerARN"`
-	// The rule priority.
+	// The rule priority, which denotes the precedence of rule among rules on the same listener.
+	// the actual priority is allocated upon deployment, and preserved across reconciles while the precedence holds.
 	Priority int64 `json:"priority"`
 	// The actions.
 	Actions []Action `json:"actions"`
@@ -167,6 +167,10 @@ type LoadBalancerSpec struct {
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+
+	// The ARN of an existing load balancer to adopt instead of creating a new one.
+	// +optional
+	ExistingLoadBalancerARN string `json:"existingLoadBalancerARN,omitempty"`
 }
 
 // LoadBalancerStatus defines the observed state of LoadBalancer
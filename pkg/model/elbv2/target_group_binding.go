@@ -107,6 +107,14 @@ type TargetGroupBindingSpec struct {
 	// VpcID is the VPC of the TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
 	VpcID string `json:"vpcID,omitempty"`
+
+	// IAMRoleARNToAssume is the ARN of the IAM role to assume to access the TargetGroup in another AWS account.
+	// +optional
+	IAMRoleARNToAssume string `json:"iamRoleArnToAssume,omitempty"`
+
+	// AssumeRoleExternalID is the external ID to specify when assuming the IAM role.
+	// +optional
+	AssumeRoleExternalID string `json:"assumeRoleExternalId,omitempty"`
 }
 
 // Template for TargetGroupBinding Custom Resource.
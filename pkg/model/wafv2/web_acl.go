@@ -0,0 +1,197 @@
+package wafv2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &WebACL{}
+
+// WebACL represents a WAFv2 webACL provisioned by the controller.
+type WebACL struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of WebACL
+	Spec WebACLSpec `json:"spec"`
+
+	// observed state of WebACL
+	// +optional
+	Status *WebACLStatus `json:"status,omitempty"`
+}
+
+// NewWebACL constructs new WebACL resource.
+func NewWebACL(stack core.Stack, id string, spec WebACLSpec) *WebACL {
+	webACL := &WebACL{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::WAFv2::WebACL", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(webACL)
+	return webACL
+}
+
+// SetStatus sets the WebACL's status
+func (w *WebACL) SetStatus(status WebACLStatus) {
+	w.Status = &status
+}
+
+// WebACLARN returns The Amazon Resource Name (ARN) of the webACL.
+func (w *WebACL) WebACLARN() core.StringToken {
+	return core.NewResourceFieldStringToken(w, "status/webACLARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			webACL := res.(*WebACL)
+			if webACL.Status == nil {
+				return "", errors.Errorf("WebACL is not fulfilled yet: %v", webACL.ID())
+			}
+			return webACL.Status.WebACLARN, nil
+		},
+	)
+}
+
+type ActionType string
+
+const (
+	ActionTypeAllow ActionType = "Allow"
+	ActionTypeBlock ActionType = "Block"
+	ActionTypeCount ActionType = "Count"
+)
+
+type OverrideActionType string
+
+const (
+	OverrideActionTypeNone  OverrideActionType = "None"
+	OverrideActionTypeCount OverrideActionType = "Count"
+)
+
+// CustomResponse defines the custom response to blocked requests.
+type CustomResponse struct {
+	// HTTP status code of the response.
+	ResponseCode int64 `json:"responseCode"`
+
+	// the key of response body in the customResponseBodies of webACL.
+	// +optional
+	CustomResponseBodyKey string `json:"customResponseBodyKey,omitempty"`
+}
+
+// CustomResponseBody defines a body of custom responses.
+type CustomResponseBody struct {
+	// the type of content, one of TEXT_PLAIN, TEXT_HTML or APPLICATION_JSON.
+	ContentType string `json:"contentType"`
+
+	// the body content.
+	Content string `json:"content"`
+}
+
+// Action defines the action for requests.
+type Action struct {
+	// the type of action.
+	Type ActionType `json:"type"`
+
+	// the custom response to blocked requests.
+	// +optional
+	CustomResponse *CustomResponse `json:"customResponse,omitempty"`
+}
+
+// ManagedRuleGroupStatement defines a statement that evaluates requests against a managed rule group.
+type ManagedRuleGroupStatement struct {
+	// the vendor of rule group.
+	VendorName string `json:"vendorName"`
+
+	// the name of rule group.
+	Name string `json:"name"`
+
+	// the version of rule group.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// the rules in rule group whose matches are counted only.
+	// +optional
+	ExcludedRules []string `json:"excludedRules,omitempty"`
+}
+
+// RateBasedStatement defines a statement that matches requests from IP addresses exceeding a rate limit.
+type RateBasedStatement struct {
+	// the maximum number of requests from an IP address in any 5 minute period.
+	Limit int64 `json:"limit"`
+
+	// the header with the IP address of requests.
+	// +optional
+	ForwardedIPHeader string `json:"forwardedIPHeader,omitempty"`
+}
+
+// IPSetReferenceStatement defines a statement that matches requests from IP addresses in an IP set.
+type IPSetReferenceStatement struct {
+	// the ARN of an existing IP set.
+	// +optional
+	ARN string `json:"arn,omitempty"`
+
+	// the IP address version of addresses.
+	// +optional
+	IPAddressVersion string `json:"ipAddressVersion,omitempty"`
+
+	// the IP addresses of an IP set provisioned along with webACL.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// Rule defines a rule of webACL.
+type Rule struct {
+	// the name of rule.
+	Name string `json:"name"`
+
+	// the order in which rules are evaluated.
+	Priority int64 `json:"priority"`
+
+	// the action for requests matching RateBased or IPSetReference statement.
+	// +optional
+	Action *Action `json:"action,omitempty"`
+
+	// the action that overrides the actions of rules in ManagedRuleGroup statement.
+	// +optional
+	OverrideAction *OverrideActionType `json:"overrideAction,omitempty"`
+
+	// +optional
+	ManagedRuleGroup *ManagedRuleGroupStatement `json:"managedRuleGroup,omitempty"`
+
+	// +optional
+	RateBased *RateBasedStatement `json:"rateBased,omitempty"`
+
+	// +optional
+	IPSetReference *IPSetReferenceStatement `json:"ipSetReference,omitempty"`
+}
+
+// WebACLSpec defines the desired state of WebACL
+type WebACLSpec struct {
+	// The name of the webACL.
+	Name string `json:"name"`
+
+	// The description of the webACL.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// The action for requests matching none of the rules.
+	DefaultAction Action `json:"defaultAction"`
+
+	// The rules of the webACL.
+	// +optional
+	Rules []Rule `json:"rules,omitempty"`
+
+	// The bodies of custom responses.
+	// +optional
+	CustomResponseBodies map[string]CustomResponseBody `json:"customResponseBodies,omitempty"`
+
+	// Whether to send metrics to CloudWatch and sample requests.
+	MetricsEnabled bool `json:"metricsEnabled"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// WebACLStatus defines the observed state of WebACL
+type WebACLStatus struct {
+	// The Amazon Resource Name (ARN) of the webACL.
+	WebACLARN string `json:"webACLARN"`
+}
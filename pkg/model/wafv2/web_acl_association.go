@@ -25,6 +25,9 @@ func NewWebACLAssociation(stack core.Stack, id string, spec WebACLAssociationSpe
 
 // register dependencies for WebACLAssociation.
 func (a *WebACLAssociation) registerDependencies(stack core.Stack) {
+	for _, dep := range a.Spec.WebACLARN.Dependencies() {
+		stack.AddDependency(dep, a)
+	}
 	for _, dep := range a.Spec.ResourceARN.Dependencies() {
 		stack.AddDependency(dep, a)
 	}
@@ -32,6 +35,6 @@ func (a *WebACLAssociation) registerDependencies(stack core.Stack) {
 
 // WebACLAssociationSpec defines the desired state of LoadBalancer
 type WebACLAssociationSpec struct {
-	WebACLARN   string           `json:"webACLARN"`
+	WebACLARN   core.StringToken `json:"webACLARN"`
 	ResourceARN core.StringToken `json:"resourceARN"`
 }
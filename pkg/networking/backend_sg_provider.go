@@ -252,6 +252,49 @@ func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context, resour
 	return nil
 }
 
+// ReloadDefaultTags applies the reloaded defaultTags, to the auto-generated backend SG as well if it exists.
+// default tags that are no longer in defaultTags are removed from the backend SG.
+func (p *defaultBackendSGProvider) ReloadDefaultTags(ctx context.Context, defaultTags map[string]string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	currentDefaultTags := p.defaultTags
+	p.defaultTags = defaultTags
+	if len(p.autoGeneratedSG) == 0 {
+		return nil
+	}
+
+	var tagsToAdd []*ec2sdk.Tag
+	for key, val := range p.defaultTags {
+		if currentVal, exists := currentDefaultTags[key]; !exists || currentVal != val {
+			tagsToAdd = append(tagsToAdd, &ec2sdk.Tag{Key: awssdk.String(key), Value: awssdk.String(val)})
+		}
+	}
+	var tagsToRemove []*ec2sdk.Tag
+	for key := range currentDefaultTags {
+		if _, exists := p.defaultTags[key]; !exists {
+			tagsToRemove = append(tagsToRemove, &ec2sdk.Tag{Key: awssdk.String(key)})
+		}
+	}
+	if len(tagsToAdd) > 0 {
+		if _, err := p.ec2Client.CreateTagsWithContext(ctx, &ec2sdk.CreateTagsInput{
+			Resources: awssdk.StringSlice([]string{p.autoGeneratedSG}),
+			Tags:      tagsToAdd,
+		}); err != nil {
+			return errors.Wrap(err, "failed to tag backend securityGroup")
+		}
+	}
+	if len(tagsToRemove) > 0 {
+		if _, err := p.ec2Client.DeleteTagsWithContext(ctx, &ec2sdk.DeleteTagsInput{
+			Resources: awssdk.StringSlice([]string{p.autoGeneratedSG}),
+			Tags:      tagsToRemove,
+		}); err != nil {
+			return errors.Wrap(err, "failed to untag backend securityGroup")
+		}
+	}
+	p.logger.Info("reloaded default tags of backend securityGroup", "ID", p.autoGeneratedSG)
+	return nil
+}
+
 func (p *defaultBackendSGProvider) buildBackendSGTags(_ context.Context) []*ec2sdk.TagSpecification {
 	var defaultTags []*ec2sdk.Tag
 	for key, val := range p.defaultTags {
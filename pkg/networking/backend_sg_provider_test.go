@@ -793,3 +793,64 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultBackendSGProvider_ReloadDefaultTags(t *testing.T) {
+	tests := []struct {
+		name            string
+		autogenSG       string
+		defaultTags     map[string]string
+		reloadedTags    map[string]string
+		wantCreateTags  *ec2sdk.CreateTagsInput
+		wantDeleteTags  *ec2sdk.DeleteTagsInput
+		wantDefaultTags map[string]string
+	}{
+		{
+			name:            "backend SG isn't generated yet",
+			defaultTags:     map[string]string{"team": "platform"},
+			reloadedTags:    map[string]string{"team": "networking"},
+			wantDefaultTags: map[string]string{"team": "networking"},
+		},
+		{
+			name:         "changed default tags are applied to generated backend SG",
+			autogenSG:    "sg-autogen",
+			defaultTags:  map[string]string{"team": "platform", "env": "prod"},
+			reloadedTags: map[string]string{"team": "networking"},
+			wantCreateTags: &ec2sdk.CreateTagsInput{
+				Resources: awssdk.StringSlice([]string{"sg-autogen"}),
+				Tags:      []*ec2sdk.Tag{{Key: awssdk.String("team"), Value: awssdk.String("networking")}},
+			},
+			wantDeleteTags: &ec2sdk.DeleteTagsInput{
+				Resources: awssdk.StringSlice([]string{"sg-autogen"}),
+				Tags:      []*ec2sdk.Tag{{Key: awssdk.String("env")}},
+			},
+			wantDefaultTags: map[string]string{"team": "networking"},
+		},
+		{
+			name:            "unchanged default tags",
+			autogenSG:       "sg-autogen",
+			defaultTags:     map[string]string{"team": "platform"},
+			reloadedTags:    map[string]string{"team": "platform"},
+			wantDefaultTags: map[string]string{"team": "platform"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			if tt.wantCreateTags != nil {
+				ec2Client.EXPECT().CreateTagsWithContext(gomock.Any(), tt.wantCreateTags).Return(&ec2sdk.CreateTagsOutput{}, nil)
+			}
+			if tt.wantDeleteTags != nil {
+				ec2Client.EXPECT().DeleteTagsWithContext(gomock.Any(), tt.wantDeleteTags).Return(&ec2sdk.DeleteTagsOutput{}, nil)
+			}
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, nil, tt.defaultTags, logr.New(&log.NullLogSink{}))
+			sgProvider.autoGeneratedSG = tt.autogenSG
+
+			err := sgProvider.ReloadDefaultTags(context.Background(), tt.reloadedTags)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDefaultTags, sgProvider.defaultTags)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package render
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultFixtureSubnetAvailableIPAddressCount = 256
+	defaultFixtureAvailabilityZoneType          = "availability-zone"
+	defaultFixtureCertificateType               = "AMAZON_ISSUED"
+)
+
+// Fixture is the set of AWS resources that AWS lookups are satisfied from when rendering.
+type Fixture struct {
+	// VpcID is the ID of the VPC where the controller runs.
+	VpcID string `json:"vpcID"`
+
+	// VpcCIDRs are the IPv4 CIDRs associated with the VPC.
+	// +optional
+	VpcCIDRs []string `json:"vpcCIDRs,omitempty"`
+
+	// VpcIPv6CIDRs are the IPv6 CIDRs associated with the VPC.
+	// +optional
+	VpcIPv6CIDRs []string `json:"vpcIPv6CIDRs,omitempty"`
+
+	// BackendSecurityGroupID is the ID of the backend SecurityGroup shared by LoadBalancers.
+	// +optional
+	BackendSecurityGroupID string `json:"backendSecurityGroupID,omitempty"`
+
+	// Subnets are the subnets within VPC.
+	// +optional
+	Subnets []FixtureSubnet `json:"subnets,omitempty"`
+
+	// SecurityGroups are the SecurityGroups within VPC.
+	// +optional
+	SecurityGroups []FixtureSecurityGroup `json:"securityGroups,omitempty"`
+
+	// Certificates are the ACM certificates that are issued.
+	// +optional
+	Certificates []FixtureCertificate `json:"certificates,omitempty"`
+
+	// TrustStores are the ELBv2 TrustStores.
+	// +optional
+	TrustStores []FixtureTrustStore `json:"trustStores,omitempty"`
+}
+
+// FixtureSubnet is a subnet within VPC.
+type FixtureSubnet struct {
+	// ID is the ID of subnet.
+	ID string `json:"id"`
+
+	// AvailabilityZone is the name of the availability zone of subnet.
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// AvailabilityZoneID is the ID of the availability zone of subnet.
+	AvailabilityZoneID string `json:"availabilityZoneID"`
+
+	// AvailabilityZoneType is the type of the availability zone of subnet, defaults to availability-zone.
+	// +optional
+	AvailabilityZoneType string `json:"availabilityZoneType,omitempty"`
+
+	// CIDRBlock is the IPv4 CIDR of subnet.
+	// +optional
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv6CIDRBlocks are the IPv6 CIDRs of subnet.
+	// +optional
+	IPv6CIDRBlocks []string `json:"ipv6CIDRBlocks,omitempty"`
+
+	// AvailableIPAddressCount is the number of available IPv4 addresses in subnet, defaults to 256.
+	// +optional
+	AvailableIPAddressCount *int64 `json:"availableIPAddressCount,omitempty"`
+
+	// Tags are the tags of subnet.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// FixtureSecurityGroup is a SecurityGroup within VPC.
+type FixtureSecurityGroup struct {
+	// ID is the ID of SecurityGroup.
+	ID string `json:"id"`
+
+	// Name is the name of SecurityGroup, it's also exposed as the Name tag.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Tags are the tags of SecurityGroup.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// FixtureCertificate is an issued ACM certificate.
+type FixtureCertificate struct {
+	// ARN is the ARN of certificate.
+	ARN string `json:"arn"`
+
+	// DomainName is the domain name of certificate.
+	DomainName string `json:"domainName"`
+
+	// SubjectAlternativeNames are the additional domain names of certificate.
+	// the DomainName is always considered as a subject alternative name.
+	// +optional
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+
+	// Type is the type of certificate, defaults to AMAZON_ISSUED.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// CertificateAuthorityARN is the ARN of the private CA that issued certificate.
+	// +optional
+	CertificateAuthorityARN string `json:"certificateAuthorityARN,omitempty"`
+}
+
+// FixtureTrustStore is an ELBv2 TrustStore.
+type FixtureTrustStore struct {
+	// Name is the name of TrustStore.
+	Name string `json:"name"`
+
+	// ARN is the ARN of TrustStore.
+	ARN string `json:"arn"`
+}
+
+// LoadFixture loads the fixture from a YAML or JSON file.
+func LoadFixture(path string) (Fixture, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, errors.Wrapf(err, "failed to read fixture: %v", path)
+	}
+	var fixture Fixture
+	if err := yaml.UnmarshalStrict(payload, &fixture); err != nil {
+		return Fixture{}, errors.Wrapf(err, "failed to decode fixture: %v", path)
+	}
+	if err := validateFixture(fixture); err != nil {
+		return Fixture{}, errors.Wrapf(err, "invalid fixture: %v", path)
+	}
+	return defaultFixture(fixture), nil
+}
+
+func validateFixture(fixture Fixture) error {
+	if fixture.VpcID == "" {
+		return errors.New("vpcID must be specified")
+	}
+	for _, subnet := range fixture.Subnets {
+		if subnet.ID == "" || subnet.AvailabilityZone == "" || subnet.AvailabilityZoneID == "" {
+			return errors.Errorf("id, availabilityZone and availabilityZoneID must be specified for subnet: %v", subnet.ID)
+		}
+	}
+	for _, sg := range fixture.SecurityGroups {
+		if sg.ID == "" {
+			return errors.New("id must be specified for securityGroup")
+		}
+	}
+	for _, cert := range fixture.Certificates {
+		if cert.ARN == "" || cert.DomainName == "" {
+			return errors.Errorf("arn and domainName must be specified for certificate: %v", cert.ARN)
+		}
+	}
+	for _, ts := range fixture.TrustStores {
+		if ts.Name == "" || ts.ARN == "" {
+			return errors.Errorf("name and arn must be specified for trustStore: %v", ts.Name)
+		}
+	}
+	return nil
+}
+
+func defaultFixture(fixture Fixture) Fixture {
+	for i := range fixture.Subnets {
+		subnet := &fixture.Subnets[i]
+		if subnet.AvailabilityZoneType == "" {
+			subnet.AvailabilityZoneType = defaultFixtureAvailabilityZoneType
+		}
+		if subnet.AvailableIPAddressCount == nil {
+			availableIPAddressCount := int64(defaultFixtureSubnetAvailableIPAddressCount)
+			subnet.AvailableIPAddressCount = &availableIPAddressCount
+		}
+	}
+	for i := range fixture.Certificates {
+		cert := &fixture.Certificates[i]
+		if cert.Type == "" {
+			cert.Type = defaultFixtureCertificateType
+		}
+	}
+	return fixture
+}
@@ -0,0 +1,331 @@
+package render
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// NewFixtureEC2 constructs new EC2 implementation that answers lookups from fixture.
+// only the lookups used during model building are supported.
+func NewFixtureEC2(fixture Fixture) *fixtureEC2 {
+	return &fixtureEC2{
+		fixture: fixture,
+	}
+}
+
+var _ services.EC2 = &fixtureEC2{}
+
+// fixture implementation for EC2.
+type fixtureEC2 struct {
+	services.EC2
+	fixture Fixture
+}
+
+func (c *fixtureEC2) DescribeSubnetsAsList(_ context.Context, input *ec2sdk.DescribeSubnetsInput) ([]*ec2sdk.Subnet, error) {
+	subnetIDs := sets.NewString(awssdk.StringValueSlice(input.SubnetIds)...)
+	var result []*ec2sdk.Subnet
+	for _, subnet := range c.fixture.Subnets {
+		if len(subnetIDs) != 0 && !subnetIDs.Has(subnet.ID) {
+			continue
+		}
+		attrs := map[string]string{
+			"vpc-id":               c.fixture.VpcID,
+			"subnet-id":            subnet.ID,
+			"availability-zone":    subnet.AvailabilityZone,
+			"availability-zone-id": subnet.AvailabilityZoneID,
+		}
+		matches, err := matchEC2Filters(input.Filters, attrs, subnet.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			result = append(result, buildSDKSubnet(c.fixture.VpcID, subnet))
+		}
+	}
+	return result, nil
+}
+
+func (c *fixtureEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	sgIDs := sets.NewString(awssdk.StringValueSlice(input.GroupIds)...)
+	var result []*ec2sdk.SecurityGroup
+	for _, sg := range c.fixture.SecurityGroups {
+		if len(sgIDs) != 0 && !sgIDs.Has(sg.ID) {
+			continue
+		}
+		attrs := map[string]string{
+			"vpc-id":     c.fixture.VpcID,
+			"group-id":   sg.ID,
+			"group-name": sg.Name,
+		}
+		matches, err := matchEC2Filters(input.Filters, attrs, buildFixtureSecurityGroupTags(sg))
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			result = append(result, buildSDKSecurityGroup(c.fixture.VpcID, sg))
+		}
+	}
+	return result, nil
+}
+
+func (c *fixtureEC2) DescribeVpcsWithContext(_ context.Context, input *ec2sdk.DescribeVpcsInput, _ ...request.Option) (*ec2sdk.DescribeVpcsOutput, error) {
+	for _, vpcID := range awssdk.StringValueSlice(input.VpcIds) {
+		if vpcID != c.fixture.VpcID {
+			return nil, errors.Errorf("vpc not found in fixture: %v", vpcID)
+		}
+	}
+	vpc := &ec2sdk.Vpc{
+		VpcId: awssdk.String(c.fixture.VpcID),
+	}
+	for _, cidr := range c.fixture.VpcCIDRs {
+		vpc.CidrBlockAssociationSet = append(vpc.CidrBlockAssociationSet, &ec2sdk.VpcCidrBlockAssociation{
+			CidrBlock: awssdk.String(cidr),
+			CidrBlockState: &ec2sdk.VpcCidrBlockState{
+				State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	for _, cidr := range c.fixture.VpcIPv6CIDRs {
+		vpc.Ipv6CidrBlockAssociationSet = append(vpc.Ipv6CidrBlockAssociationSet, &ec2sdk.VpcIpv6CidrBlockAssociation{
+			Ipv6CidrBlock: awssdk.String(cidr),
+			Ipv6CidrBlockState: &ec2sdk.VpcCidrBlockState{
+				State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	return &ec2sdk.DescribeVpcsOutput{
+		Vpcs: []*ec2sdk.Vpc{vpc},
+	}, nil
+}
+
+func (c *fixtureEC2) DescribeAvailabilityZonesWithContext(_ context.Context, input *ec2sdk.DescribeAvailabilityZonesInput, _ ...request.Option) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	zoneIDs := sets.NewString(awssdk.StringValueSlice(input.ZoneIds)...)
+	azByZoneID := make(map[string]*ec2sdk.AvailabilityZone)
+	for _, subnet := range c.fixture.Subnets {
+		if len(zoneIDs) != 0 && !zoneIDs.Has(subnet.AvailabilityZoneID) {
+			continue
+		}
+		azByZoneID[subnet.AvailabilityZoneID] = &ec2sdk.AvailabilityZone{
+			ZoneId:   awssdk.String(subnet.AvailabilityZoneID),
+			ZoneName: awssdk.String(subnet.AvailabilityZone),
+			ZoneType: awssdk.String(subnet.AvailabilityZoneType),
+		}
+	}
+	output := &ec2sdk.DescribeAvailabilityZonesOutput{}
+	for _, zoneID := range sets.StringKeySet(azByZoneID).List() {
+		output.AvailabilityZones = append(output.AvailabilityZones, azByZoneID[zoneID])
+	}
+	return output, nil
+}
+
+// NewFixtureELBV2 constructs new ELBV2 implementation that answers lookups from fixture.
+// there is no existing LoadBalancer when rendering, only the lookups used during model building are supported.
+func NewFixtureELBV2(fixture Fixture) *fixtureELBV2 {
+	return &fixtureELBV2{
+		fixture: fixture,
+	}
+}
+
+var _ services.ELBV2 = &fixtureELBV2{}
+
+// fixture implementation for ELBV2.
+type fixtureELBV2 struct {
+	services.ELBV2
+	fixture Fixture
+}
+
+func (c *fixtureELBV2) DescribeLoadBalancersAsList(_ context.Context, _ *elbv2sdk.DescribeLoadBalancersInput) ([]*elbv2sdk.LoadBalancer, error) {
+	return nil, nil
+}
+
+func (c *fixtureELBV2) DescribeTrustStoresWithContext(_ context.Context, input *elbv2sdk.DescribeTrustStoresInput, _ ...request.Option) (*elbv2sdk.DescribeTrustStoresOutput, error) {
+	names := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	arns := sets.NewString(awssdk.StringValueSlice(input.TrustStoreArns)...)
+	output := &elbv2sdk.DescribeTrustStoresOutput{}
+	for _, ts := range c.fixture.TrustStores {
+		if len(names) != 0 && !names.Has(ts.Name) {
+			continue
+		}
+		if len(arns) != 0 && !arns.Has(ts.ARN) {
+			continue
+		}
+		output.TrustStores = append(output.TrustStores, &elbv2sdk.TrustStore{
+			Name:          awssdk.String(ts.Name),
+			TrustStoreArn: awssdk.String(ts.ARN),
+		})
+	}
+	return output, nil
+}
+
+// NewFixtureACM constructs new ACM implementation that answers lookups from fixture.
+// only the lookups used during certificate discovery are supported.
+func NewFixtureACM(fixture Fixture) *fixtureACM {
+	return &fixtureACM{
+		fixture: fixture,
+	}
+}
+
+var _ services.ACM = &fixtureACM{}
+
+// fixture implementation for ACM.
+type fixtureACM struct {
+	services.ACM
+	fixture Fixture
+}
+
+func (c *fixtureACM) ListCertificatesAsList(_ context.Context, _ *acmsdk.ListCertificatesInput) ([]*acmsdk.CertificateSummary, error) {
+	var result []*acmsdk.CertificateSummary
+	for _, cert := range c.fixture.Certificates {
+		result = append(result, &acmsdk.CertificateSummary{
+			CertificateArn: awssdk.String(cert.ARN),
+			DomainName:     awssdk.String(cert.DomainName),
+		})
+	}
+	return result, nil
+}
+
+func (c *fixtureACM) DescribeCertificateWithContext(_ context.Context, input *acmsdk.DescribeCertificateInput, _ ...request.Option) (*acmsdk.DescribeCertificateOutput, error) {
+	for _, cert := range c.fixture.Certificates {
+		if cert.ARN != awssdk.StringValue(input.CertificateArn) {
+			continue
+		}
+		sans := sets.NewString(cert.SubjectAlternativeNames...).Insert(cert.DomainName)
+		certDetail := &acmsdk.CertificateDetail{
+			CertificateArn:          awssdk.String(cert.ARN),
+			DomainName:              awssdk.String(cert.DomainName),
+			SubjectAlternativeNames: awssdk.StringSlice(sans.List()),
+			Status:                  awssdk.String(acmsdk.CertificateStatusIssued),
+			Type:                    awssdk.String(cert.Type),
+		}
+		if cert.CertificateAuthorityARN != "" {
+			certDetail.CertificateAuthorityArn = awssdk.String(cert.CertificateAuthorityARN)
+		}
+		return &acmsdk.DescribeCertificateOutput{
+			Certificate: certDetail,
+		}, nil
+	}
+	return nil, errors.Errorf("certificate not found in fixture: %v", awssdk.StringValue(input.CertificateArn))
+}
+
+// NewFixtureBackendSGProvider constructs new BackendSGProvider that always provides the backend SecurityGroup from fixture.
+func NewFixtureBackendSGProvider(fixture Fixture) *fixtureBackendSGProvider {
+	return &fixtureBackendSGProvider{
+		fixture: fixture,
+	}
+}
+
+var _ networking.BackendSGProvider = &fixtureBackendSGProvider{}
+
+// fixture implementation for BackendSGProvider.
+type fixtureBackendSGProvider struct {
+	fixture Fixture
+}
+
+func (p *fixtureBackendSGProvider) Get(_ context.Context, _ networking.ResourceType, _ []types.NamespacedName) (string, error) {
+	if p.fixture.BackendSecurityGroupID == "" {
+		return "", errors.New("backendSecurityGroupID must be specified in fixture")
+	}
+	return p.fixture.BackendSecurityGroupID, nil
+}
+
+func (p *fixtureBackendSGProvider) Release(_ context.Context, _ networking.ResourceType, _ []types.NamespacedName) error {
+	return nil
+}
+
+// matchEC2Filters checks whether an EC2 resource with attrs and tags matches all filters.
+// only exact matches are supported for filter values.
+func matchEC2Filters(filters []*ec2sdk.Filter, attrs map[string]string, tags map[string]string) (bool, error) {
+	for _, filter := range filters {
+		filterName := awssdk.StringValue(filter.Name)
+		filterValues := sets.NewString(awssdk.StringValueSlice(filter.Values)...)
+		switch {
+		case strings.HasPrefix(filterName, "tag:"):
+			tagValue, exists := tags[strings.TrimPrefix(filterName, "tag:")]
+			if !exists || !filterValues.Has(tagValue) {
+				return false, nil
+			}
+		case filterName == "tag-key":
+			if !filterValues.HasAny(sets.StringKeySet(tags).UnsortedList()...) {
+				return false, nil
+			}
+		default:
+			attrValue, supported := attrs[filterName]
+			if !supported {
+				return false, errors.Errorf("unsupported filter in fixture: %v", filterName)
+			}
+			if !filterValues.Has(attrValue) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func buildSDKSubnet(vpcID string, subnet FixtureSubnet) *ec2sdk.Subnet {
+	sdkSubnet := &ec2sdk.Subnet{
+		SubnetId:                awssdk.String(subnet.ID),
+		VpcId:                   awssdk.String(vpcID),
+		AvailabilityZone:        awssdk.String(subnet.AvailabilityZone),
+		AvailabilityZoneId:      awssdk.String(subnet.AvailabilityZoneID),
+		AvailableIpAddressCount: subnet.AvailableIPAddressCount,
+		Tags:                    buildSDKTags(subnet.Tags),
+	}
+	if subnet.CIDRBlock != "" {
+		sdkSubnet.CidrBlock = awssdk.String(subnet.CIDRBlock)
+	}
+	for _, cidr := range subnet.IPv6CIDRBlocks {
+		sdkSubnet.Ipv6CidrBlockAssociationSet = append(sdkSubnet.Ipv6CidrBlockAssociationSet, &ec2sdk.SubnetIpv6CidrBlockAssociation{
+			Ipv6CidrBlock: awssdk.String(cidr),
+			Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
+				State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	return sdkSubnet
+}
+
+func buildSDKSecurityGroup(vpcID string, sg FixtureSecurityGroup) *ec2sdk.SecurityGroup {
+	sdkSG := &ec2sdk.SecurityGroup{
+		GroupId: awssdk.String(sg.ID),
+		VpcId:   awssdk.String(vpcID),
+		Tags:    buildSDKTags(buildFixtureSecurityGroupTags(sg)),
+	}
+	if sg.Name != "" {
+		sdkSG.GroupName = awssdk.String(sg.Name)
+	}
+	return sdkSG
+}
+
+func buildFixtureSecurityGroupTags(sg FixtureSecurityGroup) map[string]string {
+	tags := make(map[string]string, len(sg.Tags)+1)
+	for key, value := range sg.Tags {
+		tags[key] = value
+	}
+	if _, exists := tags["Name"]; !exists && sg.Name != "" {
+		tags["Name"] = sg.Name
+	}
+	return tags
+}
+
+func buildSDKTags(tags map[string]string) []*ec2sdk.Tag {
+	keys := sets.StringKeySet(tags).List()
+	sdkTags := make([]*ec2sdk.Tag, 0, len(keys))
+	for _, key := range keys {
+		sdkTags = append(sdkTags, &ec2sdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
@@ -0,0 +1,145 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_fixtureEC2_DescribeSubnetsAsList(t *testing.T) {
+	fixture := defaultFixture(Fixture{
+		VpcID: "vpc-xxx",
+		Subnets: []FixtureSubnet{
+			{
+				ID:                 "subnet-a",
+				AvailabilityZone:   "us-west-2a",
+				AvailabilityZoneID: "usw2-az1",
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1", "Name": "public-a"},
+			},
+			{
+				ID:                 "subnet-b",
+				AvailabilityZone:   "us-west-2b",
+				AvailabilityZoneID: "usw2-az2",
+				Tags:               map[string]string{"kubernetes.io/role/internal-elb": ""},
+			},
+		},
+	})
+	tests := []struct {
+		name    string
+		input   *ec2sdk.DescribeSubnetsInput
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "by subnet IDs",
+			input: &ec2sdk.DescribeSubnetsInput{
+				SubnetIds: awssdk.StringSlice([]string{"subnet-b", "subnet-c"}),
+			},
+			want: []string{"subnet-b"},
+		},
+		{
+			name: "by vpc and role tag",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{"vpc-xxx"})},
+					{Name: awssdk.String("tag:kubernetes.io/role/internal-elb"), Values: awssdk.StringSlice([]string{"", "1"})},
+				},
+			},
+			want: []string{"subnet-b"},
+		},
+		{
+			name: "by name tag and tag key",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("tag:Name"), Values: awssdk.StringSlice([]string{"public-a"})},
+					{Name: awssdk.String("tag-key"), Values: awssdk.StringSlice([]string{"kubernetes.io/role/elb"})},
+				},
+			},
+			want: []string{"subnet-a"},
+		},
+		{
+			name: "other vpc",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{"vpc-yyy"})},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "unsupported filter",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("cidr-block"), Values: awssdk.StringSlice([]string{"10.0.0.0/24"})},
+				},
+			},
+			wantErr: errors.New("unsupported filter in fixture: cidr-block"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewFixtureEC2(fixture)
+			got, err := c.DescribeSubnetsAsList(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotIDs []string
+			for _, subnet := range got {
+				gotIDs = append(gotIDs, awssdk.StringValue(subnet.SubnetId))
+				assert.Equal(t, int64(256), awssdk.Int64Value(subnet.AvailableIpAddressCount))
+			}
+			assert.Equal(t, tt.want, gotIDs)
+		})
+	}
+}
+
+func Test_fixtureEC2_DescribeSecurityGroupsAsList(t *testing.T) {
+	fixture := Fixture{
+		VpcID: "vpc-xxx",
+		SecurityGroups: []FixtureSecurityGroup{
+			{ID: "sg-a", Name: "frontend"},
+			{ID: "sg-b", Name: "backend", Tags: map[string]string{"Name": "backend-tag"}},
+		},
+	}
+	tests := []struct {
+		name  string
+		input *ec2sdk.DescribeSecurityGroupsInput
+		want  []string
+	}{
+		{
+			name: "by group IDs",
+			input: &ec2sdk.DescribeSecurityGroupsInput{
+				GroupIds: awssdk.StringSlice([]string{"sg-a"}),
+			},
+			want: []string{"sg-a"},
+		},
+		{
+			name: "name is exposed as Name tag",
+			input: &ec2sdk.DescribeSecurityGroupsInput{
+				Filters: []*ec2sdk.Filter{
+					{Name: awssdk.String("tag:Name"), Values: awssdk.StringSlice([]string{"frontend", "backend"})},
+					{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{"vpc-xxx"})},
+				},
+			},
+			want: []string{"sg-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewFixtureEC2(fixture)
+			got, err := c.DescribeSecurityGroupsAsList(context.Background(), tt.input)
+			assert.NoError(t, err)
+			var gotIDs []string
+			for _, sg := range got {
+				gotIDs = append(gotIDs, awssdk.StringValue(sg.GroupId))
+			}
+			assert.Equal(t, tt.want, gotIDs)
+		})
+	}
+}
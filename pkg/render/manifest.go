@@ -0,0 +1,87 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LoadManifests loads the Kubernetes objects from YAML or JSON files, files can contain multiple documents.
+// objects of kinds that aren't registered in scheme are skipped.
+func LoadManifests(scheme *k8sruntime.Scheme, paths []string) ([]client.Object, error) {
+	var objs []client.Object
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open manifest: %v", path)
+		}
+		fileObjs, err := decodeManifests(scheme, file)
+		file.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode manifest: %v", path)
+		}
+		objs = append(objs, fileObjs...)
+	}
+	return objs, nil
+}
+
+func decodeManifests(scheme *k8sruntime.Scheme, r io.Reader) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	var objs []client.Object
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		rawObj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if k8sruntime.IsNotRegisteredError(err) || k8sruntime.IsMissingKind(err) {
+				continue
+			}
+			return nil, err
+		}
+		obj, ok := rawObj.(client.Object)
+		if !ok {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// DefaultNamespace sets namespace for namespaced objects that don't specify one, like kubectl does when applying manifests.
+func DefaultNamespace(objs []client.Object, namespace string) {
+	for _, obj := range objs {
+		if obj.GetNamespace() != "" || isClusterScoped(obj) {
+			continue
+		}
+		obj.SetNamespace(namespace)
+	}
+}
+
+// isClusterScoped checks whether obj is of a cluster scoped kind that is relevant to rendering.
+func isClusterScoped(obj client.Object) bool {
+	switch obj.(type) {
+	case *corev1.Namespace, *corev1.Node, *networking.IngressClass, *elbv2api.IngressClassParams:
+		return true
+	default:
+		return false
+	}
+}
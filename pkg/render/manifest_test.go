@@ -0,0 +1,113 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_decodeManifests(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests string
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name: "multiple documents",
+			manifests: `
+# Source: chart/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+---
+# Source: chart/templates/empty.yaml
+---
+apiVersion: elbv2.k8s.aws/v1beta1
+kind: IngressClassParams
+metadata:
+  name: params
+---
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: unknown-kind
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  namespace: ns
+  name: ing
+`,
+			want: []string{"Service//svc", "IngressClassParams//params", "Ingress/ns/ing"},
+		},
+		{
+			name: "malformed document",
+			manifests: `
+apiVersion: v1
+kind: Service
+metadata: [
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := k8sruntime.NewScheme()
+			clientgoscheme.AddToScheme(scheme)
+			elbv2api.AddToScheme(scheme)
+			got, err := decodeManifests(scheme, strings.NewReader(tt.manifests))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, summarizeObjects(got))
+		})
+	}
+}
+
+func Test_DefaultNamespace(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+	objs, err := decodeManifests(scheme, strings.NewReader(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+---
+apiVersion: v1
+kind: Service
+metadata:
+  namespace: other-ns
+  name: svc
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+---
+apiVersion: elbv2.k8s.aws/v1beta1
+kind: IngressClassParams
+metadata:
+  name: params
+`))
+	assert.NoError(t, err)
+	DefaultNamespace(objs, "awesome-ns")
+	assert.Equal(t, []string{"Service/awesome-ns/svc", "Service/other-ns/svc", "IngressClass//alb", "IngressClassParams//params"}, summarizeObjects(objs))
+}
+
+func summarizeObjects(objs []client.Object) []string {
+	var summaries []string
+	for _, obj := range objs {
+		summaries = append(summaries, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetNamespace()+"/"+obj.GetName())
+	}
+	return summaries
+}
@@ -0,0 +1,160 @@
+package render
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// errReadOnlyClient is returned for any write through objectClient.
+var errReadOnlyClient = errors.New("objects are read-only when rendering")
+
+// newObjectClient constructs new objectClient that serves lookups from objs.
+func newObjectClient(scheme *k8sruntime.Scheme, objs []client.Object) (*objectClient, error) {
+	objsByGVK := make(map[schema.GroupVersionKind][]client.Object)
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		objsByGVK[gvk] = append(objsByGVK[gvk], obj)
+	}
+	return &objectClient{
+		scheme:    scheme,
+		objsByGVK: objsByGVK,
+	}, nil
+}
+
+var _ client.Client = &objectClient{}
+
+// objectClient is a read-only client.Client backed by the objects being rendered.
+// model builders only read Kubernetes objects, so every write is rejected with errReadOnlyClient.
+type objectClient struct {
+	scheme    *k8sruntime.Scheme
+	objsByGVK map[schema.GroupVersionKind][]client.Object
+}
+
+func (c *objectClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range c.objsByGVK[gvk] {
+		if candidate.GetNamespace() == key.Namespace && candidate.GetName() == key.Name {
+			return c.copyInto(candidate, obj)
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: strings.ToLower(gvk.Kind)}, key.Name)
+}
+
+func (c *objectClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listGVK, err := apiutil.GVKForObject(list, c.scheme)
+	if err != nil {
+		return err
+	}
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.FieldSelector != nil && !listOpts.FieldSelector.Empty() {
+		return errors.Errorf("field selectors are unsupported when rendering: %v", listOpts.FieldSelector)
+	}
+	labelSelector := listOpts.LabelSelector
+	if labelSelector == nil {
+		labelSelector = labels.Everything()
+	}
+
+	itemGVK := listGVK.GroupVersion().WithKind(strings.TrimSuffix(listGVK.Kind, "List"))
+	var items []k8sruntime.Object
+	for _, candidate := range c.objsByGVK[itemGVK] {
+		if listOpts.Namespace != "" && candidate.GetNamespace() != listOpts.Namespace {
+			continue
+		}
+		if !labelSelector.Matches(labels.Set(candidate.GetLabels())) {
+			continue
+		}
+		items = append(items, candidate.DeepCopyObject())
+	}
+	return meta.SetList(list, items)
+}
+
+func (c *objectClient) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return errReadOnlyClient
+}
+
+func (c *objectClient) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	return errReadOnlyClient
+}
+
+func (c *objectClient) Update(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+	return errReadOnlyClient
+}
+
+func (c *objectClient) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	return errReadOnlyClient
+}
+
+func (c *objectClient) DeleteAllOf(_ context.Context, _ client.Object, _ ...client.DeleteAllOfOption) error {
+	return errReadOnlyClient
+}
+
+func (c *objectClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *objectClient) SubResource(_ string) client.SubResourceClient {
+	return readOnlySubResourceClient{}
+}
+
+func (c *objectClient) Scheme() *k8sruntime.Scheme {
+	return c.scheme
+}
+
+func (c *objectClient) RESTMapper() meta.RESTMapper {
+	return nil
+}
+
+func (c *objectClient) GroupVersionKindFor(obj k8sruntime.Object) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, c.scheme)
+}
+
+func (c *objectClient) IsObjectNamespaced(_ k8sruntime.Object) (bool, error) {
+	return false, errors.New("object scope is unknown when rendering")
+}
+
+// copyInto copies src into dst, both are expected to be of the same Go type as they share a GVK.
+func (c *objectClient) copyInto(src client.Object, dst client.Object) error {
+	srcValue := reflect.ValueOf(src.DeepCopyObject())
+	dstValue := reflect.ValueOf(dst)
+	if srcValue.Type() != dstValue.Type() {
+		return c.scheme.Convert(src.DeepCopyObject(), dst, nil)
+	}
+	dstValue.Elem().Set(srcValue.Elem())
+	return nil
+}
+
+// readOnlySubResourceClient rejects every subresource access when rendering.
+type readOnlySubResourceClient struct{}
+
+func (readOnlySubResourceClient) Get(_ context.Context, _ client.Object, _ client.Object, _ ...client.SubResourceGetOption) error {
+	return errReadOnlyClient
+}
+
+func (readOnlySubResourceClient) Create(_ context.Context, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	return errReadOnlyClient
+}
+
+func (readOnlySubResourceClient) Update(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+	return errReadOnlyClient
+}
+
+func (readOnlySubResourceClient) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+	return errReadOnlyClient
+}
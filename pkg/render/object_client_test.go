@@ -0,0 +1,70 @@
+package render
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_objectClient(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-a",
+			Labels:    map[string]string{"app": "a"},
+		},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-b",
+			Labels:    map[string]string{"app": "b"},
+		},
+	}
+	svcC := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-2",
+			Name:      "svc-c",
+			Labels:    map[string]string{"app": "a"},
+		},
+	}
+	k8sClient, err := newObjectClient(scheme, []client.Object{svcA, svcB, svcC})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("get existing object", func(t *testing.T) {
+		got := &corev1.Service{}
+		err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns-1", Name: "svc-b"}, got)
+		assert.NoError(t, err)
+		assert.Equal(t, svcB, got)
+	})
+	t.Run("get non-existent object", func(t *testing.T) {
+		err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "ns-2", Name: "svc-a"}, &corev1.Service{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+	t.Run("list objects in namespace", func(t *testing.T) {
+		got := &corev1.ServiceList{}
+		err := k8sClient.List(ctx, got, client.InNamespace("ns-1"))
+		assert.NoError(t, err)
+		assert.Equal(t, []corev1.Service{*svcA, *svcB}, got.Items)
+	})
+	t.Run("list objects matching labels", func(t *testing.T) {
+		got := &corev1.ServiceList{}
+		err := k8sClient.List(ctx, got, client.MatchingLabels{"app": "a"})
+		assert.NoError(t, err)
+		assert.Equal(t, []corev1.Service{*svcA, *svcC}, got.Items)
+	})
+	t.Run("writes are rejected", func(t *testing.T) {
+		err := k8sClient.Update(ctx, svcA.DeepCopy())
+		assert.Equal(t, errReadOnlyClient, err)
+	})
+}
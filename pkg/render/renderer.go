@@ -0,0 +1,238 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ingresscontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
+	servicecontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RenderedStack is the model stack rendered for an IngressGroup or a Service.
+type RenderedStack struct {
+	// Kind is the kind of Kubernetes resource the stack is built for.
+	Kind core.StackKind `json:"kind"`
+
+	// StackID is the ID of the stack.
+	StackID string `json:"stackID"`
+
+	// Model is the marshalled model stack.
+	Model json.RawMessage `json:"model"`
+}
+
+// Renderer renders the model stacks that the controller would build for Kubernetes objects.
+type Renderer interface {
+	// Render renders the model stacks for IngressGroups and Services among objs, ordered by kind and stackID.
+	Render(ctx context.Context, objs []client.Object) ([]RenderedStack, error)
+}
+
+// NewDefaultRenderer constructs new defaultRenderer.
+func NewDefaultRenderer(scheme *k8sruntime.Scheme, controllerConfig config.ControllerConfig, fixture Fixture, logger logr.Logger) *defaultRenderer {
+	// no LoadBalancer exists when rendering, LoadBalancers are always listed via the ELBV2 API that answers from fixture.
+	controllerConfig.FeatureGates.Disable(config.EnableRGTAPI)
+	return &defaultRenderer{
+		scheme:           scheme,
+		controllerConfig: controllerConfig,
+		fixture:          fixture,
+		stackMarshaller:  deploy.NewDefaultStackMarshaller(),
+		logger:           logger,
+	}
+}
+
+var _ Renderer = &defaultRenderer{}
+
+// default implementation for Renderer.
+// Kubernetes lookups are satisfied from the rendered objects, and AWS lookups are satisfied from fixture.
+type defaultRenderer struct {
+	scheme           *k8sruntime.Scheme
+	controllerConfig config.ControllerConfig
+	fixture          Fixture
+	stackMarshaller  deploy.StackMarshaller
+	logger           logr.Logger
+}
+
+func (r *defaultRenderer) Render(ctx context.Context, objs []client.Object) ([]RenderedStack, error) {
+	k8sClient, err := newObjectClient(r.scheme, append(buildImplicitNamespaces(objs), objs...))
+	if err != nil {
+		return nil, err
+	}
+
+	ingStacks, err := r.renderIngressGroups(ctx, k8sClient, objs)
+	if err != nil {
+		return nil, err
+	}
+	svcStacks, err := r.renderServices(ctx, k8sClient, objs)
+	if err != nil {
+		return nil, err
+	}
+	stacks := append(append([]RenderedStack{}, ingStacks...), svcStacks...)
+	sort.SliceStable(stacks, func(i, j int) bool {
+		if stacks[i].Kind != stacks[j].Kind {
+			return stacks[i].Kind < stacks[j].Kind
+		}
+		return stacks[i].StackID < stacks[j].StackID
+	})
+	return stacks, nil
+}
+
+func (r *defaultRenderer) renderIngressGroups(ctx context.Context, k8sClient client.Client, objs []client.Object) ([]RenderedStack, error) {
+	// events are discarded when rendering.
+	eventRecorder := &record.FakeRecorder{}
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	ingressConfig := r.controllerConfig.IngressConfig
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder,
+		ingressConfig.TolerateNonExistentBackendService, ingressConfig.TolerateNonExistentBackendAction)
+	trackingProvider := tracking.NewDefaultProvider(ingresscontroller.IngressTagPrefix, r.controllerConfig.ClusterName)
+	ec2Client := NewFixtureEC2(r.fixture)
+	elbv2Client := NewFixtureELBV2(r.fixture)
+	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+		ec2Client, elbv2Client, NewFixtureACM(r.fixture),
+		annotationParser, r.buildSubnetsResolver(ec2Client),
+		authConfigBuilder, enhancedBackendBuilder, trackingProvider, r.buildTaggingManager(elbv2Client), r.controllerConfig.FeatureGates,
+		r.fixture.VpcID, r.controllerConfig.ClusterName, r.controllerConfig.DefaultTags, r.controllerConfig.ExternalManagedTags,
+		r.controllerConfig.DefaultSSLPolicy, r.controllerConfig.DefaultTargetType, NewFixtureBackendSGProvider(r.fixture),
+		networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, r.fixture.VpcID),
+		r.controllerConfig.EnableBackendSecurityGroup, r.controllerConfig.DisableRestrictedSGRules, ingress.BuildCertDiscoveryFilters(ingressConfig),
+		r.controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), ingressConfig.ListenerRulesLimit, nil, nil, r.logger)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(ingressConfig.IngressClass)
+	manageIngressesWithoutIngressClass := ingressConfig.IngressClass == ""
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
+
+	var stacks []RenderedStack
+	renderedGroupIDs := make(map[ingress.GroupID]struct{})
+	for _, obj := range objs {
+		ing, ok := obj.(*networking.Ingress)
+		if !ok {
+			continue
+		}
+		groupID, err := groupLoader.LoadGroupIDIfAny(ctx, ing)
+		if err != nil {
+			return nil, err
+		}
+		if groupID == nil {
+			continue
+		}
+		if _, rendered := renderedGroupIDs[*groupID]; rendered {
+			continue
+		}
+		renderedGroupIDs[*groupID] = struct{}{}
+
+		ingGroup, err := groupLoader.Load(ctx, *groupID)
+		if err != nil {
+			return nil, err
+		}
+		if len(ingGroup.Members) == 0 {
+			continue
+		}
+		stack, _, _, _, err := modelBuilder.Build(ctx, ingGroup)
+		if err != nil {
+			return nil, err
+		}
+		stackJSON, err := r.stackMarshaller.Marshal(stack)
+		if err != nil {
+			return nil, err
+		}
+		stacks = append(stacks, RenderedStack{
+			Kind:    core.StackKindIngressGroup,
+			StackID: stack.StackID().String(),
+			Model:   json.RawMessage(stackJSON),
+		})
+	}
+	return stacks, nil
+}
+
+func (r *defaultRenderer) renderServices(ctx context.Context, k8sClient client.Client, objs []client.Object) ([]RenderedStack, error) {
+	annotationParser := annotations.NewSuffixAnnotationParser(servicecontroller.ServiceAnnotationPrefix)
+	trackingProvider := tracking.NewDefaultProvider(servicecontroller.ServiceTagPrefix, r.controllerConfig.ClusterName)
+	serviceUtils := service.NewServiceUtils(annotationParser, servicecontroller.ServiceFinalizer, r.controllerConfig.ServiceConfig.LoadBalancerClass, r.controllerConfig.FeatureGates)
+	ec2Client := NewFixtureEC2(r.fixture)
+	modelBuilder := service.NewDefaultModelBuilder(k8sClient, annotationParser, r.buildSubnetsResolver(ec2Client),
+		networkingpkg.NewDefaultVPCInfoProvider(ec2Client, r.logger), r.fixture.VpcID, trackingProvider,
+		r.buildTaggingManager(NewFixtureELBV2(r.fixture)), ec2Client, r.controllerConfig.FeatureGates, r.controllerConfig.ClusterName,
+		r.controllerConfig.DefaultTags, r.controllerConfig.ExternalManagedTags, r.controllerConfig.DefaultSSLPolicy, r.controllerConfig.DefaultTargetType,
+		r.controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+		config.NewDefaultTargetGroupConfigurationLoader(k8sClient), NewFixtureBackendSGProvider(r.fixture), networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, r.fixture.VpcID),
+		r.controllerConfig.EnableBackendSecurityGroup, r.controllerConfig.DisableRestrictedSGRules, nil, r.logger)
+
+	var stacks []RenderedStack
+	for _, obj := range objs {
+		svc, ok := obj.(*corev1.Service)
+		if !ok {
+			continue
+		}
+		if !serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		stack, lb, _, err := modelBuilder.Build(ctx, svc)
+		if err != nil {
+			return nil, err
+		}
+		if lb == nil {
+			continue
+		}
+		stackJSON, err := r.stackMarshaller.Marshal(stack)
+		if err != nil {
+			return nil, err
+		}
+		stacks = append(stacks, RenderedStack{
+			Kind:    core.StackKindService,
+			StackID: stack.StackID().String(),
+			Model:   json.RawMessage(stackJSON),
+		})
+	}
+	return stacks, nil
+}
+
+func (r *defaultRenderer) buildSubnetsResolver(ec2Client *fixtureEC2) networkingpkg.SubnetsResolver {
+	azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(ec2Client, r.logger)
+	return networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, ec2Client, r.fixture.VpcID, r.controllerConfig.ClusterName, r.logger)
+}
+
+func (r *defaultRenderer) buildTaggingManager(elbv2Client *fixtureELBV2) elbv2deploy.TaggingManager {
+	return elbv2deploy.NewDefaultTaggingManager(elbv2Client, r.fixture.VpcID, r.controllerConfig.FeatureGates, nil, r.logger)
+}
+
+// buildImplicitNamespaces builds the Namespaces that objects live in but aren't among objects.
+// Namespaces are needed to evaluate the namespaceSelector of IngressClassParams.
+func buildImplicitNamespaces(objs []client.Object) []client.Object {
+	explicitNamespaces := sets.NewString()
+	implicitNamespaces := sets.NewString()
+	for _, obj := range objs {
+		if _, ok := obj.(*corev1.Namespace); ok {
+			explicitNamespaces.Insert(obj.GetName())
+			continue
+		}
+		if obj.GetNamespace() != "" {
+			implicitNamespaces.Insert(obj.GetNamespace())
+		}
+	}
+	var namespaces []client.Object
+	for _, namespace := range implicitNamespaces.Difference(explicitNamespaces).List() {
+		namespaces = append(namespaces, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		})
+	}
+	return namespaces
+}
@@ -0,0 +1,154 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultRenderer_Render(t *testing.T) {
+	fixture := defaultFixture(Fixture{
+		VpcID:                  "vpc-xxx",
+		VpcCIDRs:               []string{"10.0.0.0/16"},
+		BackendSecurityGroupID: "sg-backend",
+		Subnets: []FixtureSubnet{
+			{
+				ID:                 "subnet-a",
+				AvailabilityZone:   "us-west-2a",
+				AvailabilityZoneID: "usw2-az1",
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1"},
+			},
+			{
+				ID:                 "subnet-b",
+				AvailabilityZone:   "us-west-2b",
+				AvailabilityZoneID: "usw2-az2",
+				Tags:               map[string]string{"kubernetes.io/role/elb": "1"},
+			},
+		},
+		Certificates: []FixtureCertificate{
+			{
+				ARN:        "arn:aws:acm:us-west-2:123456789012:certificate/wildcard",
+				DomainName: "*.example.com",
+			},
+		},
+	})
+	manifests := `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: nginx
+spec:
+  controller: k8s.io/ingress-nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: NodePort
+  ports:
+  - port: 80
+    nodePort: 30080
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  annotations:
+    alb.ingress.kubernetes.io/scheme: internet-facing
+    alb.ingress.kubernetes.io/listen-ports: '[{"HTTPS": 443}]'
+spec:
+  ingressClassName: alb
+  tls:
+  - hosts: [www.example.com]
+  rules:
+  - host: www.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: other-class
+spec:
+  ingressClassName: nginx
+  defaultBackend:
+    service:
+      name: web
+      port:
+        number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nlb
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-scheme: internet-facing
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 53
+    protocol: UDP
+`
+	scheme := k8sruntime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+	objs, err := decodeManifests(scheme, strings.NewReader(manifests))
+	assert.NoError(t, err)
+	DefaultNamespace(objs, "awesome-ns")
+
+	controllerConfig := config.ControllerConfig{
+		ClusterName:       "awesome-cluster",
+		DefaultTargetType: "instance",
+		DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+		FeatureGates:      config.NewFeatureGates(),
+	}
+	r := NewDefaultRenderer(scheme, controllerConfig, fixture, logr.New(&log.NullLogSink{}))
+	got, err := r.Render(context.Background(), objs)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	var gotStackIDs []string
+	for _, stack := range got {
+		gotStackIDs = append(gotStackIDs, string(stack.Kind)+":"+stack.StackID)
+	}
+	assert.Equal(t, []string{"IngressGroup:awesome-ns/web", "Service:awesome-ns/nlb"}, gotStackIDs)
+
+	var ingModel struct {
+		ID        string `json:"id"`
+		Resources map[string]map[string]struct {
+			Spec json.RawMessage `json:"spec"`
+		} `json:"resources"`
+	}
+	assert.NoError(t, json.Unmarshal(got[0].Model, &ingModel))
+	assert.Equal(t, "awesome-ns/web", ingModel.ID)
+	lsSpec := ingModel.Resources["AWS::ElasticLoadBalancingV2::Listener"]["443"].Spec
+	assert.Contains(t, string(lsSpec), `"certificateARN":"arn:aws:acm:us-west-2:123456789012:certificate/wildcard"`)
+	lbSpec := ingModel.Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"]["LoadBalancer"].Spec
+	assert.Contains(t, string(lbSpec), `"subnetMapping":[{"subnetID":"subnet-a"},{"subnetID":"subnet-b"}]`)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+)
+
+const (
+	// AnnotationDeployedIAMRole records the IAM role that the LoadBalancer resources of a Service are deployed with.
+	// it's set by the controller, so that the LoadBalancer resources are cleaned up in the AWS account they're deployed to.
+	AnnotationDeployedIAMRole = "service.k8s.aws/deployed-iam-role"
+)
+
+// BuildAssumeRoleConfig builds the IAM role to assume to provision LoadBalancer resources of service in another AWS account.
+// once deployed, LoadBalancer resources are managed with the IAM role recorded on service by AnnotationDeployedIAMRole:
+//   - a service that's no longer supported has its LoadBalancer resources cleaned up with the recorded IAM role.
+//   - it's an error if service specifies another IAM role, since LoadBalancer resources can't be moved to another AWS account.
+//
+// returns nil if the LoadBalancer resources are provisioned in the controller's own AWS account.
+func BuildAssumeRoleConfig(annotationParser annotations.Parser, serviceUtils ServiceUtils, service *corev1.Service) (*aws.AssumeRoleConfig, error) {
+	deployedAssumeRole, deployed, err := buildDeployedAssumeRoleConfig(service)
+	if err != nil {
+		return nil, err
+	}
+	if deployed && !serviceUtils.IsServiceSupported(service) {
+		return deployedAssumeRole, nil
+	}
+	assumeRole, err := buildAssumeRoleConfigFromAnnotations(annotationParser, service)
+	if err != nil {
+		return nil, err
+	}
+	if deployed && aws.MarshalAssumeRoleConfig(assumeRole) != aws.MarshalAssumeRoleConfig(deployedAssumeRole) {
+		return nil, errors.Errorf("IAM role to assume changed from %v to %v, LoadBalancer resources can't be moved to another AWS account",
+			aws.MarshalAssumeRoleConfig(deployedAssumeRole), aws.MarshalAssumeRoleConfig(assumeRole))
+	}
+	return assumeRole, nil
+}
+
+// buildAssumeRoleConfigFromAnnotations builds the IAM role specified by the annotations of service.
+func buildAssumeRoleConfigFromAnnotations(annotationParser annotations.Parser, service *corev1.Service) (*aws.AssumeRoleConfig, error) {
+	assumeRole := aws.AssumeRoleConfig{}
+	roleARNExists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIAMRoleARN, &assumeRole.RoleARN, service.Annotations)
+	externalIDExists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIAMRoleExternalID, &assumeRole.ExternalID, service.Annotations)
+	vpcIDExists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIAMRoleVpcID, &assumeRole.VpcID, service.Annotations)
+	if !roleARNExists || len(assumeRole.RoleARN) == 0 {
+		if externalIDExists || vpcIDExists {
+			return nil, errors.Errorf("IAM role ARN must be specified along with external ID or VPC ID")
+		}
+		return nil, nil
+	}
+	return &assumeRole, nil
+}
+
+// buildDeployedAssumeRoleConfig builds the IAM role that LoadBalancer resources of service are deployed with, as recorded on service.
+// returns false if service doesn't record it, e.g. the LoadBalancer resources aren't deployed yet.
+func buildDeployedAssumeRoleConfig(service *corev1.Service) (*aws.AssumeRoleConfig, bool, error) {
+	rawAssumeRole, exists := service.Annotations[AnnotationDeployedIAMRole]
+	if !exists {
+		return nil, false, nil
+	}
+	assumeRole, err := aws.UnmarshalAssumeRoleConfig(rawAssumeRole)
+	if err != nil {
+		return nil, false, err
+	}
+	return assumeRole, true, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+func Test_BuildAssumeRoleConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		deleted     bool
+		want        *aws.AssumeRoleConfig
+		wantErr     error
+	}{
+		{
+			name:        "IAM role not specified",
+			annotations: map[string]string{},
+			want:        nil,
+		},
+		{
+			name: "IAM role specified",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-arn": "arn:aws:iam::123456789012:role/ingress",
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN: "arn:aws:iam::123456789012:role/ingress",
+			},
+		},
+		{
+			name: "IAM role specified with external ID and VPC ID",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-arn":         "arn:aws:iam::123456789012:role/ingress",
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-external-id": "external-id",
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-vpc-id":      "vpc-ingress",
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN:    "arn:aws:iam::123456789012:role/ingress",
+				ExternalID: "external-id",
+				VpcID:      "vpc-ingress",
+			},
+		},
+		{
+			name: "external ID specified without IAM role",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-external-id": "external-id",
+			},
+			wantErr: errors.New("IAM role ARN must be specified along with external ID or VPC ID"),
+		},
+		{
+			name: "IAM role specified and recorded",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-arn": "arn:aws:iam::123456789012:role/ingress",
+				"service.k8s.aws/deployed-iam-role":                         `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`,
+			},
+			want: &aws.AssumeRoleConfig{
+				RoleARN: "arn:aws:iam::123456789012:role/ingress",
+			},
+		},
+		{
+			name: "IAM role changed since recorded",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-arn": "arn:aws:iam::123456789012:role/other",
+				"service.k8s.aws/deployed-iam-role":                         `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`,
+			},
+			wantErr: errors.New(`IAM role to assume changed from {"roleARN":"arn:aws:iam::123456789012:role/ingress"} to {"roleARN":"arn:aws:iam::123456789012:role/other"}, LoadBalancer resources can't be moved to another AWS account`),
+		},
+		{
+			name: "IAM role removed since recorded",
+			annotations: map[string]string{
+				"service.k8s.aws/deployed-iam-role": `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`,
+			},
+			wantErr: errors.New(`IAM role to assume changed from {"roleARN":"arn:aws:iam::123456789012:role/ingress"} to {}, LoadBalancer resources can't be moved to another AWS account`),
+		},
+		{
+			name: "IAM role removed from deleted service",
+			annotations: map[string]string{
+				"service.k8s.aws/deployed-iam-role": `{"roleARN":"arn:aws:iam::123456789012:role/ingress"}`,
+			},
+			deleted: true,
+			want: &aws.AssumeRoleConfig{
+				RoleARN: "arn:aws:iam::123456789012:role/ingress",
+			},
+		},
+		{
+			name: "controller's own account recorded on deleted service",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-iam-role-arn": "arn:aws:iam::123456789012:role/ingress",
+				"service.k8s.aws/deployed-iam-role":                         `{}`,
+			},
+			deleted: true,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", config.NewFeatureGates())
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "awesome-svc",
+					Annotations: tt.annotations,
+				},
+				Spec: corev1.ServiceSpec{
+					Type:              corev1.ServiceTypeLoadBalancer,
+					LoadBalancerClass: awssdk.String("service.k8s.aws/nlb"),
+				},
+			}
+			if tt.deleted {
+				svc.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			got, err := BuildAssumeRoleConfig(annotationParser, serviceUtils, svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
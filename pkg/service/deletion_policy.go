@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+// BuildDeletionPolicy builds the deletion policy for LoadBalancer resources of service once they're no longer desired.
+func BuildDeletionPolicy(annotationParser annotations.Parser, service *corev1.Service) (elbv2api.DeletionPolicy, error) {
+	rawPolicy := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDeletionPolicy, &rawPolicy, service.Annotations); !exists {
+		return elbv2api.DeletionPolicyDelete, nil
+	}
+	switch elbv2api.DeletionPolicy(rawPolicy) {
+	case elbv2api.DeletionPolicyDelete, elbv2api.DeletionPolicyRetain, elbv2api.DeletionPolicyOrphan:
+		return elbv2api.DeletionPolicy(rawPolicy), nil
+	default:
+		return "", errors.Errorf("unknown deletion policy: %v", rawPolicy)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_BuildDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        elbv2api.DeletionPolicy
+		wantErr     error
+	}{
+		{
+			name:        "deletion policy not specified",
+			annotations: map[string]string{},
+			want:        elbv2api.DeletionPolicyDelete,
+		},
+		{
+			name: "deletion policy Retain",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Retain",
+			},
+			want: elbv2api.DeletionPolicyRetain,
+		},
+		{
+			name: "deletion policy Orphan",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "Orphan",
+			},
+			want: elbv2api.DeletionPolicyOrphan,
+		},
+		{
+			name: "unknown deletion policy",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-deletion-policy": "retain",
+			},
+			wantErr: errors.New("unknown deletion policy: retain"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "awesome-svc",
+					Annotations: tt.annotations,
+				},
+			}
+			got, err := BuildDeletionPolicy(annotationParser, svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
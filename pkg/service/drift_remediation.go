@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+// IsDriftRemediationEnabled checks whether drift of the LoadBalancer resources of service should be remediated.
+// drift is only reported unless the drift remediation annotation is Auto.
+func IsDriftRemediationEnabled(annotationParser annotations.Parser, service *corev1.Service) (bool, error) {
+	rawRemediation := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixDriftRemediation, &rawRemediation, service.Annotations); !exists {
+		return false, nil
+	}
+	switch elbv2api.DriftRemediation(rawRemediation) {
+	case elbv2api.DriftRemediationAuto:
+		return true, nil
+	case elbv2api.DriftRemediationNone:
+		return false, nil
+	default:
+		return false, errors.Errorf("unknown drift remediation: %v", rawRemediation)
+	}
+}
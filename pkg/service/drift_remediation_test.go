@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_IsDriftRemediationEnabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+		wantErr     error
+	}{
+		{
+			name:        "drift remediation not specified",
+			annotations: map[string]string{},
+			want:        false,
+		},
+		{
+			name: "drift remediation Auto",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-drift-remediation": "Auto",
+			},
+			want: true,
+		},
+		{
+			name: "drift remediation None",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-drift-remediation": "None",
+			},
+			want: false,
+		},
+		{
+			name: "unknown drift remediation",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-drift-remediation": "auto",
+			},
+			wantErr: errors.New("unknown drift remediation: auto"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "awesome-svc",
+					Annotations: tt.annotations,
+				},
+			}
+			got, err := IsDriftRemediationEnabled(annotationParser, svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -43,8 +43,17 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
 	tgProtocol := elbv2model.Protocol(port.Protocol)
 	listenerProtocol := elbv2model.Protocol(port.Protocol)
-	if tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
-		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
+	useTLS := tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
+		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port))))
+	// the listener settings in LoadBalancerConfiguration take higher priority than the ones in annotation.
+	lsConfig := t.buildListenerConfigurationForPort(port)
+	if lsConfig != nil {
+		var err error
+		if useTLS, err = buildListenerTLSViaLoadBalancerConfiguration(port, lsConfig, useTLS); err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+	}
+	if useTLS {
 		if cfg.backendProtocol == "ssl" {
 			tgProtocol = elbv2model.ProtocolTLS
 		}
@@ -70,6 +79,18 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	if listenerProtocol == elbv2model.ProtocolTLS {
 		sslPolicy = cfg.sslPolicy
 		certificates = cfg.certificates
+		if lsConfig != nil && lsConfig.SSLPolicy != nil {
+			sslPolicy = lsConfig.SSLPolicy
+		}
+		if lsConfig != nil && len(lsConfig.CertificateARNs) != 0 {
+			certificates = make([]elbv2model.Certificate, 0, len(lsConfig.CertificateARNs))
+			for _, cert := range lsConfig.CertificateARNs {
+				certificates = append(certificates, elbv2model.Certificate{CertificateARN: aws.String(cert)})
+			}
+		}
+	}
+	if listenerProtocol == elbv2model.ProtocolTLS && len(certificates) == 0 {
+		return elbv2model.ListenerSpec{}, errors.Errorf("certificates must be specified for TLS listener on port: %v", port.Port)
 	}
 
 	defaultActions := t.buildListenerDefaultActions(ctx, targetGroup)
@@ -81,16 +81,18 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, schem
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	existingLBARN := t.buildLoadBalancerExistingARN(ctx)
 
 	spec := elbv2model.LoadBalancerSpec{
-		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeNetwork,
-		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
-		SecurityGroups:         securityGroups,
-		SubnetMappings:         subnetMappings,
-		LoadBalancerAttributes: lbAttributes,
-		Tags:                   tags,
+		Name:                    name,
+		Type:                    elbv2model.LoadBalancerTypeNetwork,
+		Scheme:                  &scheme,
+		IPAddressType:           &ipAddressType,
+		SecurityGroups:          securityGroups,
+		SubnetMappings:          subnetMappings,
+		LoadBalancerAttributes:  lbAttributes,
+		Tags:                    tags,
+		ExistingLoadBalancerARN: existingLBARN,
 	}
 
 	if securityGroupsInboundRulesOnPrivateLink != nil {
@@ -113,7 +115,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	}
 	var sgNameOrIDs []string
 	var lbSGTokens []core.StringToken
-	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	if t.lbConfig != nil && len(t.lbConfig.SecurityGroups) != 0 {
+		sgNameOrIDs = t.lbConfig.SecurityGroups
+	} else {
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	}
 	if len(sgNameOrIDs) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, ipAddressType)
 		if err != nil {
@@ -173,7 +179,9 @@ func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlag(ctx context.Co
 
 func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
 	rawIPAddressType := ""
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, t.service.Annotations); !exists {
+	if t.lbConfig != nil && t.lbConfig.IPAddressType != nil {
+		rawIPAddressType = string(*t.lbConfig.IPAddressType)
+	} else if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, t.service.Annotations); !exists {
 		return t.defaultIPAddressType, nil
 	}
 
@@ -229,7 +237,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (el
 	return elbv2model.LoadBalancerSchemeInternal, nil
 }
 
+// buildLoadBalancerSchemeViaAnnotation builds the explicit scheme, the scheme in LoadBalancerConfiguration takes higher priority than the ones in annotation.
 func (t *defaultModelBuildTask) buildLoadBalancerSchemeViaAnnotation(ctx context.Context) (elbv2model.LoadBalancerScheme, bool, error) {
+	if t.lbConfig != nil && t.lbConfig.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*t.lbConfig.Scheme), true, nil
+	}
 	rawScheme := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, t.service.Annotations); exists {
 		switch rawScheme {
@@ -274,10 +286,28 @@ func (t *defaultModelBuildTask) fetchExistingLoadBalancer(ctx context.Context) (
 		} else {
 			t.existingLoadBalancer = &sdkLBs[0]
 		}
+		// the LoadBalancer to adopt isn't tagged for stack until it's adopted.
+		if t.existingLoadBalancer == nil && fetchError == nil {
+			if existingLBARN := t.buildLoadBalancerExistingARN(ctx); existingLBARN != "" {
+				sdkLB, err := t.elbv2TaggingManager.GetLoadBalancer(ctx, existingLBARN)
+				if err != nil {
+					fetchError = err
+					return
+				}
+				t.existingLoadBalancer = &sdkLB
+			}
+		}
 	})
 	return t.existingLoadBalancer, fetchError
 }
 
+// buildLoadBalancerExistingARN builds the ARN of an existing LoadBalancer to adopt, it's empty if not specified.
+func (t *defaultModelBuildTask) buildLoadBalancerExistingARN(_ context.Context) string {
+	rawARN := ""
+	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerARN, &rawARN, t.service.Annotations)
+	return rawARN
+}
+
 func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (map[string]string, error) {
 	var annotationTags map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixAdditionalTags, &annotationTags, t.service.Annotations); err != nil {
@@ -289,7 +319,12 @@ func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (
 		}
 	}
 
-	mergedTags := algorithm.MergeStringMap(t.defaultTags, annotationTags)
+	lbConfigTags, err := t.buildLoadBalancerConfigurationTags()
+	if err != nil {
+		return nil, err
+	}
+
+	mergedTags := algorithm.MergeStringMap(t.defaultTags, lbConfigTags, annotationTags)
 	return mergedTags, nil
 }
 
@@ -391,6 +426,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]*ec2sdk.Subnet, error) {
+	if t.lbConfig != nil && t.lbConfig.Subnets != nil {
+		return t.subnetsResolver.ResolveViaSelector(ctx, t.lbConfig.Subnets,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		)
+	}
 	var rawSubnetNameOrIDs []string
 	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations); exists {
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
@@ -445,7 +487,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) (
 	if err != nil {
 		return []elbv2model.LoadBalancerAttribute{}, err
 	}
-	mergedAttributes := algorithm.MergeStringMap(specificAttributes, loadBalancerAttributes)
+	mergedAttributes := algorithm.MergeStringMap(t.buildLoadBalancerConfigurationAttributes(), specificAttributes, loadBalancerAttributes)
 	return makeAttributesSliceFromMap(mergedAttributes), nil
 }
 
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// loadLoadBalancerConfiguration loads the LoadBalancerConfiguration referenced by annotation on Service if any.
+func (t *defaultModelBuildTask) loadLoadBalancerConfiguration(ctx context.Context) error {
+	lbConfigName := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigName, t.service.Annotations); !exists || lbConfigName == "" {
+		return nil
+	}
+	lbConfigKey := types.NamespacedName{Namespace: t.service.Namespace, Name: lbConfigName}
+	lbConfig := &elbv2api.LoadBalancerConfiguration{}
+	if err := t.k8sClient.Get(ctx, lbConfigKey, lbConfig); err != nil {
+		return errors.Wrapf(err, "failed to load LoadBalancerConfiguration %v for service: %v", lbConfigKey, k8s.NamespacedName(t.service))
+	}
+	t.lbConfig = &lbConfig.Spec
+	return nil
+}
+
+// buildListenerConfigurationForPort returns the listener settings from LoadBalancerConfiguration for the Service port, or nil if there is none.
+func (t *defaultModelBuildTask) buildListenerConfigurationForPort(port corev1.ServicePort) *elbv2api.ListenerConfiguration {
+	if t.lbConfig == nil {
+		return nil
+	}
+	for i := range t.lbConfig.Listeners {
+		if t.lbConfig.Listeners[i].Port == port.Port {
+			return &t.lbConfig.Listeners[i]
+		}
+	}
+	return nil
+}
+
+// buildListenerTLSViaLoadBalancerConfiguration determines whether the listener for the Service port uses TLS per the listener settings from LoadBalancerConfiguration.
+// the protocol of listener can only be TLS or the protocol of Service port, TLS is used if certificates are specified without protocol.
+func buildListenerTLSViaLoadBalancerConfiguration(port corev1.ServicePort, lsConfig *elbv2api.ListenerConfiguration, useTLS bool) (bool, error) {
+	if lsConfig.Protocol == nil {
+		return useTLS || (port.Protocol != corev1.ProtocolUDP && len(lsConfig.CertificateARNs) != 0), nil
+	}
+	switch {
+	case *lsConfig.Protocol == elbv2api.ListenerProtocolTLS && port.Protocol != corev1.ProtocolUDP:
+		return true, nil
+	case string(*lsConfig.Protocol) == string(port.Protocol):
+		return false, nil
+	default:
+		return false, errors.Errorf("listener protocol %v in LoadBalancerConfiguration is not supported for %v port: %v",
+			*lsConfig.Protocol, port.Protocol, port.Port)
+	}
+}
+
+// buildLoadBalancerConfigurationAttributes builds the LB attributes from the LoadBalancerConfiguration settings.
+// Note: the access logs settings takes higher priority than the access_logs.s3 attributes.
+func (t *defaultModelBuildTask) buildLoadBalancerConfigurationAttributes() map[string]string {
+	if t.lbConfig == nil {
+		return nil
+	}
+	lbConfigAttributes := make(map[string]string, len(t.lbConfig.LoadBalancerAttributes))
+	for _, attr := range t.lbConfig.LoadBalancerAttributes {
+		lbConfigAttributes[attr.Key] = attr.Value
+	}
+	if accessLogs := t.lbConfig.AccessLogs; accessLogs != nil {
+		accessLogsEnabled := accessLogs.Enabled == nil || *accessLogs.Enabled
+		lbConfigAttributes[lbAttrsAccessLogsS3Enabled] = strconv.FormatBool(accessLogsEnabled)
+		if accessLogs.S3Bucket != "" {
+			lbConfigAttributes[lbAttrsAccessLogsS3Bucket] = accessLogs.S3Bucket
+		}
+		if accessLogs.S3Prefix != "" {
+			lbConfigAttributes[lbAttrsAccessLogsS3Prefix] = accessLogs.S3Prefix
+		}
+	}
+	return lbConfigAttributes
+}
+
+// buildLoadBalancerConfigurationTags builds the AWS Tags from the LoadBalancerConfiguration settings.
+func (t *defaultModelBuildTask) buildLoadBalancerConfigurationTags() (map[string]string, error) {
+	if t.lbConfig == nil || len(t.lbConfig.Tags) == 0 {
+		return nil, nil
+	}
+	lbConfigTags := make(map[string]string, len(t.lbConfig.Tags))
+	for _, tag := range t.lbConfig.Tags {
+		if t.externalManagedTags.Has(tag.Key) {
+			return nil, errors.Errorf("external managed tag key %v cannot be specified in LoadBalancerConfiguration", tag.Key)
+		}
+		lbConfigTags[tag.Key] = tag.Value
+	}
+	return lbConfigTags, nil
+}
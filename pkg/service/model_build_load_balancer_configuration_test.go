@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_loadLoadBalancerConfiguration(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	lbConfig := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-config"},
+		Spec:       elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternetFacing},
+	}
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		want    *elbv2api.LoadBalancerConfigurationSpec
+		wantErr error
+	}{
+		{
+			name: "no LoadBalancerConfiguration referenced",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"},
+			},
+			want: nil,
+		},
+		{
+			name: "LoadBalancerConfiguration referenced via annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config",
+					},
+				},
+			},
+			want: &elbv2api.LoadBalancerConfigurationSpec{Scheme: &schemeInternetFacing},
+		},
+		{
+			name: "referenced LoadBalancerConfiguration not found",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "other-ns",
+					Name:      "awesome-svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config",
+					},
+				},
+			},
+			wantErr: errors.New("failed to load LoadBalancerConfiguration other-ns/awesome-config for service: other-ns/awesome-svc: loadbalancerconfigurations.elbv2.k8s.aws \"awesome-config\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(context.Background(), lbConfig.DeepCopy()))
+			task := &defaultModelBuildTask{
+				k8sClient:        k8sClient,
+				service:          tt.svc,
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+			}
+			err := task.loadLoadBalancerConfiguration(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, task.lbConfig)
+			}
+		})
+	}
+}
+
+func Test_buildListenerTLSViaLoadBalancerConfiguration(t *testing.T) {
+	protocolTLS := elbv2api.ListenerProtocolTLS
+	protocolTCP := elbv2api.ListenerProtocolTCP
+	protocolHTTPS := elbv2api.ListenerProtocolHTTPS
+	tcpPort := corev1.ServicePort{Port: 443, Protocol: corev1.ProtocolTCP}
+	udpPort := corev1.ServicePort{Port: 53, Protocol: corev1.ProtocolUDP}
+	tests := []struct {
+		name     string
+		port     corev1.ServicePort
+		lsConfig *elbv2api.ListenerConfiguration
+		useTLS   bool
+		want     bool
+		wantErr  error
+	}{
+		{
+			name:     "protocol not specified, keeps annotation setting",
+			port:     tcpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 443},
+			useTLS:   true,
+			want:     true,
+		},
+		{
+			name:     "protocol not specified, certificates specified",
+			port:     tcpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 443, CertificateARNs: []string{"cert-arn"}},
+			want:     true,
+		},
+		{
+			name:     "protocol not specified, certificates specified for UDP port",
+			port:     udpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 53, CertificateARNs: []string{"cert-arn"}},
+			want:     false,
+		},
+		{
+			name:     "TLS protocol",
+			port:     tcpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 443, Protocol: &protocolTLS},
+			want:     true,
+		},
+		{
+			name:     "TCP protocol overrides annotation setting",
+			port:     tcpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 443, Protocol: &protocolTCP},
+			useTLS:   true,
+			want:     false,
+		},
+		{
+			name:     "TLS protocol for UDP port",
+			port:     udpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 53, Protocol: &protocolTLS},
+			wantErr:  errors.New("listener protocol TLS in LoadBalancerConfiguration is not supported for UDP port: 53"),
+		},
+		{
+			name:     "HTTPS protocol",
+			port:     tcpPort,
+			lsConfig: &elbv2api.ListenerConfiguration{Port: 443, Protocol: &protocolHTTPS},
+			wantErr:  errors.New("listener protocol HTTPS in LoadBalancerConfiguration is not supported for TCP port: 443"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildListenerTLSViaLoadBalancerConfiguration(tt.port, tt.lsConfig, tt.useTLS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancer_LoadBalancerConfiguration(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	ipAddressTypeDualStack := elbv2api.IPAddressTypeDualStack
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                    "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":           "ipv4",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":        "true",
+				"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name": "annotation-bucket",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":                "load_balancing.cross_zone.enabled=false",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":  "team=annotation,env=dev",
+			},
+		},
+	}
+	lbConfig := &elbv2api.LoadBalancerConfigurationSpec{
+		Scheme:        &schemeInternetFacing,
+		IPAddressType: &ipAddressTypeDualStack,
+		LoadBalancerAttributes: []elbv2api.Attribute{
+			{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+		},
+		AccessLogs: &elbv2api.AccessLogsConfiguration{
+			S3Bucket: "config-bucket",
+		},
+		Tags: []elbv2api.Tag{{Key: "team", Value: "config"}},
+	}
+	task := &defaultModelBuildTask{
+		service:             svc,
+		annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+		lbConfig:            lbConfig,
+		externalManagedTags: sets.NewString(),
+	}
+
+	scheme, explicitSchemeSpecified, err := task.buildLoadBalancerSchemeViaAnnotation(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, explicitSchemeSpecified)
+	assert.Equal(t, elbv2model.LoadBalancerSchemeInternetFacing, scheme)
+
+	ipAddressType, err := task.buildLoadBalancerIPAddressType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, elbv2model.IPAddressTypeDualStack, ipAddressType)
+
+	attributes, err := task.buildLoadBalancerAttributes(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []elbv2model.LoadBalancerAttribute{
+		{Key: "access_logs.s3.bucket", Value: "config-bucket"},
+		{Key: "access_logs.s3.enabled", Value: "true"},
+		{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+	}, attributes)
+
+	tags, err := task.buildLoadBalancerTags(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "config", "env": "dev"}, tags)
+
+	task.externalManagedTags = sets.NewString("team")
+	_, err = task.buildLoadBalancerTags(context.Background())
+	assert.EqualError(t, err, "external managed tag key team cannot be specified on Service")
+
+	task.service = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"}}
+	_, err = task.buildLoadBalancerTags(context.Background())
+	assert.EqualError(t, err, "external managed tag key team cannot be specified in LoadBalancerConfiguration")
+
+}
@@ -17,9 +17,11 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
@@ -33,18 +35,28 @@ const (
 func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev1.ServicePort, tgProtocol elbv2model.Protocol, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroup, error) {
 	svcPort := intstr.FromInt(int(port.Port))
 	tgResourceID := t.buildTargetGroupResourceID(k8s.NamespacedName(t.service), svcPort)
+	return t.buildTargetGroupWithResourceID(ctx, tgResourceID, port, tgProtocol, scheme)
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupWithResourceID(ctx context.Context, tgResourceID string, port corev1.ServicePort,
+	tgProtocol elbv2model.Protocol, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroup, error) {
 	if targetGroup, exists := t.tgByResID[tgResourceID]; exists {
 		return targetGroup, nil
 	}
-	targetType, err := t.buildTargetType(ctx, port)
+	// settings from TargetGroupConfiguration of the Service take precedence over the ones from annotations.
+	tgProps, err := t.tgConfigLoader.Load(ctx, t.service, port)
+	if err != nil {
+		return nil, err
+	}
+	targetType, err := t.buildTargetType(ctx, port, tgProps)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType)
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType, tgProps)
 	if err != nil {
 		return nil, err
 	}
-	tgAttrs, err := t.buildTargetGroupAttributes(ctx)
+	tgAttrs, err := t.buildTargetGroupAttributes(ctx, tgProps)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +64,7 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	if err != nil {
 		return nil, err
 	}
-	tgSpec, err := t.buildTargetGroupSpec(ctx, tgProtocol, targetType, port, healthCheckConfig, tgAttrs)
+	tgSpec, err := t.buildTargetGroupSpec(ctx, tgProtocol, targetType, port, healthCheckConfig, tgAttrs, tgProps)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +78,9 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProtocol elbv2model.Protocol, targetType elbv2model.TargetType,
-	port corev1.ServicePort, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig, tgAttrs []elbv2model.TargetGroupAttribute) (elbv2model.TargetGroupSpec, error) {
-	tags, err := t.buildTargetGroupTags(ctx)
+	port corev1.ServicePort, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig, tgAttrs []elbv2model.TargetGroupAttribute,
+	tgProps *elbv2api.TargetGroupProps) (elbv2model.TargetGroupSpec, error) {
+	tags, err := t.buildTargetGroupTags(ctx, tgProps)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
@@ -89,39 +102,44 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProt
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(ctx context.Context, targetType elbv2model.TargetType, tgProps *elbv2api.TargetGroupProps) (*elbv2model.TargetGroupHealthCheckConfig, error) {
+	var hcProps *elbv2api.TargetGroupHealthCheckConfig
+	if tgProps != nil {
+		hcProps = tgProps.HealthCheckConfig
+	}
 	if targetType == elbv2model.TargetTypeInstance && t.service.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal &&
 		t.service.Spec.Type == corev1.ServiceTypeLoadBalancer {
-		return t.buildTargetGroupHealthCheckConfigForInstanceModeLocal(ctx, targetType)
+		return t.buildTargetGroupHealthCheckConfigForInstanceModeLocal(ctx, targetType, hcProps)
 	}
-	return t.buildTargetGroupHealthCheckConfigDefault(ctx, targetType)
+	return t.buildTargetGroupHealthCheckConfigDefault(ctx, targetType, hcProps)
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx context.Context, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
-	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocol)
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx context.Context, targetType elbv2model.TargetType,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (*elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocol, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckPathPtr := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPath, healthCheckProtocol)
-	healthCheckMatcherPtr := t.buildTargetGroupHealthCheckMatcher(ctx, healthCheckProtocol)
-	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPort, targetType)
+	healthCheckPathPtr := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPath, hcProps, healthCheckProtocol)
+	healthCheckMatcherPtr := t.buildTargetGroupHealthCheckMatcher(ctx, hcProps, healthCheckProtocol)
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPort, hcProps, targetType)
 	if err != nil {
 		return nil, err
 	}
-	intervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, t.defaultHealthCheckInterval)
+	intervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, t.defaultHealthCheckInterval, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckTimeoutSecondsPtr, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, t.defaultHealthCheckTimeout)
+	healthCheckTimeoutSecondsPtr, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, t.defaultHealthCheckTimeout, hcProps)
 	if err != nil {
 		return nil, err
 	}
 
-	healthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, t.defaultHealthCheckHealthyThreshold)
+	healthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, t.defaultHealthCheckHealthyThreshold, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	unhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, t.defaultHealthCheckUnhealthyThreshold)
+	unhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, t.defaultHealthCheckUnhealthyThreshold, hcProps)
 	if err != nil {
 		return nil, err
 	}
@@ -137,30 +155,31 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigDefault(ctx con
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceModeLocal(ctx context.Context, targetType elbv2model.TargetType) (*elbv2model.TargetGroupHealthCheckConfig, error) {
-	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocolForInstanceModeLocal)
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfigForInstanceModeLocal(ctx context.Context, targetType elbv2model.TargetType,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (*elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckProtocol, err := t.buildTargetGroupHealthCheckProtocol(ctx, t.defaultHealthCheckProtocolForInstanceModeLocal, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckPathPtr := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPathForInstanceModeLocal, healthCheckProtocol)
-	healthCheckMatcherPtr := t.buildTargetGroupHealthCheckMatcher(ctx, healthCheckProtocol)
-	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPortForInstanceModeLocal, targetType)
+	healthCheckPathPtr := t.buildTargetGroupHealthCheckPath(ctx, t.defaultHealthCheckPathForInstanceModeLocal, hcProps, healthCheckProtocol)
+	healthCheckMatcherPtr := t.buildTargetGroupHealthCheckMatcher(ctx, hcProps, healthCheckProtocol)
+	healthCheckPort, err := t.buildTargetGroupHealthCheckPort(ctx, t.defaultHealthCheckPortForInstanceModeLocal, hcProps, targetType)
 	if err != nil {
 		return nil, err
 	}
-	intervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, t.defaultHealthCheckIntervalForInstanceModeLocal)
+	intervalSeconds, err := t.buildTargetGroupHealthCheckIntervalSeconds(ctx, t.defaultHealthCheckIntervalForInstanceModeLocal, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	healthCheckTimeoutSecondsPtr, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, t.defaultHealthCheckTimeoutForInstanceModeLocal)
+	healthCheckTimeoutSecondsPtr, err := t.buildTargetGroupHealthCheckTimeoutSeconds(ctx, t.defaultHealthCheckTimeoutForInstanceModeLocal, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	healthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, t.defaultHealthCheckHealthyThresholdForInstanceModeLocal)
+	healthyThresholdCount, err := t.buildTargetGroupHealthCheckHealthyThresholdCount(ctx, t.defaultHealthCheckHealthyThresholdForInstanceModeLocal, hcProps)
 	if err != nil {
 		return nil, err
 	}
-	unhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, t.defaultHealthCheckUnhealthyThresholdForInstanceModeLocal)
+	unhealthyThresholdCount, err := t.buildTargetGroupHealthCheckUnhealthyThresholdCount(ctx, t.defaultHealthCheckUnhealthyThresholdForInstanceModeLocal, hcProps)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +210,10 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.service.UID))
+	// TargetGroups built into the stack of another resource must not collide with the ones of the Service itself.
+	if t.stack.StackID() != core.StackID(k8s.NamespacedName(t.service)) {
+		_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	}
 	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
 	_, _ = uuidHash.Write([]byte(svcPort.String()))
 	_, _ = uuidHash.Write([]byte(targetType))
@@ -204,7 +227,7 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([]elbv2model.TargetGroupAttribute, error) {
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, tgProps *elbv2api.TargetGroupProps) ([]elbv2model.TargetGroupAttribute, error) {
 	var rawAttributes map[string]string
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawAttributes, t.service.Annotations); err != nil {
 		return nil, err
@@ -212,6 +235,11 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	if rawAttributes == nil {
 		rawAttributes = make(map[string]string)
 	}
+	if tgProps != nil {
+		for _, attr := range tgProps.TargetGroupAttributes {
+			rawAttributes[attr.Key] = attr.Value
+		}
+	}
 	if _, ok := rawAttributes[tgAttrsProxyProtocolV2Enabled]; !ok {
 		rawAttributes[tgAttrsProxyProtocolV2Enabled] = strconv.FormatBool(t.defaultProxyProtocolV2Enabled)
 	}
@@ -276,9 +304,13 @@ func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetTy
 	return 1
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, defaultHealthCheckPort string, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Context, defaultHealthCheckPort string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, targetType elbv2model.TargetType) (intstr.IntOrString, error) {
 	rawHealthCheckPort := defaultHealthCheckPort
 	t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCPort, &rawHealthCheckPort, t.service.Annotations)
+	if hcProps != nil && hcProps.Port != nil {
+		rawHealthCheckPort = hcProps.Port.String()
+	}
 	if rawHealthCheckPort == healthCheckPortTrafficPort {
 		return intstr.FromString(rawHealthCheckPort), nil
 	}
@@ -300,9 +332,13 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPort(_ context.Contex
 	return intstr.IntOrString{}, errors.New("cannot use named healthCheckPort for IP TargetType when service's targetPort is a named port")
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, defaultHealthCheckProtocol elbv2model.Protocol) (elbv2model.Protocol, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Context, defaultHealthCheckProtocol elbv2model.Protocol,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (elbv2model.Protocol, error) {
 	rawHealthCheckProtocol := string(defaultHealthCheckProtocol)
 	t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCProtocol, &rawHealthCheckProtocol, t.service.Annotations)
+	if hcProps != nil && hcProps.Protocol != nil {
+		rawHealthCheckProtocol = string(*hcProps.Protocol)
+	}
 	switch strings.ToUpper(rawHealthCheckProtocol) {
 	case string(elbv2model.ProtocolTCP):
 		return elbv2model.ProtocolTCP, nil
@@ -315,34 +351,47 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckProtocol(_ context.Co
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, defaultHealthCheckPath string, hcProtocol elbv2model.Protocol) *string {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckPath(_ context.Context, defaultHealthCheckPath string,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig, hcProtocol elbv2model.Protocol) *string {
 	if hcProtocol == elbv2model.ProtocolTCP {
 		return nil
 	}
 	healthCheckPath := defaultHealthCheckPath
 	t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCPath, &healthCheckPath, t.service.Annotations)
+	if hcProps != nil && hcProps.Path != nil {
+		healthCheckPath = *hcProps.Path
+	}
 	return &healthCheckPath
 }
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, hcProtocol elbv2model.Protocol) *elbv2model.HealthCheckMatcher {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckMatcher(_ context.Context, hcProps *elbv2api.TargetGroupHealthCheckConfig,
+	hcProtocol elbv2model.Protocol) *elbv2model.HealthCheckMatcher {
 	if hcProtocol == elbv2model.ProtocolTCP || !t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
 		return nil
 	}
 	rawHealthCheckMatcherSuccessCodes := t.defaultHealthCheckMatcherHTTPCode
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixHCSuccessCodes, &rawHealthCheckMatcherSuccessCodes, t.service.Annotations)
+	if hcProps != nil && hcProps.Matcher != nil && hcProps.Matcher.HTTPCode != nil {
+		rawHealthCheckMatcherSuccessCodes = *hcProps.Matcher.HTTPCode
+	}
 	return &elbv2model.HealthCheckMatcher{
 		HTTPCode: &rawHealthCheckMatcherSuccessCodes,
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, defaultHealthCheckInterval int64) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckIntervalSeconds(_ context.Context, defaultHealthCheckInterval int64,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	intervalSeconds := defaultHealthCheckInterval
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixHCInterval, &intervalSeconds, t.service.Annotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.IntervalSeconds != nil {
+		intervalSeconds = *hcProps.IntervalSeconds
+	}
 	return intervalSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, defaultHealthCheckTimeout int64) (*int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ context.Context, defaultHealthCheckTimeout int64,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (*int64, error) {
 	timeoutSeconds := defaultHealthCheckTimeout
 	if !t.featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
 		return &timeoutSeconds, nil
@@ -350,32 +399,46 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckTimeoutSeconds(_ cont
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixHCTimeout, &timeoutSeconds, t.service.Annotations); err != nil {
 		return nil, err
 	}
+	if hcProps != nil && hcProps.TimeoutSeconds != nil {
+		timeoutSeconds = *hcProps.TimeoutSeconds
+	}
 	return &timeoutSeconds, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, defaultHealthCheckHealthyThreshold int64) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckHealthyThresholdCount(_ context.Context, defaultHealthCheckHealthyThreshold int64,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	healthyThresholdCount := defaultHealthCheckHealthyThreshold
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixHCHealthyThreshold, &healthyThresholdCount, t.service.Annotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.HealthyThresholdCount != nil {
+		healthyThresholdCount = *hcProps.HealthyThresholdCount
+	}
 	return healthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, defaultHealthCheckUnhealthyThreshold int64) (int64, error) {
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCount(_ context.Context, defaultHealthCheckUnhealthyThreshold int64,
+	hcProps *elbv2api.TargetGroupHealthCheckConfig) (int64, error) {
 	unhealthyThresholdCount := defaultHealthCheckUnhealthyThreshold
 	if _, err := t.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixHCUnhealthyThreshold, &unhealthyThresholdCount, t.service.Annotations); err != nil {
 		return 0, err
 	}
+	if hcProps != nil && hcProps.UnhealthyThresholdCount != nil {
+		unhealthyThresholdCount = *hcProps.UnhealthyThresholdCount
+	}
 	return unhealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetType(_ context.Context, port corev1.ServicePort) (elbv2model.TargetType, error) {
+func (t *defaultModelBuildTask) buildTargetType(_ context.Context, port corev1.ServicePort, tgProps *elbv2api.TargetGroupProps) (elbv2model.TargetType, error) {
 	svcType := t.service.Spec.Type
 	var lbType string
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, t.service.Annotations)
 	var lbTargetType string
 	lbTargetType = string(t.defaultTargetType)
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, t.service.Annotations)
+	if tgProps != nil && tgProps.TargetType != nil {
+		lbTargetType = string(*tgProps.TargetType)
+	}
 	if lbTargetType == LoadBalancerTargetTypeIP && !t.enableIPTargetType {
 		return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", lbTargetType, t.enableIPTargetType)
 	}
@@ -395,8 +458,22 @@ func (t *defaultModelBuildTask) buildTargetGroupResourceID(svcKey types.Namespac
 	return fmt.Sprintf("%s/%s:%s", svcKey.Namespace, svcKey.Name, port.String())
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupTags(ctx context.Context) (map[string]string, error) {
-	return t.buildAdditionalResourceTags(ctx)
+func (t *defaultModelBuildTask) buildTargetGroupTags(ctx context.Context, tgProps *elbv2api.TargetGroupProps) (map[string]string, error) {
+	additionalTags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tgProps == nil || len(tgProps.Tags) == 0 {
+		return additionalTags, nil
+	}
+	tgConfigTags := make(map[string]string, len(tgProps.Tags))
+	for _, tag := range tgProps.Tags {
+		if t.externalManagedTags.Has(tag.Key) {
+			return nil, errors.Errorf("external managed tag key %v cannot be specified on TargetGroupConfiguration", tag.Key)
+		}
+		tgConfigTags[tag.Key] = tag.Value
+	}
+	return algorithm.MergeStringMap(t.defaultTags, tgConfigTags, additionalTags), nil
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, targetGroup *elbv2model.TargetGroup,
@@ -428,24 +505,29 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupBindingResourceSpec{}, err
 	}
+	tgbSpec := elbv2model.TargetGroupBindingSpec{
+		TargetGroupARN: targetGroup.TargetGroupARN(),
+		TargetType:     &targetType,
+		ServiceRef: elbv2api.ServiceReference{
+			Name: t.service.Name,
+			Port: intstr.FromInt(int(port.Port)),
+		},
+		Networking:    tgbNetworking,
+		NodeSelector:  nodeSelector,
+		IPAddressType: (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType),
+		VpcID:         t.vpcID,
+	}
+	if t.assumeRole != nil {
+		tgbSpec.IAMRoleARNToAssume = t.assumeRole.RoleARN
+		tgbSpec.AssumeRoleExternalID = t.assumeRole.ExternalID
+	}
 	return elbv2model.TargetGroupBindingResourceSpec{
 		Template: elbv2model.TargetGroupBindingTemplate{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: t.service.Namespace,
 				Name:      targetGroup.Spec.Name,
 			},
-			Spec: elbv2model.TargetGroupBindingSpec{
-				TargetGroupARN: targetGroup.TargetGroupARN(),
-				TargetType:     &targetType,
-				ServiceRef: elbv2api.ServiceReference{
-					Name: t.service.Name,
-					Port: intstr.FromInt(int(port.Port)),
-				},
-				Networking:    tgbNetworking,
-				NodeSelector:  nodeSelector,
-				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType),
-				VpcID:         t.vpcID,
-			},
+			Spec: tgbSpec,
 		},
 	}, nil
 }
@@ -26,6 +26,7 @@ func Test_defaultModelBuilderTask_targetGroupAttrs(t *testing.T) {
 	tests := []struct {
 		testName  string
 		svc       *corev1.Service
+		tgProps   *elbv2api.TargetGroupProps
 		wantError bool
 		wantValue []elbv2.TargetGroupAttribute
 	}{
@@ -140,6 +141,46 @@ func Test_defaultModelBuilderTask_targetGroupAttrs(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			testName: "target group attributes from TargetGroupConfiguration",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": "target.group-attr-1=80, preserve_client_ip.enabled=true",
+					},
+				},
+			},
+			tgProps: &elbv2api.TargetGroupProps{
+				TargetGroupAttributes: []elbv2api.Attribute{
+					{
+						Key:   tgAttrsPreserveClientIPEnabled,
+						Value: "false",
+					},
+					{
+						Key:   "deregistration_delay.timeout_seconds",
+						Value: "120",
+					},
+				},
+			},
+			wantValue: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsProxyProtocolV2Enabled,
+					Value: "false",
+				},
+				{
+					Key:   tgAttrsPreserveClientIPEnabled,
+					Value: "false",
+				},
+				{
+					Key:   "target.group-attr-1",
+					Value: "80",
+				},
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "120",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -148,7 +189,7 @@ func Test_defaultModelBuilderTask_targetGroupAttrs(t *testing.T) {
 				service:          tt.svc,
 				annotationParser: parser,
 			}
-			tgAttrs, err := builder.buildTargetGroupAttributes(context.Background())
+			tgAttrs, err := builder.buildTargetGroupAttributes(context.Background(), tt.tgProps)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
@@ -171,6 +212,7 @@ func Test_defaultModelBuilderTask_buildTargetHealthCheck(t *testing.T) {
 	tests := []struct {
 		testName   string
 		svc        *corev1.Service
+		tgProps    *elbv2api.TargetGroupProps
 		targetType elbv2.TargetType
 		wantError  bool
 		wantValue  *elbv2.TargetGroupHealthCheckConfig
@@ -358,6 +400,40 @@ func Test_defaultModelBuilderTask_buildTargetHealthCheck(t *testing.T) {
 			},
 			targetType: elbv2.TargetTypeInstance,
 		},
+		{
+			testName: "TargetGroupConfiguration takes precedence over annotations",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol":          "TCP",
+						"service.beta.kubernetes.io/aws-load-balancer-healthcheck-port":              "8888",
+						"service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval":          "30",
+						"service.beta.kubernetes.io/aws-load-balancer-healthcheck-healthy-threshold": "5",
+					},
+				},
+			},
+			tgProps: &elbv2api.TargetGroupProps{
+				HealthCheckConfig: &elbv2api.TargetGroupHealthCheckConfig{
+					Protocol:        (*elbv2api.TargetGroupHealthCheckProtocol)(aws.String("HTTP")),
+					Path:            aws.String("/ping"),
+					IntervalSeconds: aws.Int64(15),
+				},
+			},
+			wantError: false,
+			wantValue: &elbv2.TargetGroupHealthCheckConfig{
+				Port:                    &port8888,
+				Protocol:                (*elbv2.Protocol)(aws.String("HTTP")),
+				Path:                    aws.String("/ping"),
+				IntervalSeconds:         aws.Int64(15),
+				TimeoutSeconds:          aws.Int64(10),
+				HealthyThresholdCount:   aws.Int64(5),
+				UnhealthyThresholdCount: aws.Int64(3),
+				Matcher: &elbv2.HealthCheckMatcher{
+					HTTPCode: aws.String("200-399"),
+				},
+			},
+			targetType: elbv2.TargetTypeIP,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -387,7 +463,7 @@ func Test_defaultModelBuilderTask_buildTargetHealthCheck(t *testing.T) {
 				defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 				defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
 			}
-			hc, err := builder.buildTargetGroupHealthCheckConfig(context.Background(), tt.targetType)
+			hc, err := builder.buildTargetGroupHealthCheckConfig(context.Background(), tt.targetType, tt.tgProps)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
@@ -1769,7 +1845,7 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			} else {
 				builder.enableIPTargetType = *tt.enableIPTargetType
 			}
-			got, err := builder.buildTargetType(context.Background(), tt.svc.Spec.Ports[0])
+			got, err := builder.buildTargetType(context.Background(), tt.svc.Spec.Ports[0], nil)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -2013,7 +2089,7 @@ func Test_defaultModelBuilder_buildTargetGroupHealthCheckPort(t *testing.T) {
 				service:                tt.svc,
 				defaultHealthCheckPort: tt.defaultPort,
 			}
-			got, err := builder.buildTargetGroupHealthCheckPort(context.Background(), tt.defaultPort, tt.targetType)
+			got, err := builder.buildTargetGroupHealthCheckPort(context.Background(), tt.defaultPort, nil, tt.targetType)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -10,7 +10,9 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
@@ -19,6 +21,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -35,14 +38,23 @@ type ModelBuilder interface {
 	Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error)
 }
 
+// TargetGroupBuilder builds NLB TargetGroups for Service ports into the model stack of another resource, e.g. a Gateway.
+type TargetGroupBuilder interface {
+	// BuildTargetGroup builds the TargetGroup along with its TargetGroupBinding for port of service into stack.
+	// the TargetGroup will have the resource ID tgResID, and the backendSGIDToken is used for TargetGroupBinding networking if not nil.
+	BuildTargetGroup(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, backendSGIDToken core.StringToken,
+		tgResID string, service *corev1.Service, port corev1.ServicePort, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error)
+}
+
 // NewDefaultModelBuilder construct a new defaultModelBuilder
-func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
+func NewDefaultModelBuilder(k8sClient client.Client, annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
 	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
 	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
 	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, enableIPTargetType bool, serviceUtils ServiceUtils,
-	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool,
-	disableRestrictedSGRules bool, logger logr.Logger) *defaultModelBuilder {
+	tgConfigLoader config.TargetGroupConfigurationLoader, backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool,
+	disableRestrictedSGRules bool, assumeRole *aws.AssumeRoleConfig, logger logr.Logger) *defaultModelBuilder {
 	return &defaultModelBuilder{
+		k8sClient:                k8sClient,
 		annotationParser:         annotationParser,
 		subnetsResolver:          subnetsResolver,
 		vpcInfoProvider:          vpcInfoProvider,
@@ -50,6 +62,7 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 		elbv2TaggingManager:      elbv2TaggingManager,
 		featureGates:             featureGates,
 		serviceUtils:             serviceUtils,
+		tgConfigLoader:           tgConfigLoader,
 		clusterName:              clusterName,
 		vpcID:                    vpcID,
 		defaultTags:              defaultTags,
@@ -62,13 +75,16 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 		ec2Client:                ec2Client,
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
+		assumeRole:               assumeRole,
 		logger:                   logger,
 	}
 }
 
 var _ ModelBuilder = &defaultModelBuilder{}
+var _ TargetGroupBuilder = &defaultModelBuilder{}
 
 type defaultModelBuilder struct {
+	k8sClient                client.Client
 	annotationParser         annotations.Parser
 	subnetsResolver          networking.SubnetsResolver
 	vpcInfoProvider          networking.VPCInfoProvider
@@ -78,12 +94,14 @@ type defaultModelBuilder struct {
 	elbv2TaggingManager      elbv2deploy.TaggingManager
 	featureGates             config.FeatureGates
 	serviceUtils             ServiceUtils
+	tgConfigLoader           config.TargetGroupConfigurationLoader
 	ec2Client                services.EC2
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 
 	clusterName         string
 	vpcID               string
+	assumeRole          *aws.AssumeRoleConfig
 	defaultTags         map[string]string
 	externalManagedTags sets.String
 	defaultSSLPolicy    string
@@ -94,9 +112,27 @@ type defaultModelBuilder struct {
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
-	task := &defaultModelBuildTask{
+	task := b.newModelBuildTask(service, stack)
+	if err := task.run(ctx); err != nil {
+		return nil, nil, false, err
+	}
+	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
+}
+
+func (b *defaultModelBuilder) BuildTargetGroup(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer,
+	backendSGIDToken core.StringToken, tgResID string, service *corev1.Service, port corev1.ServicePort, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	task := b.newModelBuildTask(service, stack)
+	task.loadBalancer = lb
+	task.backendSGIDToken = backendSGIDToken
+	return task.buildTargetGroupWithResourceID(ctx, tgResID, port, tgProtocol, *lb.Spec.Scheme)
+}
+
+func (b *defaultModelBuilder) newModelBuildTask(service *corev1.Service, stack core.Stack) *defaultModelBuildTask {
+	return &defaultModelBuildTask{
+		k8sClient:                b.k8sClient,
 		clusterName:              b.clusterName,
 		vpcID:                    b.vpcID,
+		assumeRole:               b.assumeRole,
 		annotationParser:         b.annotationParser,
 		subnetsResolver:          b.subnetsResolver,
 		backendSGProvider:        b.backendSGProvider,
@@ -106,6 +142,7 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		elbv2TaggingManager:      b.elbv2TaggingManager,
 		featureGates:             b.featureGates,
 		serviceUtils:             b.serviceUtils,
+		tgConfigLoader:           b.tgConfigLoader,
 		enableIPTargetType:       b.enableIPTargetType,
 		ec2Client:                b.ec2Client,
 		enableBackendSG:          b.enableBackendSG,
@@ -145,16 +182,13 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
 	}
-
-	if err := task.run(ctx); err != nil {
-		return nil, nil, false, err
-	}
-	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
 }
 
 type defaultModelBuildTask struct {
+	k8sClient           client.Client
 	clusterName         string
 	vpcID               string
+	assumeRole          *aws.AssumeRoleConfig
 	annotationParser    annotations.Parser
 	subnetsResolver     networking.SubnetsResolver
 	vpcInfoProvider     networking.VPCInfoProvider
@@ -164,11 +198,14 @@ type defaultModelBuildTask struct {
 	elbv2TaggingManager elbv2deploy.TaggingManager
 	featureGates        config.FeatureGates
 	serviceUtils        ServiceUtils
+	tgConfigLoader      config.TargetGroupConfigurationLoader
 	enableIPTargetType  bool
 	ec2Client           services.EC2
 	logger              logr.Logger
 
 	service *corev1.Service
+	// lbConfig is the LoadBalancerConfiguration settings referenced by Service, nil if there is none.
+	lbConfig *elbv2api.LoadBalancerConfigurationSpec
 
 	stack                    core.Stack
 	loadBalancer             *elbv2model.LoadBalancer
@@ -228,6 +265,9 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		}
 		return nil
 	}
+	if err := t.loadLoadBalancerConfiguration(ctx); err != nil {
+		return err
+	}
 	err := t.buildModel(ctx)
 	return err
 }
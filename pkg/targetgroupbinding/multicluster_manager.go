@@ -0,0 +1,153 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// trackedTargetsConfigMapPrefix is the name prefix of ConfigMaps with the snapshot of tracked targets.
+	trackedTargetsConfigMapPrefix = "aws-lbc-targets-"
+	// trackedTargetsConfigMapDataKey is the ConfigMap data key of tracked targets.
+	trackedTargetsConfigMapDataKey = "targets"
+)
+
+// MultiClusterManager tracks the targets registered by this cluster into TargetGroups shared among multiple clusters.
+// the snapshot of tracked targets is kept in a ConfigMap along with the TargetGroupBinding.
+type MultiClusterManager interface {
+	// TrackedTargets returns the IDs of targets registered by this cluster for tgb.
+	TrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.String, error)
+
+	// UpdateTrackedTargets replaces the IDs of targets registered by this cluster for tgb.
+	UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetIDs sets.String) error
+
+	// Cleanup removes the snapshot of tracked targets for tgb.
+	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+}
+
+// NewDefaultMultiClusterManager constructs new defaultMultiClusterManager.
+func NewDefaultMultiClusterManager(k8sClient client.Client) *defaultMultiClusterManager {
+	return &defaultMultiClusterManager{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ MultiClusterManager = &defaultMultiClusterManager{}
+
+// default implementation for MultiClusterManager.
+type defaultMultiClusterManager struct {
+	k8sClient client.Client
+}
+
+func (m *defaultMultiClusterManager) TrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.String, error) {
+	cm := &corev1.ConfigMap{}
+	cmKey := buildTrackedTargetsConfigMapKey(tgb)
+	if err := m.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return sets.NewString(), nil
+		}
+		return nil, errors.Wrapf(err, "failed to get tracked targets configMap: %v", cmKey)
+	}
+	return decodeTrackedTargets(cm.Data[trackedTargetsConfigMapDataKey]), nil
+}
+
+func (m *defaultMultiClusterManager) UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetIDs sets.String) error {
+	data := map[string]string{
+		trackedTargetsConfigMapDataKey: encodeTrackedTargets(targetIDs),
+	}
+	cm := &corev1.ConfigMap{}
+	cmKey := buildTrackedTargetsConfigMapKey(tgb)
+	if err := m.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get tracked targets configMap: %v", cmKey)
+		}
+		// the snapshot is owned by tgb, so that it's garbage collected along with tgb.
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: elbv2api.GroupVersion.String(),
+						Kind:       "TargetGroupBinding",
+						Name:       tgb.Name,
+						UID:        tgb.UID,
+					},
+				},
+			},
+			Data: data,
+		}
+		if err := m.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create tracked targets configMap: %v", cmKey)
+		}
+		return nil
+	}
+	if cm.Data[trackedTargetsConfigMapDataKey] == data[trackedTargetsConfigMapDataKey] {
+		return nil
+	}
+
+	cmOld := cm.DeepCopy()
+	cm.Data = data
+	if err := m.k8sClient.Patch(ctx, cm, client.MergeFrom(cmOld)); err != nil {
+		return errors.Wrapf(err, "failed to update tracked targets configMap: %v", cmKey)
+	}
+	return nil
+}
+
+func (m *defaultMultiClusterManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	cmKey := buildTrackedTargetsConfigMapKey(tgb)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cmKey.Namespace,
+			Name:      cmKey.Name,
+		},
+	}
+	if err := m.k8sClient.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete tracked targets configMap: %v", cmKey)
+	}
+	return nil
+}
+
+// buildTrackedTargetsConfigMapKey builds the key of the ConfigMap with the snapshot of tracked targets for tgb.
+func buildTrackedTargetsConfigMapKey(tgb *elbv2api.TargetGroupBinding) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: tgb.Namespace,
+		Name:      fmt.Sprintf("%s%s", trackedTargetsConfigMapPrefix, tgb.Name),
+	}
+}
+
+// encodeTrackedTargets encodes the target IDs in sorted order, so that the snapshot is stable.
+func encodeTrackedTargets(targetIDs sets.String) string {
+	return strings.Join(targetIDs.List(), ",")
+}
+
+func decodeTrackedTargets(rawTargetIDs string) sets.String {
+	targetIDs := sets.NewString()
+	for _, targetID := range strings.Split(rawTargetIDs, ",") {
+		if targetID = strings.TrimSpace(targetID); targetID != "" {
+			targetIDs.Insert(targetID)
+		}
+	}
+	return targetIDs
+}
+
+// filterTrackedTargets returns the targets among targets that are tracked as registered by this cluster.
+func filterTrackedTargets(targets []TargetInfo, trackedTargetIDs sets.String) []TargetInfo {
+	var trackedTargets []TargetInfo
+	for _, target := range targets {
+		if trackedTargetIDs.Has(UniqueIDForTargetDescription(target.Target)) {
+			trackedTargets = append(trackedTargets, target)
+		}
+	}
+	return trackedTargets
+}
@@ -0,0 +1,180 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultMultiClusterManager_UpdateTrackedTargets(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-tgb",
+			UID:       "tgb-uid",
+		},
+	}
+	cmKey := types.NamespacedName{Namespace: "awesome-ns", Name: "aws-lbc-targets-awesome-tgb"}
+	tests := []struct {
+		name        string
+		existingCM  *corev1.ConfigMap
+		targetIDs   sets.String
+		wantTargets string
+	}{
+		{
+			name:        "configMap doesn't exist",
+			targetIDs:   sets.NewString("192.168.1.2:8080", "192.168.1.1:8080"),
+			wantTargets: "192.168.1.1:8080,192.168.1.2:8080",
+		},
+		{
+			name: "configMap exists",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "aws-lbc-targets-awesome-tgb",
+				},
+				Data: map[string]string{
+					"targets": "192.168.1.1:8080",
+				},
+			},
+			targetIDs:   sets.NewString("192.168.1.3:8080"),
+			wantTargets: "192.168.1.3:8080",
+		},
+		{
+			name: "no targets",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "aws-lbc-targets-awesome-tgb",
+				},
+				Data: map[string]string{
+					"targets": "192.168.1.1:8080",
+				},
+			},
+			targetIDs:   sets.NewString(),
+			wantTargets: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+
+			m := NewDefaultMultiClusterManager(k8sClient)
+			err := m.UpdateTrackedTargets(ctx, tgb, tt.targetIDs)
+			assert.NoError(t, err)
+
+			gotCM := &corev1.ConfigMap{}
+			assert.NoError(t, k8sClient.Get(ctx, cmKey, gotCM))
+			assert.Equal(t, map[string]string{"targets": tt.wantTargets}, gotCM.Data)
+			if tt.existingCM == nil {
+				assert.Equal(t, []metav1.OwnerReference{
+					{
+						APIVersion: "elbv2.k8s.aws/v1beta1",
+						Kind:       "TargetGroupBinding",
+						Name:       "awesome-tgb",
+						UID:        "tgb-uid",
+					},
+				}, gotCM.OwnerReferences)
+			}
+
+			gotTargetIDs, err := m.TrackedTargets(ctx, tgb)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.targetIDs, gotTargetIDs)
+		})
+	}
+}
+
+func Test_defaultMultiClusterManager_Cleanup(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-tgb",
+		},
+	}
+	tests := []struct {
+		name       string
+		existingCM *corev1.ConfigMap
+	}{
+		{
+			name: "configMap exists",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "aws-lbc-targets-awesome-tgb",
+				},
+				Data: map[string]string{
+					"targets": "192.168.1.1:8080",
+				},
+			},
+		},
+		{
+			name: "configMap doesn't exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+
+			m := NewDefaultMultiClusterManager(k8sClient)
+			assert.NoError(t, m.Cleanup(ctx, tgb))
+
+			gotTargetIDs, err := m.TrackedTargets(ctx, tgb)
+			assert.NoError(t, err)
+			assert.Equal(t, sets.NewString(), gotTargetIDs)
+		})
+	}
+}
+
+func Test_filterTrackedTargets(t *testing.T) {
+	target1 := TargetInfo{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int64(8080)}}
+	target2 := TargetInfo{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int64(8080)}}
+	target3 := TargetInfo{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int64(9090)}}
+	tests := []struct {
+		name             string
+		targets          []TargetInfo
+		trackedTargetIDs sets.String
+		want             []TargetInfo
+	}{
+		{
+			name:             "only tracked targets are kept",
+			targets:          []TargetInfo{target1, target2, target3},
+			trackedTargetIDs: sets.NewString("192.168.1.2:8080"),
+			want:             []TargetInfo{target2},
+		},
+		{
+			name:             "no tracked targets",
+			targets:          []TargetInfo{target1, target2},
+			trackedTargetIDs: sets.NewString(),
+			want:             nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterTrackedTargets(tt.targets, tt.trackedTargetIDs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"sync"
 	"time"
 
 	"k8s.io/client-go/tools/record"
@@ -18,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -30,12 +32,14 @@ const defaultTargetHealthRequeueDuration = 15 * time.Second
 
 // ResourceManager manages the TargetGroupBinding resource.
 type ResourceManager interface {
+	// Reconcile reconciles the targets and networking of tgb.
+	// the health of targets and conditions observed along the way are recorded into the status of tgb, it's up to the caller to persist them.
 	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
 	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
 }
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
-func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, ec2Client services.EC2,
+func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, ec2Client services.EC2, cloudProvider aws.CloudProvider,
 	podInfoRepo k8s.PodInfoRepo, sgManager networking.SecurityGroupManager, sgReconciler networking.SecurityGroupReconciler,
 	vpcInfoProvider networking.VPCInfoProvider,
 	vpcID string, clusterName string, failOpenEnabled bool, endpointSliceEnabled bool, disabledRestrictedSGRulesFlag bool,
@@ -59,6 +63,11 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 		vpcID:             vpcID,
 		vpcInfoProvider:   vpcInfoProvider,
 		podInfoRepo:       podInfoRepo,
+		cloudProvider:     cloudProvider,
+
+		multiClusterManager: NewDefaultMultiClusterManager(k8sClient),
+
+		assumedRoleClients: make(map[aws.AssumeRoleConfig]targetsClients),
 
 		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
 	}
@@ -77,10 +86,23 @@ type defaultResourceManager struct {
 	vpcInfoProvider   networking.VPCInfoProvider
 	podInfoRepo       k8s.PodInfoRepo
 	vpcID             string
+	cloudProvider     aws.CloudProvider
+
+	multiClusterManager MultiClusterManager
+
+	// assumedRoleClients are the clients for TargetGroups in other AWS accounts, per IAM role to assume.
+	assumedRoleClientsMutex sync.Mutex
+	assumedRoleClients      map[aws.AssumeRoleConfig]targetsClients
 
 	targetHealthRequeueDuration time.Duration
 }
 
+// targetsClients are the clients to manage targets of TargetGroups in an AWS account.
+type targetsClients struct {
+	targetsManager  TargetsManager
+	vpcInfoProvider networking.VPCInfoProvider
+}
+
 func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	if tgb.Spec.TargetType == nil {
 		return errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
@@ -95,6 +117,11 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.cleanupTargets(ctx, tgb); err != nil {
 		return err
 	}
+	if tgb.Spec.MultiClusterTargetGroup {
+		if err := m.multiClusterManager.Cleanup(ctx, tgb); err != nil {
+			return err
+		}
+	}
 	if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
 		return err
 	}
@@ -128,28 +155,65 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 
 	tgARN := tgb.Spec.TargetGroupARN
 	vpcID := tgb.Spec.VpcID
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	clients, err := m.targetsClientsForTGB(tgb)
 	if err != nil {
 		return err
 	}
+	targets, err := clients.targetsManager.ListTargets(ctx, tgARN)
+	if err != nil {
+		setTargetsRegisteredCondition(tgb, err)
+		return err
+	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
 	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(endpoints, notDrainingTargets)
+	desiredTargetIDs := sets.NewString()
+	for _, endpoint := range endpoints {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port))
+	}
+	unmatchedTargets, err = m.trackMultiClusterTargets(ctx, tgb, desiredTargetIDs, unmatchedTargets)
+	if err != nil {
+		return err
+	}
 
 	needNetworkingRequeue := false
-	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
-		m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedNetworkReconcile, err.Error())
+	networkingErr := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints)
+	if networkingErr != nil {
+		m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedNetworkReconcile, networkingErr.Error())
 		needNetworkingRequeue = true
 	}
+	setNetworkingReconciledCondition(tgb, networkingErr)
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, clients, tgARN, unmatchedTargets); err != nil {
+			setTargetsRegisteredCondition(tgb, err)
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerPodEndpoints(ctx, tgARN, vpcID, unmatchedEndpoints); err != nil {
+		if err := m.registerPodEndpoints(ctx, clients, tgARN, vpcID, unmatchedEndpoints); err != nil {
+			setTargetsRegisteredCondition(tgb, err)
 			return err
 		}
+		tgb.Status.LastRegistrationTime = &metav1.Time{Time: time.Now()}
 	}
+	if err := m.untrackMultiClusterTargets(ctx, tgb, desiredTargetIDs); err != nil {
+		return err
+	}
+	setTargetsRegisteredCondition(tgb, nil)
+
+	statusTargets := make([]TargetInfo, 0, len(targets)+len(unmatchedEndpoints))
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		statusTargets = append(statusTargets, endpointAndTarget.target)
+	}
+	registeringSDKTargets := make([]elbv2sdk.TargetDescription, 0, len(unmatchedEndpoints))
+	for _, endpoint := range unmatchedEndpoints {
+		registeringSDKTargets = append(registeringSDKTargets, elbv2sdk.TargetDescription{
+			Id:   awssdk.String(endpoint.IP),
+			Port: awssdk.Int64(endpoint.Port),
+		})
+	}
+	statusTargets = append(statusTargets, buildRegisteringTargets(registeringSDKTargets)...)
+	statusTargets = append(statusTargets, m.buildDrainingStatusTargets(tgb, drainingTargets, unmatchedTargets)...)
+	setTargetHealthStatus(tgb, statusTargets)
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints)
 	if err != nil {
@@ -167,8 +231,6 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		return runtime.NewRequeueNeeded("monitor potential ready endpoints")
 	}
 
-	_ = drainingTargets
-
 	if needNetworkingRequeue {
 		return runtime.NewRequeueNeeded("networking reconciliation")
 	}
@@ -192,32 +254,72 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		return err
 	}
 	tgARN := tgb.Spec.TargetGroupARN
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	clients, err := m.targetsClientsForTGB(tgb)
+	if err != nil {
+		return err
+	}
+	targets, err := clients.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
+		setTargetsRegisteredCondition(tgb, err)
 		return err
 	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	_, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	desiredTargetIDs := sets.NewString()
+	for _, endpoint := range endpoints {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", endpoint.InstanceID, endpoint.Port))
+	}
+	unmatchedTargets, err = m.trackMultiClusterTargets(ctx, tgb, desiredTargetIDs, unmatchedTargets)
+	if err != nil {
+		return err
+	}
 
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
+		setNetworkingReconciledCondition(tgb, err)
 		return err
 	}
+	setNetworkingReconciledCondition(tgb, nil)
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, clients, tgARN, unmatchedTargets); err != nil {
+			setTargetsRegisteredCondition(tgb, err)
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerNodePortEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+		if err := m.registerNodePortEndpoints(ctx, clients, tgARN, unmatchedEndpoints); err != nil {
+			setTargetsRegisteredCondition(tgb, err)
 			return err
 		}
+		tgb.Status.LastRegistrationTime = &metav1.Time{Time: time.Now()}
+	}
+	if err := m.untrackMultiClusterTargets(ctx, tgb, desiredTargetIDs); err != nil {
+		return err
+	}
+	setTargetsRegisteredCondition(tgb, nil)
+
+	statusTargets := make([]TargetInfo, 0, len(targets)+len(unmatchedEndpoints))
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		statusTargets = append(statusTargets, endpointAndTarget.target)
+	}
+	registeringSDKTargets := make([]elbv2sdk.TargetDescription, 0, len(unmatchedEndpoints))
+	for _, endpoint := range unmatchedEndpoints {
+		registeringSDKTargets = append(registeringSDKTargets, elbv2sdk.TargetDescription{
+			Id:   awssdk.String(endpoint.InstanceID),
+			Port: awssdk.Int64(endpoint.Port),
+		})
 	}
-	_ = drainingTargets
+	statusTargets = append(statusTargets, buildRegisteringTargets(registeringSDKTargets)...)
+	statusTargets = append(statusTargets, m.buildDrainingStatusTargets(tgb, drainingTargets, unmatchedTargets)...)
+	setTargetHealthStatus(tgb, statusTargets)
 	return nil
 }
 
 func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	targets, err := m.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
+	clients, err := m.targetsClientsForTGB(tgb)
+	if err != nil {
+		return err
+	}
+	targets, err := clients.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
@@ -226,7 +328,14 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 		}
 		return err
 	}
-	if err := m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targets); err != nil {
+	if tgb.Spec.MultiClusterTargetGroup {
+		trackedTargetIDs, err := m.multiClusterManager.TrackedTargets(ctx, tgb)
+		if err != nil {
+			return err
+		}
+		targets = filterTrackedTargets(targets, trackedTargetIDs)
+	}
+	if err := m.deregisterTargets(ctx, clients, tgb.Spec.TargetGroupARN, targets); err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
 		} else if isELBV2TargetGroupARNInvalidError(err) {
@@ -375,15 +484,15 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 	return nil
 }
 
-func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN string, targets []TargetInfo) error {
+func (m *defaultResourceManager) deregisterTargets(ctx context.Context, clients targetsClients, tgARN string, targets []TargetInfo) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(targets))
 	for _, target := range targets {
 		sdkTargets = append(sdkTargets, target.Target)
 	}
-	return m.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
+	return clients.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN, tgVpcID string, endpoints []backend.PodEndpoint) error {
+func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, clients targetsClients, tgARN, tgVpcID string, endpoints []backend.PodEndpoint) error {
 	vpcID := m.vpcID
 	// Target group is in a different VPC from the cluster's VPC
 	if tgVpcID != "" && tgVpcID != m.vpcID {
@@ -391,7 +500,7 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN
 		m.logger.Info("registering endpoints using the targetGroup's vpcID", tgVpcID,
 			"which is different from the cluster's vpcID", m.vpcID)
 	}
-	vpcInfo, err := m.vpcInfoProvider.FetchVPCInfo(ctx, vpcID)
+	vpcInfo, err := clients.vpcInfoProvider.FetchVPCInfo(ctx, vpcID)
 	if err != nil {
 		return err
 	}
@@ -418,10 +527,10 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN
 		}
 		sdkTargets = append(sdkTargets, target)
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return clients.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgARN string, endpoints []backend.NodePortEndpoint) error {
+func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, clients targetsClients, tgARN string, endpoints []backend.NodePortEndpoint) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
 		sdkTargets = append(sdkTargets, elbv2sdk.TargetDescription{
@@ -429,7 +538,74 @@ func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context,
 			Port: awssdk.Int64(endpoint.Port),
 		})
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return clients.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+}
+
+// buildDrainingStatusTargets builds the targets of tgb that are being deregistered for its status.
+// targets already draining in a TargetGroup shared among multiple clusters can't be told apart from those of other clusters, so only deregisteredTargets are included.
+func (m *defaultResourceManager) buildDrainingStatusTargets(tgb *elbv2api.TargetGroupBinding, drainingTargets []TargetInfo, deregisteredTargets []TargetInfo) []TargetInfo {
+	statusTargets := buildDeregisteringTargets(deregisteredTargets)
+	if !tgb.Spec.MultiClusterTargetGroup {
+		statusTargets = append(statusTargets, drainingTargets...)
+	}
+	return statusTargets
+}
+
+// trackMultiClusterTargets tracks the desired targets as registered by this cluster if the TargetGroup of tgb is shared among multiple clusters,
+// and returns the targets among unmatchedTargets that were registered by this cluster, the others are left to the clusters that registered them.
+// the desired targets are tracked ahead of registration, so that a target registered by this cluster is always in the snapshot.
+func (m *defaultResourceManager) trackMultiClusterTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	desiredTargetIDs sets.String, unmatchedTargets []TargetInfo) ([]TargetInfo, error) {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return unmatchedTargets, nil
+	}
+	trackedTargetIDs, err := m.multiClusterManager.TrackedTargets(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.multiClusterManager.UpdateTrackedTargets(ctx, tgb, trackedTargetIDs.Union(desiredTargetIDs)); err != nil {
+		return nil, err
+	}
+	return filterTrackedTargets(unmatchedTargets, trackedTargetIDs), nil
+}
+
+// untrackMultiClusterTargets tracks only the desired targets as registered by this cluster once the targets registered by this cluster are deregistered.
+func (m *defaultResourceManager) untrackMultiClusterTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, desiredTargetIDs sets.String) error {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return nil
+	}
+	return m.multiClusterManager.UpdateTrackedTargets(ctx, tgb, desiredTargetIDs)
+}
+
+// targetsClientsForTGB returns the clients to manage targets of the TargetGroup of tgb.
+// the TargetGroup is accessed through the assumed IAM role if it's in another AWS account.
+func (m *defaultResourceManager) targetsClientsForTGB(tgb *elbv2api.TargetGroupBinding) (targetsClients, error) {
+	if tgb.Spec.IAMRoleARNToAssume == "" {
+		return targetsClients{
+			targetsManager:  m.targetsManager,
+			vpcInfoProvider: m.vpcInfoProvider,
+		}, nil
+	}
+	assumeRole := aws.AssumeRoleConfig{
+		RoleARN:    tgb.Spec.IAMRoleARNToAssume,
+		ExternalID: tgb.Spec.AssumeRoleExternalID,
+		VpcID:      tgb.Spec.VpcID,
+	}
+	m.assumedRoleClientsMutex.Lock()
+	defer m.assumedRoleClientsMutex.Unlock()
+	if clients, exists := m.assumedRoleClients[assumeRole]; exists {
+		return clients, nil
+	}
+	cloud, err := m.cloudProvider.CloudForRole(&assumeRole)
+	if err != nil {
+		return targetsClients{}, err
+	}
+	clients := targetsClients{
+		targetsManager:  NewCachedTargetsManager(cloud.ELBV2(), m.logger),
+		vpcInfoProvider: networking.NewDefaultVPCInfoProvider(cloud.EC2(), m.logger),
+	}
+	m.assumedRoleClients[assumeRole] = clients
+	return clients, nil
 }
 
 type podEndpointAndTargetPair struct {
@@ -0,0 +1,184 @@
+package targetgroupbinding
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+const (
+	conditionReasonRegistered             = "Registered"
+	conditionReasonFailedRegistration     = "FailedRegistration"
+	conditionReasonReconciled             = "Reconciled"
+	conditionReasonFailedNetworkReconcile = "FailedNetworkReconcile"
+	conditionReasonTargetsHealthy         = "TargetsHealthy"
+	conditionReasonTargetsUnhealthy       = "TargetsUnhealthy"
+	conditionReasonTargetsInitializing    = "TargetsInitializing"
+	conditionReasonNotReconciled          = "NotReconciled"
+
+	// maxTargetStatuses is the max number of targets reported in the status of TargetGroupBinding, to keep its size bounded.
+	maxTargetStatuses = 100
+)
+
+// setTargetsRegisteredCondition sets the TargetsRegistered condition of tgb per the error of target registration.
+func setTargetsRegisteredCondition(tgb *elbv2api.TargetGroupBinding, err error) {
+	cond := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionTargetsRegistered,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditionReasonRegistered,
+		Message:            "Targets are registered",
+		ObservedGeneration: tgb.Generation,
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = conditionReasonFailedRegistration
+		cond.Message = err.Error()
+	}
+	meta.SetStatusCondition(&tgb.Status.Conditions, cond)
+	setReadyCondition(tgb)
+}
+
+// setNetworkingReconciledCondition sets the NetworkingReconciled condition of tgb per the error of networking reconciliation.
+func setNetworkingReconciledCondition(tgb *elbv2api.TargetGroupBinding, err error) {
+	cond := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionNetworkingReconciled,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditionReasonReconciled,
+		Message:            "Networking rules are reconciled",
+		ObservedGeneration: tgb.Generation,
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = conditionReasonFailedNetworkReconcile
+		cond.Message = err.Error()
+	}
+	meta.SetStatusCondition(&tgb.Status.Conditions, cond)
+	setReadyCondition(tgb)
+}
+
+// setReadyCondition sets the Ready condition of tgb from its other conditions and the health of its targets.
+func setReadyCondition(tgb *elbv2api.TargetGroupBinding) {
+	cond := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: tgb.Generation,
+	}
+	for _, condType := range []string{elbv2api.TargetGroupBindingConditionTargetsRegistered, elbv2api.TargetGroupBindingConditionNetworkingReconciled} {
+		if !meta.IsStatusConditionTrue(tgb.Status.Conditions, condType) {
+			cond.Reason = conditionReasonNotReconciled
+			cond.Message = fmt.Sprintf("%v condition isn't true", condType)
+			meta.SetStatusCondition(&tgb.Status.Conditions, cond)
+			return
+		}
+	}
+	targetHealth := tgb.Status.TargetHealth
+	switch {
+	case targetHealth != nil && targetHealth.Unhealthy > 0:
+		cond.Reason = conditionReasonTargetsUnhealthy
+		cond.Message = fmt.Sprintf("%d of %d targets are unhealthy: %v", targetHealth.Unhealthy, targetHealth.Total, strings.Join(targetHealth.UnhealthyReasons, ","))
+	case targetHealth != nil && targetHealth.Initial > 0:
+		cond.Reason = conditionReasonTargetsInitializing
+		cond.Message = fmt.Sprintf("%d of %d targets are being registered", targetHealth.Initial, targetHealth.Total)
+	default:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = conditionReasonTargetsHealthy
+		cond.Message = "All targets are healthy"
+	}
+	meta.SetStatusCondition(&tgb.Status.Conditions, cond)
+}
+
+// setTargetHealthStatus sets the health of each target and the aggregated health into the status of tgb.
+// only the first maxTargetStatuses targets are reported individually, with targets that aren't healthy first.
+func setTargetHealthStatus(tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) {
+	targetStatuses := make([]elbv2api.TargetStatus, 0, len(targets))
+	summary := elbv2api.TargetHealthSummary{}
+	unhealthyReasons := sets.NewString()
+	for _, target := range targets {
+		targetStatus := elbv2api.TargetStatus{
+			ID:   awssdk.StringValue(target.Target.Id),
+			Port: awssdk.Int64Value(target.Target.Port),
+		}
+		if target.TargetHealth != nil {
+			targetStatus.State = awssdk.StringValue(target.TargetHealth.State)
+			targetStatus.Reason = awssdk.StringValue(target.TargetHealth.Reason)
+			targetStatus.Description = awssdk.StringValue(target.TargetHealth.Description)
+		}
+		summary.Total++
+		switch targetStatus.State {
+		case elbv2sdk.TargetHealthStateEnumHealthy:
+			summary.Healthy++
+		case elbv2sdk.TargetHealthStateEnumInitial:
+			summary.Initial++
+		case elbv2sdk.TargetHealthStateEnumDraining, elbv2sdk.TargetHealthStateEnumUnhealthyDraining:
+			summary.Draining++
+		default:
+			summary.Unhealthy++
+			if targetStatus.Reason != "" {
+				unhealthyReasons.Insert(targetStatus.Reason)
+			}
+		}
+		targetStatuses = append(targetStatuses, targetStatus)
+	}
+	sort.Slice(targetStatuses, func(i, j int) bool {
+		iHealthy := targetStatuses[i].State == elbv2sdk.TargetHealthStateEnumHealthy
+		jHealthy := targetStatuses[j].State == elbv2sdk.TargetHealthStateEnumHealthy
+		if iHealthy != jHealthy {
+			return jHealthy
+		}
+		if targetStatuses[i].ID != targetStatuses[j].ID {
+			return targetStatuses[i].ID < targetStatuses[j].ID
+		}
+		return targetStatuses[i].Port < targetStatuses[j].Port
+	})
+	if unhealthyReasons.Len() > 0 {
+		summary.UnhealthyReasons = unhealthyReasons.List()
+	}
+	tgb.Status.TargetHealth = &summary
+	if len(targetStatuses) > maxTargetStatuses {
+		targetStatuses = targetStatuses[:maxTargetStatuses]
+	}
+	tgb.Status.Targets = nil
+	if len(targetStatuses) > 0 {
+		tgb.Status.Targets = targetStatuses
+	}
+	setReadyCondition(tgb)
+}
+
+// buildRegisteringTargets builds the targets being registered, their health is initial until ELBV2 probes them.
+func buildRegisteringTargets(sdkTargets []elbv2sdk.TargetDescription) []TargetInfo {
+	targets := make([]TargetInfo, 0, len(sdkTargets))
+	for _, sdkTarget := range sdkTargets {
+		targets = append(targets, TargetInfo{
+			Target: sdkTarget,
+			TargetHealth: &elbv2sdk.TargetHealth{
+				State:       awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
+				Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress),
+				Description: awssdk.String("Target registration is in progress"),
+			},
+		})
+	}
+	return targets
+}
+
+// buildDeregisteringTargets builds the targets being deregistered, their health is draining until they're removed.
+func buildDeregisteringTargets(targets []TargetInfo) []TargetInfo {
+	deregisteringTargets := make([]TargetInfo, 0, len(targets))
+	for _, target := range targets {
+		deregisteringTargets = append(deregisteringTargets, TargetInfo{
+			Target: target.Target,
+			TargetHealth: &elbv2sdk.TargetHealth{
+				State:       awssdk.String(elbv2sdk.TargetHealthStateEnumDraining),
+				Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetDeregistrationInProgress),
+				Description: awssdk.String("Target deregistration is in progress"),
+			},
+		})
+	}
+	return deregisteringTargets
+}
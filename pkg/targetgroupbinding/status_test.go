@@ -0,0 +1,185 @@
+package targetgroupbinding
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_setTargetHealthStatus(t *testing.T) {
+	newTarget := func(id string, port int64, state string, reason string) TargetInfo {
+		target := TargetInfo{
+			Target: elbv2sdk.TargetDescription{Id: awssdk.String(id), Port: awssdk.Int64(port)},
+		}
+		if state != "" {
+			target.TargetHealth = &elbv2sdk.TargetHealth{State: awssdk.String(state)}
+			if reason != "" {
+				target.TargetHealth.Reason = awssdk.String(reason)
+			}
+		}
+		return target
+	}
+	tests := []struct {
+		name             string
+		targets          []TargetInfo
+		wantTargetHealth *elbv2api.TargetHealthSummary
+		wantTargets      []elbv2api.TargetStatus
+		wantReady        metav1.ConditionStatus
+		wantReadyReason  string
+	}{
+		{
+			name: "all targets healthy",
+			targets: []TargetInfo{
+				newTarget("192.168.1.2", 8080, elbv2sdk.TargetHealthStateEnumHealthy, ""),
+				newTarget("192.168.1.1", 8080, elbv2sdk.TargetHealthStateEnumHealthy, ""),
+			},
+			wantTargetHealth: &elbv2api.TargetHealthSummary{Total: 2, Healthy: 2},
+			wantTargets: []elbv2api.TargetStatus{
+				{ID: "192.168.1.1", Port: 8080, State: "healthy"},
+				{ID: "192.168.1.2", Port: 8080, State: "healthy"},
+			},
+			wantReady:       metav1.ConditionTrue,
+			wantReadyReason: "TargetsHealthy",
+		},
+		{
+			name: "targets in each state",
+			targets: []TargetInfo{
+				newTarget("192.168.1.1", 8080, elbv2sdk.TargetHealthStateEnumHealthy, ""),
+				newTarget("192.168.1.2", 8080, elbv2sdk.TargetHealthStateEnumUnhealthy, elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks),
+				newTarget("192.168.1.3", 8080, elbv2sdk.TargetHealthStateEnumUnused, elbv2sdk.TargetHealthReasonEnumTargetInvalidState),
+				newTarget("192.168.1.4", 8080, elbv2sdk.TargetHealthStateEnumUnhealthy, elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks),
+				newTarget("192.168.1.5", 8080, elbv2sdk.TargetHealthStateEnumDraining, ""),
+				newTarget("192.168.1.6", 8080, elbv2sdk.TargetHealthStateEnumInitial, ""),
+			},
+			wantTargetHealth: &elbv2api.TargetHealthSummary{
+				Total:            6,
+				Healthy:          1,
+				Unhealthy:        3,
+				Draining:         1,
+				Initial:          1,
+				UnhealthyReasons: []string{"Target.FailedHealthChecks", "Target.InvalidState"},
+			},
+			wantTargets: []elbv2api.TargetStatus{
+				{ID: "192.168.1.2", Port: 8080, State: "unhealthy", Reason: "Target.FailedHealthChecks"},
+				{ID: "192.168.1.3", Port: 8080, State: "unused", Reason: "Target.InvalidState"},
+				{ID: "192.168.1.4", Port: 8080, State: "unhealthy", Reason: "Target.FailedHealthChecks"},
+				{ID: "192.168.1.5", Port: 8080, State: "draining"},
+				{ID: "192.168.1.6", Port: 8080, State: "initial"},
+				{ID: "192.168.1.1", Port: 8080, State: "healthy"},
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReadyReason: "TargetsUnhealthy",
+		},
+		{
+			name: "targets being registered",
+			targets: buildRegisteringTargets([]elbv2sdk.TargetDescription{
+				{Id: awssdk.String("i-0123456789abcdef0"), Port: awssdk.Int64(30080)},
+			}),
+			wantTargetHealth: &elbv2api.TargetHealthSummary{Total: 1, Initial: 1},
+			wantTargets: []elbv2api.TargetStatus{
+				{ID: "i-0123456789abcdef0", Port: 30080, State: "initial", Reason: "Elb.RegistrationInProgress", Description: "Target registration is in progress"},
+			},
+			wantReady:       metav1.ConditionFalse,
+			wantReadyReason: "TargetsInitializing",
+		},
+		{
+			name: "targets beyond the max number are only counted",
+			targets: func() []TargetInfo {
+				targets := []TargetInfo{newTarget("192.168.2.1", 8080, elbv2sdk.TargetHealthStateEnumUnhealthy, elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks)}
+				for i := 0; i < maxTargetStatuses; i++ {
+					targets = append(targets, newTarget("192.168.1.1", int64(10000+i), elbv2sdk.TargetHealthStateEnumHealthy, ""))
+				}
+				return targets
+			}(),
+			wantTargetHealth: &elbv2api.TargetHealthSummary{
+				Total:            101,
+				Healthy:          100,
+				Unhealthy:        1,
+				UnhealthyReasons: []string{"Target.FailedHealthChecks"},
+			},
+			wantTargets: func() []elbv2api.TargetStatus {
+				targets := []elbv2api.TargetStatus{{ID: "192.168.2.1", Port: 8080, State: "unhealthy", Reason: "Target.FailedHealthChecks"}}
+				for i := 0; i < maxTargetStatuses-1; i++ {
+					targets = append(targets, elbv2api.TargetStatus{ID: "192.168.1.1", Port: int64(10000 + i), State: "healthy"})
+				}
+				return targets
+			}(),
+			wantReady:       metav1.ConditionFalse,
+			wantReadyReason: "TargetsUnhealthy",
+		},
+		{
+			name:             "no targets",
+			wantTargetHealth: &elbv2api.TargetHealthSummary{},
+			wantReady:        metav1.ConditionTrue,
+			wantReadyReason:  "TargetsHealthy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{}
+			setTargetsRegisteredCondition(tgb, nil)
+			setNetworkingReconciledCondition(tgb, nil)
+			setTargetHealthStatus(tgb, tt.targets)
+			assert.Equal(t, tt.wantTargetHealth, tgb.Status.TargetHealth)
+			assert.Equal(t, tt.wantTargets, tgb.Status.Targets)
+			readyCond := meta.FindStatusCondition(tgb.Status.Conditions, elbv2api.TargetGroupBindingConditionReady)
+			assert.Equal(t, tt.wantReady, readyCond.Status)
+			assert.Equal(t, tt.wantReadyReason, readyCond.Reason)
+		})
+	}
+}
+
+func Test_setReadyCondition(t *testing.T) {
+	tests := []struct {
+		name              string
+		registerErr       error
+		networkingErr     error
+		wantReady         metav1.ConditionStatus
+		wantReadyReason   string
+		wantReadyMessage  string
+		wantTargetsReason string
+	}{
+		{
+			name:              "targets registered and networking reconciled",
+			wantReady:         metav1.ConditionTrue,
+			wantReadyReason:   "TargetsHealthy",
+			wantReadyMessage:  "All targets are healthy",
+			wantTargetsReason: "Registered",
+		},
+		{
+			name:              "targets failed registration",
+			registerErr:       errors.New("some error"),
+			wantReady:         metav1.ConditionFalse,
+			wantReadyReason:   "NotReconciled",
+			wantReadyMessage:  "TargetsRegistered condition isn't true",
+			wantTargetsReason: "FailedRegistration",
+		},
+		{
+			name:              "networking failed reconcile",
+			networkingErr:     errors.New("some error"),
+			wantReady:         metav1.ConditionFalse,
+			wantReadyReason:   "NotReconciled",
+			wantReadyMessage:  "NetworkingReconciled condition isn't true",
+			wantTargetsReason: "Registered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{}
+			setNetworkingReconciledCondition(tgb, tt.networkingErr)
+			setTargetsRegisteredCondition(tgb, tt.registerErr)
+			readyCond := meta.FindStatusCondition(tgb.Status.Conditions, elbv2api.TargetGroupBindingConditionReady)
+			assert.Equal(t, tt.wantReady, readyCond.Status)
+			assert.Equal(t, tt.wantReadyReason, readyCond.Reason)
+			assert.Equal(t, tt.wantReadyMessage, readyCond.Message)
+			targetsCond := meta.FindStatusCondition(tgb.Status.Conditions, elbv2api.TargetGroupBindingConditionTargetsRegistered)
+			assert.Equal(t, tt.wantTargetsReason, targetsCond.Reason)
+		})
+	}
+}
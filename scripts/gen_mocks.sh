@@ -11,6 +11,7 @@ $MOCKGEN -package=webhook -destination=./pkg/webhook/mutator_mocks.go sigs.k8s.i
 $MOCKGEN -package=webhook -destination=./pkg/webhook/validator_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/webhook Validator
 $MOCKGEN -package=k8s -destination=./pkg/k8s/finalizer_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/k8s FinalizerManager
 $MOCKGEN -package=k8s -destination=./pkg/k8s/pod_info_repo_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/k8s PodInfoRepo
+$MOCKGEN -package=config -destination=./pkg/config/tg_configuration_loader_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/config TargetGroupConfigurationLoader
 $MOCKGEN -package=networking -destination=./pkg/networking/security_group_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking SecurityGroupManager
 $MOCKGEN -package=networking -destination=./pkg/networking/subnet_resolver_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking SubnetsResolver
 $MOCKGEN -package=networking -destination=./pkg/networking/az_info_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking AZInfoProvider
@@ -22,4 +23,5 @@ $MOCKGEN -package=ingress -destination=./pkg/ingress/cert_discovery_mocks.go sig
 $MOCKGEN -package=elbv2 -destination=./pkg/deploy/elbv2/tagging_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 TaggingManager
 $MOCKGEN -package=shield -destination=./pkg/deploy/shield/protection_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield ProtectionManager
 $MOCKGEN -package=wafv2 -destination=./pkg/deploy/wafv2/web_acl_association_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafv2 WebACLAssociationManager
+$MOCKGEN -package=wafv2 -destination=./pkg/deploy/wafv2/web_acl_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafv2 WebACLManager
 $MOCKGEN -package=wafregional -destination=./pkg/deploy/wafregional/web_acl_association_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional WebACLAssociationManager
\ No newline at end of file
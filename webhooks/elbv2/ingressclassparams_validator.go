@@ -35,6 +35,7 @@ func (v *ingressClassParamsValidator) ValidateCreate(ctx context.Context, obj ru
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2Settings(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -44,6 +45,7 @@ func (v *ingressClassParamsValidator) ValidateUpdate(ctx context.Context, obj ru
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2Settings(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -132,6 +134,15 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	return allErrs
 }
 
+// checkWAFv2Settings will check that at most one of webACL and wafv2AclArn is set.
+func (v *ingressClassParamsValidator) checkWAFv2Settings(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.WebACL != "" && icp.Spec.WAFv2ACLArn != "" {
+		fieldPath := field.NewPath("spec", "wafv2AclArn")
+		allErrs = append(allErrs, field.Forbidden(fieldPath, "may not have both `webACL` and `wafv2AclArn` set"))
+	}
+	return allErrs
+}
+
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-ingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=create;update,versions=v1beta1,name=vingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -129,6 +129,16 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: "spec.subnets.tags: Forbidden: may not have both `ids` and `tags` set",
 		},
+		{
+			name: "webACL with wafv2AclArn",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WebACL:      "my-web-acl",
+					WAFv2ACLArn: "wafv2-arn-1",
+				},
+			},
+			wantErr: "spec.wafv2AclArn: Forbidden: may not have both `webACL` and `wafv2AclArn` set",
+		},
 		{
 			name: "subnet duplicate id",
 			obj: &elbv2api.IngressClassParams{
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -18,18 +19,20 @@ import (
 const apiPathMutateELBv2TargetGroupBinding = "/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
 
 // NewTargetGroupBindingMutator returns a mutator for TargetGroupBinding CRD.
-func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingMutator {
+func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, cloudProvider aws.CloudProvider, logger logr.Logger) *targetGroupBindingMutator {
 	return &targetGroupBindingMutator{
-		elbv2Client: elbv2Client,
-		logger:      logger,
+		elbv2Client:   elbv2Client,
+		cloudProvider: cloudProvider,
+		logger:        logger,
 	}
 }
 
 var _ webhook.Mutator = &targetGroupBindingMutator{}
 
 type targetGroupBindingMutator struct {
-	elbv2Client services.ELBV2
-	logger      logr.Logger
+	elbv2Client   services.ELBV2
+	cloudProvider aws.CloudProvider
+	logger        logr.Logger
 }
 
 func (m *targetGroupBindingMutator) Prototype(_ admission.Request) (runtime.Object, error) {
@@ -58,8 +61,7 @@ func (m *targetGroupBindingMutator) defaultingTargetType(ctx context.Context, tg
 	if tgb.Spec.TargetType != nil {
 		return nil
 	}
-	tgARN := tgb.Spec.TargetGroupARN
-	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgARN)
+	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "couldn't determine TargetType")
 	}
@@ -81,7 +83,7 @@ func (m *targetGroupBindingMutator) defaultingIPAddressType(ctx context.Context,
 	if tgb.Spec.IPAddressType != nil {
 		return nil
 	}
-	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group IP address type")
 	}
@@ -93,7 +95,7 @@ func (m *targetGroupBindingMutator) defaultingVpcID(ctx context.Context, tgb *el
 	if tgb.Spec.VpcID != "" {
 		return nil
 	}
-	vpcId, err := m.getVpcIDFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	vpcId, err := m.getVpcIDFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group VpcID")
 	}
@@ -101,8 +103,8 @@ func (m *targetGroupBindingMutator) defaultingVpcID(ctx context.Context, tgb *el
 	return nil
 }
 
-func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -110,8 +112,8 @@ func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Conte
 }
 
 // getTargetGroupIPAddressTypeFromAWS returns the target group IP address type of AWS target group
-func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgARN string) (elbv2api.TargetGroupIPAddressType, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2api.TargetGroupIPAddressType, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
@@ -127,11 +129,15 @@ func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx conte
 	return ipAddressType, nil
 }
 
-func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgARN string) (*elbv2sdk.TargetGroup, error) {
+func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2sdk.TargetGroup, error) {
+	elbv2Client, err := elbv2ClientForTGB(m.elbv2Client, m.cloudProvider, tgb)
+	if err != nil {
+		return nil, err
+	}
 	req := &elbv2sdk.DescribeTargetGroupsInput{
-		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		TargetGroupArns: awssdk.StringSlice([]string{tgb.Spec.TargetGroupARN}),
 	}
-	tgList, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	tgList, err := elbv2Client.DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -141,14 +147,31 @@ func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, t
 	return tgList[0], nil
 }
 
-func (m *targetGroupBindingMutator) getVpcIDFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) getVpcIDFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (string, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
 	return awssdk.StringValue(targetGroup.VpcId), nil
 }
 
+// elbv2ClientForTGB returns the ELBV2 client to access the TargetGroup of tgb.
+// the TargetGroup is accessed through the assumed IAM role if it's in another AWS account.
+func elbv2ClientForTGB(elbv2Client services.ELBV2, cloudProvider aws.CloudProvider, tgb *elbv2api.TargetGroupBinding) (services.ELBV2, error) {
+	if tgb.Spec.IAMRoleARNToAssume == "" {
+		return elbv2Client, nil
+	}
+	cloud, err := cloudProvider.CloudForRole(&aws.AssumeRoleConfig{
+		RoleARN:    tgb.Spec.IAMRoleARNToAssume,
+		ExternalID: tgb.Spec.AssumeRoleExternalID,
+		VpcID:      tgb.Spec.VpcID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloud.ELBV2(), nil
+}
+
 // +kubebuilder:webhook:path=/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding,mutating=true,failurePolicy=fail,groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=create;update,versions=v1beta1,name=mtargetgroupbinding.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (m *targetGroupBindingMutator) SetupWithManager(mgr ctrl.Manager) {
@@ -356,7 +356,11 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: tt.args.tgARN,
+				},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -483,7 +487,11 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: tt.args.tgARN,
+				},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -566,7 +574,11 @@ func Test_targetGroupBindingMutator_obtainSDKVpcIDFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.getVpcIDFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.getVpcIDFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: tt.args.tgARN,
+				},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -9,9 +9,12 @@ import (
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,9 +28,10 @@ const (
 // NewIngressValidator returns a validator for Ingress API.
 func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, logger logr.Logger) *ingressValidator {
 	return &ingressValidator{
+		k8sClient:                          client,
 		annotationParser:                   annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
 		classAnnotationMatcher:             ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass),
-		classLoader:                        ingress.NewDefaultClassLoader(client, false),
+		classLoader:                        ingress.NewDefaultClassLoader(client, true),
 		disableIngressClassAnnotation:      ingConfig.DisableIngressClassAnnotation,
 		disableIngressGroupAnnotation:      ingConfig.DisableIngressGroupNameAnnotation,
 		manageIngressesWithoutIngressClass: ingConfig.IngressClass == "",
@@ -38,6 +42,7 @@ func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, l
 var _ webhook.Validator = &ingressValidator{}
 
 type ingressValidator struct {
+	k8sClient                     client.Client
 	annotationParser              annotations.Parser
 	classAnnotationMatcher        ingress.ClassAnnotationMatcher
 	classLoader                   ingress.ClassLoader
@@ -70,6 +75,9 @@ func (v *ingressValidator) ValidateCreate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.checkIngressClassParamsAddOnsUsage(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -91,6 +99,9 @@ func (v *ingressValidator) ValidateUpdate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.checkIngressClassParamsAddOnsUsage(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -226,6 +237,91 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 	return nil
 }
 
+// checkIngressClassParamsAddOnsUsage checks the usage of WAF and Shield annotations, along with the WAF and Shield settings in LoadBalancerConfiguration of the Ingress.
+// they cannot conflict with the WAF and Shield settings enforced by IngressClassParams of the Ingress.
+func (v *ingressValidator) checkIngressClassParamsAddOnsUsage(ctx context.Context, ing *networking.Ingress) error {
+	classConfig, err := v.classLoader.Load(ctx, ing)
+	if err != nil || classConfig.IngClassParams == nil {
+		return err
+	}
+	ingClassParams := classConfig.IngClassParams
+	lbConfig, err := v.loadLoadBalancerConfiguration(ctx, ing, ingClassParams)
+	if err != nil {
+		return err
+	}
+	if ingClassParams.Spec.WebACL != "" || ingClassParams.Spec.WAFv2ACLArn != "" {
+		if err := v.checkAnnotationMatchesIngressClassParams(ing, ingClassParams, annotations.IngressSuffixWAFv2WebACL, "webACL", ingClassParams.Spec.WebACL); err != nil {
+			return err
+		}
+		if err := v.checkAnnotationMatchesIngressClassParams(ing, ingClassParams, annotations.IngressSuffixWAFv2ACLARN, "wafv2AclArn", ingClassParams.Spec.WAFv2ACLArn); err != nil {
+			return err
+		}
+		if lbConfig != nil && lbConfig.Spec.WAFv2ACLArn != "" && lbConfig.Spec.WAFv2ACLArn != ingClassParams.Spec.WAFv2ACLArn {
+			return errors.Errorf("spec.wafv2AclArn of LoadBalancerConfiguration %v conflicts with spec.wafv2AclArn of IngressClassParams %v",
+				k8s.NamespacedName(lbConfig), ingClassParams.Name)
+		}
+	}
+	if ingClassParams.Spec.WAFACLID != "" {
+		if err := v.checkAnnotationMatchesIngressClassParams(ing, ingClassParams, annotations.IngressSuffixWAFACLID, "wafAclId", ingClassParams.Spec.WAFACLID); err != nil {
+			return err
+		}
+		if err := v.checkAnnotationMatchesIngressClassParams(ing, ingClassParams, annotations.IngressSuffixWebACLID, "wafAclId", ingClassParams.Spec.WAFACLID); err != nil {
+			return err
+		}
+		if lbConfig != nil && lbConfig.Spec.WAFACLID != "" && lbConfig.Spec.WAFACLID != ingClassParams.Spec.WAFACLID {
+			return errors.Errorf("spec.wafAclId of LoadBalancerConfiguration %v conflicts with spec.wafAclId of IngressClassParams %v",
+				k8s.NamespacedName(lbConfig), ingClassParams.Name)
+		}
+	}
+	if ingClassParams.Spec.ShieldAdvancedProtection != nil {
+		if lbConfig != nil && lbConfig.Spec.ShieldAdvancedProtection != nil && *lbConfig.Spec.ShieldAdvancedProtection != *ingClassParams.Spec.ShieldAdvancedProtection {
+			return errors.Errorf("spec.shieldAdvancedProtection of LoadBalancerConfiguration %v conflicts with spec.shieldAdvancedProtection of IngressClassParams %v",
+				k8s.NamespacedName(lbConfig), ingClassParams.Name)
+		}
+		enableProtection := false
+		exists, err := v.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixShieldAdvancedProtection, &enableProtection, ing.Annotations)
+		if err != nil {
+			return err
+		}
+		if exists && enableProtection != *ingClassParams.Spec.ShieldAdvancedProtection {
+			return errors.Errorf("`%s/%s` annotation conflicts with spec.shieldAdvancedProtection of IngressClassParams %v",
+				annotations.AnnotationPrefixIngress, annotations.IngressSuffixShieldAdvancedProtection, ingClassParams.Name)
+		}
+	}
+	return nil
+}
+
+// checkAnnotationMatchesIngressClassParams checks that the annotation is either absent or has the same value as field of IngressClassParams.
+func (v *ingressValidator) checkAnnotationMatchesIngressClassParams(ing *networking.Ingress, ingClassParams *elbv2api.IngressClassParams,
+	annotationSuffix string, fieldName string, fieldValue string) error {
+	rawValue := ""
+	if exists := v.annotationParser.ParseStringAnnotation(annotationSuffix, &rawValue, ing.Annotations); exists && rawValue != fieldValue {
+		return errors.Errorf("`%s/%s` annotation conflicts with spec.%s of IngressClassParams %v",
+			annotations.AnnotationPrefixIngress, annotationSuffix, fieldName, ingClassParams.Name)
+	}
+	return nil
+}
+
+// loadLoadBalancerConfiguration loads the LoadBalancerConfiguration of the Ingress, if any.
+// the LoadBalancerConfiguration referenced by IngressClassParams takes higher priority than the one referenced by annotation on Ingress.
+func (v *ingressValidator) loadLoadBalancerConfiguration(ctx context.Context, ing *networking.Ingress, ingClassParams *elbv2api.IngressClassParams) (*elbv2api.LoadBalancerConfiguration, error) {
+	var lbConfigKey types.NamespacedName
+	if lbConfigRef := ingClassParams.Spec.LoadBalancerConfiguration; lbConfigRef != nil {
+		lbConfigKey = types.NamespacedName{Namespace: lbConfigRef.Namespace, Name: lbConfigRef.Name}
+	} else {
+		lbConfigName := ""
+		if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerConfiguration, &lbConfigName, ing.Annotations); !exists || lbConfigName == "" {
+			return nil, nil
+		}
+		lbConfigKey = types.NamespacedName{Namespace: ing.Namespace, Name: lbConfigName}
+	}
+	lbConfig := &elbv2api.LoadBalancerConfiguration{}
+	if err := v.k8sClient.Get(ctx, lbConfigKey, lbConfig); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return lbConfig, nil
+}
+
 // +kubebuilder:webhook:path=/validate-networking-v1-ingress,mutating=false,failurePolicy=fail,groups=networking.k8s.io,resources=ingresses,verbs=create;update,versions=v1,name=vingress.elbv2.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -1028,6 +1028,160 @@ func Test_ingressValidator_checkIngressClassUsage(t *testing.T) {
 	}
 }
 
+func Test_ingressValidator_checkIngressClassParamsAddOnsUsage(t *testing.T) {
+	ingClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String("elbv2.k8s.aws"),
+				Kind:     "IngressClassParams",
+				Name:     "awesome-class-params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			WAFv2ACLArn:              "wafv2-arn-1",
+			WAFACLID:                 "none",
+			ShieldAdvancedProtection: awssdk.Bool(true),
+		},
+	}
+	otherIngClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "other-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+		},
+	}
+	lbConfig := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-lb-config",
+		},
+		Spec: elbv2api.LoadBalancerConfigurationSpec{
+			WAFv2ACLArn:              "wafv2-arn-1",
+			ShieldAdvancedProtection: awssdk.Bool(true),
+		},
+	}
+	conflictingLBConfig := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "conflicting-lb-config",
+		},
+		Spec: elbv2api.LoadBalancerConfigurationSpec{
+			WAFACLID: "web-acl-id-1",
+		},
+	}
+	newIngress := func(ingClassName string, ingAnnotations map[string]string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        "awesome-ing",
+				Annotations: ingAnnotations,
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: awssdk.String(ingClassName),
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		ing     *networking.Ingress
+		wantErr error
+	}{
+		{
+			name: "Ingress without annotations",
+			ing:  newIngress("awesome-class", nil),
+		},
+		{
+			name: "Ingress with annotations matching IngressClassParams",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn":              "wafv2-arn-1",
+				"alb.ingress.kubernetes.io/waf-acl-id":                 "none",
+				"alb.ingress.kubernetes.io/shield-advanced-protection": "true",
+			}),
+		},
+		{
+			name: "Ingress with conflicting wafv2-acl-arn annotation",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": "none",
+			}),
+			wantErr: errors.New("`alb.ingress.kubernetes.io/wafv2-acl-arn` annotation conflicts with spec.wafv2AclArn of IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "Ingress with conflicting wafv2-web-acl annotation",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-web-acl": "my-web-acl",
+			}),
+			wantErr: errors.New("`alb.ingress.kubernetes.io/wafv2-web-acl` annotation conflicts with spec.webACL of IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "Ingress with conflicting web-acl-id annotation",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/web-acl-id": "web-acl-id-1",
+			}),
+			wantErr: errors.New("`alb.ingress.kubernetes.io/web-acl-id` annotation conflicts with spec.wafAclId of IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "Ingress with conflicting shield-advanced-protection annotation",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/shield-advanced-protection": "false",
+			}),
+			wantErr: errors.New("`alb.ingress.kubernetes.io/shield-advanced-protection` annotation conflicts with spec.shieldAdvancedProtection of IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "Ingress with LoadBalancerConfiguration matching IngressClassParams",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-lb-config",
+			}),
+		},
+		{
+			name: "Ingress with LoadBalancerConfiguration conflicting with IngressClassParams",
+			ing: newIngress("awesome-class", map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-configuration": "conflicting-lb-config",
+			}),
+			wantErr: errors.New("spec.wafAclId of LoadBalancerConfiguration awesome-ns/conflicting-lb-config conflicts with spec.wafAclId of IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "Ingress with IngressClass without IngressClassParams",
+			ing: newIngress("other-class", map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": "none",
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				WithObjects(ingClass, otherIngClass, ingClassParams, lbConfig, conflictingLBConfig).
+				Build()
+
+			v := &ingressValidator{
+				k8sClient:        k8sClient,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classLoader:      ingress.NewDefaultClassLoader(k8sClient, true),
+			}
+			err := v.checkIngressClassParamsAddOnsUsage(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_ingressValidator_checkIngressAnnotationConditions(t *testing.T) {
 	type fields struct {
 		disableIngressGroupAnnotation bool